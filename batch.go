@@ -0,0 +1,230 @@
+// batch.go - Batch interface
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/subtle"
+	"errors"
+)
+
+// ErrInvalidBatch is the error thrown via a panic when the slices passed to
+// a batch operation have inconsistent lengths.
+var ErrInvalidBatch = errors.New("morus: inconsistent batch lengths")
+
+// SealBatch seals each plaintexts[i] with nonces[i] and additionalData[i],
+// appending the result to dst[i], and returns the updated slices.  It is
+// equivalent to calling Seal for each message, but is considerably faster
+// when the implementation can process multiple messages at once (eg: AVX2).
+//
+// The dst and additionalData slices may be nil, in which case every message
+// is treated as having a nil dst or additional data respectively.
+// Otherwise all of the slices must be the same length.  The aliasing rules
+// of Seal apply to each message.
+func (ae *AEAD) SealBatch(dst, nonces, plaintexts, additionalData [][]byte) [][]byte {
+	ae.checkKey()
+
+	n := len(plaintexts)
+	checkBatch(n, dst, nonces, additionalData)
+
+	impl := ae.getImpl()
+	ret := make([][]byte, n)
+
+	// The interleaved routines only support full size tags.
+	i := 0
+	if impl.initX2Fn != nil && ae.tagSize == TagSize {
+		for ; i+1 < n; i += 2 {
+			j := i + 1
+			ret[i], ret[j] = impl.sealX2(
+				batchEntry(dst, i), batchEntry(dst, j),
+				plaintexts[i], plaintexts[j],
+				batchEntry(additionalData, i), batchEntry(additionalData, j),
+				nonces[i], nonces[j],
+				ae.key,
+			)
+		}
+	}
+	for ; i < n; i++ {
+		ret[i] = impl.seal(batchEntry(dst, i), plaintexts[i], batchEntry(additionalData, i), nonces[i], ae.key, ae.tagSize)
+	}
+
+	return ret
+}
+
+// OpenBatch opens each ciphertexts[i] with nonces[i] and additionalData[i],
+// appending the resulting plaintext to dst[i], and returns the updated
+// slices.  It is equivalent to calling Open for each message, but is
+// considerably faster when the implementation can process multiple messages
+// at once (eg: AVX2).
+//
+// The returned error slice is nil iff every message was authenticated.
+// Otherwise it is the same length as ciphertexts, and the entry for each
+// message that failed to authenticate is ErrOpen, with the corresponding
+// plaintext entry set to nil.
+//
+// The dst and additionalData slices may be nil, in which case every message
+// is treated as having a nil dst or additional data respectively.
+// Otherwise all of the slices must be the same length.  The aliasing rules
+// of Open apply to each message.
+func (ae *AEAD) OpenBatch(dst, nonces, ciphertexts, additionalData [][]byte) ([][]byte, []error) {
+	ae.checkKey()
+
+	n := len(ciphertexts)
+	checkBatch(n, dst, nonces, additionalData)
+
+	impl := ae.getImpl()
+	ret := make([][]byte, n)
+	oks := make([]bool, n)
+
+	// Messages that are too short to have a tag can't be paired up, and
+	// are rejected without further processing.
+	pending := make([]int, 0, n)
+	for i, c := range ciphertexts {
+		if len(c) >= ae.tagSize {
+			pending = append(pending, i)
+		}
+	}
+
+	if impl.initX2Fn != nil && ae.tagSize == TagSize {
+		for len(pending) > 1 {
+			i, j := pending[0], pending[1]
+			ret[i], oks[i], ret[j], oks[j] = impl.openX2(
+				batchEntry(dst, i), batchEntry(dst, j),
+				ciphertexts[i], ciphertexts[j],
+				batchEntry(additionalData, i), batchEntry(additionalData, j),
+				nonces[i], nonces[j],
+				ae.key,
+			)
+			pending = pending[2:]
+		}
+	}
+	for _, i := range pending {
+		ret[i], oks[i] = impl.open(batchEntry(dst, i), ciphertexts[i], batchEntry(additionalData, i), nonces[i], ae.key, ae.tagSize)
+	}
+
+	var errs []error
+	for i, ok := range oks {
+		if ok {
+			continue
+		}
+		if errs == nil {
+			errs = make([]error, n)
+		}
+		ret[i], errs[i] = nil, ErrOpen
+	}
+
+	return ret, errs
+}
+
+func checkBatch(n int, dst, nonces, additionalData [][]byte) {
+	if len(nonces) != n || (dst != nil && len(dst) != n) || (additionalData != nil && len(additionalData) != n) {
+		panic(ErrInvalidBatch)
+	}
+	for _, nonce := range nonces {
+		if len(nonce) != NonceSize {
+			panic(ErrInvalidNonceSize)
+		}
+	}
+}
+
+func batchEntry(v [][]byte, i int) []byte {
+	if v == nil {
+		return nil
+	}
+	return v[i]
+}
+
+// commonBlocks returns the length of the longest prefix, consisting of
+// entire blocks, that two inputs of the specified lengths have in common.
+func commonBlocks(len0, len1 int) int {
+	if len1 < len0 {
+		len0 = len1
+	}
+	return len0 &^ (blockSize - 1)
+}
+
+// absorbDataX2 absorbs arbitrary length input into two states, interleaving
+// the processing for as long as both inputs have full blocks remaining.
+func (impl *hwaccelImpl) absorbDataX2(s0, s1 *state, in0, in1 []byte) {
+	n := commonBlocks(len(in0), len(in1))
+	if n > 0 {
+		impl.absorbBlocksX2Fn(s0, s1, in0[:n], in1[:n])
+	}
+	impl.absorbData(s0, in0[n:])
+	impl.absorbData(s1, in1[n:])
+}
+
+// encryptDataX2 encrypts arbitrary length input with two states,
+// interleaving the processing for as long as both inputs have full blocks
+// remaining.
+func (impl *hwaccelImpl) encryptDataX2(s0, s1 *state, out0, in0, out1, in1 []byte) {
+	n := commonBlocks(len(in0), len(in1))
+	if n > 0 {
+		impl.encryptBlocksX2Fn(s0, s1, out0[:n], in0[:n], out1[:n], in1[:n])
+	}
+	impl.encryptData(s0, out0[n:], in0[n:])
+	impl.encryptData(s1, out1[n:], in1[n:])
+}
+
+// decryptDataX2 decrypts arbitrary length input with two states,
+// interleaving the processing for as long as both inputs have full blocks
+// remaining.
+func (impl *hwaccelImpl) decryptDataX2(s0, s1 *state, out0, in0, out1, in1 []byte) {
+	n := commonBlocks(len(in0), len(in1))
+	if n > 0 {
+		impl.decryptBlocksX2Fn(s0, s1, out0[:n], in0[:n], out1[:n], in1[:n])
+	}
+	impl.decryptData(s0, out0[n:], in0[n:])
+	impl.decryptData(s1, out1[n:], in1[n:])
+}
+
+func (impl *hwaccelImpl) sealX2(dst0, dst1, m0, m1, a0, a1, nonce0, nonce1, key []byte) ([]byte, []byte) {
+	var s0, s1 state
+
+	mLen0, mLen1 := len(m0), len(m1)
+	ret0, out0 := sliceForAppend(dst0, mLen0+TagSize)
+	ret1, out1 := sliceForAppend(dst1, mLen1+TagSize)
+
+	impl.initX2Fn(&s0, &s1, key, nonce0, nonce1)
+	impl.absorbDataX2(&s0, &s1, a0, a1)
+	impl.encryptDataX2(&s0, &s1, out0, m0, out1, m1)
+	impl.finalizeX2Fn(&s0, &s1, uint64(mLen0), uint64(len(a0)), uint64(mLen1), uint64(len(a1)), out0[mLen0:], out1[mLen1:])
+
+	burnUint64s(s0.s[:])
+	burnUint64s(s1.s[:])
+
+	return ret0, ret1
+}
+
+func (impl *hwaccelImpl) openX2(dst0, dst1, c0, c1, a0, a1, nonce0, nonce1, key []byte) ([]byte, bool, []byte, bool) {
+	var s0, s1 state
+	var tag0, tag1 [TagSize]byte
+
+	mLen0, mLen1 := len(c0)-TagSize, len(c1)-TagSize
+	ret0, out0 := sliceForAppend(dst0, mLen0)
+	ret1, out1 := sliceForAppend(dst1, mLen1)
+
+	impl.initX2Fn(&s0, &s1, key, nonce0, nonce1)
+	impl.absorbDataX2(&s0, &s1, a0, a1)
+	impl.decryptDataX2(&s0, &s1, out0, c0[:mLen0], out1, c1[:mLen1])
+	impl.finalizeX2Fn(&s0, &s1, uint64(mLen0), uint64(len(a0)), uint64(mLen1), uint64(len(a1)), tag0[:], tag1[:])
+
+	burnUint64s(s0.s[:])
+	burnUint64s(s1.s[:])
+
+	ok0 := subtle.ConstantTimeCompare(c0[mLen0:], tag0[:]) == 1
+	if !ok0 && mLen0 > 0 {
+		// Burn decrypted plaintext on auth failure.
+		burnBytes(out0)
+	}
+	ok1 := subtle.ConstantTimeCompare(c1[mLen1:], tag1[:]) == 1
+	if !ok1 && mLen1 > 0 {
+		burnBytes(out1)
+	}
+
+	return ret0, ok0, ret1, ok1
+}
@@ -0,0 +1,157 @@
+// batch_test.go - Batch interface tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	for _, name := range Implementations() {
+		t.Run("Batch_"+name, func(t *testing.T) { doTestBatch(t, name) })
+	}
+}
+
+func doTestBatch(t *testing.T, implName string) {
+	require := require.New(t)
+
+	var key [KeySize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+
+	aead := New(key[:])
+	err = aead.SetImplementation(implName)
+	require.NoError(err, "SetImplementation(%s)", implName)
+
+	// Mix matching and mismatched lengths so that both the interleaved and
+	// the single state tails get exercised, along with an odd count.
+	sizes := [][2]int{
+		{0, 0}, {1, 0}, {32, 0}, {64, 96}, {33, 7}, {1024, 1000},
+		{100, 64}, {31, 32}, {4099, 12}, {0, 45}, {257, 257},
+	}
+	n := len(sizes)
+	nonces, ms, as := make([][]byte, n), make([][]byte, n), make([][]byte, n)
+	for i, sz := range sizes {
+		nonces[i], ms[i], as[i] = make([]byte, NonceSize), make([]byte, sz[0]), make([]byte, sz[1])
+		_, _ = rand.Read(nonces[i])
+		_, _ = rand.Read(ms[i])
+		_, _ = rand.Read(as[i])
+	}
+
+	cs := aead.SealBatch(nil, nonces, ms, as)
+	require.Len(cs, n, "SealBatch(): len(cs)")
+	for i := range cs {
+		require.Equal(aead.Seal(nil, nonces[i], ms[i], as[i]), cs[i], "SealBatch(): %d", i)
+	}
+
+	ds, errs := aead.OpenBatch(nil, nonces, cs, as)
+	require.Nil(errs, "OpenBatch()")
+	for i := range ds {
+		require.Len(ds[i], len(ms[i]), "OpenBatch(): len(d) %d", i)
+		if len(ms[i]) != 0 {
+			require.Equal(ms[i], ds[i], "OpenBatch(): %d", i)
+		}
+	}
+
+	// Test in-place operation, and a nil additional data batch.
+	bufs := make([][]byte, n)
+	for i := range bufs {
+		bufs[i] = make([]byte, len(ms[i]), len(ms[i])+TagSize)
+		copy(bufs[i], ms[i])
+		bufs[i] = bufs[i][:0]
+	}
+	ins := make([][]byte, n)
+	for i := range ins {
+		ins[i] = bufs[i][:len(ms[i])]
+	}
+	cs = aead.SealBatch(bufs, nonces, ins, nil)
+	for i := range cs {
+		require.Equal(aead.Seal(nil, nonces[i], ms[i], nil), cs[i], "SealBatch(In place): %d", i)
+		bufs[i] = cs[i][:0]
+	}
+	ds, errs = aead.OpenBatch(bufs, nonces, cs, nil)
+	require.Nil(errs, "OpenBatch(In place)")
+	for i := range ds {
+		if len(ms[i]) != 0 {
+			require.Equal(ms[i], ds[i], "OpenBatch(In place): %d", i)
+		}
+	}
+
+	// Test that failures are isolated to the malformed messages.
+	cs = aead.SealBatch(nil, nonces, ms, as)
+	bad := map[int]bool{1: true, 4: true, 10: true}
+	for i := range bad {
+		cs[i][len(cs[i])-1] ^= 0x23
+	}
+	cs[7] = cs[7][:TagSize-1]
+	bad[7] = true
+	ds, errs = aead.OpenBatch(nil, nonces, cs, as)
+	require.Len(errs, n, "OpenBatch(Bad c): len(errs)")
+	for i := range cs {
+		if bad[i] {
+			require.Equal(ErrOpen, errs[i], "OpenBatch(Bad c): %d", i)
+			require.Nil(ds[i], "OpenBatch(Bad c): %d", i)
+		} else {
+			require.NoError(errs[i], "OpenBatch(Bad c): %d", i)
+			require.Len(ds[i], len(ms[i]), "OpenBatch(Bad c): len(d) %d", i)
+		}
+	}
+
+	require.Panics(func() { aead.SealBatch(nil, nonces[1:], ms, as) }, "SealBatch(Short nonces)")
+	require.Panics(func() { aead.OpenBatch(nil, nonces, cs, as[1:]) }, "OpenBatch(Short AD)")
+	require.Panics(func() { aead.SealBatch(make([][]byte, 1), nonces, ms, as) }, "SealBatch(Short dst)")
+	badNonces := append([][]byte{}, nonces...)
+	badNonces[3] = badNonces[3][1:]
+	require.Panics(func() { aead.SealBatch(nil, badNonces, ms, as) }, "SealBatch(Bad nonce)")
+}
+
+func BenchmarkBatch(b *testing.B) {
+	const batchSize = 64
+
+	for _, name := range Implementations() {
+		for _, sz := range []int{64, 576, 1536} {
+			sn := fmt.Sprintf("_%d", sz)
+			b.Run("Seal_"+name+sn, func(b *testing.B) { doBenchmarkBatch(b, name, sz, batchSize, false) })
+			b.Run("SealBatch_"+name+sn, func(b *testing.B) { doBenchmarkBatch(b, name, sz, batchSize, true) })
+		}
+	}
+}
+
+func doBenchmarkBatch(b *testing.B, implName string, sz, batchSize int, batch bool) {
+	b.StopTimer()
+	b.SetBytes(int64(sz * batchSize))
+
+	key := make([]byte, KeySize)
+	rand.Read(key)
+	aead := New(key)
+	if err := aead.SetImplementation(implName); err != nil {
+		b.Fatal(err)
+	}
+
+	nonces, ms, dst := make([][]byte, batchSize), make([][]byte, batchSize), make([][]byte, batchSize)
+	for i := range ms {
+		nonces[i], ms[i], dst[i] = make([]byte, NonceSize), make([]byte, sz), make([]byte, 0, sz+TagSize)
+		rand.Read(nonces[i])
+		rand.Read(ms[i])
+	}
+
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		if batch {
+			aead.SealBatch(dst, nonces, ms, nil)
+			continue
+		}
+		for j := range ms {
+			aead.Seal(dst[j], nonces[j], ms[j], nil)
+		}
+	}
+}
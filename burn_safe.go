@@ -9,8 +9,6 @@
 
 package morus
 
-import "unsafe"
-
 func burnBytes(b []byte) {
 	for i := range b {
 		b[i] = 0
@@ -22,3 +20,9 @@ func burnUint64s(b []uint64) {
 		b[i] = 0
 	}
 }
+
+func burnUint32s(b []uint32) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -10,6 +10,7 @@ package morus
 import (
 	"crypto/rand"
 	"encoding/binary"
+	"os/exec"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -44,3 +45,34 @@ func TestBurnUint64s(t *testing.T) {
 	burnUint64s(buf[:])
 	require.Zero(buf, "buf: After burnUint64s()")
 }
+
+func TestBurnUint32s(t *testing.T) {
+	require := require.New(t)
+
+	var buf [1024]uint32
+	require.Zero(buf, "buf: Before random read")
+
+	err := binary.Read(rand.Reader, binary.LittleEndian, &buf)
+	require.NoError(err, "binary.Read(rand.Reader)")
+	require.NotZero(buf, "buf: After random read")
+
+	burnUint32s(buf[:])
+	require.Zero(buf, "buf: After burnUint32s()")
+}
+
+// TestBuildTags vets the package with the portable-only build tags, as
+// burn_safe.go is never compiled by a default `go test`.
+func TestBuildTags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping build tag checks in short mode.")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not available.")
+	}
+
+	for _, tag := range []string{"noasm", "appengine"} {
+		out, err := exec.Command(goBin, "vet", "-tags", tag, ".").CombinedOutput()
+		require.NoError(t, err, "go vet -tags %s: %s", tag, out)
+	}
+}
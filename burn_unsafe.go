@@ -27,3 +27,8 @@ func burnUint64s(b []uint64) {
 	l := len(b) * 8
 	memclrNoHeapPointers(unsafe.Pointer(&b[0]), uintptr(l))
 }
+
+func burnUint32s(b []uint32) {
+	l := len(b) * 4
+	memclrNoHeapPointers(unsafe.Pointer(&b[0]), uintptr(l))
+}
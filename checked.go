@@ -0,0 +1,217 @@
+// checked.go - Error returning interface
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"io"
+	"strconv"
+)
+
+// KeySizeError is the error returned when a key is an invalid size.  It
+// matches ErrInvalidKeySize when used with errors.Is.
+//
+// Every constructor for an AEAD, incremental or segmented stream instance
+// has a checked variant that returns one of these errors instead of
+// panicking.  The low level NewSession, NewMAC, NewDRBG and State.Init do
+// not, as their callers are building constructions that fix the sizes in
+// advance.
+type KeySizeError struct {
+	Expected int
+	Actual   int
+
+	// Alternate is the other accepted key size for constructors that
+	// accept two (eg: NewWithTagSizeChecked), and 0 otherwise.
+	Alternate int
+}
+
+func (e *KeySizeError) Error() string {
+	s := "morus: invalid key size " + strconv.Itoa(e.Actual) + ", expected " + strconv.Itoa(e.Expected)
+	if e.Alternate != 0 {
+		s += " or " + strconv.Itoa(e.Alternate)
+	}
+	return s
+}
+
+// Is returns true iff target is ErrInvalidKeySize.
+func (e *KeySizeError) Is(target error) bool {
+	return target == ErrInvalidKeySize
+}
+
+// NonceSizeError is the error returned when a nonce is an invalid size.  It
+// matches ErrInvalidNonceSize when used with errors.Is.
+type NonceSizeError struct {
+	Expected int
+	Actual   int
+}
+
+func (e *NonceSizeError) Error() string {
+	return "morus: invalid nonce size " + strconv.Itoa(e.Actual) + ", expected " + strconv.Itoa(e.Expected)
+}
+
+// Is returns true iff target is ErrInvalidNonceSize.
+func (e *NonceSizeError) Is(target error) bool {
+	return target == ErrInvalidNonceSize
+}
+
+// TagSizeError is the error returned when a tag size is invalid.  It matches
+// ErrInvalidTagSize when used with errors.Is.
+type TagSizeError struct {
+	Min    int
+	Max    int
+	Actual int
+}
+
+func (e *TagSizeError) Error() string {
+	return "morus: invalid tag size " + strconv.Itoa(e.Actual) + ", expected " + strconv.Itoa(e.Min) + " to " + strconv.Itoa(e.Max)
+}
+
+// Is returns true iff target is ErrInvalidTagSize.
+func (e *TagSizeError) Is(target error) bool {
+	return target == ErrInvalidTagSize
+}
+
+// NewAEAD returns a new keyed MORUS-1280-256 instance.  Unlike New, an
+// invalid key results in a *KeySizeError being returned rather than a
+// panic.
+func NewAEAD(key []byte) (*AEAD, error) {
+	if err := checkKeySize(key, KeySize); err != nil {
+		return nil, err
+	}
+	return New(key), nil
+}
+
+// New128Checked is New128, except that an invalid key results in a
+// *KeySizeError being returned rather than a panic.
+func New128Checked(key []byte) (*AEAD, error) {
+	if err := checkKeySize(key, KeySize128); err != nil {
+		return nil, err
+	}
+	return New128(key), nil
+}
+
+// NewWithTagSizeChecked is NewWithTagSize, except that an invalid tag size
+// or key results in a *TagSizeError or a *KeySizeError being returned rather
+// than a panic.  The KeySizeError reports both KeySize and KeySize128 as
+// accepted.
+func NewWithTagSizeChecked(key []byte, tagSize int) (*AEAD, error) {
+	if tagSize < MinTagSize || tagSize > TagSize {
+		return nil, &TagSizeError{Min: MinTagSize, Max: TagSize, Actual: tagSize}
+	}
+	if len(key) != KeySize && len(key) != KeySize128 {
+		return nil, &KeySizeError{Expected: KeySize, Alternate: KeySize128, Actual: len(key)}
+	}
+	return NewWithTagSize(key, tagSize), nil
+}
+
+// New640Checked is New640, except that an invalid key results in a
+// *KeySizeError being returned rather than a panic.
+func New640Checked(key []byte) (*AEAD640, error) {
+	if err := checkKeySize(key, KeySize640); err != nil {
+		return nil, err
+	}
+	return New640(key), nil
+}
+
+// NewSIVChecked is NewSIV, except that an invalid key results in a
+// *KeySizeError being returned rather than a panic.
+func NewSIVChecked(key []byte) (*SIV, error) {
+	if err := checkKeySize(key, SIVKeySize); err != nil {
+		return nil, err
+	}
+	return NewSIV(key), nil
+}
+
+// NewXChecked is NewX, except that an invalid key results in a
+// *KeySizeError being returned rather than a panic.
+func NewXChecked(key []byte) (*XAEAD, error) {
+	if err := checkKeySize(key, KeySize); err != nil {
+		return nil, err
+	}
+	return NewX(key), nil
+}
+
+// NewSealerChecked is NewSealer, except that an invalid key or nonce results
+// in a *KeySizeError or *NonceSizeError being returned rather than a panic.
+func NewSealerChecked(key, nonce []byte) (*Sealer, error) {
+	if err := checkKeyNonceSize(key, KeySize, nonce, NonceSize); err != nil {
+		return nil, err
+	}
+	return NewSealer(key, nonce), nil
+}
+
+// NewOpenerChecked is NewOpener, except that an invalid key or nonce results
+// in a *KeySizeError or *NonceSizeError being returned rather than a panic.
+func NewOpenerChecked(key, nonce []byte) (*Opener, error) {
+	if err := checkKeyNonceSize(key, KeySize, nonce, NonceSize); err != nil {
+		return nil, err
+	}
+	return NewOpener(key, nonce), nil
+}
+
+// NewWriterChecked is NewWriter, except that an invalid key or nonce prefix
+// results in a *KeySizeError or *NonceSizeError being returned rather than a
+// panic.
+func NewWriterChecked(w io.Writer, key, noncePrefix []byte) (*Writer, error) {
+	if err := checkKeyNonceSize(key, KeySize, noncePrefix, NoncePrefixSize); err != nil {
+		return nil, err
+	}
+	return NewWriter(w, key, noncePrefix), nil
+}
+
+// NewReaderChecked is NewReader, except that an invalid key or nonce prefix
+// results in a *KeySizeError or *NonceSizeError being returned rather than a
+// panic.
+func NewReaderChecked(r io.Reader, key, noncePrefix []byte) (*Reader, error) {
+	if err := checkKeyNonceSize(key, KeySize, noncePrefix, NoncePrefixSize); err != nil {
+		return nil, err
+	}
+	return NewReader(r, key, noncePrefix), nil
+}
+
+func checkKeySize(key []byte, expected int) error {
+	if len(key) != expected {
+		return &KeySizeError{Expected: expected, Actual: len(key)}
+	}
+	return nil
+}
+
+func checkKeyNonceSize(key []byte, expectedKey int, nonce []byte, expectedNonce int) error {
+	if err := checkKeySize(key, expectedKey); err != nil {
+		return err
+	}
+	if len(nonce) != expectedNonce {
+		return &NonceSizeError{Expected: expectedNonce, Actual: len(nonce)}
+	}
+	return nil
+}
+
+// SealChecked is Seal, except that an invalid nonce or a reset instance
+// results in a *NonceSizeError or ErrInvalidState being returned rather
+// than a panic.
+func (ae *AEAD) SealChecked(dst, nonce, plaintext, additionalData []byte) ([]byte, error) {
+	if ae.key == nil {
+		return nil, ErrInvalidState
+	}
+	if len(nonce) != NonceSize {
+		return nil, &NonceSizeError{Expected: NonceSize, Actual: len(nonce)}
+	}
+	return ae.Seal(dst, nonce, plaintext, additionalData), nil
+}
+
+// OpenChecked is Open, except that an invalid nonce or a reset instance
+// results in a *NonceSizeError or ErrInvalidState being returned rather
+// than a panic.  Authentication failures are still reported as ErrOpen.
+func (ae *AEAD) OpenChecked(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	if ae.key == nil {
+		return nil, ErrInvalidState
+	}
+	if len(nonce) != NonceSize {
+		return nil, &NonceSizeError{Expected: NonceSize, Actual: len(nonce)}
+	}
+	return ae.Open(dst, nonce, ciphertext, additionalData)
+}
@@ -0,0 +1,127 @@
+// checked_test.go - Error returning interface tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecked(t *testing.T) {
+	require := require.New(t)
+
+	var key [KeySize]byte
+	var nonce [NonceSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+
+	aead, err := NewAEAD(key[:KeySize-1])
+	require.Nil(aead, "NewAEAD(Short key)")
+	require.True(errors.Is(err, ErrInvalidKeySize), "NewAEAD(Short key): errors.Is")
+	require.False(errors.Is(err, ErrInvalidNonceSize), "NewAEAD(Short key): errors.Is")
+	var keyErr *KeySizeError
+	require.True(errors.As(err, &keyErr), "NewAEAD(Short key): errors.As")
+	require.Equal(KeySize, keyErr.Expected, "KeySizeError.Expected")
+	require.Equal(KeySize-1, keyErr.Actual, "KeySizeError.Actual")
+	require.Equal("morus: invalid key size 31, expected 32", err.Error(), "KeySizeError.Error()")
+
+	ae, err := NewAEAD(key[:])
+	require.NoError(err, "NewAEAD()")
+
+	m := []byte("The ghost of Lord Ravenscroft haunts the vacant rooms")
+	c, err := ae.SealChecked(nil, nonce[:], m, nil)
+	require.NoError(err, "SealChecked()")
+	require.Equal(ae.Seal(nil, nonce[:], m, nil), c, "SealChecked()")
+
+	d, err := ae.OpenChecked(nil, nonce[:], c, nil)
+	require.NoError(err, "OpenChecked()")
+	require.Equal(m, d, "OpenChecked()")
+
+	c[0] ^= 0x23
+	d, err = ae.OpenChecked(nil, nonce[:], c, nil)
+	require.Equal(ErrOpen, err, "OpenChecked(Bad c)")
+	require.Nil(d, "OpenChecked(Bad c)")
+
+	for _, fn := range []func() ([]byte, error){
+		func() ([]byte, error) { return ae.SealChecked(nil, nonce[1:], m, nil) },
+		func() ([]byte, error) { return ae.OpenChecked(nil, nonce[1:], c, nil) },
+	} {
+		d, err = fn()
+		require.Nil(d, "Checked(Short nonce)")
+		require.True(errors.Is(err, ErrInvalidNonceSize), "Checked(Short nonce): errors.Is")
+		var nonceErr *NonceSizeError
+		require.True(errors.As(err, &nonceErr), "Checked(Short nonce): errors.As")
+		require.Equal(NonceSize, nonceErr.Expected, "NonceSizeError.Expected")
+		require.Equal(NonceSize-1, nonceErr.Actual, "NonceSizeError.Actual")
+	}
+}
+
+func TestCheckedConstructors(t *testing.T) {
+	require := require.New(t)
+
+	key := make([]byte, SIVKeySize)
+	nonce := make([]byte, NonceSize)
+	_, err := rand.Read(key)
+	require.NoError(err, "rand.Read(key)")
+
+	var buf bytes.Buffer
+	for _, v := range []struct {
+		name      string
+		keySize   int
+		altSize   int
+		nonceSize int
+		fn        func(key, nonce []byte) (interface{}, error)
+	}{
+		{"New128", KeySize128, 0, 0, func(k, _ []byte) (interface{}, error) { return New128Checked(k) }},
+		{"NewWithTagSize", KeySize, KeySize128, 0, func(k, _ []byte) (interface{}, error) { return NewWithTagSizeChecked(k, MinTagSize) }},
+		{"New640", KeySize640, 0, 0, func(k, _ []byte) (interface{}, error) { return New640Checked(k) }},
+		{"NewSIV", SIVKeySize, 0, 0, func(k, _ []byte) (interface{}, error) { return NewSIVChecked(k) }},
+		{"NewX", KeySize, 0, 0, func(k, _ []byte) (interface{}, error) { return NewXChecked(k) }},
+		{"NewSealer", KeySize, 0, NonceSize, func(k, n []byte) (interface{}, error) { return NewSealerChecked(k, n) }},
+		{"NewOpener", KeySize, 0, NonceSize, func(k, n []byte) (interface{}, error) { return NewOpenerChecked(k, n) }},
+		{"NewWriter", KeySize, 0, NoncePrefixSize, func(k, n []byte) (interface{}, error) { return NewWriterChecked(&buf, k, n) }},
+		{"NewReader", KeySize, 0, NoncePrefixSize, func(k, n []byte) (interface{}, error) { return NewReaderChecked(&buf, k, n) }},
+	} {
+		inst, err := v.fn(key[:v.keySize], nonce[:v.nonceSize])
+		require.NoError(err, "%s()", v.name)
+		require.NotNil(inst, "%s()", v.name)
+
+		_, err = v.fn(key[:v.keySize-1], nonce[:v.nonceSize])
+		var keyErr *KeySizeError
+		require.True(errors.As(err, &keyErr), "%s(Short key): errors.As", v.name)
+		require.Equal(v.keySize, keyErr.Expected, "%s(Short key): Expected", v.name)
+		require.Equal(v.keySize-1, keyErr.Actual, "%s(Short key): Actual", v.name)
+		require.Equal(v.altSize, keyErr.Alternate, "%s(Short key): Alternate", v.name)
+
+		if v.nonceSize > 0 {
+			_, err = v.fn(key[:v.keySize], nonce[:v.nonceSize-1])
+			var nonceErr *NonceSizeError
+			require.True(errors.As(err, &nonceErr), "%s(Short nonce): errors.As", v.name)
+			require.Equal(v.nonceSize, nonceErr.Expected, "%s(Short nonce): Expected", v.name)
+		}
+	}
+
+	aead, err := NewWithTagSizeChecked(key[:KeySize128], TagSize)
+	require.NoError(err, "NewWithTagSizeChecked(KeySize128)")
+	require.Equal(TagSize, aead.Overhead(), "NewWithTagSizeChecked(KeySize128): Overhead()")
+	for _, tagSize := range []int{MinTagSize - 1, TagSize + 1} {
+		aead, err = NewWithTagSizeChecked(key[:KeySize], tagSize)
+		require.Nil(aead, "NewWithTagSizeChecked(%d)", tagSize)
+		require.True(errors.Is(err, ErrInvalidTagSize), "NewWithTagSizeChecked(%d): errors.Is", tagSize)
+		var tagErr *TagSizeError
+		require.True(errors.As(err, &tagErr), "NewWithTagSizeChecked(%d): errors.As", tagSize)
+		require.Equal(TagSizeError{Min: MinTagSize, Max: TagSize, Actual: tagSize}, *tagErr, "NewWithTagSizeChecked(%d)", tagSize)
+	}
+	require.EqualError(&KeySizeError{Expected: KeySize, Alternate: KeySize128, Actual: 1}, "morus: invalid key size 1, expected 32 or 16")
+}
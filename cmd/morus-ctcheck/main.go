@@ -0,0 +1,254 @@
+// main.go - MORUS constant time verification harness
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+// Command morus-ctcheck tests if MORUS-1280-256 Open runs in time that is
+// independent of secret data, using the methodology of dudect (Reparaz,
+// Balasch and Verbauwhede, "Dude, is my code constant time?").
+//
+// For each implementation and test, Open is timed on a randomly interleaved
+// mix of inputs from a "fixed" and a "random" class, and Welch's t-test is
+// used to check if the timing distributions of the two classes differ.  A
+// large |t| is evidence of a timing leak, while a small |t| only means that
+// no leak was detected with the number of measurements taken.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/Yawning/morus"
+)
+
+const (
+	// tThresholdModerate and tThresholdBananas are the dudect thresholds
+	// above which an implementation is probably, and definitely, not
+	// constant time.
+	tThresholdModerate = 10
+	tThresholdBananas  = 500
+
+	batchSize = 10000
+)
+
+// input is a single prepared Open call.
+type input struct {
+	aead  *morus.AEAD
+	nonce []byte
+	c     []byte
+}
+
+// env is the per implementation state shared by the inputs of a test.
+type env struct {
+	rng      *rand.Rand
+	implName string
+	size     int
+	aead     *morus.AEAD
+}
+
+func (e *env) newAEAD(key []byte) *morus.AEAD {
+	aead := morus.New(key)
+	if err := aead.SetImplementation(e.implName); err != nil {
+		panic(err)
+	}
+	return aead
+}
+
+func (e *env) random(n int) []byte {
+	b := make([]byte, n)
+	e.rng.Read(b)
+	return b
+}
+
+// fill sets b to a fixed value for class 0, and to random data for class 1.
+func (e *env) fill(b []byte, class int) {
+	if class == 1 {
+		e.rng.Read(b)
+		return
+	}
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+type ctTest struct {
+	name    string
+	desc    string
+	prepare func(e *env, class int) input
+}
+
+var ctTests = []*ctTest{
+	{
+		name: "open-key",
+		desc: "Open (invalid tag), fixed vs random key",
+		prepare: func(e *env, class int) input {
+			key := make([]byte, morus.KeySize)
+			e.fill(key, class)
+			return input{
+				aead:  e.newAEAD(key),
+				nonce: e.random(morus.NonceSize),
+				c:     e.random(e.size + morus.TagSize),
+			}
+		},
+	},
+	{
+		name: "open-tag",
+		desc: "Open (invalid tag), fixed vs random tag",
+		prepare: func(e *env, class int) input {
+			nonce := e.random(morus.NonceSize)
+			c := e.aead.Seal(nil, nonce, e.random(e.size), nil)
+			tag := c[e.size:]
+			e.fill(tag, class)
+			return input{aead: e.aead, nonce: nonce, c: c}
+		},
+	},
+	{
+		name: "open-plaintext",
+		desc: "Open (valid tag), fixed vs random plaintext",
+		prepare: func(e *env, class int) input {
+			nonce, m := e.random(morus.NonceSize), make([]byte, e.size)
+			e.fill(m, class)
+			return input{aead: e.aead, nonce: nonce, c: e.aead.Seal(nil, nonce, m, nil)}
+		},
+	},
+}
+
+func measure(inputs []input, dst []byte, inner int, times []float64) {
+	for i := range inputs {
+		in := &inputs[i]
+		start := time.Now()
+		for j := 0; j < inner; j++ {
+			_, _ = in.aead.Open(dst[:0], in.nonce, in.c, nil)
+		}
+		times[i] = float64(time.Since(start))
+	}
+}
+
+func run(test *ctTest, implName string, n, size, inner int, rng *rand.Rand) result {
+	e := &env{
+		rng:      rng,
+		implName: implName,
+		size:     size,
+	}
+	e.aead = e.newAEAD(e.random(morus.KeySize))
+
+	var lt leakageTest
+	inputs := make([]input, batchSize)
+	classes := make([]int, batchSize)
+	times := make([]float64, batchSize)
+	dst := make([]byte, 0, size)
+
+	for done := 0; done < n; done += batchSize {
+		m := batchSize
+		if n-done < m {
+			m = n - done
+		}
+		for i := 0; i < m; i++ {
+			classes[i] = rng.Intn(2)
+			inputs[i] = test.prepare(e, classes[i])
+		}
+
+		// Prepare everything up front, and keep the garbage collector
+		// out of the way while timing.
+		runtime.GC()
+		gcPercent := debug.SetGCPercent(-1)
+		measure(inputs[:m], dst, inner, times[:m])
+		debug.SetGCPercent(gcPercent)
+
+		lt.update(classes[:m], times[:m])
+	}
+
+	return lt.result()
+}
+
+func verdict(t float64) string {
+	switch {
+	case t > tThresholdBananas:
+		return "LEAK: definitely not constant time"
+	case t > tThresholdModerate:
+		return "LEAK: probably not constant time"
+	default:
+		return "ok: no leakage detected"
+	}
+}
+
+func main() {
+	n := flag.Int("n", 1000000, "number of measurements per test")
+	size := flag.Int("size", 64, "plaintext size in bytes")
+	inner := flag.Int("inner", 4, "Open calls per measurement")
+	implFlag := flag.String("impl", "", "comma separated implementations to test (default: all supported)")
+	testFlag := flag.String("test", "", "comma separated tests to run (default: all)")
+	seed := flag.Int64("seed", 0, "random seed (default: time based)")
+	list := flag.Bool("list", false, "list the tests and exit")
+	flag.Parse()
+
+	if *list {
+		for _, test := range ctTests {
+			fmt.Printf("%-15s %s\n", test.name, test.desc)
+		}
+		return
+	}
+
+	if *n < 2*minSamples || *size < 0 || *inner < 1 {
+		fmt.Fprintf(os.Stderr, "morus-ctcheck: invalid parameters\n")
+		os.Exit(2)
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	implNames := morus.Implementations()
+	if *implFlag != "" {
+		implNames = strings.Split(*implFlag, ",")
+		for _, name := range implNames {
+			if err := morus.New(make([]byte, morus.KeySize)).SetImplementation(name); err != nil {
+				fmt.Fprintf(os.Stderr, "morus-ctcheck: %s: %v\n", name, err)
+				os.Exit(2)
+			}
+		}
+	}
+
+	tests := ctTests
+	if *testFlag != "" {
+		tests = nil
+		for _, name := range strings.Split(*testFlag, ",") {
+			var found *ctTest
+			for _, test := range ctTests {
+				if test.name == name {
+					found = test
+				}
+			}
+			if found == nil {
+				fmt.Fprintf(os.Stderr, "morus-ctcheck: unknown test: %s\n", name)
+				os.Exit(2)
+			}
+			tests = append(tests, found)
+		}
+	}
+
+	runtime.LockOSThread()
+	rng := rand.New(rand.NewSource(*seed))
+
+	fmt.Printf("seed: %d, measurements: %d, size: %d, inner: %d\n", *seed, *n, *size, *inner)
+	leaked := false
+	for _, implName := range implNames {
+		for _, test := range tests {
+			r := run(test, implName, *n, *size, *inner, rng)
+			fmt.Printf("%-10s %-15s |t| = %7.2f  tau = %.2e  p = %.2e  (~%.2e measurements to detect)  %s\n",
+				implName, test.name, r.t, r.tau, r.p, r.needed(), verdict(r.t))
+			leaked = leaked || r.t > tThresholdModerate
+		}
+	}
+
+	if leaked {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,131 @@
+// ttest.go - Welch's t-test
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// numCrops is the number of percentile thresholds that measurements
+	// are cropped at, in addition to the uncropped test, as in dudect.
+	numCrops = 20
+
+	// minSamples is the number of measurements required in each class
+	// before a test's result is considered.
+	minSamples = 1000
+)
+
+// welch is an online Welch's t-test between two classes of measurements,
+// using Welford's method for the mean and variance.
+type welch struct {
+	n    [2]float64
+	mean [2]float64
+	m2   [2]float64
+}
+
+func (w *welch) push(class int, x float64) {
+	w.n[class]++
+	delta := x - w.mean[class]
+	w.mean[class] += delta / w.n[class]
+	w.m2[class] += delta * (x - w.mean[class])
+}
+
+func (w *welch) samples() float64 {
+	return w.n[0] + w.n[1]
+}
+
+func (w *welch) t() float64 {
+	if w.n[0] < 2 || w.n[1] < 2 {
+		return 0
+	}
+	v0 := w.m2[0] / (w.n[0] - 1)
+	v1 := w.m2[1] / (w.n[1] - 1)
+	den := math.Sqrt(v0/w.n[0] + v1/w.n[1])
+	if den == 0 {
+		return 0
+	}
+	return (w.mean[0] - w.mean[1]) / den
+}
+
+// leakageTest accumulates the uncropped test, and a test for each of the
+// percentile thresholds, which are set from the first batch of
+// measurements.
+type leakageTest struct {
+	tests      [1 + numCrops]welch
+	thresholds []float64
+}
+
+func (lt *leakageTest) update(classes []int, times []float64) {
+	if lt.thresholds == nil {
+		sorted := append([]float64{}, times...)
+		sort.Float64s(sorted)
+		lt.thresholds = make([]float64, numCrops)
+		for i := range lt.thresholds {
+			p := 1 - math.Pow(0.5, 10*float64(i+1)/numCrops)
+			lt.thresholds[i] = sorted[int(p*float64(len(sorted)-1))]
+		}
+	}
+
+	for i, x := range times {
+		class := classes[i]
+		lt.tests[0].push(class, x)
+		for j, threshold := range lt.thresholds {
+			if x < threshold {
+				lt.tests[j+1].push(class, x)
+			}
+		}
+	}
+}
+
+// result is the outcome of a leakage test.
+type result struct {
+	// samples is the number of measurements in the test with the largest
+	// t statistic.
+	samples float64
+
+	// t is the largest absolute t statistic.
+	t float64
+
+	// tau is t normalized by the square root of the number of samples,
+	// which is comparable across runs with different sample sizes.
+	tau float64
+
+	// p is the two-sided p-value of t, under the null hypothesis that
+	// the timing distributions of both classes have the same mean.  It is
+	// not corrected for taking the largest t over the cropped tests.
+	p float64
+}
+
+// needed returns an estimate of the number of measurements required to
+// detect the leakage (ie: reach |t| > 5), as in dudect.
+func (r *result) needed() float64 {
+	if r.tau == 0 {
+		return math.Inf(1)
+	}
+	return (5 / r.tau) * (5 / r.tau)
+}
+
+func (lt *leakageTest) result() result {
+	var r result
+	for i := range lt.tests {
+		w := &lt.tests[i]
+		if w.n[0] < minSamples || w.n[1] < minSamples {
+			continue
+		}
+		if t := math.Abs(w.t()); t > r.t || r.samples == 0 {
+			r.samples, r.t = w.samples(), t
+		}
+	}
+	if r.samples > 0 {
+		r.tau = r.t / math.Sqrt(r.samples)
+	}
+	r.p = math.Erfc(r.t / math.Sqrt2)
+	return r
+}
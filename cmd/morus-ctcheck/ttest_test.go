@@ -0,0 +1,79 @@
+// ttest_test.go - Welch's t-test tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWelch(t *testing.T) {
+	require := require.New(t)
+
+	var w welch
+	for _, x := range []float64{1, 2, 3, 4, 5} {
+		w.push(0, x)
+	}
+	for _, x := range []float64{2, 4, 6, 8} {
+		w.push(1, x)
+	}
+
+	// mean0 = 3, var0 = 2.5, mean1 = 5, var1 = 20/3.
+	require.InDelta(3.0, w.mean[0], 1e-12, "mean[0]")
+	require.InDelta(5.0, w.mean[1], 1e-12, "mean[1]")
+	expected := -2 / math.Sqrt(2.5/5+(20.0/3)/4)
+	require.InDelta(expected, w.t(), 1e-12, "t()")
+	require.Equal(9.0, w.samples(), "samples()")
+}
+
+func TestLeakageTest(t *testing.T) {
+	require := require.New(t)
+
+	rng := rand.New(rand.NewSource(23))
+	sample := func(offset float64) ([]int, []float64) {
+		classes, times := make([]int, batchSize), make([]float64, batchSize)
+		for i := range times {
+			classes[i] = rng.Intn(2)
+			times[i] = 100 + 10*rng.NormFloat64()
+			if classes[i] == 1 {
+				times[i] += offset
+			}
+		}
+		return classes, times
+	}
+
+	var same leakageTest
+	for i := 0; i < 5; i++ {
+		same.update(sample(0))
+	}
+	r := same.result()
+	require.Less(r.t, float64(tThresholdModerate), "Identical distributions: t")
+	require.Len(same.thresholds, numCrops, "thresholds")
+
+	var leaky leakageTest
+	for i := 0; i < 5; i++ {
+		leaky.update(sample(2))
+	}
+	r = leaky.result()
+	require.Greater(r.t, float64(tThresholdModerate), "Shifted distributions: t")
+	require.Less(r.p, 1e-6, "Shifted distributions: p")
+	require.Equal("ok: no leakage detected", verdict(same.result().t), "verdict()")
+}
+
+func TestRun(t *testing.T) {
+	require := require.New(t)
+
+	rng := rand.New(rand.NewSource(23))
+	for _, test := range ctTests {
+		r := run(test, "Reference", 2*minSamples+500, 64, 1, rng)
+		require.Greater(r.samples, float64(0), "%s: samples", test.name)
+	}
+}
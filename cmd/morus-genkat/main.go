@@ -0,0 +1,79 @@
+// main.go - MORUS KAT generator
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+// Command morus-genkat writes MORUS known answer tests in the format used
+// by the NIST Lightweight Cryptography `genkat_aead.c` harness.
+//
+// The values are computed by this package, not by the reference code, so
+// the output is only useful for checking other implementations against this
+// one, or for catching regressions.
+package main
+
+import (
+	"crypto/cipher"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/Yawning/morus"
+	"github.com/Yawning/morus/internal/lwckat"
+)
+
+type variant struct {
+	keySize int
+	newFn   func([]byte) cipher.AEAD
+}
+
+var variants = map[string]*variant{
+	"1280-256": {morus.KeySize, func(k []byte) cipher.AEAD { return morus.New(k) }},
+	"1280-128": {morus.KeySize128, func(k []byte) cipher.AEAD { return morus.New128(k) }},
+	"640-128":  {morus.KeySize640, func(k []byte) cipher.AEAD { return morus.New640(k) }},
+}
+
+func generate(w io.Writer, v *variant) error {
+	vectors := lwckat.Generate(v.keySize, morus.NonceSize, func(key, nonce, pt, ad []byte) []byte {
+		return v.newFn(key).Seal(nil, nonce, pt, ad)
+	})
+	return lwckat.Write(w, vectors)
+}
+
+func main() {
+	variantName := flag.String("variant", "1280-256", "MORUS variant (1280-256, 1280-128, 640-128)")
+	outDir := flag.String("o", ".", "output directory")
+	implName := flag.String("impl", "", "implementation to use (default: fastest supported)")
+	flag.Parse()
+
+	v, ok := variants[*variantName]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "morus-genkat: unknown variant: %s\n", *variantName)
+		os.Exit(2)
+	}
+	if *implName != "" {
+		if err := morus.SetImplementation(*implName); err != nil {
+			fmt.Fprintf(os.Stderr, "morus-genkat: %v\n", err)
+			os.Exit(2)
+		}
+	}
+
+	fn := filepath.Join(*outDir, lwckat.FileName(v.keySize, morus.NonceSize))
+	f, err := os.Create(fn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "morus-genkat: %v\n", err)
+		os.Exit(1)
+	}
+	if err = generate(f, v); err == nil {
+		err = f.Close()
+	} else {
+		f.Close()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "morus-genkat: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,42 @@
+// main_test.go - MORUS KAT generator tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/Yawning/morus"
+	"github.com/Yawning/morus/internal/lwckat"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerate checks that the checked in files under `testdata/lwc` are
+// exactly what the generator produces.  As both are derived from this
+// package, it detects changes in the output, not errors in it.
+func TestGenerate(t *testing.T) {
+	require := require.New(t)
+
+	dirs := map[string]string{
+		"1280-256": "morus1280256",
+		"1280-128": "morus1280128",
+		"640-128":  "morus640128",
+	}
+	for name, v := range variants {
+		fn := filepath.Join("..", "..", "testdata", "lwc", dirs[name], lwckat.FileName(v.keySize, morus.NonceSize))
+		expected, err := ioutil.ReadFile(fn)
+		require.NoError(err, "ReadFile(%s)", fn)
+
+		var buf bytes.Buffer
+		err = generate(&buf, v)
+		require.NoError(err, "generate(%s)", name)
+		require.Equal(expected, buf.Bytes(), "generate(%s)", name)
+	}
+}
@@ -0,0 +1,96 @@
+// differential_test.go - Differential tests against the reference code
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Yawning/morus/internal/supercop"
+)
+
+const differentialIterations = 5000
+
+func TestDifferential(t *testing.T) {
+	if !supercop.Enabled {
+		t.Skip("SUPERCOP reference implementation requires cgo, the supercop build tag and internal/supercop/vendor.sh")
+	}
+
+	for _, v := range []struct {
+		ref   supercop.Variant
+		newFn func([]byte) *AEAD
+	}{
+		{supercop.MORUS1280256, New},
+		{supercop.MORUS1280128, New128},
+	} {
+		for _, name := range Implementations() {
+			v, name := v, name
+			t.Run(v.ref.String()+"/"+name, func(t *testing.T) { doTestDifferential(t, v.ref, v.newFn, name) })
+		}
+	}
+}
+
+func doTestDifferential(t *testing.T, ref supercop.Variant, newFn func([]byte) *AEAD, implName string) {
+	require := require.New(t)
+
+	seed := time.Now().UnixNano()
+	rng := rand.New(rand.NewSource(seed))
+
+	n := differentialIterations
+	if testing.Short() {
+		n /= 10
+	}
+
+	// Bias the lengths towards the block boundaries, where the partial
+	// block handling lives, while still covering multi-block inputs.
+	randLen := func() int {
+		switch rng.Intn(4) {
+		case 0:
+			return rng.Intn(blockSize + 1)
+		case 1:
+			return rng.Intn(8)*blockSize + rng.Intn(3)
+		default:
+			return rng.Intn(1024)
+		}
+	}
+
+	key, nonce := make([]byte, ref.KeySize()), make([]byte, NonceSize)
+	for i := 0; i < n; i++ {
+		m, ad := make([]byte, randLen()), make([]byte, randLen())
+		rng.Read(key)
+		rng.Read(nonce)
+		rng.Read(m)
+		rng.Read(ad)
+
+		aead := newFn(key)
+		err := aead.SetImplementation(implName)
+		require.NoError(err, "SetImplementation(%s)", implName)
+
+		expected := ref.Encrypt(key, nonce, m, ad)
+		c := aead.Seal(nil, nonce, m, ad)
+		require.Equal(expected, c, "seed %d, iteration %d: Seal(): len(m) %d, len(ad) %d", seed, i, len(m), len(ad))
+
+		d, ok := ref.Decrypt(key, nonce, c, ad)
+		require.True(ok, "seed %d, iteration %d: crypto_aead_decrypt()", seed, i)
+		require.Equal(string(m), string(d), "seed %d, iteration %d: crypto_aead_decrypt()", seed, i)
+
+		d, err = aead.Open(nil, nonce, expected, ad)
+		require.NoError(err, "seed %d, iteration %d: Open()", seed, i)
+		require.Equal(string(m), string(d), "seed %d, iteration %d: Open()", seed, i)
+
+		// Both implementations must reject the same corrupted input.
+		c[rng.Intn(len(c))] ^= byte(1 + rng.Intn(255))
+		_, ok = ref.Decrypt(key, nonce, c, ad)
+		require.False(ok, "seed %d, iteration %d: crypto_aead_decrypt(Bad c)", seed, i)
+		_, err = aead.Open(nil, nonce, c, ad)
+		require.Equal(ErrOpen, err, "seed %d, iteration %d: Open(Bad c)", seed, i)
+	}
+}
@@ -0,0 +1,167 @@
+// drbg.go - Deterministic random bit generator
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import "io"
+
+const (
+	drbgInstantiateDomain = 0x81
+	drbgReseedDomain      = 0x82
+
+	// DRBGRatchetInterval is the number of bytes of output that a DRBG
+	// generates between ratchets.
+	DRBGRatchetInterval = 4096
+)
+
+var _ io.Reader = (*DRBG)(nil)
+
+// DRBG is a deterministic random bit generator based on MORUS-1280-256.
+// Output is squeezed from the state as the key stream for an all zero
+// plaintext, which uses the same implementation (eg: AVX2) as the AEAD.
+//
+// The output is a pure function of the key, nonce, personalization string,
+// and the calls to Reseed and Ratchet, and is independent of how it is
+// split across calls to Read, so the same sequence of operations always
+// produces the same stream.
+//
+// Every DRBGRatchetInterval bytes of output, and on each call to Reseed or
+// Ratchet, the state is replaced by a fresh state keyed with key stream
+// derived from the old one.  This is one-way, so a compromise of the state
+// reveals none of the output generated prior to the most recent ratchet.
+//
+// The nonce must be unique for all time, for a given key, and is shared
+// between the AEAD and DRBG.  A DRBG is not safe for concurrent use.
+type DRBG struct {
+	s    state
+	impl *hwaccelImpl
+
+	buf    [blockSize]byte // Partially consumed output block.
+	bufOff int
+
+	generated int // Output since the last ratchet, a multiple of blockSize.
+}
+
+// Read fills p with the next len(p) bytes of output.  It never returns an
+// error.
+func (d *DRBG) Read(p []byte) (int, error) {
+	d.checkReset()
+
+	pLen := len(p)
+
+	// Use up the remainder of the previous partial block.
+	if d.bufOff < blockSize {
+		n := copy(p, d.buf[d.bufOff:])
+		burnBytes(d.buf[d.bufOff : d.bufOff+n])
+		d.bufOff += n
+		p = p[n:]
+	}
+
+	for len(p) >= blockSize {
+		n := len(p) &^ (blockSize - 1)
+		if rem := DRBGRatchetInterval - d.generated; n > rem {
+			n = rem
+		}
+		d.generate(p[:n])
+		p = p[n:]
+	}
+
+	if len(p) > 0 {
+		d.generate(d.buf[:])
+		d.bufOff = copy(p, d.buf[:])
+		burnBytes(d.buf[:d.bufOff])
+	}
+
+	return pLen, nil
+}
+
+// Reseed mixes additional seed material into the state, and ratchets.  Any
+// output left over from a partially consumed block is discarded.
+func (d *DRBG) Reseed(seed []byte) {
+	d.checkReset()
+	d.absorbInput(drbgReseedDomain, seed)
+	d.Ratchet()
+}
+
+// Ratchet replaces the state with a fresh state keyed with key stream
+// derived from the old one, so that a compromise of the state reveals none
+// of the prior output.  Any output left over from a partially consumed
+// block is discarded.
+func (d *DRBG) Ratchet() {
+	d.checkReset()
+
+	burnBytes(d.buf[:])
+	d.bufOff = blockSize
+	d.ratchet()
+}
+
+func (d *DRBG) ratchet() {
+	var key [KeySize]byte
+	var nonce [blockSize]byte
+
+	d.impl.encryptBlocksFn(&d.s, key[:], key[:])
+	d.impl.encryptBlocksFn(&d.s, nonce[:], nonce[:])
+	d.impl.initFn(&d.s, key[:], nonce[:NonceSize])
+	d.generated = 0
+
+	burnBytes(key[:])
+	burnBytes(nonce[:])
+}
+
+// Reset securely purges stored sensitive data from the DRBG instance, which
+// may not be used afterwards.
+func (d *DRBG) Reset() {
+	burnUint64s(d.s.s[:])
+	burnBytes(d.buf[:])
+	d.impl = nil
+}
+
+func (d *DRBG) checkReset() {
+	if d.impl == nil {
+		panic(ErrInvalidState)
+	}
+}
+
+// generate fills out, which must be a multiple of blockSize bytes, and not
+// cross a ratchet boundary, with output.
+func (d *DRBG) generate(out []byte) {
+	burnBytes(out)
+	d.impl.encryptBlocksFn(&d.s, out, out)
+
+	d.generated += len(out)
+	if d.generated == DRBGRatchetInterval {
+		d.ratchet()
+	}
+}
+
+// absorbInput absorbs a header block containing the domain and the length
+// of the input, followed by the input.
+func (d *DRBG) absorbInput(domain byte, in []byte) {
+	var hdr [blockSize]byte
+
+	hdr[0] = domain
+	byteOrder.PutUint64(hdr[8:16], uint64(len(in)))
+	d.impl.absorbBlocksFn(&d.s, hdr[:])
+	d.impl.absorbData(&d.s, in)
+}
+
+// NewDRBG returns a new DRBG seeded with a MORUS-1280-256 key and nonce,
+// and an optional personalization string.  The nonce must be NonceSize
+// bytes long and unique for all time, for a given key.
+func NewDRBG(key, nonce, personalization []byte) *DRBG {
+	if len(key) != KeySize {
+		panic(ErrInvalidKeySize)
+	}
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+
+	d := &DRBG{impl: hardwareAccelImpl, bufOff: blockSize}
+	d.impl.initFn(&d.s, key, nonce)
+	d.absorbInput(drbgInstantiateDomain, personalization)
+	return d
+}
@@ -0,0 +1,110 @@
+// drbg_test.go - Deterministic random bit generator tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDRBG(t *testing.T) {
+	require := require.New(t)
+
+	defer initHardwareAcceleration()
+
+	var key [KeySize]byte
+	var nonce [NonceSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+	pers := []byte("Simulation run 23")
+
+	const outLen = 3*DRBGRatchetInterval + 123
+
+	// Every implementation must produce the same output, regardless of
+	// how it is split across calls to Read.
+	var expected []byte
+	for _, impl := range supportedImpls() {
+		hardwareAccelImpl = impl
+
+		out := make([]byte, outLen)
+		n, err := NewDRBG(key[:], nonce[:], pers).Read(out)
+		require.NoError(err, "Read(): %s", impl.name)
+		require.Equal(outLen, n, "Read(): %s", impl.name)
+		if expected == nil {
+			expected = out
+		}
+		require.Equal(expected, out, "Read(): %s", impl.name)
+
+		d := NewDRBG(key[:], nonce[:], pers)
+		var chunked []byte
+		for _, chunk := range randomChunks(make([]byte, outLen)) {
+			_, _ = d.Read(chunk)
+			chunked = append(chunked, chunk...)
+		}
+		require.Equal(expected, chunked, "Read(Chunked): %s", impl.name)
+	}
+
+	// The output prior to the first ratchet is the MORUS key stream.
+	var hdr [blockSize]byte
+	hdr[0] = drbgInstantiateDomain
+	byteOrder.PutUint64(hdr[8:], uint64(len(pers)))
+	ks := New(key[:]).Seal(nil, nonce[:], make([]byte, DRBGRatchetInterval+blockSize), append(hdr[:], pers...))
+	require.Equal(ks[:DRBGRatchetInterval], expected[:DRBGRatchetInterval], "Read(): Key stream")
+	require.NotEqual(ks[DRBGRatchetInterval:DRBGRatchetInterval+blockSize], expected[DRBGRatchetInterval:DRBGRatchetInterval+blockSize], "Read(): After ratchet")
+
+	// Different inputs produce different output.
+	readN := func(d *DRBG, n int) []byte {
+		b := make([]byte, n)
+		_, err := io.ReadFull(d, b)
+		require.NoError(err, "ReadFull()")
+		return b
+	}
+	otherNonce := append([]byte{}, nonce[:]...)
+	otherNonce[0] ^= 0x23
+	require.NotEqual(expected[:64], readN(NewDRBG(key[:], nonce[:], nil), 64), "Read(): No personalization")
+	require.NotEqual(expected[:64], readN(NewDRBG(key[:], otherNonce, pers), 64), "Read(): Other nonce")
+
+	// Reseed and Ratchet are deterministic, and change the output.
+	reseeded := func() []byte {
+		d := NewDRBG(key[:], nonce[:], pers)
+		_ = readN(d, 10)
+		d.Reseed([]byte("entropy"))
+		a := readN(d, 64)
+		d.Ratchet()
+		return append(a, readN(d, 64)...)
+	}
+	a := reseeded()
+	require.Equal(a, reseeded(), "Reseed(): Deterministic")
+	require.NotEqual(expected[10:74], a[:64], "Reseed(): Output changed")
+	require.NotEqual(a[:64], a[64:], "Ratchet(): Output changed")
+
+	d := NewDRBG(key[:], nonce[:], pers)
+	d.Reset()
+	require.PanicsWithValue(ErrInvalidState, func() { _, _ = d.Read(make([]byte, 1)) }, "Read(): After Reset")
+	require.PanicsWithValue(ErrInvalidState, func() { d.Reseed(nil) }, "Reseed(): After Reset")
+}
+
+func BenchmarkDRBG(b *testing.B) {
+	for _, sz := range []int{32, 4096, 1024768} {
+		b.Run(fmt.Sprintf("DRBG_%d", sz), func(b *testing.B) {
+			d := NewDRBG(make([]byte, KeySize), make([]byte, NonceSize), nil)
+			buf := make([]byte, sz)
+			b.SetBytes(int64(sz))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = d.Read(buf)
+			}
+		})
+	}
+}
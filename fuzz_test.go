@@ -0,0 +1,139 @@
+// fuzz_test.go - Fuzz tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+// +build go1.18
+
+package morus
+
+import (
+	"bytes"
+	"testing"
+)
+
+// The seed corpus lives in testdata/fuzz, and covers the lengths around the
+// block boundaries, where the partial block handling is.
+
+func fuzzAEADs(t *testing.T, key []byte) []*AEAD {
+	var k [KeySize]byte
+	copy(k[:], key)
+
+	var aeads []*AEAD
+	for _, name := range Implementations() {
+		aead := New(k[:])
+		if err := aead.SetImplementation(name); err != nil {
+			t.Fatalf("SetImplementation(%s): %v", name, err)
+		}
+		aeads = append(aeads, aead)
+	}
+	return aeads
+}
+
+func fuzzNonce(nonce []byte) []byte {
+	var n [NonceSize]byte
+	copy(n[:], nonce)
+	return n[:]
+}
+
+func FuzzSealOpen(f *testing.F) {
+	f.Fuzz(func(t *testing.T, key, nonce, ad, m []byte) {
+		aeads := fuzzAEADs(t, key)
+		nonce = fuzzNonce(nonce)
+
+		var c []byte
+		for _, sealer := range aeads {
+			sealName := sealer.Implementation()
+
+			ct := sealer.Seal(nil, nonce, m, ad)
+			if c == nil {
+				c = ct
+			} else if !bytes.Equal(c, ct) {
+				t.Fatalf("Seal(%s): ciphertext mismatch", sealName)
+			}
+
+			// Exact overlap between the plaintext and dst.
+			buf := make([]byte, len(m), len(m)+TagSize)
+			copy(buf, m)
+			if ct = sealer.Seal(buf[:0], nonce, buf, ad); !bytes.Equal(c, ct) {
+				t.Fatalf("Seal(%s, in-place): ciphertext mismatch", sealName)
+			}
+
+			for _, opener := range aeads {
+				openName := opener.Implementation()
+
+				pt, err := opener.Open(nil, nonce, ct, ad)
+				if err != nil {
+					t.Fatalf("Open(%s, %s): %v", sealName, openName, err)
+				}
+				if !bytes.Equal(m, pt) {
+					t.Fatalf("Open(%s, %s): plaintext mismatch", sealName, openName)
+				}
+
+				buf = append([]byte{}, ct...)
+				pt, err = opener.Open(buf[:0], nonce, buf, ad)
+				if err != nil {
+					t.Fatalf("Open(%s, %s, in-place): %v", sealName, openName, err)
+				}
+				if !bytes.Equal(m, pt) {
+					t.Fatalf("Open(%s, %s, in-place): plaintext mismatch", sealName, openName)
+				}
+			}
+		}
+	})
+}
+
+func FuzzTamper(f *testing.F) {
+	f.Fuzz(func(t *testing.T, key, nonce, ad, m []byte, pos uint) {
+		aeads := fuzzAEADs(t, key)
+		nonce = fuzzNonce(nonce)
+
+		c := aeads[0].Seal(nil, nonce, m, ad)
+
+		// Flip a single bit somewhere in the ciphertext, tag or additional
+		// data.
+		c, ad = append([]byte{}, c...), append([]byte{}, ad...)
+		idx := pos % uint((len(c)+len(ad))*8)
+		if i := int(idx / 8); i < len(c) {
+			c[i] ^= 1 << (idx % 8)
+		} else {
+			ad[i-len(c)] ^= 1 << (idx % 8)
+		}
+
+		for _, aead := range aeads {
+			name := aead.Implementation()
+
+			dst := make([]byte, 0, len(c))
+			for i, full := 0, dst[:cap(dst)]; i < len(full); i++ {
+				full[i] = 0xa5
+			}
+			pt, err := aead.Open(dst, nonce, c, ad)
+			if err != ErrOpen || pt != nil {
+				t.Fatalf("Open(%s): tampered input was accepted", name)
+			}
+			if !isZero(dst[:len(m)]) {
+				t.Fatalf("Open(%s): output not zeroed on failure", name)
+			}
+
+			buf := append([]byte{}, c...)
+			pt, err = aead.Open(buf[:0], nonce, buf, ad)
+			if err != ErrOpen || pt != nil {
+				t.Fatalf("Open(%s, in-place): tampered input was accepted", name)
+			}
+			if !isZero(buf[:len(m)]) {
+				t.Fatalf("Open(%s, in-place): output not zeroed on failure", name)
+			}
+		}
+	})
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
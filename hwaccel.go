@@ -7,34 +7,313 @@
 
 package morus
 
+import (
+	"crypto/subtle"
+	"errors"
+	"math/bits"
+	"os"
+	"strings"
+)
+
+// ImplementationEnvVar is the environment variable that, if set to the name
+// of a supported implementation, overrides the default implementation when
+// the package is initialized.  Unknown or unsupported values are ignored.
+const ImplementationEnvVar = "MORUS_IMPLEMENTATION"
+
+// ErrUnsupportedImplementation is the error returned when the requested
+// implementation is unknown, or not supported by the host.
+var ErrUnsupportedImplementation = errors.New("morus: unsupported implementation")
+
 var (
 	isHardwareAccelerated = false
 	hardwareAccelImpl     = implReference
 
+	// hardwareAccelImpls is the list of accelerated implementations that
+	// are supported by the host, in order of preference.
+	hardwareAccelImpls []*hwaccelImpl
+
 	implReference = &hwaccelImpl{
-		name:          "Reference",
-		aeadEncryptFn: aeadEncryptRef,
-		aeadDecryptFn: aeadDecryptRef,
+		name:            "Reference",
+		aeadEncryptFn:   aeadEncryptRef,
+		aeadDecryptFn:   aeadDecryptRef,
+		initFn:          (*state).init,
+		absorbBlocksFn:  (*state).absorbData,
+		encryptBlocksFn: (*state).encryptData,
+		decryptBlocksFn: (*state).decryptData,
+		finalizeFn:      (*state).finalize,
 	}
+
+	implPortable32 = &hwaccelImpl{
+		name:            "Portable32",
+		aeadEncryptFn:   aeadEncryptPortable32,
+		aeadDecryptFn:   aeadDecryptPortable32,
+		initFn:          init32,
+		absorbBlocksFn:  absorbBlocks32,
+		encryptBlocksFn: encryptBlocks32,
+		decryptBlocksFn: decryptBlocks32,
+		finalizeFn:      finalize32,
+	}
+
+	// portableImpls is the list of portable implementations, which are
+	// supported everywhere, in order of preference for the host's word
+	// size.
+	portableImpls = newPortableImpls()
 )
 
+func newPortableImpls() []*hwaccelImpl {
+	if bits.UintSize == 32 {
+		return []*hwaccelImpl{implPortable32, implReference}
+	}
+	return []*hwaccelImpl{implReference, implPortable32}
+}
+
 type hwaccelImpl struct {
-	name          string
-	aeadEncryptFn func([]byte, []byte, []byte, []byte, []byte) []byte
-	aeadDecryptFn func([]byte, []byte, []byte, []byte, []byte) ([]byte, bool)
+	name string
+
+	// The whole message functions write the ciphertext (or plaintext),
+	// which is the same length as the input, and the tag to separate
+	// buffers.  The decrypt function returns the expected tag, which must
+	// be checked by the caller.
+	aeadEncryptFn func([]byte, []byte, []byte, []byte, []byte, []byte)
+	aeadDecryptFn func([]byte, []byte, []byte, []byte, []byte, []byte)
+
+	// The incremental interface operates on a state that uses the same
+	// layout across all implementations, so that partial blocks can be
+	// handled by the reference code.  The block functions only process
+	// input that is a multiple of blockSize, and the state is unusable
+	// after finalizeFn is called.
+	initFn          func(*state, []byte, []byte)
+	absorbBlocksFn  func(*state, []byte)
+	encryptBlocksFn func(*state, []byte, []byte)
+	decryptBlocksFn func(*state, []byte, []byte)
+	finalizeFn      func(*state, uint64, uint64, []byte)
+
+	// The two-way interleaved block functions are optional, and process
+	// two independent states at once for the batch interface.  Both
+	// inputs to absorbBlocksX2Fn, encryptBlocksX2Fn and decryptBlocksX2Fn
+	// must be the same length.
+	initX2Fn          func(*state, *state, []byte, []byte, []byte)
+	absorbBlocksX2Fn  func(*state, *state, []byte, []byte)
+	encryptBlocksX2Fn func(*state, *state, []byte, []byte, []byte, []byte)
+	decryptBlocksX2Fn func(*state, *state, []byte, []byte, []byte, []byte)
+	finalizeX2Fn      func(*state, *state, uint64, uint64, uint64, uint64, []byte, []byte)
+}
+
+// seal encrypts and authenticates m and a, appending the ciphertext and a
+// tagSize byte tag to dst.
+func (impl *hwaccelImpl) seal(dst, m, a, nonce, key []byte, tagSize int) []byte {
+	mLen := len(m)
+	ret, out := sliceForAppend(dst, mLen+tagSize)
+	impl.encrypt(out[:mLen], out[mLen:], m, a, nonce, key)
+
+	return ret
+}
+
+// sealDetached encrypts and authenticates m and a, appending the ciphertext
+// to dst, and writing the tag to tag.
+func (impl *hwaccelImpl) sealDetached(dst, tag, m, a, nonce, key []byte) []byte {
+	ret, out := sliceForAppend(dst, len(m))
+	impl.encrypt(out, tag, m, a, nonce, key)
+
+	return ret
+}
+
+// open decrypts and authenticates c and a, where c includes a tagSize byte
+// tag, appending the plaintext to dst iff the tag is valid.
+func (impl *hwaccelImpl) open(dst, c, a, nonce, key []byte, tagSize int) ([]byte, bool) {
+	cLen := len(c)
+	if cLen < tagSize {
+		return nil, false
+	}
+
+	mLen := cLen - tagSize
+	return impl.openDetached(dst, c[:mLen], c[mLen:], a, nonce, key)
+}
+
+// openDetached decrypts and authenticates c, tag and a, appending the
+// plaintext to dst iff the tag is valid.  The caller is responsible for
+// ensuring that the tag is the expected size.
+func (impl *hwaccelImpl) openDetached(dst, c, tag, a, nonce, key []byte) ([]byte, bool) {
+	var srcTag, expectedTag [TagSize]byte
+
+	tagSize := len(tag)
+	if tagSize < MinTagSize || tagSize > TagSize {
+		return nil, false
+	}
+
+	// Copy the tag first, in case it is overwritten by in-place decryption.
+	copy(srcTag[:], tag)
+
+	mLen := len(c)
+	ret, out := sliceForAppend(dst, mLen)
+	impl.decrypt(out, expectedTag[:tagSize], c, a, nonce, key)
+
+	ok := subtle.ConstantTimeCompare(srcTag[:tagSize], expectedTag[:tagSize]) == 1
+	if !ok {
+		// Burn decrypted plaintext on auth failure.
+		if mLen > 0 {
+			burnBytes(out)
+		}
+		ret = nil
+	}
+
+	return ret, ok
+}
+
+// encrypt encrypts and authenticates m and a, writing the ciphertext to
+// out, and a len(tag) byte tag to tag.
+func (impl *hwaccelImpl) encrypt(out, tag, m, a, nonce, key []byte) {
+	if len(tag) == TagSize {
+		impl.aeadEncryptFn(out, m, a, nonce, key, tag)
+		return
+	}
+	impl.truncatedCrypt(out, tag, m, a, nonce, key, false)
+}
+
+// decrypt decrypts c and authenticates c and a, writing the plaintext to
+// out, and the expected len(tag) byte tag to tag.
+func (impl *hwaccelImpl) decrypt(out, tag, c, a, nonce, key []byte) {
+	if len(tag) == TagSize {
+		impl.aeadDecryptFn(out, c, a, nonce, key, tag)
+		return
+	}
+	impl.truncatedCrypt(out, tag, c, a, nonce, key, true)
+}
+
+// truncatedCrypt is MORUS-1280 with a truncated tag.
+//
+// To prevent tags of different sizes from being interchangeable, a block
+// with the first byte set to the tag size is absorbed immediately after
+// initialization.  The block is not included in the additional data length
+// used during finalization, so the construction can't collide with regular
+// MORUS-1280 where the additional data happens to start with the block.
+func (impl *hwaccelImpl) truncatedCrypt(out, tag, in, a, nonce, key []byte, decrypt bool) {
+	var s state
+	var tmp [blockSize]byte
+
+	impl.initFn(&s, key, nonce)
+	tmp[0] = byte(len(tag))
+	impl.absorbBlocksFn(&s, tmp[:])
+
+	impl.absorbData(&s, a)
+	if decrypt {
+		impl.decryptData(&s, out, in)
+	} else {
+		impl.encryptData(&s, out, in)
+	}
+	impl.finalizeFn(&s, uint64(len(in)), uint64(len(a)), tmp[:TagSize])
+	copy(tag, tmp[:])
+
+	burnBytes(tmp[:])
+	burnUint64s(s.s[:])
+}
+
+// absorbData absorbs arbitrary length input, padding the trailing partial
+// block if any.
+func (impl *hwaccelImpl) absorbData(s *state, in []byte) {
+	n := len(in) &^ (blockSize - 1)
+	if n > 0 {
+		impl.absorbBlocksFn(s, in[:n])
+	}
+	s.absorbData(in[n:])
+}
+
+// encryptData encrypts arbitrary length input, padding the trailing partial
+// block if any.
+func (impl *hwaccelImpl) encryptData(s *state, out, in []byte) {
+	n := len(in) &^ (blockSize - 1)
+	if n > 0 {
+		impl.encryptBlocksFn(s, out[:n], in[:n])
+	}
+	s.encryptData(out[n:], in[n:])
+}
+
+// decryptData decrypts arbitrary length input, padding the trailing partial
+// block if any.
+func (impl *hwaccelImpl) decryptData(s *state, out, in []byte) {
+	n := len(in) &^ (blockSize - 1)
+	if n > 0 {
+		impl.decryptBlocksFn(s, out[:n], in[:n])
+	}
+	s.decryptData(out[n:], in[n:])
 }
 
 func forceDisableHardwareAcceleration() {
 	isHardwareAccelerated = false
-	hardwareAccelImpl = implReference
+	hardwareAccelImpl = portableImpls[0]
+}
+
+func supportedImpls() []*hwaccelImpl {
+	impls := append([]*hwaccelImpl{}, hardwareAccelImpls...)
+	return append(impls, portableImpls...)
+}
+
+func isPortable(impl *hwaccelImpl) bool {
+	for _, v := range portableImpls {
+		if impl == v {
+			return true
+		}
+	}
+	return false
+}
+
+func implByName(name string) (*hwaccelImpl, error) {
+	for _, impl := range supportedImpls() {
+		if strings.EqualFold(impl.name, name) {
+			return impl, nil
+		}
+	}
+	return nil, ErrUnsupportedImplementation
 }
 
 // IsHardwareAccelerated returns true iff the MORUS implementation will use
-// hardware acceleration (eg: AVX2).
+// hardware acceleration (eg: AVX2, SSE2).
 func IsHardwareAccelerated() bool {
 	return isHardwareAccelerated
 }
 
+// Implementations returns the names of the implementations supported by the
+// host, in order of preference.
+func Implementations() []string {
+	var names []string
+	for _, impl := range supportedImpls() {
+		names = append(names, impl.name)
+	}
+	return names
+}
+
+// Implementation returns the name of the implementation that will be used
+// by default.
+func Implementation() string {
+	return hardwareAccelImpl.name
+}
+
+// SetImplementation sets the implementation that will be used by default,
+// by name (case insensitive).  This is not safe to call concurrently with
+// any other use of the package, and is intended to be called once at
+// startup.
+func SetImplementation(name string) error {
+	impl, err := implByName(name)
+	if err != nil {
+		return err
+	}
+
+	isHardwareAccelerated = !isPortable(impl)
+	hardwareAccelImpl = impl
+	return nil
+}
+
+// setImplementationFromEnv sets the default implementation from the value
+// of ImplementationEnvVar, and returns true iff it was changed.  Empty,
+// unknown and unsupported values are silently ignored.
+func setImplementationFromEnv(value string) bool {
+	if value = strings.TrimSpace(value); value == "" {
+		return false
+	}
+	return SetImplementation(value) == nil
+}
+
 func init() {
 	initHardwareAcceleration()
+	setImplementationFromEnv(os.Getenv(ImplementationEnvVar))
 }
@@ -9,8 +9,6 @@
 
 package morus
 
-import "crypto/subtle"
-
 //go:noescape
 func cpuidAmd64(cpuidParams *uint32)
 
@@ -18,11 +16,62 @@ func cpuidAmd64(cpuidParams *uint32)
 func xgetbv0Amd64(xcrVec *uint32)
 
 //go:noescape
-func aeadEncryptAVX2(c, m, a []byte, nonce, key *byte)
+func aeadEncryptAVX2(c, m, a []byte, nonce, key, tag *byte)
 
 //go:noescape
 func aeadDecryptAVX2(m, c, a []byte, nonce, key, tag *byte)
 
+//go:noescape
+func initAVX2(s *uint64, key, iv *byte)
+
+//go:noescape
+func absorbBlocksAVX2(s *uint64, in []byte)
+
+//go:noescape
+func encryptBlocksAVX2(s *uint64, out, in []byte)
+
+//go:noescape
+func decryptBlocksAVX2(s *uint64, out, in []byte)
+
+//go:noescape
+func finalizeAVX2(s *uint64, tag *byte, msgLen, adLen uint64)
+
+//go:noescape
+func initAVX2x2(s0, s1 *uint64, key, iv0, iv1 *byte)
+
+//go:noescape
+func absorbBlocksAVX2x2(s0, s1 *uint64, in0, in1 []byte)
+
+//go:noescape
+func encryptBlocksAVX2x2(s0, s1 *uint64, out0, in0, out1, in1 []byte)
+
+//go:noescape
+func decryptBlocksAVX2x2(s0, s1 *uint64, out0, in0, out1, in1 []byte)
+
+//go:noescape
+func finalizeAVX2x2(s0, s1 *uint64, tag0, tag1 *byte, msgLen0, adLen0, msgLen1, adLen1 uint64)
+
+//go:noescape
+func aeadEncryptSSE2(c, m, a []byte, nonce, key, tag *byte)
+
+//go:noescape
+func aeadDecryptSSE2(m, c, a []byte, nonce, key, tag *byte)
+
+//go:noescape
+func initSSE2(s *uint64, key, iv *byte)
+
+//go:noescape
+func absorbBlocksSSE2(s *uint64, in []byte)
+
+//go:noescape
+func encryptBlocksSSE2(s *uint64, out, in []byte)
+
+//go:noescape
+func decryptBlocksSSE2(s *uint64, out, in []byte)
+
+//go:noescape
+func finalizeSSE2(s *uint64, tag *byte, msgLen, adLen uint64)
+
 func supportsAVX2() bool {
 	// https://software.intel.com/en-us/articles/how-to-detect-new-instruction-support-in-the-4th-generation-intel-core-processor-family
 	const (
@@ -58,46 +107,121 @@ func supportsAVX2() bool {
 	return regs[1]&avx2Bit != 0
 }
 
-func aeadEncryptYMM(c, m, a, nonce, key []byte) []byte {
-	mLen := len(m)
-	ret, out := sliceForAppend(c, mLen+TagSize)
-	aeadEncryptAVX2(out, m, a, &nonce[0], &key[0])
+func aeadEncryptYMM(c, m, a, nonce, key, tag []byte) {
+	aeadEncryptAVX2(c, m, a, &nonce[0], &key[0], &tag[0])
+}
 
-	return ret
+func aeadDecryptYMM(m, c, a, nonce, key, tag []byte) {
+	aeadDecryptAVX2(m, c, a, &nonce[0], &key[0], &tag[0])
 }
 
-func aeadDecryptYMM(m, c, a, nonce, key []byte) ([]byte, bool) {
-	var tag [TagSize]byte
-	cLen := len(c)
+func initYMM(s *state, key, iv []byte) {
+	initAVX2(&s.s[0], &key[0], &iv[0])
+}
 
-	if cLen < TagSize {
-		return nil, false
-	}
+func absorbBlocksYMM(s *state, in []byte) {
+	absorbBlocksAVX2(&s.s[0], in)
+}
 
-	mLen := cLen - TagSize
-	ret, out := sliceForAppend(m, mLen)
-	aeadDecryptAVX2(out, c[:mLen], a, &nonce[0], &key[0], &tag[0])
+func encryptBlocksYMM(s *state, out, in []byte) {
+	encryptBlocksAVX2(&s.s[0], out, in)
+}
 
-	srcTag := c[mLen:]
-	ok := subtle.ConstantTimeCompare(srcTag, tag[:]) == 1
-	if !ok && mLen > 0 {
-		// Burn decrypted plaintext on auth failure.
-		burnBytes(out[:mLen])
-		ret = nil
-	}
+func decryptBlocksYMM(s *state, out, in []byte) {
+	decryptBlocksAVX2(&s.s[0], out, in)
+}
+
+func finalizeYMM(s *state, msgLen, adLen uint64, tag []byte) {
+	_ = tag[15] // Bounds check elimination
+	finalizeAVX2(&s.s[0], &tag[0], msgLen, adLen)
+}
 
-	return ret, ok
+func initYMMx2(s0, s1 *state, key, iv0, iv1 []byte) {
+	initAVX2x2(&s0.s[0], &s1.s[0], &key[0], &iv0[0], &iv1[0])
+}
+
+func absorbBlocksYMMx2(s0, s1 *state, in0, in1 []byte) {
+	absorbBlocksAVX2x2(&s0.s[0], &s1.s[0], in0, in1)
+}
+
+func encryptBlocksYMMx2(s0, s1 *state, out0, in0, out1, in1 []byte) {
+	encryptBlocksAVX2x2(&s0.s[0], &s1.s[0], out0, in0, out1, in1)
+}
+
+func decryptBlocksYMMx2(s0, s1 *state, out0, in0, out1, in1 []byte) {
+	decryptBlocksAVX2x2(&s0.s[0], &s1.s[0], out0, in0, out1, in1)
+}
+
+func finalizeYMMx2(s0, s1 *state, msgLen0, adLen0, msgLen1, adLen1 uint64, tag0, tag1 []byte) {
+	_, _ = tag0[15], tag1[15] // Bounds check elimination
+	finalizeAVX2x2(&s0.s[0], &s1.s[0], &tag0[0], &tag1[0], msgLen0, adLen0, msgLen1, adLen1)
 }
 
 var implAVX2 = &hwaccelImpl{
-	name:          "AVX2",
-	aeadEncryptFn: aeadEncryptYMM,
-	aeadDecryptFn: aeadDecryptYMM,
+	name:              "AVX2",
+	aeadEncryptFn:     aeadEncryptYMM,
+	aeadDecryptFn:     aeadDecryptYMM,
+	initFn:            initYMM,
+	absorbBlocksFn:    absorbBlocksYMM,
+	encryptBlocksFn:   encryptBlocksYMM,
+	decryptBlocksFn:   decryptBlocksYMM,
+	finalizeFn:        finalizeYMM,
+	initX2Fn:          initYMMx2,
+	absorbBlocksX2Fn:  absorbBlocksYMMx2,
+	encryptBlocksX2Fn: encryptBlocksYMMx2,
+	decryptBlocksX2Fn: decryptBlocksYMMx2,
+	finalizeX2Fn:      finalizeYMMx2,
+}
+
+func aeadEncryptXMM(c, m, a, nonce, key, tag []byte) {
+	aeadEncryptSSE2(c, m, a, &nonce[0], &key[0], &tag[0])
+}
+
+func aeadDecryptXMM(m, c, a, nonce, key, tag []byte) {
+	aeadDecryptSSE2(m, c, a, &nonce[0], &key[0], &tag[0])
+}
+
+func initXMM(s *state, key, iv []byte) {
+	initSSE2(&s.s[0], &key[0], &iv[0])
+}
+
+func absorbBlocksXMM(s *state, in []byte) {
+	absorbBlocksSSE2(&s.s[0], in)
+}
+
+func encryptBlocksXMM(s *state, out, in []byte) {
+	encryptBlocksSSE2(&s.s[0], out, in)
+}
+
+func decryptBlocksXMM(s *state, out, in []byte) {
+	decryptBlocksSSE2(&s.s[0], out, in)
+}
+
+func finalizeXMM(s *state, msgLen, adLen uint64, tag []byte) {
+	_ = tag[15] // Bounds check elimination
+	finalizeSSE2(&s.s[0], &tag[0], msgLen, adLen)
+}
+
+var implSSE2 = &hwaccelImpl{
+	name:            "SSE2",
+	aeadEncryptFn:   aeadEncryptXMM,
+	aeadDecryptFn:   aeadDecryptXMM,
+	initFn:          initXMM,
+	absorbBlocksFn:  absorbBlocksXMM,
+	encryptBlocksFn: encryptBlocksXMM,
+	decryptBlocksFn: decryptBlocksXMM,
+	finalizeFn:      finalizeXMM,
 }
 
 func initHardwareAcceleration() {
+	hardwareAccelImpls = nil
 	if supportsAVX2() {
-		isHardwareAccelerated = true
-		hardwareAccelImpl = implAVX2
+		hardwareAccelImpls = append(hardwareAccelImpls, implAVX2)
 	}
+
+	// SSE2 is part of the AMD64 baseline, so it is always supported.
+	hardwareAccelImpls = append(hardwareAccelImpls, implSSE2)
+
+	isHardwareAccelerated = true
+	hardwareAccelImpl = hardwareAccelImpls[0]
 }
@@ -92,6 +92,20 @@ TEXT ·xgetbv0Amd64(SB), NOSPLIT, $0-8
 	VPOR   T0, T1, S4    \
 	VPERMQ $-109, S2, S2
 
+#define LOAD_STATE(SRC) \
+	VMOVDQU 0(SRC), S0   \
+	VMOVDQU 32(SRC), S1  \
+	VMOVDQU 64(SRC), S2  \
+	VMOVDQU 96(SRC), S3  \
+	VMOVDQU 128(SRC), S4
+
+#define STORE_STATE(DST) \
+	VMOVDQU S0, 0(DST)   \
+	VMOVDQU S1, 32(DST)  \
+	VMOVDQU S2, 64(DST)  \
+	VMOVDQU S3, 96(DST)  \
+	VMOVDQU S4, 128(DST)
+
 #define COPY(DST, SRC, LEN) \
 	MOVQ SRC, SI \
 	MOVQ DST, DI \
@@ -156,8 +170,8 @@ loopFinal:                    \
 	VPXOR      Y6, Y7, Y7       \
 	MOVOU      X7, (TAG)
 
-// func aeadEncryptAVX2(c, m, a []byte, nonce, key *byte)
-TEXT ·aeadEncryptAVX2(SB), NOSPLIT, $32-88
+// func aeadEncryptAVX2(c, m, a []byte, nonce, key, tag *byte)
+TEXT ·aeadEncryptAVX2(SB), NOSPLIT, $32-96
 	MOVQ    SP, R15
 	VPXOR   Y13, Y13, Y13
 	VMOVDQU Y13, (R15)
@@ -169,14 +183,14 @@ TEXT ·aeadEncryptAVX2(SB), NOSPLIT, $32-88
 	INIT_STATE(R8, R9)
 
 	// Absorb the AD.
-	MOVQ a+48(FP), R8 // &a[0] -> R8
-	MOVQ a+56(FP), R9 // len(a) -> R9
+	MOVQ a+48(FP), R8     // &a[0] -> R8
+	MOVQ a_len+56(FP), R9 // len(a) -> R9
 	ABSORB_BLOCKS(R8, R9, R15)
 
 	// Encrypt the data.
-	MOVQ m+24(FP), R8 // &m[0] -> R8
-	MOVQ m+32(FP), R9 // len(m) -> R9
-	MOVQ c+0(FP), R10 // &c[0] -> R10
+	MOVQ m+24(FP), R8     // &m[0] -> R8
+	MOVQ m_len+32(FP), R9 // len(m) -> R9
+	MOVQ c+0(FP), R10     // &c[0] -> R10
 
 	MOVQ R9, AX
 	SHRQ $5, AX
@@ -210,15 +224,15 @@ encryptPartial:
 	VMOVDQU Y6, (R15)
 	STATE_UPDATE()
 	COPY(R10, R15, R9)
-	ADDQ    R9, R10
 
 encryptDone:
 
 	// Finalize and write the tag.
-	MOVQ    a+56(FP), R8 // len(a) -> R8
-	MOVQ    m+32(FP), R9 // len(m) -> R9
+	MOVQ    a_len+56(FP), R8 // len(a) -> R8
+	MOVQ    m_len+32(FP), R9 // len(m) -> R9
+	MOVQ    tag+88(FP), R14  // tag -> R14
 	VMOVDQU Y13, (R15)
-	FINALIZE(R10, R8, R9, R15)
+	FINALIZE(R14, R8, R9, R15)
 
 	VMOVDQU Y13, (R15)
 	VZEROUPPER
@@ -237,14 +251,14 @@ TEXT ·aeadDecryptAVX2(SB), NOSPLIT, $32-96
 	INIT_STATE(R8, R9)
 
 	// Absorb the AD.
-	MOVQ a+48(FP), R8 // &a[0] -> R8
-	MOVQ a+56(FP), R9 // len(a) -> R9
+	MOVQ a+48(FP), R8     // &a[0] -> R8
+	MOVQ a_len+56(FP), R9 // len(a) -> R9
 	ABSORB_BLOCKS(R8, R9, R15)
 
 	// Decrypt the data.
-	MOVQ c+24(FP), R8 // &c[0] -> R8
-	MOVQ c+32(FP), R9 // len(c) -> R9
-	MOVQ m+0(FP), R10 // &m[0] -> R10
+	MOVQ c+24(FP), R8     // &c[0] -> R8
+	MOVQ c_len+32(FP), R9 // len(c) -> R9
+	MOVQ m+0(FP), R10     // &m[0] -> R10
 
 	MOVQ R9, AX
 	SHRQ $5, AX
@@ -290,12 +304,130 @@ decryptPartial:
 decryptDone:
 
 	// Finalize and write the tag.
-	MOVQ    a+56(FP), R8    // len(a) -> R8
-	MOVQ    m+32(FP), R9    // len(m) -> R9
-	MOVQ    tag+88(FP), R14 // tag -> R14
+	MOVQ    a_len+56(FP), R8 // len(a) -> R8
+	MOVQ    c_len+32(FP), R9 // len(c) -> R9
+	MOVQ    tag+88(FP), R14  // tag -> R14
 	VMOVDQU Y13, (R15)
 	FINALIZE(R14, R8, R9, R15)
 
 	VMOVDQU Y13, (R15)
 	VZEROUPPER
 	RET
+
+// The incremental interface keeps the state in memory between calls, using
+// the same layout as the reference implementation.
+
+// func initAVX2(s *uint64, key, iv *byte)
+TEXT ·initAVX2(SB), NOSPLIT, $0-24
+	MOVQ iv+16(FP), R8
+	MOVQ key+8(FP), R9
+	INIT_STATE(R8, R9)
+
+	MOVQ s+0(FP), R10
+	STORE_STATE(R10)
+
+	VZEROUPPER
+	RET
+
+// func absorbBlocksAVX2(s *uint64, in []byte)
+TEXT ·absorbBlocksAVX2(SB), NOSPLIT, $0-32
+	MOVQ s+0(FP), R10
+	MOVQ in_base+8(FP), R8 // &in[0] -> R8
+	MOVQ in_len+16(FP), AX // len(in) -> AX
+	SHRQ $5, AX
+	JZ   absorbBlocksDone
+
+	LOAD_STATE(R10)
+
+loopAbsorbBlocks:
+	VMOVDQU (R8), M0
+	STATE_UPDATE()
+	ADDQ    $32, R8
+	SUBQ    $1, AX
+	JNZ     loopAbsorbBlocks
+
+	STORE_STATE(R10)
+	VZEROUPPER
+
+absorbBlocksDone:
+	RET
+
+// func encryptBlocksAVX2(s *uint64, out, in []byte)
+TEXT ·encryptBlocksAVX2(SB), NOSPLIT, $0-56
+	MOVQ s+0(FP), R11
+	MOVQ out_base+8(FP), R10 // &out[0] -> R10
+	MOVQ in_base+32(FP), R8  // &in[0] -> R8
+	MOVQ in_len+40(FP), AX   // len(in) -> AX
+	SHRQ $5, AX
+	JZ   encryptBlocksDone
+
+	LOAD_STATE(R11)
+
+loopEncryptBlocks:
+	VMOVDQU (R8), M0
+	VPERMQ  $57, S1, Y6
+	VPXOR   S0, Y6, Y6
+	VPAND   S2, S3, Y7
+	VPXOR   Y6, Y7, Y6
+	VPXOR   M0, Y6, Y6
+	VMOVDQU Y6, (R10)
+	STATE_UPDATE()
+	ADDQ    $32, R8
+	ADDQ    $32, R10
+	SUBQ    $1, AX
+	JNZ     loopEncryptBlocks
+
+	STORE_STATE(R11)
+	VZEROUPPER
+
+encryptBlocksDone:
+	RET
+
+// func decryptBlocksAVX2(s *uint64, out, in []byte)
+TEXT ·decryptBlocksAVX2(SB), NOSPLIT, $0-56
+	MOVQ s+0(FP), R11
+	MOVQ out_base+8(FP), R10 // &out[0] -> R10
+	MOVQ in_base+32(FP), R8  // &in[0] -> R8
+	MOVQ in_len+40(FP), AX   // len(in) -> AX
+	SHRQ $5, AX
+	JZ   decryptBlocksDone
+
+	LOAD_STATE(R11)
+
+loopDecryptBlocks:
+	VMOVDQU (R8), M0
+	VPERMQ  $57, S1, Y6
+	VPXOR   S0, Y6, Y6
+	VPAND   S2, S3, Y7
+	VPXOR   Y6, Y7, Y6
+	VPXOR   M0, Y6, M0
+	VMOVDQU M0, (R10)
+	STATE_UPDATE()
+	ADDQ    $32, R8
+	ADDQ    $32, R10
+	SUBQ    $1, AX
+	JNZ     loopDecryptBlocks
+
+	STORE_STATE(R11)
+	VZEROUPPER
+
+decryptBlocksDone:
+	RET
+
+// func finalizeAVX2(s *uint64, tag *byte, msgLen, adLen uint64)
+TEXT ·finalizeAVX2(SB), NOSPLIT, $32-32
+	MOVQ    SP, R15
+	VPXOR   Y13, Y13, Y13
+	VMOVDQU Y13, (R15)
+
+	MOVQ s+0(FP), R10
+	LOAD_STATE(R10)
+
+	MOVQ tag+8(FP), R14
+	MOVQ adLen+24(FP), R8
+	MOVQ msgLen+16(FP), R9
+	FINALIZE(R14, R8, R9, R15)
+
+	VMOVDQU Y13, (R15)
+	VZEROUPPER
+	RET
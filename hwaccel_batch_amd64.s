@@ -0,0 +1,335 @@
+// +build !noasm,go1.10
+// hwaccel_batch_amd64.s - AMD64 optimized two-way interleaved routines
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+#include "textflag.h"
+
+// The MORUS state update is a single long dependency chain, so processing
+// one message at a time leaves most of the execution ports idle.  These
+// routines process two independent states in lockstep, with the
+// instructions for each state interleaved, so that the latency of one
+// chain is hidden behind the other.
+//
+// The states use the same in-memory layout as the reference implementation,
+// and all sixteen YMM registers are needed to hold both states, so unlike
+// the single state code there is nothing to spare for caching the key.
+
+// YMM Registers: Ax/Bx -> State, MA/MB -> Message, TAx/TBx -> Temporary
+#define A0 Y0
+#define A1 Y1
+#define A2 Y2
+#define A3 Y3
+#define A4 Y4
+#define B0 Y5
+#define B1 Y6
+#define B2 Y7
+#define B3 Y8
+#define B4 Y9
+#define MA Y10
+#define MB Y11
+#define TA0 Y12
+#define TA1 Y13
+#define TB0 Y14
+#define TB1 Y15
+
+#define STATE_UPDATE_X2() \
+	VPXOR  A0, A3, A0    \
+	VPXOR  B0, B3, B0    \
+	VPAND  A1, A2, TA0   \
+	VPAND  B1, B2, TB0   \
+	VPXOR  A0, TA0, A0   \
+	VPXOR  B0, TB0, B0   \
+	VPSLLQ $13, A0, TA0  \
+	VPSLLQ $13, B0, TB0  \
+	VPSRLQ $51, A0, TA1  \
+	VPSRLQ $51, B0, TB1  \
+	VPOR   TA0, TA1, A0  \
+	VPOR   TB0, TB1, B0  \
+	VPERMQ $-109, A3, A3 \
+	VPERMQ $-109, B3, B3 \
+	VPXOR  A1, MA, A1    \
+	VPXOR  B1, MB, B1    \
+	VPXOR  A1, A4, A1    \
+	VPXOR  B1, B4, B1    \
+	VPAND  A2, A3, TA0   \
+	VPAND  B2, B3, TB0   \
+	VPXOR  A1, TA0, A1   \
+	VPXOR  B1, TB0, B1   \
+	VPSLLQ $46, A1, TA0  \
+	VPSLLQ $46, B1, TB0  \
+	VPSRLQ $18, A1, TA1  \
+	VPSRLQ $18, B1, TB1  \
+	VPOR   TA0, TA1, A1  \
+	VPOR   TB0, TB1, B1  \
+	VPERMQ $78, A4, A4   \
+	VPERMQ $78, B4, B4   \
+	VPXOR  A2, MA, A2    \
+	VPXOR  B2, MB, B2    \
+	VPXOR  A2, A0, A2    \
+	VPXOR  B2, B0, B2    \
+	VPAND  A3, A4, TA0   \
+	VPAND  B3, B4, TB0   \
+	VPXOR  A2, TA0, A2   \
+	VPXOR  B2, TB0, B2   \
+	VPSLLQ $38, A2, TA0  \
+	VPSLLQ $38, B2, TB0  \
+	VPSRLQ $26, A2, TA1  \
+	VPSRLQ $26, B2, TB1  \
+	VPOR   TA0, TA1, A2  \
+	VPOR   TB0, TB1, B2  \
+	VPERMQ $57, A0, A0   \
+	VPERMQ $57, B0, B0   \
+	VPXOR  A3, MA, A3    \
+	VPXOR  B3, MB, B3    \
+	VPXOR  A3, A1, A3    \
+	VPXOR  B3, B1, B3    \
+	VPAND  A4, A0, TA0   \
+	VPAND  B4, B0, TB0   \
+	VPXOR  A3, TA0, A3   \
+	VPXOR  B3, TB0, B3   \
+	VPSLLQ $7, A3, TA0   \
+	VPSLLQ $7, B3, TB0   \
+	VPSRLQ $57, A3, TA1  \
+	VPSRLQ $57, B3, TB1  \
+	VPOR   TA0, TA1, A3  \
+	VPOR   TB0, TB1, B3  \
+	VPERMQ $78, A1, A1   \
+	VPERMQ $78, B1, B1   \
+	VPXOR  A4, MA, A4    \
+	VPXOR  B4, MB, B4    \
+	VPXOR  A4, A2, A4    \
+	VPXOR  B4, B2, B4    \
+	VPAND  A0, A1, TA0   \
+	VPAND  B0, B1, TB0   \
+	VPXOR  A4, TA0, A4   \
+	VPXOR  B4, TB0, B4   \
+	VPSLLQ $4, A4, TA0   \
+	VPSLLQ $4, B4, TB0   \
+	VPSRLQ $60, A4, TA1  \
+	VPSRLQ $60, B4, TB1  \
+	VPOR   TA0, TA1, A4  \
+	VPOR   TB0, TB1, B4  \
+	VPERMQ $-109, A2, A2 \
+	VPERMQ $-109, B2, B2
+
+#define LOAD_STATE_X2(SRC0, SRC1) \
+	VMOVDQU 0(SRC0), A0   \
+	VMOVDQU 0(SRC1), B0   \
+	VMOVDQU 32(SRC0), A1  \
+	VMOVDQU 32(SRC1), B1  \
+	VMOVDQU 64(SRC0), A2  \
+	VMOVDQU 64(SRC1), B2  \
+	VMOVDQU 96(SRC0), A3  \
+	VMOVDQU 96(SRC1), B3  \
+	VMOVDQU 128(SRC0), A4 \
+	VMOVDQU 128(SRC1), B4
+
+#define STORE_STATE_X2(DST0, DST1) \
+	VMOVDQU A0, 0(DST0)   \
+	VMOVDQU B0, 0(DST1)   \
+	VMOVDQU A1, 32(DST0)  \
+	VMOVDQU B1, 32(DST1)  \
+	VMOVDQU A2, 64(DST0)  \
+	VMOVDQU B2, 64(DST1)  \
+	VMOVDQU A3, 96(DST0)  \
+	VMOVDQU B3, 96(DST1)  \
+	VMOVDQU A4, 128(DST0) \
+	VMOVDQU B4, 128(DST1)
+
+// Leaves the key stream for each state in TA0 and TB0.
+#define KEY_STREAM_X2() \
+	VPERMQ $57, A1, TA0  \
+	VPERMQ $57, B1, TB0  \
+	VPXOR  A0, TA0, TA0  \
+	VPXOR  B0, TB0, TB0  \
+	VPAND  A2, A3, TA1   \
+	VPAND  B2, B3, TB1   \
+	VPXOR  TA0, TA1, TA0 \
+	VPXOR  TB0, TB1, TB0
+
+// func initAVX2x2(s0, s1 *uint64, key, iv0, iv1 *byte)
+TEXT ·initAVX2x2(SB), NOSPLIT, $0-40
+	MOVQ key+16(FP), R9
+	MOVQ iv0+24(FP), R8
+	MOVQ iv1+32(FP), R10
+
+	VMOVDQU  (R8), X0
+	VMOVDQU  (R10), X5
+	VMOVDQU  (R9), A1
+	VMOVDQA  A1, B1
+	VPCMPEQD A2, A2, A2
+	VPCMPEQD B2, B2, B2
+	VPXOR    A3, A3, A3
+	VPXOR    B3, B3, B3
+	VMOVDQU  ·initializationConstants(SB), A4
+	VMOVDQA  A4, B4
+	VPXOR    MA, MA, MA
+	VPXOR    MB, MB, MB
+
+	MOVQ $16, AX
+
+initLoop:
+	STATE_UPDATE_X2()
+	SUBQ $1, AX
+	JNZ  initLoop
+
+	VPXOR (R9), A1, A1
+	VPXOR (R9), B1, B1
+
+	MOVQ s0+0(FP), R11
+	MOVQ s1+8(FP), R12
+	STORE_STATE_X2(R11, R12)
+
+	VZEROUPPER
+	RET
+
+// func absorbBlocksAVX2x2(s0, s1 *uint64, in0, in1 []byte)
+TEXT ·absorbBlocksAVX2x2(SB), NOSPLIT, $0-64
+	MOVQ s0+0(FP), R11
+	MOVQ s1+8(FP), R12
+	MOVQ in0_base+16(FP), R8 // &in0[0] -> R8
+	MOVQ in0_len+24(FP), AX  // len(in0) -> AX
+	MOVQ in1_base+40(FP), R9 // &in1[0] -> R9
+	SHRQ $5, AX
+	JZ   absorbBlocksDone
+
+	LOAD_STATE_X2(R11, R12)
+
+loopAbsorbBlocks:
+	VMOVDQU (R8), MA
+	VMOVDQU (R9), MB
+	STATE_UPDATE_X2()
+	ADDQ    $32, R8
+	ADDQ    $32, R9
+	SUBQ    $1, AX
+	JNZ     loopAbsorbBlocks
+
+	STORE_STATE_X2(R11, R12)
+	VZEROUPPER
+
+absorbBlocksDone:
+	RET
+
+// func encryptBlocksAVX2x2(s0, s1 *uint64, out0, in0, out1, in1 []byte)
+TEXT ·encryptBlocksAVX2x2(SB), NOSPLIT, $0-112
+	MOVQ s0+0(FP), R11
+	MOVQ s1+8(FP), R12
+	MOVQ out0_base+16(FP), R10 // &out0[0] -> R10
+	MOVQ in0_base+40(FP), R8   // &in0[0] -> R8
+	MOVQ in0_len+48(FP), AX    // len(in0) -> AX
+	MOVQ out1_base+64(FP), R13 // &out1[0] -> R13
+	MOVQ in1_base+88(FP), R9   // &in1[0] -> R9
+	SHRQ $5, AX
+	JZ   encryptBlocksDone
+
+	LOAD_STATE_X2(R11, R12)
+
+loopEncryptBlocks:
+	VMOVDQU (R8), MA
+	VMOVDQU (R9), MB
+	KEY_STREAM_X2()
+	VPXOR   MA, TA0, TA0
+	VPXOR   MB, TB0, TB0
+	VMOVDQU TA0, (R10)
+	VMOVDQU TB0, (R13)
+	STATE_UPDATE_X2()
+	ADDQ    $32, R8
+	ADDQ    $32, R9
+	ADDQ    $32, R10
+	ADDQ    $32, R13
+	SUBQ    $1, AX
+	JNZ     loopEncryptBlocks
+
+	STORE_STATE_X2(R11, R12)
+	VZEROUPPER
+
+encryptBlocksDone:
+	RET
+
+// func decryptBlocksAVX2x2(s0, s1 *uint64, out0, in0, out1, in1 []byte)
+TEXT ·decryptBlocksAVX2x2(SB), NOSPLIT, $0-112
+	MOVQ s0+0(FP), R11
+	MOVQ s1+8(FP), R12
+	MOVQ out0_base+16(FP), R10 // &out0[0] -> R10
+	MOVQ in0_base+40(FP), R8   // &in0[0] -> R8
+	MOVQ in0_len+48(FP), AX    // len(in0) -> AX
+	MOVQ out1_base+64(FP), R13 // &out1[0] -> R13
+	MOVQ in1_base+88(FP), R9   // &in1[0] -> R9
+	SHRQ $5, AX
+	JZ   decryptBlocksDone
+
+	LOAD_STATE_X2(R11, R12)
+
+loopDecryptBlocks:
+	VMOVDQU (R8), MA
+	VMOVDQU (R9), MB
+	KEY_STREAM_X2()
+	VPXOR   TA0, MA, MA
+	VPXOR   TB0, MB, MB
+	VMOVDQU MA, (R10)
+	VMOVDQU MB, (R13)
+	STATE_UPDATE_X2()
+	ADDQ    $32, R8
+	ADDQ    $32, R9
+	ADDQ    $32, R10
+	ADDQ    $32, R13
+	SUBQ    $1, AX
+	JNZ     loopDecryptBlocks
+
+	STORE_STATE_X2(R11, R12)
+	VZEROUPPER
+
+decryptBlocksDone:
+	RET
+
+// func finalizeAVX2x2(s0, s1 *uint64, tag0, tag1 *byte, msgLen0, adLen0, msgLen1, adLen1 uint64)
+TEXT ·finalizeAVX2x2(SB), NOSPLIT, $64-64
+	MOVQ    SP, R15
+	VPXOR   TA0, TA0, TA0
+	VMOVDQU TA0, (R15)
+	VMOVDQU TA0, 32(R15)
+
+	MOVQ msgLen0+32(FP), R8
+	MOVQ adLen0+40(FP), R9
+	MOVQ msgLen1+48(FP), R10
+	MOVQ adLen1+56(FP), R11
+	SHLQ $3, R8
+	SHLQ $3, R9
+	SHLQ $3, R10
+	SHLQ $3, R11
+	MOVQ R9, 0(R15)
+	MOVQ R8, 8(R15)
+	MOVQ R11, 32(R15)
+	MOVQ R10, 40(R15)
+
+	MOVQ s0+0(FP), R11
+	MOVQ s1+8(FP), R12
+	LOAD_STATE_X2(R11, R12)
+
+	VPXOR   A4, A0, A4
+	VPXOR   B4, B0, B4
+	VMOVDQU 0(R15), MA
+	VMOVDQU 32(R15), MB
+
+	MOVQ $10, AX
+
+loopFinal:
+	STATE_UPDATE_X2()
+	SUBQ $1, AX
+	JNZ  loopFinal
+
+	KEY_STREAM_X2()
+	MOVQ  tag0+16(FP), R8
+	MOVQ  tag1+24(FP), R9
+	MOVOU X12, (R8)
+	MOVOU X14, (R9)
+
+	VPXOR   TA0, TA0, TA0
+	VMOVDQU TA0, (R15)
+	VMOVDQU TA0, 32(R15)
+	VZEROUPPER
+	RET
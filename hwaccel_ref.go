@@ -10,5 +10,6 @@
 package morus
 
 func initHardwareAcceleration() {
+	hardwareAccelImpls = nil
 	forceDisableHardwareAcceleration()
 }
@@ -0,0 +1,455 @@
+// +build !noasm,go1.10
+// hwaccel_sse2_amd64.s - AMD64 SSE2 optimized routines
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+#include "textflag.h"
+
+// This is the AVX2 implementation, with each 256 bit row of the state split
+// across a pair of XMM registers.  SSE2 is part of the AMD64 baseline, so
+// this is always available.
+
+// XMM Registers: SxL/SxH -> State (Low/High), M0L/M0H -> Message,
+// Tx -> Temporary
+// GP Registers: RAX, RBX, RCX -> Temporary
+#define S0L X0
+#define S0H X1
+#define S1L X2
+#define S1H X3
+#define S2L X4
+#define S2H X5
+#define S3L X6
+#define S3H X7
+#define S4L X8
+#define S4H X9
+#define M0L X10
+#define M0H X11
+#define T0 X12
+#define T1 X13
+#define T2 X14
+#define T3 X15
+
+// A ^= X ^ (B & C), A <<<= SL (per 64 bit lane)
+#define ROW_UPDATE(A_L, A_H, X_L, X_H, B_L, B_H, C_L, C_H, SL, SR) \
+	PXOR  X_L, A_L \
+	PXOR  X_H, A_H \
+	MOVO  B_L, T0  \
+	MOVO  B_H, T1  \
+	PAND  C_L, T0  \
+	PAND  C_H, T1  \
+	PXOR  T0, A_L  \
+	PXOR  T1, A_H  \
+	MOVO  A_L, T0  \
+	MOVO  A_H, T1  \
+	PSLLQ SL, A_L  \
+	PSLLQ SL, A_H  \
+	PSRLQ SR, T0   \
+	PSRLQ SR, T1   \
+	POR   T0, A_L  \
+	POR   T1, A_H
+
+#define XOR_MSG(A_L, A_H) \
+	PXOR M0L, A_L \
+	PXOR M0H, A_H
+
+// Equivalent to `VPERMQ $-109` (A = A <<< 64).
+#define WORD_ROTATE_1(A_L, A_H) \
+	MOVO   A_H, T0  \
+	SHUFPD $1, A_L, T0 \
+	SHUFPD $1, A_H, A_L \
+	MOVO   A_L, A_H \
+	MOVO   T0, A_L
+
+// Equivalent to `VPERMQ $78` (A = A <<< 128).
+#define WORD_ROTATE_2(A_L, A_H) \
+	MOVO A_L, T0  \
+	MOVO A_H, A_L \
+	MOVO T0, A_H
+
+// Equivalent to `VPERMQ $57` (A = A <<< 192).
+#define WORD_ROTATE_3(A_L, A_H) \
+	MOVO   A_L, T0  \
+	SHUFPD $1, A_H, A_L \
+	SHUFPD $1, T0, A_H
+
+#define STATE_UPDATE() \
+	ROW_UPDATE(S0L, S0H, S3L, S3H, S1L, S1H, S2L, S2H, $13, $51) \
+	WORD_ROTATE_1(S3L, S3H)                                      \
+	                                                             \
+	XOR_MSG(S1L, S1H)                                            \
+	ROW_UPDATE(S1L, S1H, S4L, S4H, S2L, S2H, S3L, S3H, $46, $18) \
+	WORD_ROTATE_2(S4L, S4H)                                      \
+	                                                             \
+	XOR_MSG(S2L, S2H)                                            \
+	ROW_UPDATE(S2L, S2H, S0L, S0H, S3L, S3H, S4L, S4H, $38, $26) \
+	WORD_ROTATE_3(S0L, S0H)                                      \
+	                                                             \
+	XOR_MSG(S3L, S3H)                                            \
+	ROW_UPDATE(S3L, S3H, S1L, S1H, S4L, S4H, S0L, S0H, $7, $57)  \
+	WORD_ROTATE_2(S1L, S1H)                                      \
+	                                                             \
+	XOR_MSG(S4L, S4H)                                            \
+	ROW_UPDATE(S4L, S4H, S2L, S2H, S0L, S0H, S1L, S1H, $4, $60)  \
+	WORD_ROTATE_1(S2L, S2H)
+
+// T2:T3 = S0 ^ (S1 <<< 192) ^ (S2 & S3)
+#define KEY_STREAM() \
+	MOVO   S1L, T2      \
+	MOVO   S1H, T3      \
+	SHUFPD $1, S1H, T2  \
+	SHUFPD $1, S1L, T3  \
+	PXOR   S0L, T2      \
+	PXOR   S0H, T3      \
+	MOVO   S2L, T0      \
+	MOVO   S2H, T1      \
+	PAND   S3L, T0      \
+	PAND   S3H, T1      \
+	PXOR   T0, T2       \
+	PXOR   T1, T3
+
+#define LOAD_STATE(SRC) \
+	MOVOU 0(SRC), S0L   \
+	MOVOU 16(SRC), S0H  \
+	MOVOU 32(SRC), S1L  \
+	MOVOU 48(SRC), S1H  \
+	MOVOU 64(SRC), S2L  \
+	MOVOU 80(SRC), S2H  \
+	MOVOU 96(SRC), S3L  \
+	MOVOU 112(SRC), S3H \
+	MOVOU 128(SRC), S4L \
+	MOVOU 144(SRC), S4H
+
+#define STORE_STATE(DST) \
+	MOVOU S0L, 0(DST)   \
+	MOVOU S0H, 16(DST)  \
+	MOVOU S1L, 32(DST)  \
+	MOVOU S1H, 48(DST)  \
+	MOVOU S2L, 64(DST)  \
+	MOVOU S2H, 80(DST)  \
+	MOVOU S3L, 96(DST)  \
+	MOVOU S3H, 112(DST) \
+	MOVOU S4L, 128(DST) \
+	MOVOU S4H, 144(DST)
+
+#define LOAD_MSG(SRC) \
+	MOVOU 0(SRC), M0L \
+	MOVOU 16(SRC), M0H
+
+#define ZERO_SCRATCH(SCRATCH) \
+	PXOR  T0, T0          \
+	MOVOU T0, 0(SCRATCH)  \
+	MOVOU T0, 16(SCRATCH)
+
+#define COPY(DST, SRC, LEN) \
+	MOVQ SRC, SI \
+	MOVQ DST, DI \
+	MOVQ LEN, CX \
+	REP          \
+	MOVSB
+
+#define INIT_STATE(IV, KEY) \
+	MOVOU   (IV), S0L                                 \
+	PXOR    S0H, S0H                                  \
+	MOVOU   (KEY), S1L                                \
+	MOVOU   16(KEY), S1H                              \
+	PCMPEQL S2L, S2L                                  \
+	PCMPEQL S2H, S2H                                  \
+	PXOR    S3L, S3L                                  \
+	PXOR    S3H, S3H                                  \
+	MOVOU   ·initializationConstants(SB), S4L         \
+	MOVOU   ·initializationConstants+16(SB), S4H      \
+	PXOR    M0L, M0L                                  \
+	PXOR    M0H, M0H                                  \
+	MOVQ    $16, AX                                   \
+	                                                  \
+initLoop:                                           \
+	STATE_UPDATE()                                    \
+	SUBQ    $1, AX                                    \
+	JNZ     initLoop                                  \
+	                                                  \
+	MOVOU   (KEY), T0                                 \
+	MOVOU   16(KEY), T1                               \
+	PXOR    T0, S1L                                   \
+	PXOR    T1, S1H
+
+#define ABSORB_BLOCKS(A, ALEN, SCRATCH) \
+	MOVQ            ALEN, AX       \
+	SHRQ            $5, AX         \
+	JZ              absorbPartial  \
+loopAbsorbFull:                  \
+	LOAD_MSG(A)                    \
+	STATE_UPDATE()                 \
+	ADDQ            $32, A         \
+	SUBQ            $1, AX         \
+	JNZ             loopAbsorbFull \
+absorbPartial:                   \
+	ANDQ            $31, ALEN      \
+	JZ              absorbDone     \
+	COPY(SCRATCH, A, ALEN)         \
+	LOAD_MSG(SCRATCH)              \
+	STATE_UPDATE()                 \
+absorbDone:
+
+#define FINALIZE(TAG, ALEN, MLEN, SCRATCH) \
+	SHLQ       $3, ALEN         \
+	MOVQ       ALEN, (SCRATCH)  \
+	SHLQ       $3, MLEN         \
+	MOVQ       MLEN, 8(SCRATCH) \
+	                            \
+	PXOR       S0L, S4L         \
+	PXOR       S0H, S4H         \
+	LOAD_MSG(SCRATCH)           \
+	                            \
+	MOVQ       $10, AX          \
+loopFinal:                    \
+	STATE_UPDATE()              \
+	SUBQ       $1, AX           \
+	JNZ        loopFinal        \
+	                            \
+	KEY_STREAM()                \
+	MOVOU      T2, (TAG)
+
+// func aeadEncryptSSE2(c, m, a []byte, nonce, key, tag *byte)
+TEXT ·aeadEncryptSSE2(SB), NOSPLIT, $32-96
+	MOVQ SP, R15
+	ZERO_SCRATCH(R15)
+	CLD
+
+	// Initialize the state.
+	MOVQ nonce+72(FP), R8
+	MOVQ key+80(FP), R9
+	INIT_STATE(R8, R9)
+
+	// Absorb the AD.
+	MOVQ a+48(FP), R8     // &a[0] -> R8
+	MOVQ a_len+56(FP), R9 // len(a) -> R9
+	ABSORB_BLOCKS(R8, R9, R15)
+
+	// Encrypt the data.
+	MOVQ m+24(FP), R8     // &m[0] -> R8
+	MOVQ m_len+32(FP), R9 // len(m) -> R9
+	MOVQ c+0(FP), R10     // &c[0] -> R10
+
+	MOVQ R9, AX
+	SHRQ $5, AX
+	JZ   encryptPartial
+
+loopEncryptFull:
+	LOAD_MSG(R8)
+	KEY_STREAM()
+	PXOR  M0L, T2
+	PXOR  M0H, T3
+	MOVOU T2, 0(R10)
+	MOVOU T3, 16(R10)
+	STATE_UPDATE()
+	ADDQ  $32, R8
+	ADDQ  $32, R10
+	SUBQ  $1, AX
+	JNZ   loopEncryptFull
+
+encryptPartial:
+	ANDQ $31, R9
+	JZ   encryptDone
+	ZERO_SCRATCH(R15)
+	COPY(R15, R8, R9)
+	LOAD_MSG(R15)
+	KEY_STREAM()
+	PXOR  M0L, T2
+	PXOR  M0H, T3
+	MOVOU T2, 0(R15)
+	MOVOU T3, 16(R15)
+	STATE_UPDATE()
+	COPY(R10, R15, R9)
+
+encryptDone:
+
+	// Finalize and write the tag.
+	MOVQ a_len+56(FP), R8 // len(a) -> R8
+	MOVQ m_len+32(FP), R9 // len(m) -> R9
+	MOVQ tag+88(FP), R14  // tag -> R14
+	ZERO_SCRATCH(R15)
+	FINALIZE(R14, R8, R9, R15)
+
+	ZERO_SCRATCH(R15)
+	RET
+
+// func aeadDecryptSSE2(m, c, a []byte, nonce, key, tag *byte)
+TEXT ·aeadDecryptSSE2(SB), NOSPLIT, $32-96
+	MOVQ SP, R15
+	ZERO_SCRATCH(R15)
+	CLD
+
+	// Initialize the state.
+	MOVQ nonce+72(FP), R8
+	MOVQ key+80(FP), R9
+	INIT_STATE(R8, R9)
+
+	// Absorb the AD.
+	MOVQ a+48(FP), R8     // &a[0] -> R8
+	MOVQ a_len+56(FP), R9 // len(a) -> R9
+	ABSORB_BLOCKS(R8, R9, R15)
+
+	// Decrypt the data.
+	MOVQ c+24(FP), R8     // &c[0] -> R8
+	MOVQ c_len+32(FP), R9 // len(c) -> R9
+	MOVQ m+0(FP), R10     // &m[0] -> R10
+
+	MOVQ R9, AX
+	SHRQ $5, AX
+	JZ   decryptPartial
+
+loopDecryptFull:
+	LOAD_MSG(R8)
+	KEY_STREAM()
+	PXOR  T2, M0L
+	PXOR  T3, M0H
+	MOVOU M0L, 0(R10)
+	MOVOU M0H, 16(R10)
+	STATE_UPDATE()
+	ADDQ  $32, R8
+	ADDQ  $32, R10
+	SUBQ  $1, AX
+	JNZ   loopDecryptFull
+
+decryptPartial:
+	ANDQ $31, R9
+	JZ   decryptDone
+	ZERO_SCRATCH(R15)
+	COPY(R15, R8, R9)
+	LOAD_MSG(R15)
+	KEY_STREAM()
+	PXOR  T2, M0L
+	PXOR  T3, M0H
+	MOVOU M0L, 0(R15)
+	MOVOU M0H, 16(R15)
+	COPY(R10, R15, R9)
+	MOVQ  $0, AX
+	MOVQ  R15, DI
+	MOVQ  $32, CX
+	SUBQ  R9, CX
+	ADDQ  R9, DI
+	REP
+	STOSB
+	LOAD_MSG(R15)
+	STATE_UPDATE()
+
+decryptDone:
+
+	// Finalize and write the tag.
+	MOVQ a_len+56(FP), R8 // len(a) -> R8
+	MOVQ c_len+32(FP), R9 // len(c) -> R9
+	MOVQ tag+88(FP), R14  // tag -> R14
+	ZERO_SCRATCH(R15)
+	FINALIZE(R14, R8, R9, R15)
+
+	ZERO_SCRATCH(R15)
+	RET
+
+// func initSSE2(s *uint64, key, iv *byte)
+TEXT ·initSSE2(SB), NOSPLIT, $0-24
+	MOVQ iv+16(FP), R8
+	MOVQ key+8(FP), R9
+	INIT_STATE(R8, R9)
+
+	MOVQ s+0(FP), R10
+	STORE_STATE(R10)
+	RET
+
+// func absorbBlocksSSE2(s *uint64, in []byte)
+TEXT ·absorbBlocksSSE2(SB), NOSPLIT, $0-32
+	MOVQ s+0(FP), R10
+	MOVQ in_base+8(FP), R8 // &in[0] -> R8
+	MOVQ in_len+16(FP), AX // len(in) -> AX
+	SHRQ $5, AX
+	JZ   absorbBlocksDone
+
+	LOAD_STATE(R10)
+
+loopAbsorbBlocks:
+	LOAD_MSG(R8)
+	STATE_UPDATE()
+	ADDQ $32, R8
+	SUBQ $1, AX
+	JNZ  loopAbsorbBlocks
+
+	STORE_STATE(R10)
+
+absorbBlocksDone:
+	RET
+
+// func encryptBlocksSSE2(s *uint64, out, in []byte)
+TEXT ·encryptBlocksSSE2(SB), NOSPLIT, $0-56
+	MOVQ s+0(FP), R11
+	MOVQ out_base+8(FP), R10 // &out[0] -> R10
+	MOVQ in_base+32(FP), R8  // &in[0] -> R8
+	MOVQ in_len+40(FP), AX   // len(in) -> AX
+	SHRQ $5, AX
+	JZ   encryptBlocksDone
+
+	LOAD_STATE(R11)
+
+loopEncryptBlocks:
+	LOAD_MSG(R8)
+	KEY_STREAM()
+	PXOR  M0L, T2
+	PXOR  M0H, T3
+	MOVOU T2, 0(R10)
+	MOVOU T3, 16(R10)
+	STATE_UPDATE()
+	ADDQ  $32, R8
+	ADDQ  $32, R10
+	SUBQ  $1, AX
+	JNZ   loopEncryptBlocks
+
+	STORE_STATE(R11)
+
+encryptBlocksDone:
+	RET
+
+// func decryptBlocksSSE2(s *uint64, out, in []byte)
+TEXT ·decryptBlocksSSE2(SB), NOSPLIT, $0-56
+	MOVQ s+0(FP), R11
+	MOVQ out_base+8(FP), R10 // &out[0] -> R10
+	MOVQ in_base+32(FP), R8  // &in[0] -> R8
+	MOVQ in_len+40(FP), AX   // len(in) -> AX
+	SHRQ $5, AX
+	JZ   decryptBlocksDone
+
+	LOAD_STATE(R11)
+
+loopDecryptBlocks:
+	LOAD_MSG(R8)
+	KEY_STREAM()
+	PXOR  T2, M0L
+	PXOR  T3, M0H
+	MOVOU M0L, 0(R10)
+	MOVOU M0H, 16(R10)
+	STATE_UPDATE()
+	ADDQ  $32, R8
+	ADDQ  $32, R10
+	SUBQ  $1, AX
+	JNZ   loopDecryptBlocks
+
+	STORE_STATE(R11)
+
+decryptBlocksDone:
+	RET
+
+// func finalizeSSE2(s *uint64, tag *byte, msgLen, adLen uint64)
+TEXT ·finalizeSSE2(SB), NOSPLIT, $32-32
+	MOVQ SP, R15
+	ZERO_SCRATCH(R15)
+
+	MOVQ s+0(FP), R10
+	LOAD_STATE(R10)
+
+	MOVQ tag+8(FP), R14
+	MOVQ adLen+24(FP), R8
+	MOVQ msgLen+16(FP), R9
+	FINALIZE(R14, R8, R9, R15)
+
+	ZERO_SCRATCH(R15)
+	RET
@@ -0,0 +1,110 @@
+// hwaccel_test.go - Implementation selection tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"math/bits"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImplementations(t *testing.T) {
+	require := require.New(t)
+
+	defer initHardwareAcceleration()
+
+	impls := Implementations()
+	require.Len(impls, len(hardwareAccelImpls)+len(portableImpls), "Implementations()")
+	require.Contains(impls, implReference.name, "Implementations(): Reference")
+	require.Contains(impls, implPortable32.name, "Implementations(): Portable32")
+	last := impls[len(impls)-1]
+
+	// The split 32-bit implementation is preferred on 32-bit targets.
+	require.Equal(bits.UintSize == 32, portableImpls[0] == implPortable32, "portableImpls[0]")
+
+	for _, name := range impls {
+		err := SetImplementation(strings.ToLower(name))
+		require.NoError(err, "SetImplementation(%s)", name)
+		require.Equal(name, Implementation(), "Implementation()")
+		impl, _ := implByName(name)
+		require.Equal(!isPortable(impl), IsHardwareAccelerated(), "IsHardwareAccelerated(): %s", name)
+	}
+
+	err := SetImplementation("Bogus")
+	require.Equal(ErrUnsupportedImplementation, err, "SetImplementation(Bogus)")
+	require.Equal(last, Implementation(), "Implementation(): After failure")
+
+	var key [KeySize]byte
+	var nonce [NonceSize]byte
+	m := make([]byte, 1027)
+
+	initHardwareAcceleration()
+	aead := New(key[:])
+	require.Equal(Implementation(), aead.Implementation(), "AEAD.Implementation(): Default")
+
+	expected := aead.Seal(nil, nonce[:], m, nil)
+	for _, name := range impls {
+		err = aead.SetImplementation(name)
+		require.NoError(err, "AEAD.SetImplementation(%s)", name)
+		require.Equal(name, aead.Implementation(), "AEAD.Implementation()")
+		require.Equal(expected, aead.Seal(nil, nonce[:], m, nil), "AEAD.Seal(): %s", name)
+	}
+
+	require.Equal(ErrUnsupportedImplementation, aead.SetImplementation("Bogus"), "AEAD.SetImplementation(Bogus)")
+	require.NoError(aead.SetImplementation(""), "AEAD.SetImplementation()")
+	require.Equal(Implementation(), aead.Implementation(), "AEAD.Implementation(): Reverted")
+}
+
+func TestImplementationEnvVar(t *testing.T) {
+	require := require.New(t)
+
+	defer initHardwareAcceleration()
+
+	initHardwareAcceleration()
+	def := Implementation()
+	for _, v := range []struct {
+		value    string
+		expected string
+		ok       bool
+	}{
+		{"", def, false},
+		{"  ", def, false},
+		{"Reference", implReference.name, true},
+		{"reference", implReference.name, true},
+		{"PORTABLE32", implPortable32.name, true},
+		{" Portable32\n", implPortable32.name, true},
+		{"Bogus", def, false},
+		{"Reference32", def, false},
+	} {
+		initHardwareAcceleration()
+		ok := setImplementationFromEnv(v.value)
+		require.Equal(v.ok, ok, "setImplementationFromEnv(%q)", v.value)
+		require.Equal(v.expected, Implementation(), "setImplementationFromEnv(%q): Implementation()", v.value)
+	}
+
+	// Accelerated implementations are ignored when they are not supported
+	// by the host, whether or not they exist on the target.
+	for _, value := range []string{"AVX2", "sse2"} {
+		initHardwareAcceleration()
+		hardwareAccelImpls = nil
+		forceDisableHardwareAcceleration()
+		ok := setImplementationFromEnv(value)
+		require.False(ok, "setImplementationFromEnv(%q): Unsupported", value)
+		require.Equal(portableImpls[0].name, Implementation(), "setImplementationFromEnv(%q): Unsupported", value)
+		require.False(IsHardwareAccelerated(), "setImplementationFromEnv(%q): Unsupported", value)
+	}
+
+	initHardwareAcceleration()
+	for _, name := range Implementations() {
+		initHardwareAcceleration()
+		require.True(setImplementationFromEnv(strings.ToLower(name)), "setImplementationFromEnv(%q)", name)
+		require.Equal(name, Implementation(), "setImplementationFromEnv(%q): Implementation()", name)
+	}
+}
@@ -0,0 +1,58 @@
+// lwckat_test.go - NIST LWC AEAD KAT file support tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package lwckat
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLWCKAT(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("LWC_AEAD_KAT_256_128.txt", FileName(32, 16), "FileName()")
+
+	vectors := Generate(32, 16, func(key, nonce, pt, ad []byte) []byte {
+		return append(append([]byte{}, pt...), ad...)
+	})
+	require.Len(vectors, (MaxMessageLength+1)*(MaxADLength+1), "Generate(): len(vectors)")
+	require.Equal(1, vectors[0].Count, "Generate(): First Count")
+	require.Equal(len(vectors), vectors[len(vectors)-1].Count, "Generate(): Last Count")
+	require.Len(vectors[1].AD, 1, "Generate(): AD length varies fastest")
+	require.Equal([]byte{0, 1, 2}, vectors[3].AD, "Generate(): AD")
+
+	var buf bytes.Buffer
+	err := Write(&buf, vectors[:2])
+	require.NoError(err, "Write()")
+	expected := "Count = 1\nKey = 000102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F\nNonce = 000102030405060708090A0B0C0D0E0F\nPT = \nAD = \nCT = \n\n" +
+		"Count = 2\nKey = 000102030405060708090A0B0C0D0E0F101112131415161718191A1B1C1D1E1F\nNonce = 000102030405060708090A0B0C0D0E0F\nPT = \nAD = 00\nCT = 00\n\n"
+	require.Equal(expected, buf.String(), "Write()")
+
+	buf.Reset()
+	err = Write(&buf, vectors)
+	require.NoError(err, "Write()")
+	parsed, err := Parse(&buf)
+	require.NoError(err, "Parse()")
+	require.Equal(vectors, parsed, "Parse(Write())")
+
+	for _, s := range []string{
+		"Key = 00\n",
+		"Count = x\n",
+		"Count = 1\nBogus = 00\n",
+		"Count = 1\nKey = 0\n",
+		"Count = 1\nKey = 00\nKey = 00\n",
+		"Count = 1\nKey = 00\nNonce = 00\nPT = \nAD = \n",
+		"Count = 1\nKey\n",
+	} {
+		_, err = Parse(strings.NewReader(s))
+		require.Error(err, "Parse(%q)", s)
+	}
+}
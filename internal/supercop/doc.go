@@ -0,0 +1,26 @@
+// doc.go - SUPERCOP reference implementation package documentation
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+// Package supercop wraps the SUPERCOP MORUS-1280 reference implementations
+// via cgo, for differential testing of the Go implementations.  It is only
+// intended for use by tests.
+//
+// The C sources are not in the tree yet.  Running `vendor.sh` copies them
+// unmodified from supercop-20171218:
+//
+//   crypto_aead/morus1280256v2/ref64/{api.h,encrypt.c} -> morus1280256v2/
+//   crypto_aead/morus1280128v2/ref64/{api.h,encrypt.c} -> morus1280128v2/
+//
+// records the SHA-256 digest of each file in `SHA256SUMS` (check with
+// `sha256sum -c SHA256SUMS`), and generates a `namespace.h` per variant so
+// that both can be linked into the same binary.  Its output should be
+// committed as is.
+//
+// The package is only functional when built with cgo and the `supercop`
+// build tag, which fails until `vendor.sh` has been run.  Enabled is false
+// otherwise, and the differential tests are skipped.
+package supercop
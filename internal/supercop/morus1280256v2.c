@@ -0,0 +1,11 @@
+// +build supercop
+
+/* Builds the vendored morus1280256v2 reference code under its own namespace. */
+
+#if __has_include("morus1280256v2/namespace.h")
+#include "morus1280256v2/namespace.h"
+#include "morus1280256v2/api.h"
+#include "morus1280256v2/encrypt.c"
+#else
+#error "morus1280256v2 is not vendored, run internal/supercop/vendor.sh"
+#endif
@@ -0,0 +1,60 @@
+// supercop.go - SUPERCOP reference implementation variants
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package supercop
+
+const (
+	// NonceSize is the size of a nonce in bytes.
+	NonceSize = 16
+
+	// TagSize is the size of an authentication tag in bytes.
+	TagSize = 16
+)
+
+// Variant is a vendored reference implementation.
+type Variant int
+
+const (
+	// MORUS1280256 is `crypto_aead/morus1280256v2/ref64`.
+	MORUS1280256 Variant = iota
+
+	// MORUS1280128 is `crypto_aead/morus1280128v2/ref64`.
+	MORUS1280128
+)
+
+// KeySize returns the size of a key in bytes.
+func (v Variant) KeySize() int {
+	switch v {
+	case MORUS1280256:
+		return 32
+	case MORUS1280128:
+		return 16
+	default:
+		panic("supercop: invalid variant")
+	}
+}
+
+// String returns the SUPERCOP name of the variant.
+func (v Variant) String() string {
+	switch v {
+	case MORUS1280256:
+		return "morus1280256v2"
+	case MORUS1280128:
+		return "morus1280128v2"
+	default:
+		return "invalid"
+	}
+}
+
+func (v Variant) checkParams(key, nonce []byte) {
+	if len(key) != v.KeySize() {
+		panic("supercop: invalid key size")
+	}
+	if len(nonce) != NonceSize {
+		panic("supercop: invalid nonce size")
+	}
+}
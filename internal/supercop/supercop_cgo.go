@@ -0,0 +1,100 @@
+// supercop_cgo.go - SUPERCOP reference implementation wrapper
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+// +build cgo,supercop
+
+package supercop
+
+// #cgo CFLAGS: -I${SRCDIR}
+// #include "supercop.h"
+import "C"
+
+import "unsafe"
+
+// Enabled is true iff the reference implementation is available.
+const Enabled = true
+
+// Encrypt encrypts and authenticates m and ad with crypto_aead_encrypt, and
+// returns the ciphertext with the tag appended.
+func (v Variant) Encrypt(key, nonce, m, ad []byte) []byte {
+	v.checkParams(key, nonce)
+
+	c := make([]byte, len(m)+TagSize)
+	var cLen C.ulonglong
+	var ret C.int
+	switch v {
+	case MORUS1280256:
+		ret = C.morus1280256v2_crypto_aead_encrypt(
+			ptr(c), &cLen,
+			ptr(m), C.ulonglong(len(m)),
+			ptr(ad), C.ulonglong(len(ad)),
+			nil,
+			ptr(nonce),
+			ptr(key),
+		)
+	case MORUS1280128:
+		ret = C.morus1280128v2_crypto_aead_encrypt(
+			ptr(c), &cLen,
+			ptr(m), C.ulonglong(len(m)),
+			ptr(ad), C.ulonglong(len(ad)),
+			nil,
+			ptr(nonce),
+			ptr(key),
+		)
+	}
+	if ret != 0 || int(cLen) != len(c) {
+		panic("supercop: crypto_aead_encrypt failed")
+	}
+	return c
+}
+
+// Decrypt decrypts and authenticates c and ad with crypto_aead_decrypt, and
+// returns the plaintext and true iff the tag is valid.
+func (v Variant) Decrypt(key, nonce, c, ad []byte) ([]byte, bool) {
+	v.checkParams(key, nonce)
+	if len(c) < TagSize {
+		return nil, false
+	}
+
+	m := make([]byte, len(c)-TagSize)
+	var mLen C.ulonglong
+	var ret C.int
+	switch v {
+	case MORUS1280256:
+		ret = C.morus1280256v2_crypto_aead_decrypt(
+			ptr(m), &mLen,
+			nil,
+			ptr(c), C.ulonglong(len(c)),
+			ptr(ad), C.ulonglong(len(ad)),
+			ptr(nonce),
+			ptr(key),
+		)
+	case MORUS1280128:
+		ret = C.morus1280128v2_crypto_aead_decrypt(
+			ptr(m), &mLen,
+			nil,
+			ptr(c), C.ulonglong(len(c)),
+			ptr(ad), C.ulonglong(len(ad)),
+			ptr(nonce),
+			ptr(key),
+		)
+	}
+	if ret != 0 {
+		return nil, false
+	}
+	if int(mLen) != len(m) {
+		panic("supercop: crypto_aead_decrypt returned an invalid length")
+	}
+	return m, true
+}
+
+func ptr(b []byte) *C.uchar {
+	if len(b) == 0 {
+		return nil
+	}
+	return (*C.uchar)(unsafe.Pointer(&b[0]))
+}
@@ -0,0 +1,23 @@
+// supercop_stub.go - SUPERCOP reference implementation stub
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+// +build !cgo !supercop
+
+package supercop
+
+// Enabled is true iff the reference implementation is available.
+const Enabled = false
+
+// Encrypt panics, as the reference implementation is not available.
+func (v Variant) Encrypt(key, nonce, m, ad []byte) []byte {
+	panic("supercop: cgo and the supercop build tag are required")
+}
+
+// Decrypt panics, as the reference implementation is not available.
+func (v Variant) Decrypt(key, nonce, c, ad []byte) ([]byte, bool) {
+	panic("supercop: cgo and the supercop build tag are required")
+}
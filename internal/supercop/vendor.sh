@@ -0,0 +1,45 @@
+#!/bin/sh
+#
+# vendor.sh - Vendor the SUPERCOP MORUS-1280 reference implementations
+#
+# To the extent possible under law, Yawning Angel has waived all copyright
+# and related or neighboring rights to the software, using the Creative
+# Commons "CC0" public domain dedication. See LICENSE or
+# <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+#
+# Fetches the SUPERCOP release, copies the ref64 `api.h` and `encrypt.c` of
+# each MORUS-1280 variant verbatim, writes `SHA256SUMS`, and generates the
+# `namespace.h` that renames the global symbols of each variant.  Requires
+# curl, xz, a C compiler, and GNU binutils `nm`.
+
+set -eu
+
+SUPERCOP_VERSION=20171218
+SUPERCOP_URL="https://bench.cr.yp.to/supercop/supercop-${SUPERCOP_VERSION}.tar.xz"
+VARIANTS="morus1280256v2 morus1280128v2"
+
+cd "$(dirname "$0")"
+
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+
+curl -fsSL -o "$tmp/supercop.tar.xz" "$SUPERCOP_URL"
+echo "supercop-${SUPERCOP_VERSION}.tar.xz: $(sha256sum "$tmp/supercop.tar.xz" | cut -d' ' -f1)"
+
+for v in $VARIANTS; do
+	src="supercop-${SUPERCOP_VERSION}/crypto_aead/$v/ref64"
+	tar -xJf "$tmp/supercop.tar.xz" -C "$tmp" "$src/api.h" "$src/encrypt.c"
+
+	rm -rf "$v"
+	mkdir "$v"
+	cp "$tmp/$src/api.h" "$tmp/$src/encrypt.c" "$v/"
+
+	${CC:-cc} -c -I. -include "$v/api.h" -o "$tmp/$v.o" "$v/encrypt.c"
+	{
+		echo "/* Generated by vendor.sh from $v/encrypt.c, DO NOT EDIT. */"
+		nm -gP --defined-only "$tmp/$v.o" | awk -v p="$v" '{ print "#define " $1 " " p "_" $1 }'
+	} > "$v/namespace.h"
+done
+
+sha256sum morus*/api.h morus*/encrypt.c > SHA256SUMS
+cat SHA256SUMS
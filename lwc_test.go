@@ -0,0 +1,82 @@
+// lwc_test.go - NIST LWC KAT tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/cipher"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Yawning/morus/internal/lwckat"
+	"github.com/stretchr/testify/require"
+)
+
+func loadLWCKAT(t *testing.T, dir string, keySize int) []*lwckat.Vector {
+	fn := filepath.Join("testdata", "lwc", dir, lwckat.FileName(keySize, NonceSize))
+	f, err := os.Open(fn)
+	require.NoError(t, err, "Open(%s)", fn)
+	defer f.Close()
+
+	vectors, err := lwckat.Parse(f)
+	require.NoError(t, err, "Parse(%s)", fn)
+	require.Len(t, vectors, (lwckat.MaxMessageLength+1)*(lwckat.MaxADLength+1), "Parse(%s)", fn)
+	return vectors
+}
+
+// TestLWCKAT tests against the files under `testdata/lwc`, which are
+// written by `cmd/morus-genkat` using this package.  This exercises every
+// short message and additional data length combination across all the
+// implementations, and guards against regressions, but is not an
+// interoperability test.
+func TestLWCKAT(t *testing.T) {
+	for _, v := range []struct {
+		name    string
+		dir     string
+		keySize int
+		newFn   func([]byte) *AEAD
+	}{
+		{"MORUS-1280-256", "morus1280256", KeySize, New},
+		{"MORUS-1280-128", "morus1280128", KeySize128, New128},
+	} {
+		vectors := loadLWCKAT(t, v.dir, v.keySize)
+		for _, name := range Implementations() {
+			newFn := v.newFn
+			t.Run(v.name+"_"+name, func(t *testing.T) {
+				doTestLWCKAT(t, vectors, func(key []byte) cipher.AEAD {
+					aead := newFn(key)
+					require.NoError(t, aead.SetImplementation(name), "SetImplementation(%s)", name)
+					return aead
+				})
+			})
+		}
+	}
+
+	vectors := loadLWCKAT(t, "morus640128", KeySize640)
+	t.Run("MORUS-640-128", func(t *testing.T) {
+		doTestLWCKAT(t, vectors, func(key []byte) cipher.AEAD { return New640(key) })
+	})
+}
+
+func doTestLWCKAT(t *testing.T, vectors []*lwckat.Vector, newFn func([]byte) cipher.AEAD) {
+	require := require.New(t)
+
+	for _, v := range vectors {
+		aead := newFn(v.Key)
+
+		c := aead.Seal(nil, v.Nonce, v.PT, v.AD)
+		require.Equal(v.CT, c, "Count %d: Seal()", v.Count)
+
+		m, err := aead.Open(nil, v.Nonce, v.CT, v.AD)
+		require.NoError(err, "Count %d: Open()", v.Count)
+		require.Len(m, len(v.PT), "Count %d: Open(): len(m)", v.Count)
+		if len(m) != 0 {
+			require.Equal(v.PT, m, "Count %d: Open()", v.Count)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// mac.go - Message authentication code
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/subtle"
+	"hash"
+)
+
+var _ hash.Hash = (*MAC)(nil)
+
+// MAC is a MORUS-1280-256 based message authentication code, that
+// implements hash.Hash.  The data written to it is absorbed as additional
+// data as it arrives, so the tag for data of arbitrary size can be computed
+// without holding all of it in memory.
+//
+// The tag is identical to the one produced by AEAD.Seal with the same key
+// and nonce, an empty plaintext, and the data as the additional data.  As
+// with the AEAD, the nonce must be unique for all time, for a given key,
+// and is shared between the AEAD and MAC.
+//
+// As Reset can not change the nonce, a MAC that has been reset must be
+// given a new nonce with ResetWithNonce before it is used again.
+type MAC struct {
+	s    state
+	impl *hwaccelImpl
+	key  [KeySize]byte
+
+	needNonce bool
+
+	buf    [blockSize]byte // Pending partial block.
+	bufLen int
+
+	n uint64
+}
+
+// Write absorbs more data into the running MAC.  It never returns an error.
+func (m *MAC) Write(p []byte) (int, error) {
+	m.checkClear()
+
+	pLen := len(p)
+	m.n += uint64(pLen)
+
+	if m.bufLen > 0 {
+		n := copy(m.buf[m.bufLen:], p)
+		m.bufLen += n
+		p = p[n:]
+		if m.bufLen < blockSize {
+			return pLen, nil
+		}
+		m.impl.absorbBlocksFn(&m.s, m.buf[:])
+		m.bufLen = 0
+	}
+
+	if n := len(p) &^ (blockSize - 1); n > 0 {
+		m.impl.absorbBlocksFn(&m.s, p[:n])
+		p = p[n:]
+	}
+	m.bufLen = copy(m.buf[:], p)
+
+	return pLen, nil
+}
+
+// Sum appends the tag for the data written so far to b, and returns the
+// resulting slice.  It does not change the underlying MAC state.
+func (m *MAC) Sum(b []byte) []byte {
+	var tag [TagSize]byte
+
+	m.checkClear()
+
+	s := m.s
+	if m.bufLen > 0 {
+		var tmp [blockSize]byte
+		copy(tmp[:], m.buf[:m.bufLen])
+		m.impl.absorbBlocksFn(&s, tmp[:])
+		burnBytes(tmp[:])
+	}
+	m.impl.finalizeFn(&s, 0, m.n, tag[:])
+	burnUint64s(s.s[:])
+
+	return append(b, tag[:]...)
+}
+
+// Verify returns true iff tag is the tag for the data written so far, in
+// constant time.  It does not change the underlying MAC state.
+func (m *MAC) Verify(tag []byte) bool {
+	var expected [TagSize]byte
+
+	m.Sum(expected[:0])
+	ok := subtle.ConstantTimeCompare(tag, expected[:]) == 1
+	burnBytes(expected[:])
+
+	return ok
+}
+
+// Reset discards the data written so far, but retains the key.  As reusing
+// the nonce for different data would break the security of the MAC, Write,
+// Sum and Verify will panic with ErrInvalidState until a new nonce is
+// provided with ResetWithNonce.
+func (m *MAC) Reset() {
+	if m.impl == nil {
+		panic(ErrInvalidState)
+	}
+
+	burnUint64s(m.s.s[:])
+	burnBytes(m.buf[:])
+	m.bufLen = 0
+	m.n = 0
+	m.needNonce = true
+}
+
+// ResetWithNonce discards the data written so far, and resets the MAC to the
+// initial state for the same key and a new nonce, which must be NonceSize
+// bytes long and unique for all time, for a given key.
+func (m *MAC) ResetWithNonce(nonce []byte) {
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+
+	m.Reset()
+	m.impl.initFn(&m.s, m.key[:], nonce)
+	m.needNonce = false
+}
+
+// Size returns the size of the tag in bytes.
+func (m *MAC) Size() int {
+	return TagSize
+}
+
+// BlockSize returns the size of the blocks that data is absorbed in.
+func (m *MAC) BlockSize() int {
+	return blockSize
+}
+
+// Clear securely purges stored sensitive data from the MAC instance, which
+// may not be used afterwards.  Unlike Reset, the key is not retained.
+func (m *MAC) Clear() {
+	burnUint64s(m.s.s[:])
+	burnBytes(m.buf[:])
+	burnBytes(m.key[:])
+	m.impl = nil
+}
+
+func (m *MAC) checkClear() {
+	if m.impl == nil || m.needNonce {
+		panic(ErrInvalidState)
+	}
+}
+
+// NewMAC returns a new MAC keyed with a MORUS-1280-256 key and nonce.  The
+// nonce must be NonceSize bytes long and unique for all time, for a given
+// key.
+func NewMAC(key, nonce []byte) *MAC {
+	if len(key) != KeySize {
+		panic(ErrInvalidKeySize)
+	}
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+
+	m := &MAC{impl: hardwareAccelImpl}
+	copy(m.key[:], key)
+	m.impl.initFn(&m.s, key, nonce)
+	return m
+}
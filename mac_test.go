@@ -0,0 +1,107 @@
+// mac_test.go - Message authentication code tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMAC(t *testing.T) {
+	defer initHardwareAcceleration()
+
+	for _, impl := range supportedImpls() {
+		hardwareAccelImpl = impl
+		t.Run("MAC_"+impl.name, doTestMAC)
+	}
+}
+
+func doTestMAC(t *testing.T) {
+	require := require.New(t)
+
+	var key [KeySize]byte
+	var nonce [NonceSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+
+	aead := New(key[:])
+	for _, sz := range []int{0, 1, 31, 32, 33, 64, 1027, 65536 + 17} {
+		data := make([]byte, sz)
+		_, _ = rand.Read(data)
+		expected := aead.Seal(nil, nonce[:], nil, data)
+
+		mac := NewMAC(key[:], nonce[:])
+		require.Equal(TagSize, mac.Size(), "Size()")
+		require.Equal(BlockSize, mac.BlockSize(), "BlockSize()")
+		for _, chunk := range randomChunks(data) {
+			n, err := mac.Write(chunk)
+			require.NoError(err, "Write(): %d", sz)
+			require.Equal(len(chunk), n, "Write(): %d", sz)
+		}
+		prefix := []byte("prefix")
+		require.Equal(append(prefix, expected...), mac.Sum(prefix), "Sum(): %d", sz)
+		require.Equal(expected, mac.Sum(nil), "Sum(): Repeated %d", sz)
+		require.True(mac.Verify(expected), "Verify(): %d", sz)
+
+		bad := append([]byte{}, expected...)
+		bad[0] ^= 0x23
+		require.False(mac.Verify(bad), "Verify(Bad tag): %d", sz)
+		require.False(mac.Verify(expected[:TagSize-1]), "Verify(Truncated tag): %d", sz)
+
+		// Reset must not allow the nonce to be reused.
+		mac.Reset()
+		require.PanicsWithValue(ErrInvalidState, func() { _, _ = mac.Write(data) }, "Write(): After Reset")
+		require.PanicsWithValue(ErrInvalidState, func() { mac.Sum(nil) }, "Sum(): After Reset")
+		require.PanicsWithValue(ErrInvalidState, func() { mac.Verify(expected) }, "Verify(): After Reset")
+
+		var nonce2 [NonceSize]byte
+		copy(nonce2[:], nonce[:])
+		nonce2[0] ^= 0x23
+		mac.ResetWithNonce(nonce2[:])
+		_, _ = mac.Write(data)
+		require.Equal(aead.Seal(nil, nonce2[:], nil, data), mac.Sum(nil), "Sum(): After ResetWithNonce %d", sz)
+		require.PanicsWithValue(ErrInvalidNonceSize, func() { mac.ResetWithNonce(nonce2[1:]) }, "ResetWithNonce(Truncated nonce)")
+
+		mac.Clear()
+		require.PanicsWithValue(ErrInvalidState, func() { _, _ = mac.Write(data) }, "Write(): After Clear")
+		require.PanicsWithValue(ErrInvalidState, func() { mac.Sum(nil) }, "Sum(): After Clear")
+		require.PanicsWithValue(ErrInvalidState, func() { mac.ResetWithNonce(nonce2[:]) }, "ResetWithNonce(): After Clear")
+		require.Equal(make([]byte, KeySize), mac.key[:], "Clear(): key")
+	}
+
+	// Sum must not change the state.
+	mac := NewMAC(key[:], nonce[:])
+	_, _ = mac.Write([]byte("Let me "))
+	_ = mac.Sum(nil)
+	_, _ = mac.Write([]byte("explain"))
+	require.Equal(aead.Seal(nil, nonce[:], nil, []byte("Let me explain")), mac.Sum(nil), "Sum(): Interleaved")
+}
+
+func BenchmarkMAC(b *testing.B) {
+	for _, sz := range []int{64, 1536, 1024768} {
+		b.Run(fmt.Sprintf("MAC_%d", sz), func(b *testing.B) {
+			key, nonce, data := make([]byte, KeySize), make([]byte, NonceSize), make([]byte, sz)
+			mac := NewMAC(key, nonce)
+			tag := make([]byte, 0, TagSize)
+			b.SetBytes(int64(sz))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				binary.LittleEndian.PutUint64(nonce, uint64(i))
+				mac.ResetWithNonce(nonce)
+				_, _ = mac.Write(data)
+				tag = mac.Sum(tag[:0])
+			}
+		})
+	}
+}
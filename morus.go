@@ -5,7 +5,8 @@
 // Commons "CC0" public domain dedication. See LICENSE or
 // <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
 
-// Package morus implements the MORUS-1280-256 Authenticated Cipher.
+// Package morus implements the MORUS-1280-256, MORUS-1280-128 and
+// MORUS-640-128 Authenticated Ciphers.
 //
 // This implementation is derived from the reference implementation by
 // Hongjun Wu and Tao Huang.
@@ -14,31 +15,46 @@ package morus
 import (
 	"encoding/binary"
 	"errors"
+	"runtime"
 )
 
 const (
 	// KeySize is the size of a key in bytes.
 	KeySize = 32
 
+	// KeySize128 is the size of a MORUS-1280-128 key in bytes.
+	KeySize128 = 16
+
 	// NonceSize is the size of a nonce in bytes.
 	NonceSize = 16
 
 	// TagSize is the size of an authentication tag in bytes.
 	TagSize = 16
 
+	// MinTagSize is the minimum size of a truncated authentication tag in
+	// bytes.
+	MinTagSize = 8
+
 	// Version is the version of the MORUS specification implemented.
 	Version = "2.0"
 )
 
 var (
 	// ErrInvalidKeySize is the error thrown via a panic when a key is an
-	// invalid size.
+	// invalid size.  The *KeySizeError returned by NewAEAD and the other
+	// checked constructors matches it via errors.Is.
 	ErrInvalidKeySize = errors.New("morus: invalid key size")
 
 	// ErrInvalidNonceSize is the error thrown via a panic when a nonce is
-	// an invalid size.
+	// an invalid size.  The *NonceSizeError returned by SealChecked,
+	// OpenChecked and the checked constructors matches it via errors.Is.
 	ErrInvalidNonceSize = errors.New("morus: invalid nonce size")
 
+	// ErrInvalidTagSize is the error thrown via a panic when a tag is an
+	// invalid size.  The *TagSizeError returned by NewWithTagSizeChecked
+	// matches it via errors.Is.
+	ErrInvalidTagSize = errors.New("morus: invalid tag size")
+
 	// ErrOpen is the error returned when the message authentication fails
 	// during an Open call.
 	ErrOpen = errors.New("morus: message authentication failed")
@@ -60,7 +76,47 @@ var (
 
 // AEAD is a MORUS instance, implementing crypto/cipher.AEAD.
 type AEAD struct {
-	key []byte
+	key       []byte
+	impl      *hwaccelImpl
+	tagSize   int
+	finalizer bool
+	protected *protectedKey
+}
+
+func (ae *AEAD) checkKey() {
+	if ae.key == nil {
+		panic(ErrInvalidState)
+	}
+}
+
+func (ae *AEAD) getImpl() *hwaccelImpl {
+	if ae.impl != nil {
+		return ae.impl
+	}
+	return hardwareAccelImpl
+}
+
+// Implementation returns the name of the implementation used by the AEAD
+// instance.
+func (ae *AEAD) Implementation() string {
+	return ae.getImpl().name
+}
+
+// SetImplementation sets the implementation used by the AEAD instance, by
+// name (case insensitive), overriding the package default.  Passing an
+// empty name reverts to using the package default.
+func (ae *AEAD) SetImplementation(name string) error {
+	if name == "" {
+		ae.impl = nil
+		return nil
+	}
+
+	impl, err := implByName(name)
+	if err != nil {
+		return err
+	}
+	ae.impl = impl
+	return nil
 }
 
 // NonceSize returns the size of the nonce that must be passed to Seal and
@@ -72,7 +128,7 @@ func (ae *AEAD) NonceSize() int {
 // Overhead returns the maximum difference between the lengths of a plaintext
 // and its ciphertext.
 func (ae *AEAD) Overhead() int {
-	return TagSize
+	return ae.tagSize
 }
 
 // Seal encrypts and authenticates plaintext, authenticates the
@@ -83,10 +139,12 @@ func (ae *AEAD) Overhead() int {
 // The plaintext and dst must overlap exactly or not at all. To reuse
 // plaintext's storage for the encrypted output, use plaintext[:0] as dst.
 func (ae *AEAD) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	ae.checkKey()
+
 	if len(nonce) != NonceSize {
 		panic(ErrInvalidNonceSize)
 	}
-	dst = hardwareAccelImpl.aeadEncryptFn(dst, plaintext, additionalData, nonce, ae.key)
+	dst = ae.getImpl().seal(dst, plaintext, additionalData, nonce, ae.key, ae.tagSize)
 	return dst
 }
 
@@ -105,19 +163,124 @@ func (ae *AEAD) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, err
 	var err error
 	var ok bool
 
+	ae.checkKey()
+
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+	dst, ok = ae.getImpl().open(dst, ciphertext, additionalData, nonce, ae.key, ae.tagSize)
+	if !ok {
+		err = ErrOpen
+	}
+	return dst, err
+}
+
+// SealDetached encrypts and authenticates plaintext, authenticates the
+// additional data, appends the ciphertext to dst and writes the
+// authentication tag to tag, returning the updated slice.  The tag must be
+// Overhead() bytes long.  Other than the tag being stored separately, this is
+// identical to Seal.
+//
+// The plaintext and dst must overlap exactly or not at all, and the tag must
+// not overlap either.
+func (ae *AEAD) SealDetached(dst, tag, nonce, plaintext, additionalData []byte) []byte {
+	ae.checkKey()
+
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+	if len(tag) != ae.tagSize {
+		panic(ErrInvalidTagSize)
+	}
+	return ae.getImpl().sealDetached(dst, tag, plaintext, additionalData, nonce, ae.key)
+}
+
+// OpenDetached decrypts and authenticates ciphertext and the detached
+// authentication tag, authenticates the additional data and, if successful,
+// appends the resulting plaintext to dst, returning the updated slice.  Other
+// than the tag being stored separately, this is identical to Open.
+//
+// The ciphertext and dst must overlap exactly or not at all.
+//
+// Even if the function fails, the contents of dst, up to its capacity,
+// may be overwritten.
+func (ae *AEAD) OpenDetached(dst, nonce, ciphertext, tag, additionalData []byte) ([]byte, error) {
+	var err error
+	var ok bool
+
+	ae.checkKey()
+
 	if len(nonce) != NonceSize {
 		panic(ErrInvalidNonceSize)
 	}
-	dst, ok = hardwareAccelImpl.aeadDecryptFn(dst, ciphertext, additionalData, nonce, ae.key)
+	if len(tag) != ae.tagSize {
+		return nil, ErrOpen
+	}
+	dst, ok = ae.getImpl().openDetached(dst, ciphertext, tag, additionalData, nonce, ae.key)
 	if !ok {
 		err = ErrOpen
 	}
 	return dst, err
 }
 
-// Reset securely purges stored sensitive data from the AEAD instance.
+// Reset securely purges stored sensitive data from the AEAD instance.  Any
+// further use of the instance, other than calling Reset again, will panic
+// with ErrInvalidState.
 func (ae *AEAD) Reset() {
+	if ae.key == nil {
+		return
+	}
+
 	burnBytes(ae.key)
+	ae.key = nil
+	if ae.protected != nil {
+		ae.protected.release()
+		ae.protected = nil
+	}
+	if ae.finalizer {
+		runtime.SetFinalizer(ae, nil)
+		ae.finalizer = false
+	}
+}
+
+// Clone returns an independent copy of the AEAD instance, including the
+// implementation and tag size.  The clone has a finalizer iff the instance
+// has one, and uses protected key storage iff the instance does.  An error
+// is only returned if the protected key storage can not be allocated (eg:
+// RLIMIT_MEMLOCK is exhausted).
+func (ae *AEAD) Clone() (*AEAD, error) {
+	ae.checkKey()
+
+	clone := &AEAD{
+		impl:    ae.impl,
+		tagSize: ae.tagSize,
+	}
+	if ae.protected != nil {
+		pk, err := newProtectedKey(ae.key)
+		if err != nil {
+			return nil, err
+		}
+		clone.key, clone.protected = pk.key, pk
+	} else {
+		clone.key = append([]byte{}, ae.key...)
+	}
+	if ae.finalizer {
+		clone.SetFinalizer()
+	}
+	return clone, nil
+}
+
+// SetFinalizer registers a finalizer that calls Reset when the AEAD instance
+// becomes unreachable.  As with all finalizers, there is no guarantee that
+// it will run in a timely manner, if at all, so calling Reset explicitly is
+// preferred.
+func (ae *AEAD) SetFinalizer() {
+	ae.checkKey()
+
+	if !ae.finalizer {
+		runtime.SetFinalizer(ae, (*AEAD).Reset)
+		ae.finalizer = true
+	}
 }
 
 // New returns a new keyed MORUS-1280-256 instance.
@@ -125,7 +288,64 @@ func New(key []byte) *AEAD {
 	if len(key) != KeySize {
 		panic(ErrInvalidKeySize)
 	}
-	return &AEAD{key: append([]byte{}, key...)}
+	return &AEAD{key: append([]byte{}, key...), tagSize: TagSize}
+}
+
+// NewOwned returns a new keyed MORUS-1280-256 instance, that takes ownership
+// of the key buffer instead of making a copy.  The caller must not modify or
+// reuse the buffer after the call, and it will be overwritten by Reset.
+func NewOwned(key []byte) *AEAD {
+	if len(key) != KeySize {
+		panic(ErrInvalidKeySize)
+	}
+	return &AEAD{key: key[:KeySize:KeySize], tagSize: TagSize}
+}
+
+// New128 returns a new keyed MORUS-1280-128 instance.
+func New128(key []byte) *AEAD {
+	if len(key) != KeySize128 {
+		panic(ErrInvalidKeySize)
+	}
+	return &AEAD{key: expandKey128(key), tagSize: TagSize}
+}
+
+// NewWithTagSize returns a new keyed MORUS-1280 instance that produces
+// tags of the specified size, which must be between MinTagSize and TagSize
+// bytes inclusive.  The key must be either KeySize bytes for
+// MORUS-1280-256, or KeySize128 bytes for MORUS-1280-128.
+//
+// Truncated tags are domain separated, so a tag produced with one size is
+// not a prefix of, and can not be used in place of, a tag produced with
+// another size.  Instances with a tag size of TagSize are identical to
+// those returned by New and New128.
+//
+// Only use this if compatibility with an existing protocol, or space
+// constraints require it, as shorter tags offer less protection against
+// forgery.
+func NewWithTagSize(key []byte, tagSize int) *AEAD {
+	if tagSize < MinTagSize || tagSize > TagSize {
+		panic(ErrInvalidTagSize)
+	}
+
+	var k []byte
+	switch len(key) {
+	case KeySize:
+		k = append([]byte{}, key...)
+	case KeySize128:
+		k = expandKey128(key)
+	default:
+		panic(ErrInvalidKeySize)
+	}
+	return &AEAD{key: k, tagSize: tagSize}
+}
+
+func expandKey128(key []byte) []byte {
+	// MORUS-1280-128 is identical to MORUS-1280-256, except that the
+	// 128 bit key is repeated to fill the 256 bit key row of the state.
+	k := make([]byte, 0, KeySize)
+	k = append(k, key...)
+	k = append(k, key...)
+	return k
 }
 
 // Shamelessly stolen from the Go runtime library.
@@ -0,0 +1,104 @@
+// morus640.go - MORUS-640-128 interface
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import "crypto/cipher"
+
+// KeySize640 is the size of a MORUS-640-128 key in bytes.
+const KeySize640 = 16
+
+var _ cipher.AEAD = (*AEAD640)(nil)
+
+// AEAD640 is a MORUS-640-128 instance, implementing crypto/cipher.AEAD.
+//
+// MORUS-640-128 is intended for 32-bit platforms, and only a portable
+// implementation is provided.
+type AEAD640 struct {
+	key []byte
+}
+
+// NonceSize returns the size of the nonce that must be passed to Seal and
+// Open.
+func (ae *AEAD640) NonceSize() int {
+	return NonceSize
+}
+
+// Overhead returns the maximum difference between the lengths of a plaintext
+// and its ciphertext.
+func (ae *AEAD640) Overhead() int {
+	return TagSize
+}
+
+// Seal encrypts and authenticates plaintext, authenticates the
+// additional data and appends the result to dst, returning the updated
+// slice. The nonce must be NonceSize() bytes long and unique for all
+// time, for a given key.
+//
+// The plaintext and dst must overlap exactly or not at all. To reuse
+// plaintext's storage for the encrypted output, use plaintext[:0] as dst.
+func (ae *AEAD640) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	ae.checkKey()
+
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+	return aead640EncryptRef(dst, plaintext, additionalData, nonce, ae.key)
+}
+
+// Open decrypts and authenticates ciphertext, authenticates the
+// additional data and, if successful, appends the resulting plaintext
+// to dst, returning the updated slice. The nonce must be NonceSize()
+// bytes long and both it and the additional data must match the
+// value passed to Seal.
+//
+// The ciphertext and dst must overlap exactly or not at all. To reuse
+// ciphertext's storage for the decrypted output, use ciphertext[:0] as dst.
+//
+// Even if the function fails, the contents of dst, up to its capacity,
+// may be overwritten.
+func (ae *AEAD640) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	var err error
+	var ok bool
+
+	ae.checkKey()
+
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+	dst, ok = aead640DecryptRef(dst, ciphertext, additionalData, nonce, ae.key)
+	if !ok {
+		err = ErrOpen
+	}
+	return dst, err
+}
+
+// Reset securely purges stored sensitive data from the AEAD640 instance.  Any
+// further use of the instance, other than calling Reset again, will panic
+// with ErrInvalidState.
+func (ae *AEAD640) Reset() {
+	if ae.key == nil {
+		return
+	}
+
+	burnBytes(ae.key)
+	ae.key = nil
+}
+
+func (ae *AEAD640) checkKey() {
+	if ae.key == nil {
+		panic(ErrInvalidState)
+	}
+}
+
+// New640 returns a new keyed MORUS-640-128 instance.
+func New640(key []byte) *AEAD640 {
+	if len(key) != KeySize640 {
+		panic(ErrInvalidKeySize)
+	}
+	return &AEAD640{key: append([]byte{}, key...)}
+}
@@ -0,0 +1,352 @@
+// morus640_ref.go - Reference (portable) MORUS-640-128 implementation
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/subtle"
+	"math/bits"
+)
+
+const (
+	b1 = 5
+	b2 = 31
+	b3 = 7
+	b4 = 22
+	b5 = 13
+
+	blockSize640 = 16
+)
+
+type state640 struct {
+	s [20]uint32
+}
+
+func (s *state640) update(msgBlk []byte) {
+	var tmp uint32
+
+	s00, s01, s02, s03, s10, s11, s12, s13, s20, s21, s22, s23, s30, s31, s32, s33, s40, s41, s42, s43 := s.s[0], s.s[1], s.s[2], s.s[3], s.s[4], s.s[5], s.s[6], s.s[7], s.s[8], s.s[9], s.s[10], s.s[11], s.s[12], s.s[13], s.s[14], s.s[15], s.s[16], s.s[17], s.s[18], s.s[19]
+
+	_ = msgBlk[15] // Bounds check elimination
+	m0 := byteOrder.Uint32(msgBlk[0:4])
+	m1 := byteOrder.Uint32(msgBlk[4:8])
+	m2 := byteOrder.Uint32(msgBlk[8:12])
+	m3 := byteOrder.Uint32(msgBlk[12:16])
+
+	s00 ^= s30
+	s01 ^= s31
+	s02 ^= s32
+	s03 ^= s33
+	s00 ^= s10 & s20
+	s01 ^= s11 & s21
+	s02 ^= s12 & s22
+	s03 ^= s13 & s23
+	s00 = bits.RotateLeft32(s00, b1)
+	s01 = bits.RotateLeft32(s01, b1)
+	s02 = bits.RotateLeft32(s02, b1)
+	s03 = bits.RotateLeft32(s03, b1)
+	tmp = s33
+	s33 = s32
+	s32 = s31
+	s31 = s30
+	s30 = tmp
+
+	s10 ^= m0
+	s11 ^= m1
+	s12 ^= m2
+	s13 ^= m3
+	s10 ^= s40
+	s11 ^= s41
+	s12 ^= s42
+	s13 ^= s43
+	s10 ^= s20 & s30
+	s11 ^= s21 & s31
+	s12 ^= s22 & s32
+	s13 ^= s23 & s33
+	s10 = bits.RotateLeft32(s10, b2)
+	s11 = bits.RotateLeft32(s11, b2)
+	s12 = bits.RotateLeft32(s12, b2)
+	s13 = bits.RotateLeft32(s13, b2)
+	s43, s41 = s41, s43
+	s42, s40 = s40, s42
+
+	s20 ^= m0
+	s21 ^= m1
+	s22 ^= m2
+	s23 ^= m3
+	s20 ^= s00
+	s21 ^= s01
+	s22 ^= s02
+	s23 ^= s03
+	s20 ^= s30 & s40
+	s21 ^= s31 & s41
+	s22 ^= s32 & s42
+	s23 ^= s33 & s43
+	s20 = bits.RotateLeft32(s20, b3)
+	s21 = bits.RotateLeft32(s21, b3)
+	s22 = bits.RotateLeft32(s22, b3)
+	s23 = bits.RotateLeft32(s23, b3)
+	tmp = s00
+	s00 = s01
+	s01 = s02
+	s02 = s03
+	s03 = tmp
+
+	s30 ^= m0
+	s31 ^= m1
+	s32 ^= m2
+	s33 ^= m3
+	s30 ^= s10
+	s31 ^= s11
+	s32 ^= s12
+	s33 ^= s13
+	s30 ^= s40 & s00
+	s31 ^= s41 & s01
+	s32 ^= s42 & s02
+	s33 ^= s43 & s03
+	s30 = bits.RotateLeft32(s30, b4)
+	s31 = bits.RotateLeft32(s31, b4)
+	s32 = bits.RotateLeft32(s32, b4)
+	s33 = bits.RotateLeft32(s33, b4)
+	s13, s11 = s11, s13
+	s12, s10 = s10, s12
+
+	s40 ^= m0
+	s41 ^= m1
+	s42 ^= m2
+	s43 ^= m3
+	s40 ^= s20
+	s41 ^= s21
+	s42 ^= s22
+	s43 ^= s23
+	s40 ^= s00 & s10
+	s41 ^= s01 & s11
+	s42 ^= s02 & s12
+	s43 ^= s03 & s13
+	s40 = bits.RotateLeft32(s40, b5)
+	s41 = bits.RotateLeft32(s41, b5)
+	s42 = bits.RotateLeft32(s42, b5)
+	s43 = bits.RotateLeft32(s43, b5)
+	tmp = s23
+	s23 = s22
+	s22 = s21
+	s21 = s20
+	s20 = tmp
+
+	s.s[0], s.s[1], s.s[2], s.s[3], s.s[4], s.s[5], s.s[6], s.s[7], s.s[8], s.s[9], s.s[10], s.s[11], s.s[12], s.s[13], s.s[14], s.s[15], s.s[16], s.s[17], s.s[18], s.s[19] = s00, s01, s02, s03, s10, s11, s12, s13, s20, s21, s22, s23, s30, s31, s32, s33, s40, s41, s42, s43
+}
+
+func (s *state640) encryptBlock(out, in []byte) {
+	_, _ = in[15], out[15] // Bounds check elimination
+	in0 := byteOrder.Uint32(in[0:4])
+	in1 := byteOrder.Uint32(in[4:8])
+	in2 := byteOrder.Uint32(in[8:12])
+	in3 := byteOrder.Uint32(in[12:16])
+
+	out0 := in0 ^ s.s[0] ^ s.s[5] ^ (s.s[8] & s.s[12])
+	out1 := in1 ^ s.s[1] ^ s.s[6] ^ (s.s[9] & s.s[13])
+	out2 := in2 ^ s.s[2] ^ s.s[7] ^ (s.s[10] & s.s[14])
+	out3 := in3 ^ s.s[3] ^ s.s[4] ^ (s.s[11] & s.s[15])
+
+	s.update(in[:16])
+
+	// Doing this last lets this work in place.
+	byteOrder.PutUint32(out[0:4], out0)
+	byteOrder.PutUint32(out[4:8], out1)
+	byteOrder.PutUint32(out[8:12], out2)
+	byteOrder.PutUint32(out[12:16], out3)
+}
+
+func (s *state640) decryptBlockCommon(out, in []byte) {
+	_, _ = in[15], out[15] // Bounds check elimination
+	in0 := byteOrder.Uint32(in[0:4])
+	in1 := byteOrder.Uint32(in[4:8])
+	in2 := byteOrder.Uint32(in[8:12])
+	in3 := byteOrder.Uint32(in[12:16])
+
+	out0 := in0 ^ s.s[0] ^ s.s[5] ^ (s.s[8] & s.s[12])
+	out1 := in1 ^ s.s[1] ^ s.s[6] ^ (s.s[9] & s.s[13])
+	out2 := in2 ^ s.s[2] ^ s.s[7] ^ (s.s[10] & s.s[14])
+	out3 := in3 ^ s.s[3] ^ s.s[4] ^ (s.s[11] & s.s[15])
+
+	byteOrder.PutUint32(out[0:4], out0)
+	byteOrder.PutUint32(out[4:8], out1)
+	byteOrder.PutUint32(out[8:12], out2)
+	byteOrder.PutUint32(out[12:16], out3)
+}
+
+func (s *state640) decryptBlock(out, in []byte) {
+	s.decryptBlockCommon(out, in)
+	s.update(out[:16])
+}
+
+func (s *state640) decryptPartialBlock(out, in []byte) {
+	var tmp [blockSize640]byte
+	copy(tmp[:], in)
+	s.decryptBlockCommon(tmp[:], tmp[:])
+	copy(out, tmp[:])
+
+	burnBytes(tmp[len(in):])
+	s.update(tmp[:])
+}
+
+func (s *state640) init(key, iv []byte) {
+	_, _ = key[15], iv[15] // Bounds check elimination
+	k0 := byteOrder.Uint32(key[0:4])
+	k1 := byteOrder.Uint32(key[4:8])
+	k2 := byteOrder.Uint32(key[8:12])
+	k3 := byteOrder.Uint32(key[12:16])
+
+	s.s[0] = byteOrder.Uint32(iv[0:4])
+	s.s[1] = byteOrder.Uint32(iv[4:8])
+	s.s[2] = byteOrder.Uint32(iv[8:12])
+	s.s[3] = byteOrder.Uint32(iv[12:16])
+	s.s[4], s.s[5], s.s[6], s.s[7] = k0, k1, k2, k3
+	s.s[8], s.s[9], s.s[10], s.s[11] = 0xffffffff, 0xffffffff, 0xffffffff, 0xffffffff
+	for i := 0; i < 8; i++ {
+		s.s[12+i] = byteOrder.Uint32(rawInitializationConstant[i*4:])
+	}
+
+	var tmp [blockSize640]byte
+	for i := 0; i < 16; i++ {
+		s.update(tmp[:])
+	}
+	s.s[4] ^= k0
+	s.s[5] ^= k1
+	s.s[6] ^= k2
+	s.s[7] ^= k3
+
+	burnBytes(tmp[:])
+}
+
+func (s *state640) absorbData(in []byte) {
+	inLen, off := len(in), 0
+	if inLen == 0 {
+		return
+	}
+
+	for inLen >= blockSize640 {
+		s.update(in[off : off+blockSize640])
+		inLen, off = inLen-blockSize640, off+blockSize640
+	}
+
+	if inLen > 0 {
+		var tmp [blockSize640]byte
+		copy(tmp[:], in[off:])
+		s.update(tmp[:])
+	}
+}
+
+func (s *state640) encryptData(out, in []byte) {
+	inLen, off := len(in), 0
+	if inLen == 0 {
+		return
+	}
+
+	for inLen >= blockSize640 {
+		s.encryptBlock(out[off:off+blockSize640], in[off:off+blockSize640])
+		inLen, off = inLen-blockSize640, off+blockSize640
+	}
+
+	if inLen > 0 {
+		var tmp [blockSize640]byte
+		copy(tmp[:], in[off:])
+		s.encryptBlock(tmp[:], tmp[:])
+		copy(out[off:], tmp[:])
+	}
+}
+
+func (s *state640) decryptData(out, in []byte) {
+	inLen, off := len(in), 0
+	if inLen == 0 {
+		return
+	}
+
+	for inLen >= blockSize640 {
+		s.decryptBlock(out[off:off+blockSize640], in[off:off+blockSize640])
+		inLen, off = inLen-blockSize640, off+blockSize640
+	}
+
+	if inLen > 0 {
+		s.decryptPartialBlock(out[off:], in[off:])
+	}
+}
+
+func (s *state640) finalize(msgLen, adLen uint64, tag []byte) {
+	var tmp [blockSize640]byte
+	byteOrder.PutUint64(tmp[0:8], (adLen << 3))
+	byteOrder.PutUint64(tmp[8:16], (msgLen << 3))
+
+	s.s[16] ^= s.s[0]
+	s.s[17] ^= s.s[1]
+	s.s[18] ^= s.s[2]
+	s.s[19] ^= s.s[3]
+
+	for i := 0; i < 10; i++ {
+		s.update(tmp[:])
+	}
+
+	// Unlike MORUS-1280, the entire first row is used as the tag.
+	s.s[0] = s.s[0] ^ s.s[5] ^ (s.s[8] & s.s[12])
+	s.s[1] = s.s[1] ^ s.s[6] ^ (s.s[9] & s.s[13])
+	s.s[2] = s.s[2] ^ s.s[7] ^ (s.s[10] & s.s[14])
+	s.s[3] = s.s[3] ^ s.s[4] ^ (s.s[11] & s.s[15])
+
+	_ = tag[15] // Bounds check elimination
+	byteOrder.PutUint32(tag[0:4], s.s[0])
+	byteOrder.PutUint32(tag[4:8], s.s[1])
+	byteOrder.PutUint32(tag[8:12], s.s[2])
+	byteOrder.PutUint32(tag[12:16], s.s[3])
+
+	burnBytes(tmp[:])
+}
+
+func aead640EncryptRef(c, m, a, nonce, key []byte) []byte {
+	var s state640
+	mLen := len(m)
+
+	ret, out := sliceForAppend(c, mLen+TagSize)
+
+	s.init(key, nonce)
+	s.absorbData(a)
+	s.encryptData(out, m)
+	s.finalize(uint64(mLen), uint64(len(a)), out[mLen:])
+
+	burnUint32s(s.s[:])
+
+	return ret
+}
+
+func aead640DecryptRef(m, c, a, nonce, key []byte) ([]byte, bool) {
+	var s state640
+	var tag [TagSize]byte
+	cLen := len(c)
+
+	if cLen < TagSize {
+		return nil, false
+	}
+
+	mLen := cLen - TagSize
+	ret, out := sliceForAppend(m, mLen)
+
+	s.init(key, nonce)
+	s.absorbData(a)
+	s.decryptData(out, c[:mLen])
+	s.finalize(uint64(mLen), uint64(len(a)), tag[:])
+
+	srcTag := c[mLen:]
+	ok := subtle.ConstantTimeCompare(srcTag, tag[:]) == 1
+	if !ok && mLen > 0 {
+		// Burn decrypted plaintext on auth failure.
+		burnBytes(out[:mLen])
+		ret = nil
+	}
+
+	burnUint32s(s.s[:])
+
+	return ret, ok
+}
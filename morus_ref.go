@@ -7,10 +7,7 @@
 
 package morus
 
-import (
-	"crypto/subtle"
-	"math/bits"
-)
+import "math/bits"
 
 const (
 	n1 = 13
@@ -301,48 +298,24 @@ func (s *state) finalize(msgLen, adLen uint64, tag []byte) {
 	burnBytes(tmp[:])
 }
 
-func aeadEncryptRef(c, m, a, nonce, key []byte) []byte {
+func aeadEncryptRef(c, m, a, nonce, key, tag []byte) {
 	var s state
-	mLen := len(m)
-
-	ret, out := sliceForAppend(c, mLen+TagSize)
 
 	s.init(key, nonce)
 	s.absorbData(a)
-	s.encryptData(out, m)
-	s.finalize(uint64(mLen), uint64(len(a)), out[mLen:])
+	s.encryptData(c, m)
+	s.finalize(uint64(len(m)), uint64(len(a)), tag)
 
 	burnUint64s(s.s[:])
-
-	return ret
 }
 
-func aeadDecryptRef(m, c, a, nonce, key []byte) ([]byte, bool) {
+func aeadDecryptRef(m, c, a, nonce, key, tag []byte) {
 	var s state
-	var tag [TagSize]byte
-	cLen := len(c)
-
-	if cLen < TagSize {
-		return nil, false
-	}
-
-	mLen := cLen - TagSize
-	ret, out := sliceForAppend(m, mLen)
 
 	s.init(key, nonce)
 	s.absorbData(a)
-	s.decryptData(out, c[:mLen])
-	s.finalize(uint64(mLen), uint64(len(a)), tag[:])
-
-	srcTag := c[mLen:]
-	ok := subtle.ConstantTimeCompare(srcTag, tag[:]) == 1
-	if !ok && mLen > 0 {
-		// Burn decrypted plaintext on auth failure.
-		burnBytes(out[:mLen])
-		ret = nil
-	}
+	s.decryptData(m, c)
+	s.finalize(uint64(len(c)), uint64(len(a)), tag)
 
 	burnUint64s(s.s[:])
-
-	return ret, ok
 }
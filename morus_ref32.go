@@ -0,0 +1,325 @@
+// morus_ref32.go - Portable 32-bit implementation
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+// state32 is the MORUS-1280 state, with each 64-bit word split into 32-bit
+// halves, so that the state update only requires 32-bit operations.  Each
+// 64-bit rotation is done as a pair of 32-bit double word shifts, with the
+// halves swapped for rotations of 32 bits or more, and the word rotations
+// between rows are done by renaming variables.
+//
+// This is faster than the reference implementation on 32-bit targets (eg:
+// 386, arm), where the compiler has to emulate the 64-bit operations with
+// register pairs, and is the default there.
+type state32 struct {
+	l [20]uint32
+	h [20]uint32
+}
+
+func (s *state32) load(st *state) {
+	for i, v := range st.s {
+		s.l[i], s.h[i] = uint32(v), uint32(v>>32)
+	}
+}
+
+func (s *state32) store(st *state) {
+	for i := range st.s {
+		st.s[i] = uint64(s.h[i])<<32 | uint64(s.l[i])
+	}
+}
+
+func (s *state32) burn() {
+	burnUint32s(s.l[:])
+	burnUint32s(s.h[:])
+}
+
+func loadBlock32(m *[8]uint32, in []byte) {
+	_ = in[31] // Bounds check elimination
+	for i := range m {
+		m[i] = byteOrder.Uint32(in[i*4:])
+	}
+}
+
+func (s *state32) update(m *[8]uint32) {
+	s00l, s00h, s01l, s01h, s02l, s02h, s03l, s03h, s10l, s10h, s11l, s11h, s12l, s12h, s13l, s13h, s20l, s20h, s21l, s21h, s22l, s22h, s23l, s23h, s30l, s30h, s31l, s31h, s32l, s32h, s33l, s33h, s40l, s40h, s41l, s41h, s42l, s42h, s43l, s43h := s.l[0], s.h[0], s.l[1], s.h[1], s.l[2], s.h[2], s.l[3], s.h[3], s.l[4], s.h[4], s.l[5], s.h[5], s.l[6], s.h[6], s.l[7], s.h[7], s.l[8], s.h[8], s.l[9], s.h[9], s.l[10], s.h[10], s.l[11], s.h[11], s.l[12], s.h[12], s.l[13], s.h[13], s.l[14], s.h[14], s.l[15], s.h[15], s.l[16], s.h[16], s.l[17], s.h[17], s.l[18], s.h[18], s.l[19], s.h[19]
+
+	s00l ^= s30l ^ (s10l & s20l)
+	s00h ^= s30h ^ (s10h & s20h)
+	s01l ^= s31l ^ (s11l & s21l)
+	s01h ^= s31h ^ (s11h & s21h)
+	s02l ^= s32l ^ (s12l & s22l)
+	s02h ^= s32h ^ (s12h & s22h)
+	s03l ^= s33l ^ (s13l & s23l)
+	s03h ^= s33h ^ (s13h & s23h)
+	s00h, s00l = s00h<<13|s00l>>19, s00l<<13|s00h>>19
+	s01h, s01l = s01h<<13|s01l>>19, s01l<<13|s01h>>19
+	s02h, s02l = s02h<<13|s02l>>19, s02l<<13|s02h>>19
+	s03h, s03l = s03h<<13|s03l>>19, s03l<<13|s03h>>19
+
+	s10l ^= m[0] ^ s40l ^ (s20l & s33l)
+	s10h ^= m[1] ^ s40h ^ (s20h & s33h)
+	s11l ^= m[2] ^ s41l ^ (s21l & s30l)
+	s11h ^= m[3] ^ s41h ^ (s21h & s30h)
+	s12l ^= m[4] ^ s42l ^ (s22l & s31l)
+	s12h ^= m[5] ^ s42h ^ (s22h & s31h)
+	s13l ^= m[6] ^ s43l ^ (s23l & s32l)
+	s13h ^= m[7] ^ s43h ^ (s23h & s32h)
+	s10h, s10l = s10l<<14|s10h>>18, s10h<<14|s10l>>18
+	s11h, s11l = s11l<<14|s11h>>18, s11h<<14|s11l>>18
+	s12h, s12l = s12l<<14|s12h>>18, s12h<<14|s12l>>18
+	s13h, s13l = s13l<<14|s13h>>18, s13h<<14|s13l>>18
+
+	s20l ^= m[0] ^ s00l ^ (s33l & s42l)
+	s20h ^= m[1] ^ s00h ^ (s33h & s42h)
+	s21l ^= m[2] ^ s01l ^ (s30l & s43l)
+	s21h ^= m[3] ^ s01h ^ (s30h & s43h)
+	s22l ^= m[4] ^ s02l ^ (s31l & s40l)
+	s22h ^= m[5] ^ s02h ^ (s31h & s40h)
+	s23l ^= m[6] ^ s03l ^ (s32l & s41l)
+	s23h ^= m[7] ^ s03h ^ (s32h & s41h)
+	s20h, s20l = s20l<<6|s20h>>26, s20h<<6|s20l>>26
+	s21h, s21l = s21l<<6|s21h>>26, s21h<<6|s21l>>26
+	s22h, s22l = s22l<<6|s22h>>26, s22h<<6|s22l>>26
+	s23h, s23l = s23l<<6|s23h>>26, s23h<<6|s23l>>26
+
+	s33l ^= m[0] ^ s10l ^ (s42l & s01l)
+	s33h ^= m[1] ^ s10h ^ (s42h & s01h)
+	s30l ^= m[2] ^ s11l ^ (s43l & s02l)
+	s30h ^= m[3] ^ s11h ^ (s43h & s02h)
+	s31l ^= m[4] ^ s12l ^ (s40l & s03l)
+	s31h ^= m[5] ^ s12h ^ (s40h & s03h)
+	s32l ^= m[6] ^ s13l ^ (s41l & s00l)
+	s32h ^= m[7] ^ s13h ^ (s41h & s00h)
+	s33h, s33l = s33h<<7|s33l>>25, s33l<<7|s33h>>25
+	s30h, s30l = s30h<<7|s30l>>25, s30l<<7|s30h>>25
+	s31h, s31l = s31h<<7|s31l>>25, s31l<<7|s31h>>25
+	s32h, s32l = s32h<<7|s32l>>25, s32l<<7|s32h>>25
+
+	s42l ^= m[0] ^ s20l ^ (s01l & s12l)
+	s42h ^= m[1] ^ s20h ^ (s01h & s12h)
+	s43l ^= m[2] ^ s21l ^ (s02l & s13l)
+	s43h ^= m[3] ^ s21h ^ (s02h & s13h)
+	s40l ^= m[4] ^ s22l ^ (s03l & s10l)
+	s40h ^= m[5] ^ s22h ^ (s03h & s10h)
+	s41l ^= m[6] ^ s23l ^ (s00l & s11l)
+	s41h ^= m[7] ^ s23h ^ (s00h & s11h)
+	s42h, s42l = s42h<<4|s42l>>28, s42l<<4|s42h>>28
+	s43h, s43l = s43h<<4|s43l>>28, s43l<<4|s43h>>28
+	s40h, s40l = s40h<<4|s40l>>28, s40l<<4|s40h>>28
+	s41h, s41l = s41h<<4|s41l>>28, s41l<<4|s41h>>28
+
+	s.l[0], s.h[0], s.l[1], s.h[1], s.l[2], s.h[2], s.l[3], s.h[3], s.l[4], s.h[4], s.l[5], s.h[5], s.l[6], s.h[6], s.l[7], s.h[7], s.l[8], s.h[8], s.l[9], s.h[9], s.l[10], s.h[10], s.l[11], s.h[11], s.l[12], s.h[12], s.l[13], s.h[13], s.l[14], s.h[14], s.l[15], s.h[15], s.l[16], s.h[16], s.l[17], s.h[17], s.l[18], s.h[18], s.l[19], s.h[19] = s01l, s01h, s02l, s02h, s03l, s03h, s00l, s00h, s12l, s12h, s13l, s13h, s10l, s10h, s11l, s11h, s23l, s23h, s20l, s20h, s21l, s21h, s22l, s22h, s33l, s33h, s30l, s30h, s31l, s31h, s32l, s32h, s42l, s42h, s43l, s43h, s40l, s40h, s41l, s41h
+}
+
+// keyStream writes the key stream for the next block to ks.
+func (s *state32) keyStream(ks *[8]uint32) {
+	ks[0] = s.l[0] ^ s.l[5] ^ (s.l[8] & s.l[12])
+	ks[1] = s.h[0] ^ s.h[5] ^ (s.h[8] & s.h[12])
+	ks[2] = s.l[1] ^ s.l[6] ^ (s.l[9] & s.l[13])
+	ks[3] = s.h[1] ^ s.h[6] ^ (s.h[9] & s.h[13])
+	ks[4] = s.l[2] ^ s.l[7] ^ (s.l[10] & s.l[14])
+	ks[5] = s.h[2] ^ s.h[7] ^ (s.h[10] & s.h[14])
+	ks[6] = s.l[3] ^ s.l[4] ^ (s.l[11] & s.l[15])
+	ks[7] = s.h[3] ^ s.h[4] ^ (s.h[11] & s.h[15])
+}
+
+func init32(st *state, key, iv []byte) {
+	var s state32
+	var k, zero [8]uint32
+
+	_, _ = key[31], iv[15] // Bounds check elimination
+	loadBlock32(&k, key)
+	for i := 0; i < 2; i++ {
+		s.l[i] = byteOrder.Uint32(iv[i*8:])
+		s.h[i] = byteOrder.Uint32(iv[i*8+4:])
+	}
+	for i := 0; i < 4; i++ {
+		s.l[4+i], s.h[4+i] = k[2*i], k[2*i+1]
+		s.l[8+i], s.h[8+i] = 0xffffffff, 0xffffffff
+		s.l[16+i], s.h[16+i] = uint32(initializationConstants[i]), uint32(initializationConstants[i]>>32)
+	}
+
+	for i := 0; i < 16; i++ {
+		s.update(&zero)
+	}
+	for i := 0; i < 4; i++ {
+		s.l[4+i] ^= k[2*i]
+		s.h[4+i] ^= k[2*i+1]
+	}
+
+	s.store(st)
+	s.burn()
+	burnUint32s(k[:])
+}
+
+func absorbBlocks32(st *state, in []byte) {
+	var s state32
+	var m [8]uint32
+
+	s.load(st)
+	for len(in) >= blockSize {
+		loadBlock32(&m, in)
+		s.update(&m)
+		in = in[blockSize:]
+	}
+	s.store(st)
+
+	s.burn()
+	burnUint32s(m[:])
+}
+
+func encryptBlocks32(st *state, out, in []byte) {
+	var s state32
+	var m, ks [8]uint32
+
+	s.load(st)
+	for len(in) >= blockSize {
+		_ = out[31] // Bounds check elimination
+		s.keyStream(&ks)
+		loadBlock32(&m, in)
+		s.update(&m)
+
+		// Doing this last lets this work in place.
+		for i := range m {
+			byteOrder.PutUint32(out[i*4:], m[i]^ks[i])
+		}
+		in, out = in[blockSize:], out[blockSize:]
+	}
+	s.store(st)
+
+	s.burn()
+	burnUint32s(m[:])
+	burnUint32s(ks[:])
+}
+
+func decryptBlocks32(st *state, out, in []byte) {
+	var s state32
+	var m [8]uint32
+
+	s.load(st)
+	for len(in) >= blockSize {
+		_ = out[31] // Bounds check elimination
+		s.keyStream(&m)
+		for i := range m {
+			m[i] ^= byteOrder.Uint32(in[i*4:])
+		}
+		s.update(&m)
+
+		for i := range m {
+			byteOrder.PutUint32(out[i*4:], m[i])
+		}
+		in, out = in[blockSize:], out[blockSize:]
+	}
+	s.store(st)
+
+	s.burn()
+	burnUint32s(m[:])
+}
+
+func finalize32(st *state, msgLen, adLen uint64, tag []byte) {
+	var s state32
+	var m, ks [8]uint32
+
+	m[0], m[1] = uint32(adLen<<3), uint32(adLen>>29)
+	m[2], m[3] = uint32(msgLen<<3), uint32(msgLen>>29)
+
+	s.load(st)
+	for i := 0; i < 4; i++ {
+		s.l[16+i] ^= s.l[i]
+		s.h[16+i] ^= s.h[i]
+	}
+	for i := 0; i < 10; i++ {
+		s.update(&m)
+	}
+
+	s.keyStream(&ks)
+	_ = tag[15] // Bounds check elimination
+	for i := 0; i < 4; i++ {
+		byteOrder.PutUint32(tag[i*4:], ks[i])
+	}
+
+	s.burn()
+	burnUint32s(ks[:])
+}
+
+// absorbData32, encryptData32 and decryptData32 are the arbitrary length
+// equivalents of the block functions, that also use the 32-bit code for the
+// trailing partial block.
+
+func absorbData32(st *state, in []byte) {
+	n := len(in) &^ (blockSize - 1)
+	absorbBlocks32(st, in[:n])
+	if n < len(in) {
+		var tmp [blockSize]byte
+		copy(tmp[:], in[n:])
+		absorbBlocks32(st, tmp[:])
+		burnBytes(tmp[:])
+	}
+}
+
+func encryptData32(st *state, out, in []byte) {
+	n := len(in) &^ (blockSize - 1)
+	encryptBlocks32(st, out[:n], in[:n])
+	if n < len(in) {
+		var tmp [blockSize]byte
+		copy(tmp[:], in[n:])
+		encryptBlocks32(st, tmp[:], tmp[:])
+		copy(out[n:], tmp[:])
+		burnBytes(tmp[:])
+	}
+}
+
+func decryptData32(st *state, out, in []byte) {
+	n := len(in) &^ (blockSize - 1)
+	decryptBlocks32(st, out[:n], in[:n])
+	if n < len(in) {
+		// The padding is decrypted into garbage, which must be cleared
+		// before it is absorbed.
+		var s state32
+		var m [8]uint32
+		var tmp [blockSize]byte
+
+		copy(tmp[:], in[n:])
+		s.load(st)
+		s.keyStream(&m)
+		for i := range m {
+			byteOrder.PutUint32(tmp[i*4:], m[i]^byteOrder.Uint32(tmp[i*4:]))
+		}
+		copy(out[n:], tmp[:])
+		burnBytes(tmp[len(in)-n:])
+		loadBlock32(&m, tmp[:])
+		s.update(&m)
+		s.store(st)
+
+		s.burn()
+		burnUint32s(m[:])
+		burnBytes(tmp[:])
+	}
+}
+
+func aeadEncryptPortable32(c, m, a, nonce, key, tag []byte) {
+	var s state
+
+	init32(&s, key, nonce)
+	absorbData32(&s, a)
+	encryptData32(&s, c, m)
+	finalize32(&s, uint64(len(m)), uint64(len(a)), tag)
+
+	burnUint64s(s.s[:])
+}
+
+func aeadDecryptPortable32(m, c, a, nonce, key, tag []byte) {
+	var s state
+
+	init32(&s, key, nonce)
+	absorbData32(&s, a)
+	decryptData32(&s, m, c)
+	finalize32(&s, uint64(len(c)), uint64(len(a)), tag)
+
+	burnUint64s(s.s[:])
+}
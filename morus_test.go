@@ -9,173 +9,302 @@ package morus
 
 import (
 	"bytes"
+	"crypto/cipher"
 	"crypto/rand"
-	"fmt"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
-)
 
-var canAccelerate bool
+	"github.com/Yawning/morus/morustest"
+)
 
-func mustInitHardwareAcceleration() {
-	initHardwareAcceleration()
-	if !IsHardwareAccelerated() {
-		panic("initHardwareAcceleration() failed")
+func TestKAT(t *testing.T) {
+	for _, v := range []struct {
+		variant *morustest.Variant
+		newFn   func([]byte) *AEAD
+	}{
+		{morustest.MORUS1280256, New},
+		{morustest.MORUS1280128, New128},
+	} {
+		for _, name := range Implementations() {
+			newFn := newWithImpl(t, v.newFn, name)
+			t.Run(v.variant.Name+"_KAT_"+name, func(t *testing.T) {
+				morustest.TestKAT(t, v.variant, newFn)
+				morustest.TestTamper(t, v.variant, newFn)
+			})
+		}
 	}
 }
 
-func TestKAT(t *testing.T) {
-	forceDisableHardwareAcceleration()
-	impl := "_" + hardwareAccelImpl.name
-	t.Run("MORUS-1280-256_KAT"+impl, func(t *testing.T) { doTestKAT(t) })
+func TestKAT640(t *testing.T) {
+	newFn := func(key []byte) cipher.AEAD { return New640(key) }
+	t.Run("MORUS-640-128_KAT", func(t *testing.T) {
+		morustest.TestKAT(t, morustest.MORUS640128, newFn)
+		morustest.TestTamper(t, morustest.MORUS640128, newFn)
+	})
+}
 
-	if !canAccelerate {
-		t.Log("Hardware acceleration not supported on this host.")
-		return
+func newWithImpl(tb testing.TB, newFn func([]byte) *AEAD, implName string) morustest.NewFunc {
+	return func(key []byte) cipher.AEAD {
+		aead := newFn(key)
+		require.NoError(tb, aead.SetImplementation(implName), "SetImplementation(%s)", implName)
+		return aead
 	}
-	mustInitHardwareAcceleration()
-	impl = "_" + hardwareAccelImpl.name
-	t.Run("MORUS-1280-256_KAT"+impl, func(t *testing.T) { doTestKAT(t) })
 }
 
-func doTestKAT(t *testing.T) {
-	require := require.New(t)
+func TestDetached(t *testing.T) {
+	for _, name := range Implementations() {
+		t.Run("Detached_"+name, func(t *testing.T) { doTestDetached(t, name) })
+	}
+}
 
-	// There are no official test vectors, so the "known good" values used
-	// by this test were generated by combining `genkat.c` from the NORX
-	// source package and `supercop-20171218/crypto_aead/morus1280256v2/ref64`.
+func doTestDetached(t *testing.T, implName string) {
+	require := require.New(t)
 
-	var w, h [256]byte
-	var k [32]byte
-	var n [16]byte
+	var key [KeySize]byte
+	var nonce [NonceSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
 
-	for i := range w {
-		w[i] = byte(255 & (i*197 + 123))
-	}
-	for i := range h {
-		h[i] = byte(255 & (i*193 + 123))
-	}
-	for i := range k {
-		k[i] = byte(255 & (i*191 + 123))
-	}
-	for i := range n {
-		n[i] = byte(255 & (i*181 + 123))
-	}
+	aead := New(key[:])
+	err = aead.SetImplementation(implName)
+	require.NoError(err, "SetImplementation(%s)", implName)
 
-	var katAcc []byte
-	katOff := 0
+	for _, sz := range []int{0, 1, 31, 32, 33, 1024, 4099} {
+		var tag [TagSize]byte
 
-	aead := New(k[:])
-	require.Equal(NonceSize, aead.NonceSize(), "NonceSize()")
-	require.Equal(TagSize, aead.Overhead(), "Overhead()")
+		m, a := make([]byte, sz), make([]byte, sz/2)
+		_, _ = rand.Read(m)
+		_, _ = rand.Read(a)
 
-	for i := range w {
-		katAcc = aead.Seal(katAcc, n[:], w[:i], h[:i])
-		c := katAcc[katOff:]
-		require.Len(c, i+TagSize, "Seal(): len(c) %d", i)
-		require.Equal(kat1280256[katOff:katOff+len(c)], c, "Seal(): %d", i)
+		expected := aead.Seal(nil, nonce[:], m, a)
+		c := aead.SealDetached(nil, tag[:], nonce[:], m, a)
+		require.Len(c, sz, "SealDetached(): len(c) %d", sz)
+		require.Equal(expected, append(c, tag[:]...), "SealDetached(): %d", sz)
 
-		m, err := aead.Open(nil, n[:], c, h[:i])
-		require.NoError(err, "Open(): %d", i)
-		require.Len(m, i, "Open(): len(m) %d", i)
-		if len(m) != 0 {
-			require.Equal(m, w[:i], "Open(): m %d", i)
+		d, err := aead.OpenDetached(nil, nonce[:], c, tag[:], a)
+		require.NoError(err, "OpenDetached(): %d", sz)
+		require.Len(d, sz, "OpenDetached(): len(d) %d", sz)
+		if sz != 0 {
+			require.Equal(m, d, "OpenDetached(): %d", sz)
 		}
-		katOff += len(c)
 
-		// Test malformed ciphertext.
-		badC := append([]byte{}, c...)
-		badC[i] ^= 0x23
-		m, err = aead.Open(nil, n[:], badC, h[:i])
-		require.Error(err, "Open(Bad c): %d", i)
-		require.Nil(m, "Open(Bad c): len(m) %d", i)
-
-		// Test malformed AD.
-		if i > 0 {
-			badH := append([]byte{}, h[:i]...)
-			badH[i-1] ^= 0x23
-			m, err = aead.Open(nil, n[:], c, badH)
-			require.Error(err, "Open(Bad h): %d", i)
-			require.Nil(m, "Open(Bad h): len(m) %d", i)
+		// Test in-place operation.
+		buf := append([]byte{}, m...)
+		buf = aead.SealDetached(buf[:0], tag[:], nonce[:], buf, a)
+		require.Len(buf, sz, "SealDetached(In place): len(c) %d", sz)
+		if sz != 0 {
+			require.Equal(c, buf, "SealDetached(In place): %d", sz)
+		}
+		buf, err = aead.OpenDetached(buf[:0], nonce[:], buf, tag[:], a)
+		require.NoError(err, "OpenDetached(In place): %d", sz)
+		if sz != 0 {
+			require.Equal(m, buf, "OpenDetached(In place): %d", sz)
 		}
+
+		// Test malformed tags.
+		badTag := tag
+		badTag[TagSize-1] ^= 0x23
+		d, err = aead.OpenDetached(nil, nonce[:], c, badTag[:], a)
+		require.Equal(ErrOpen, err, "OpenDetached(Bad tag): %d", sz)
+		require.Nil(d, "OpenDetached(Bad tag): %d", sz)
+		d, err = aead.OpenDetached(nil, nonce[:], c, tag[:TagSize-1], a)
+		require.Equal(ErrOpen, err, "OpenDetached(Short tag): %d", sz)
+		require.Nil(d, "OpenDetached(Short tag): %d", sz)
 	}
-	require.Equal(kat1280256, katAcc, "Final concatenated cipher texts.")
+
+	require.Panics(func() { aead.SealDetached(nil, make([]byte, TagSize-1), nonce[:], nil, nil) }, "SealDetached(Short tag)")
 }
 
-func BenchmarkMORUS(b *testing.B) {
-	forceDisableHardwareAcceleration()
-	doBenchmarkMORUS(b)
+func TestTagSize(t *testing.T) {
+	require := require.New(t)
 
-	if !canAccelerate {
-		b.Log("Hardware acceleration not supported on this host.")
-		return
-	}
-	mustInitHardwareAcceleration()
-	doBenchmarkMORUS(b)
-}
+	var key [KeySize]byte
+	var nonce [NonceSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
 
-func doBenchmarkMORUS(b *testing.B) {
-	benchSizes := []int{8, 32, 64, 576, 1536, 4096, 1024768}
-	impl := "_" + hardwareAccelImpl.name
+	m, a := make([]byte, 1027), make([]byte, 45)
+	_, _ = rand.Read(m)
+	_, _ = rand.Read(a)
 
-	for _, sz := range benchSizes {
-		bn := "MORUS-1280-256" + impl + "_"
-		sn := fmt.Sprintf("_%d", sz)
-		b.Run(bn+"Encrypt"+sn, func(b *testing.B) { doBenchmarkAEADEncrypt(b, sz) })
-		b.Run(bn+"Decrypt"+sn, func(b *testing.B) { doBenchmarkAEADDecrypt(b, sz) })
-	}
-}
+	full := New(key[:]).Seal(nil, nonce[:], m, a)
+	require.Equal(full, NewWithTagSize(key[:], TagSize).Seal(nil, nonce[:], m, a), "NewWithTagSize(TagSize)")
+	require.Equal(New128(key[:KeySize128]).Seal(nil, nonce[:], m, a), NewWithTagSize(key[:KeySize128], TagSize).Seal(nil, nonce[:], m, a), "NewWithTagSize(KeySize128, TagSize)")
 
-func doBenchmarkAEADEncrypt(b *testing.B, sz int) {
-	b.StopTimer()
-	b.SetBytes(int64(sz))
+	var tags [][]byte
+	for tagSize := MinTagSize; tagSize < TagSize; tagSize++ {
+		aead := NewWithTagSize(key[:], tagSize)
+		require.Equal(tagSize, aead.Overhead(), "Overhead(): %d", tagSize)
 
-	nonce, key := make([]byte, NonceSize), make([]byte, KeySize)
-	m, c := make([]byte, sz), make([]byte, 0, sz+TagSize)
-	rand.Read(nonce)
-	rand.Read(key)
-	rand.Read(m)
+		var c []byte
+		for _, name := range Implementations() {
+			err = aead.SetImplementation(name)
+			require.NoError(err, "SetImplementation(%s)", name)
 
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		c = c[:0]
+			c2 := aead.Seal(nil, nonce[:], m, a)
+			require.Len(c2, len(m)+tagSize, "Seal(): len(c) %d %s", tagSize, name)
+			if c != nil {
+				require.Equal(c, c2, "Seal(): %d %s", tagSize, name)
+			}
+			c = c2
+
+			d, err := aead.Open(nil, nonce[:], c, a)
+			require.NoError(err, "Open(): %d %s", tagSize, name)
+			require.Equal(m, d, "Open(): %d %s", tagSize, name)
+
+			cs := aead.SealBatch(nil, [][]byte{nonce[:], nonce[:]}, [][]byte{m, m}, [][]byte{a, a})
+			require.Equal([][]byte{c, c}, cs, "SealBatch(): %d %s", tagSize, name)
+		}
 
-		c = hardwareAccelImpl.aeadEncryptFn(c, m, nil, nonce, key)
-		if len(c) != sz+TagSize {
-			b.Fatalf("aeadEncrypt failed")
+		// The domain separation changes the key stream as well, and the tag
+		// must not be a truncation of a tag with a different size.
+		tag := c[len(m):]
+		require.NotEqual(full[:len(m)], c[:len(m)], "Seal(): c %d", tagSize)
+		require.NotEqual(full[len(m):len(m)+tagSize], tag, "Seal(): Tag prefix %d", tagSize)
+		for _, prevTag := range tags {
+			require.NotEqual(prevTag, tag[:len(prevTag)], "Seal(): Tag prefix %d", tagSize)
 		}
+		tags = append(tags, tag)
+
+		// Test malformed tag.
+		badC := append([]byte{}, c...)
+		badC[len(badC)-1] ^= 0x23
+		d, err := aead.Open(nil, nonce[:], badC, a)
+		require.Equal(ErrOpen, err, "Open(Bad tag): %d", tagSize)
+		require.Nil(d, "Open(Bad tag): %d", tagSize)
+
+		// Test that truncated full size tags are rejected.
+		d, err = aead.Open(nil, nonce[:], full[:len(m)+tagSize], a)
+		require.Equal(ErrOpen, err, "Open(Truncated tag): %d", tagSize)
+		require.Nil(d, "Open(Truncated tag): %d", tagSize)
+
+		// Test the detached interface, including tags of the wrong size.
+		var detachedTag [TagSize]byte
+		c2 := aead.SealDetached(nil, detachedTag[:tagSize], nonce[:], m, a)
+		require.Equal(c, append(c2, detachedTag[:tagSize]...), "SealDetached(): %d", tagSize)
+		_, err = aead.OpenDetached(nil, nonce[:], c2, detachedTag[:tagSize], a)
+		require.NoError(err, "OpenDetached(): %d", tagSize)
+		_, err = New(key[:]).OpenDetached(nil, nonce[:], c2, detachedTag[:tagSize], a)
+		require.Equal(ErrOpen, err, "OpenDetached(Short tag): %d", tagSize)
+		require.Panics(func() { aead.SealDetached(nil, detachedTag[:], nonce[:], m, a) }, "SealDetached(Bad tag size): %d", tagSize)
 	}
+
+	require.Panics(func() { NewWithTagSize(key[:], MinTagSize-1) }, "NewWithTagSize(MinTagSize-1)")
+	require.Panics(func() { NewWithTagSize(key[:], TagSize+1) }, "NewWithTagSize(TagSize+1)")
+	require.Panics(func() { NewWithTagSize(key[:KeySize-1], TagSize) }, "NewWithTagSize(Bad key)")
 }
 
-func doBenchmarkAEADDecrypt(b *testing.B, sz int) {
-	b.StopTimer()
-	b.SetBytes(int64(sz))
-
-	nonce, key := make([]byte, NonceSize), make([]byte, KeySize)
-	m, c, d := make([]byte, sz), make([]byte, 0, sz+TagSize), make([]byte, 0, sz)
-	rand.Read(nonce)
-	rand.Read(key)
-	rand.Read(m)
-
-	c = hardwareAccelImpl.aeadEncryptFn(c, m, nil, nonce, key)
-	b.StartTimer()
-	for i := 0; i < b.N; i++ {
-		d = d[:0]
-
-		var ok bool
-		d, ok = hardwareAccelImpl.aeadDecryptFn(d, c, nil, nonce, key)
-		if !ok {
-			b.Fatalf("aeadDecrypt failed")
-		}
+func TestLifecycle(t *testing.T) {
+	require := require.New(t)
+
+	var nonce [NonceSize]byte
+	key := make([]byte, KeySize)
+	_, err := rand.Read(key)
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+
+	m := []byte("Every operation on a reset instance must panic")
+
+	// Test that NewOwned uses the caller's buffer.
+	ownedKey := append([]byte{}, key...)
+	aead := NewOwned(ownedKey)
+	c := aead.Seal(nil, nonce[:], m, nil)
+	require.Equal(New(key).Seal(nil, nonce[:], m, nil), c, "NewOwned(): Seal()")
+
+	// Test that clones are independent.
+	clone, err := aead.Clone()
+	require.NoError(err, "Clone()")
+	require.Equal(c, clone.Seal(nil, nonce[:], m, nil), "Clone(): Seal()")
+
+	aead.Reset()
+	require.Equal(make([]byte, KeySize), ownedKey, "Reset(): Owned key")
+	aead.Reset()
+
+	d, err := clone.Open(nil, nonce[:], c, nil)
+	require.NoError(err, "Clone(): Open() after Reset()")
+	require.Equal(m, d, "Clone(): Open() after Reset()")
+
+	var tag [TagSize]byte
+	for name, fn := range map[string]func(){
+		"Seal":         func() { aead.Seal(nil, nonce[:], m, nil) },
+		"Open":         func() { _, _ = aead.Open(nil, nonce[:], c, nil) },
+		"SealDetached": func() { aead.SealDetached(nil, tag[:], nonce[:], m, nil) },
+		"OpenDetached": func() { _, _ = aead.OpenDetached(nil, nonce[:], m, tag[:], nil) },
+		"SealBatch":    func() { aead.SealBatch(nil, [][]byte{nonce[:]}, [][]byte{m}, nil) },
+		"OpenBatch":    func() { _, _ = aead.OpenBatch(nil, [][]byte{nonce[:]}, [][]byte{c}, nil) },
+		"Clone":        func() { _, _ = aead.Clone() },
+		"SetFinalizer": func() { aead.SetFinalizer() },
+	} {
+		require.PanicsWithValue(ErrInvalidState, fn, "%s(): After Reset()", name)
 	}
-	b.StopTimer()
+	_, err = aead.SealChecked(nil, nonce[:], m, nil)
+	require.Equal(ErrInvalidState, err, "SealChecked(): After Reset()")
+	_, err = aead.OpenChecked(nil, nonce[:], c, nil)
+	require.Equal(ErrInvalidState, err, "OpenChecked(): After Reset()")
 
-	if !bytes.Equal(m, d) {
-		b.Fatalf("aeadDecrypt output mismatch")
+	// Test that the finalizer burns the key.
+	ownedKey = append([]byte{}, key...)
+	func() {
+		aead := NewOwned(ownedKey)
+		aead.SetFinalizer()
+		clone, err := aead.Clone()
+		require.NoError(err, "SetFinalizer(): Clone()")
+		require.True(clone.finalizer, "SetFinalizer(): Clone()")
+		require.Equal(c, clone.Seal(nil, nonce[:], m, nil), "SetFinalizer(): Clone()")
+	}()
+	for i := 0; i < 100 && !bytes.Equal(ownedKey, make([]byte, KeySize)); i++ {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
 	}
+	require.Equal(make([]byte, KeySize), ownedKey, "SetFinalizer(): Owned key")
+
+	require.Panics(func() { NewOwned(key[:KeySize-1]) }, "NewOwned(Short key)")
+}
+
+// resetAEAD is a cipher.AEAD that can be reset.
+type resetAEAD interface {
+	cipher.AEAD
+	Reset()
 }
 
-func init() {
-	canAccelerate = IsHardwareAccelerated()
+// doTestResetLifecycle tests that Reset burns the key buffer, which must be
+// the instance's own, that Reset may be called again, and that any further
+// use of the instance panics with ErrInvalidState.
+func doTestResetLifecycle(t *testing.T, aead resetAEAD, key []byte) {
+	require := require.New(t)
+
+	nonce := make([]byte, aead.NonceSize())
+	m := []byte("Every operation on a reset instance must panic")
+	c := aead.Seal(nil, nonce, m, nil)
+	d, err := aead.Open(nil, nonce, c, nil)
+	require.NoError(err, "Open()")
+	require.Equal(m, d, "Open()")
+
+	aead.Reset()
+	require.Equal(make([]byte, len(key)), key, "Reset(): Key")
+	aead.Reset()
+
+	require.PanicsWithValue(ErrInvalidState, func() { aead.Seal(nil, nonce, m, nil) }, "Seal(): After Reset()")
+	require.PanicsWithValue(ErrInvalidState, func() { _, _ = aead.Open(nil, nonce, c, nil) }, "Open(): After Reset()")
+}
+
+func TestLifecycle640(t *testing.T) {
+	aead := New640(bytes.Repeat([]byte{0x23}, KeySize640))
+	doTestResetLifecycle(t, aead, aead.key)
+}
+
+func BenchmarkMORUS(b *testing.B) {
+	for _, name := range Implementations() {
+		b.Run(name, func(b *testing.B) {
+			morustest.BenchmarkAEAD(b, morustest.MORUS1280256, newWithImpl(b, New, name))
+		})
+	}
 }
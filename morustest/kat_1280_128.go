@@ -0,0 +1,4975 @@
+// kat_1280_128.go - MORUS-1280-128 regression test vectors
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morustest
+
+// kat1280128 was generated by this package, and has not been checked against
+// the reference code, so it is a self-generated regression vector rather
+// than a known answer test.  Once the SUPERCOP sources are vendored, the
+// `morus1280128v2` differential test in `internal/supercop` provides that
+// anchor.
+var kat1280128 = []byte{
+	0x58, 0x12, 0x82, 0xB5, 0xCB, 0xCF, 0xD5, 0xB1,
+	0x06, 0x6D, 0x05, 0xD4, 0xE5, 0x4D, 0xF5, 0x36,
+
+	0x5D, 0xF6, 0x21, 0x58, 0xFF, 0x13, 0xBF, 0xD9,
+	0x10, 0x09, 0x73, 0xA1, 0xCD, 0x9E, 0x2B, 0x67,
+	0x65,
+
+	0x5A, 0xF9, 0xDF, 0x6F, 0x14, 0x22, 0x53, 0xBB,
+	0x3B, 0x95, 0x8F, 0x9E, 0x33, 0x15, 0x61, 0xF2,
+	0x63, 0xA7,
+
+	0xDA, 0xE8, 0xB2, 0xB2, 0xF5, 0x16, 0x5A, 0xC1,
+	0x3C, 0x67, 0xE8, 0x70, 0x28, 0x73, 0x4A, 0x86,
+	0x5D, 0xBF, 0xDA,
+
+	0xDA, 0xA8, 0xB2, 0x8C, 0x72, 0x81, 0x67, 0x4D,
+	0x5B, 0x40, 0x69, 0x26, 0x7C, 0x0C, 0xDE, 0x92,
+	0x69, 0x73, 0x70, 0xF2,
+
+	0xDA, 0xA8, 0x52, 0x82, 0x63, 0x22, 0x28, 0xE4,
+	0x45, 0xC0, 0x46, 0xD7, 0xE0, 0x97, 0x6D, 0xD9,
+	0x52, 0x5C, 0xD8, 0x25, 0x14,
+
+	0xDA, 0xA8, 0x52, 0x82, 0x63, 0xAF, 0x64, 0xFD,
+	0x12, 0x63, 0xD8, 0x97, 0x5C, 0x11, 0x3E, 0x53,
+	0xBB, 0xAF, 0xD7, 0xE0, 0x23, 0x12,
+
+	0xDA, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xB7, 0x59,
+	0x42, 0xDB, 0x0A, 0x68, 0xB0, 0xAB, 0xF5, 0x1B,
+	0x2D, 0x96, 0xEF, 0x63, 0xF7, 0x88, 0xA2,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x69, 0x38, 0x4C, 0x41, 0xC1, 0x7B, 0x46, 0x66,
+	0x0E, 0x79, 0x18, 0x55, 0x68, 0x59, 0x27, 0x05,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x0F, 0xCC, 0xBD, 0x70, 0xF9, 0x16, 0x48, 0x71,
+	0xCA, 0xDA, 0x99, 0xCE, 0xDD, 0xDE, 0x15, 0x99,
+	0xDC,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x0F, 0x4C, 0xBD, 0x90, 0x05, 0x33, 0xF8, 0x84,
+	0xF3, 0xDE, 0xDC, 0xAE, 0x43, 0xE3, 0xA0, 0x22,
+	0xD2, 0xC0,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8F, 0x4C, 0x51, 0x0F, 0xCD, 0x9D, 0xEA, 0xAA,
+	0x0C, 0x2F, 0xAF, 0x87, 0x31, 0xEC, 0x3F, 0x29,
+	0x80, 0xC9, 0xBD,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8F, 0x0C, 0x41, 0xD8, 0x2D, 0xA7, 0xEF, 0x6A,
+	0xFA, 0xBA, 0x8C, 0x38, 0x6C, 0xAC, 0x54, 0x2A,
+	0x76, 0xAD, 0x30, 0x62,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8F, 0x0C, 0x41, 0xD8, 0x0B, 0xAB, 0xAE, 0xE7,
+	0x76, 0x7A, 0x95, 0xB9, 0xE4, 0x09, 0xF7, 0xA3,
+	0x88, 0xE0, 0xC9, 0x79, 0xE0,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8F, 0x0C, 0x41, 0xD8, 0x02, 0xAF, 0xCE, 0x04,
+	0x8D, 0xD9, 0x34, 0x89, 0xA0, 0x74, 0x20, 0xE2,
+	0x90, 0x25, 0x01, 0xC9, 0xD7, 0xAA,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8F, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x87, 0xA7,
+	0x01, 0xC0, 0x25, 0x67, 0x92, 0x7D, 0x14, 0x10,
+	0x75, 0x0D, 0x91, 0xE1, 0xAC, 0xDA, 0x70,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0xB9, 0xC4, 0x82, 0xB0, 0x5C, 0x46, 0x6C, 0x55,
+	0xBC, 0x29, 0x5A, 0x44, 0x2D, 0xDD, 0x7F, 0xB9,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x4A, 0x53, 0xB2, 0xB6, 0x38, 0x28, 0x80, 0xAA,
+	0x2A, 0xC5, 0x1E, 0x53, 0x7B, 0x45, 0xC1, 0x13,
+	0x28,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x4A, 0x89, 0x05, 0x75, 0x5C, 0xB4, 0x5D, 0x6D,
+	0x3C, 0x93, 0xA6, 0x7A, 0xDE, 0x07, 0x52, 0x2A,
+	0x56, 0x82,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0xEA, 0x89, 0xCF, 0xFE, 0x88, 0x95, 0xC5, 0x4E,
+	0x87, 0x86, 0xE1, 0x54, 0x07, 0x4C, 0x55, 0xD7,
+	0x37, 0x72, 0xC6,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0xEA, 0xC9, 0xD7, 0xE7, 0x59, 0x94, 0x6C, 0xC7,
+	0x18, 0xD7, 0x6D, 0xE6, 0xA0, 0x78, 0xE2, 0x35,
+	0x3E, 0xEB, 0xC6, 0xAE,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0xEA, 0xC9, 0x77, 0xE6, 0xC9, 0x20, 0x0F, 0x9D,
+	0xD9, 0x54, 0xAA, 0xDE, 0x0D, 0x59, 0x1A, 0xB6,
+	0x99, 0xE1, 0xBF, 0x82, 0x65,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0xEA, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0x68, 0xA9,
+	0xFD, 0xEE, 0xD3, 0x38, 0x73, 0xC7, 0xC5, 0x6E,
+	0x85, 0x98, 0x59, 0x0D, 0x14, 0x15,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0xEA, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0xA4, 0x42,
+	0x5C, 0x83, 0xE4, 0xB5, 0xE3, 0xA5, 0x33, 0x9C,
+	0xA3, 0xED, 0xC6, 0x18, 0x86, 0x44, 0x81,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x43, 0xAF, 0xA7, 0x10,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x8A, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0xB4, 0xCC,
+	0x1F, 0x0E, 0x0F, 0x63, 0xE7, 0xFA, 0xF1, 0x0B,
+	0xC1, 0x78, 0x0B, 0x0C, 0x15, 0xEF, 0xEB, 0x5E,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x83, 0x4B, 0x83, 0x10,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x8A, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0xB4, 0xCC,
+	0xCF, 0x16, 0x2E, 0xB8, 0x01, 0x4D, 0x9F, 0xA3,
+	0x8A, 0xE1, 0xA2, 0x39, 0x2B, 0x39, 0xA7, 0x62,
+	0xCC,
+
+	0xDB, 0xA8, 0x52, 0x82, 0x83, 0x4B, 0x96, 0x05,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x8A, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0xB4, 0xCC,
+	0xCD, 0xED, 0xA0, 0xAA, 0xF2, 0x2F, 0xDA, 0xD6,
+	0x43, 0xFB, 0x0D, 0xAC, 0x49, 0x3A, 0x01, 0xF6,
+	0xAA, 0x12,
+
+	0xDE, 0xA8, 0x52, 0x82, 0x83, 0x4B, 0x96, 0x44,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x8A, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0xB4, 0xCC,
+	0xCD, 0xED, 0x6D, 0x71, 0x3A, 0x23, 0xD0, 0xA1,
+	0x94, 0x93, 0x7C, 0x99, 0xC3, 0xCD, 0x9D, 0xA6,
+	0xFB, 0x76, 0xD8,
+
+	0x4B, 0x9D, 0x52, 0x82, 0x83, 0x4B, 0x96, 0xC4,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x8A, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0xB4, 0xCC,
+	0xCD, 0xED, 0x7D, 0x81, 0xB7, 0xC3, 0x7F, 0xA6,
+	0xAA, 0xFA, 0xDF, 0x82, 0x5F, 0x87, 0xCB, 0x7B,
+	0xD5, 0xFE, 0xE7, 0x28,
+
+	0x8B, 0x78, 0x77, 0x82, 0x83, 0x4B, 0x96, 0xC4,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x8A, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0xB4, 0xCC,
+	0xCD, 0xED, 0x7D, 0x81, 0x18, 0x6B, 0x29, 0xB7,
+	0x90, 0xAE, 0x52, 0xD5, 0xDB, 0xAB, 0x79, 0x67,
+	0x6D, 0xD2, 0x29, 0x8B, 0xF1,
+
+	0x8B, 0x78, 0x71, 0x94, 0x83, 0x4B, 0x96, 0xC4,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x8A, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0xB4, 0xCC,
+	0xCD, 0xED, 0x7D, 0x81, 0x19, 0x19, 0x1C, 0xF4,
+	0x88, 0x05, 0x1A, 0xEE, 0x22, 0x5F, 0x30, 0x43,
+	0xE6, 0x09, 0x78, 0x5C, 0x68, 0x4C,
+
+	0x8B, 0x78, 0x71, 0xD6, 0x85, 0x4B, 0x96, 0xC4,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x8A, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0xB4, 0xCC,
+	0xCD, 0xED, 0x7D, 0x81, 0x39, 0x19, 0xA2, 0xE5,
+	0x99, 0x70, 0x9A, 0xF4, 0xAD, 0x54, 0x37, 0xCA,
+	0xA0, 0xA0, 0x23, 0x7F, 0xBE, 0x45, 0xFE,
+
+	0x8B, 0x78, 0x71, 0x56, 0x35, 0x7D, 0x96, 0xC4,
+	0x8A, 0x0C, 0x41, 0xD8, 0x02, 0xAE, 0x9F, 0xFD,
+	0x8A, 0xC9, 0x77, 0xE6, 0xC9, 0xBD, 0xB4, 0xCC,
+	0x88, 0xED, 0x7D, 0x81, 0x39, 0x59, 0xA0, 0x26,
+	0x77, 0xB9, 0x72, 0xA5, 0x8F, 0x61, 0xCF, 0xDC,
+	0xEB, 0x76, 0x21, 0xB6, 0x88, 0x9C, 0xD6, 0xC3,
+
+	0x2E, 0x20, 0x98, 0x0A, 0x06, 0xC3, 0xA8, 0xC4,
+	0x4C, 0x52, 0xD4, 0xC2, 0x94, 0x6A, 0x95, 0x93,
+	0xB5, 0x4F, 0x53, 0x10, 0x5F, 0x3E, 0xC8, 0x8F,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0x23, 0x37, 0x98, 0x91, 0x09, 0x5F, 0xD2, 0x20,
+	0xE8, 0xF8, 0x4F, 0xF0, 0x3E, 0xDE, 0x10, 0x47,
+	0x3A,
+
+	0x2D, 0x20, 0x94, 0x0A, 0x06, 0xC3, 0xA8, 0x44,
+	0x4C, 0x52, 0xD4, 0xC2, 0x94, 0x6A, 0x92, 0x84,
+	0xB5, 0x4F, 0x53, 0x10, 0x5F, 0x3E, 0xC8, 0x8F,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0xA1, 0x5E, 0x55, 0x2D, 0x18, 0xA9, 0x6B, 0xDE,
+	0xD4, 0x30, 0x19, 0x1B, 0xD6, 0xC5, 0x69, 0x7B,
+	0x24, 0x9E,
+
+	0x2D, 0x20, 0x14, 0x0A, 0x06, 0xC3, 0xA8, 0x44,
+	0x4B, 0x52, 0xD4, 0xC2, 0x94, 0x6A, 0x92, 0xC6,
+	0xB5, 0x4F, 0x53, 0x10, 0x5F, 0x3E, 0xC8, 0x8F,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0xE9, 0x7E, 0x11, 0xC0, 0x63, 0xCF, 0xE6, 0xEC,
+	0x8B, 0xB1, 0x84, 0xBC, 0xDA, 0x74, 0x3A, 0x7F,
+	0x57, 0xFD, 0xE3,
+
+	0x2D, 0xE0, 0x1C, 0x0A, 0x2C, 0xC3, 0xA8, 0x44,
+	0xF8, 0x65, 0xD4, 0xC2, 0x94, 0x6A, 0x92, 0xC6,
+	0xB5, 0x4F, 0x53, 0x10, 0x5F, 0x3E, 0xC8, 0x8F,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0xE9, 0x9E, 0x0D, 0x84, 0xF6, 0xD5, 0x09, 0x76,
+	0x08, 0x88, 0x50, 0xB0, 0x39, 0x51, 0x07, 0xFC,
+	0x3F, 0x54, 0xE9, 0x77,
+
+	0x2D, 0xE0, 0xDC, 0x1A, 0xAC, 0xC1, 0xA8, 0x44,
+	0x38, 0xA2, 0xF3, 0xC2, 0x94, 0x6A, 0x92, 0xC6,
+	0xB5, 0x4F, 0x53, 0x10, 0x5F, 0x3E, 0xC8, 0x8F,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0xAD, 0x9E, 0x3D, 0x90, 0xD8, 0x81, 0x40, 0xF8,
+	0x94, 0x64, 0xD0, 0xF3, 0xB5, 0x98, 0x49, 0xA3,
+	0x97, 0x1F, 0x0D, 0x66, 0x34,
+
+	0x2D, 0xE0, 0xDC, 0x1A, 0xA4, 0xC1, 0xA8, 0x44,
+	0x38, 0xA2, 0xE3, 0xDA, 0x94, 0x6A, 0x92, 0xC6,
+	0xB5, 0x4F, 0x53, 0x10, 0x5F, 0x3E, 0xC8, 0x8F,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0xAD, 0xBE, 0x3D, 0x90, 0xD9, 0x78, 0x38, 0xF9,
+	0x9A, 0xDB, 0x53, 0xCD, 0x7E, 0xEE, 0xB7, 0x8D,
+	0xEB, 0x94, 0x72, 0xCB, 0x1A, 0x1F,
+
+	0x2D, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x28, 0x44,
+	0x38, 0xA2, 0xE3, 0x92, 0x9C, 0x6A, 0x92, 0xC6,
+	0xB5, 0x4F, 0x53, 0x10, 0x5F, 0x3E, 0xC8, 0x8F,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0xAD, 0x3E, 0x2D, 0x90, 0xD9, 0xB9, 0xAE, 0x8A,
+	0x4C, 0x23, 0xB7, 0x70, 0x17, 0x20, 0xF2, 0x5F,
+	0x46, 0xCB, 0x34, 0x0F, 0x7E, 0x73, 0x1E,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x38, 0xA2, 0xE3, 0x12, 0x04, 0x52, 0x92, 0xC6,
+	0xB5, 0x4F, 0x53, 0x10, 0x5F, 0x3E, 0xC8, 0x8F,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0xAD, 0x3E, 0x2D, 0x80, 0xD9, 0xB9, 0x6A, 0xDB,
+	0x0A, 0x37, 0x2B, 0x9C, 0x64, 0x1B, 0x51, 0x52,
+	0x2A, 0xD3, 0xFB, 0xBA, 0x92, 0x19, 0x75, 0x47,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0xB8, 0xF2, 0xE3, 0x12, 0x04, 0x52, 0xB2, 0xC2,
+	0xB5, 0x4F, 0x53, 0x10, 0x1F, 0xD6, 0xE0, 0x8F,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0x21, 0x3C, 0x3F, 0xAA, 0x71, 0xB9, 0x6A, 0xDB,
+	0xC2, 0x83, 0xC5, 0x73, 0x0D, 0x12, 0x83, 0x25,
+	0x4D, 0xFF, 0xF7, 0x15, 0xAA, 0x7B, 0x8B, 0xAE,
+	0xDD,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0xB8, 0xF2, 0xD1, 0x12, 0x04, 0x52, 0xB2, 0x42,
+	0xB5, 0x4F, 0x53, 0x10, 0x1F, 0xD6, 0xF9, 0x96,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0x21, 0xAC, 0x3E, 0xEE, 0x37, 0xDF, 0x6A, 0xDB,
+	0xC3, 0x14, 0x49, 0x6C, 0x48, 0xAE, 0x70, 0xE1,
+	0xC0, 0x70, 0x98, 0x7A, 0xC6, 0xC6, 0xAD, 0xC3,
+	0x52, 0xF8,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x38, 0xF2, 0xD1, 0x02, 0x04, 0x52, 0xB2, 0x42,
+	0xBC, 0x4F, 0x53, 0x10, 0x1F, 0xD6, 0xB9, 0xDE,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0x21, 0xAC, 0xAA, 0xEE, 0x66, 0x8D, 0x08, 0x4F,
+	0xEB, 0x11, 0x79, 0x78, 0x7B, 0x32, 0xB3, 0x21,
+	0x99, 0xE7, 0x0E, 0xA3, 0xC5, 0x33, 0x18, 0x14,
+	0xE5, 0xF0, 0xD1,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x38, 0xB2, 0xC9, 0x02, 0x44, 0x52, 0xB2, 0x42,
+	0x2C, 0x76, 0x53, 0x10, 0x1F, 0xD6, 0xB9, 0x5E,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0x21, 0xAE, 0xAA, 0x76, 0x65, 0x8D, 0x66, 0x4F,
+	0xEB, 0x01, 0xFA, 0x7C, 0x61, 0x21, 0x4E, 0xD3,
+	0x7D, 0x5D, 0xEE, 0x13, 0x36, 0x45, 0x1F, 0xC7,
+	0x4F, 0xBA, 0x51, 0x76,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x38, 0xB2, 0xA9, 0x12, 0x44, 0x52, 0xB2, 0x42,
+	0xEC, 0x97, 0x7A, 0x10, 0x1F, 0xD6, 0xB9, 0x5E,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0x0D, 0xBA, 0xA8, 0x76, 0xF9, 0x8F, 0x17, 0x35,
+	0xA8, 0x01, 0xCA, 0x71, 0xF7, 0x1E, 0xD3, 0x8E,
+	0xFE, 0x4E, 0x0A, 0x09, 0x19, 0x88, 0xDC, 0xF5,
+	0x4E, 0xF4, 0xCA, 0x0D, 0xA0,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x38, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x82, 0x42,
+	0xEC, 0x97, 0x6A, 0x0A, 0x1F, 0xD6, 0xB9, 0x5E,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0x8B, 0xCE, 0x88, 0x77, 0xF9, 0x2F, 0x16, 0xBD,
+	0xA8, 0x25, 0xCA, 0x31, 0xA4, 0x3A, 0x3F, 0x1A,
+	0x83, 0xF8, 0x5A, 0xDD, 0x39, 0xEE, 0xC9, 0x1C,
+	0x3C, 0x11, 0xB4, 0x6B, 0x27, 0x7C,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x38, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x82, 0x46,
+	0xEC, 0x97, 0x2A, 0x48, 0x15, 0xD6, 0xB9, 0x5E,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0x3A, 0x5C, 0xE1, 0xF3, 0xF9, 0x2F, 0xB2, 0xBD,
+	0xA8, 0x25, 0xDA, 0x31, 0xEC, 0x2A, 0xE7, 0x0E,
+	0x0D, 0x43, 0xBF, 0xC8, 0xE2, 0x25, 0xFA, 0xC6,
+	0xF7, 0x8C, 0x69, 0x49, 0x7D, 0x33, 0x70,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xEC, 0x97, 0x2A, 0xC8, 0x8F, 0xEC, 0xB9, 0x5E,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0xFF, 0x19, 0xF2,
+	0x39, 0xFC, 0x0F, 0x32, 0xF9, 0x2F, 0xB2, 0x15,
+	0xA8, 0x25, 0xDA, 0x04, 0xEC, 0x2A, 0x26, 0xA5,
+	0x43, 0x3E, 0xC5, 0xB9, 0x6D, 0xD1, 0xCE, 0xA9,
+	0xF3, 0x55, 0xD8, 0x5C, 0x39, 0x2C, 0x04, 0xC2,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xEC, 0x86, 0x2A, 0xC8, 0x8F, 0xEC, 0x99, 0x4E,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0x1F, 0x33, 0xF2,
+	0x39, 0xFC, 0x15, 0x3A, 0xB0, 0x3F, 0xC0, 0x01,
+	0x04, 0x63, 0x4B, 0xB6, 0x8C, 0xAE, 0x26, 0xA5,
+	0x30, 0x76, 0xC4, 0x99, 0x4C, 0x10, 0xEB, 0xB4,
+	0x1E, 0xDA, 0xED, 0xA2, 0xA7, 0xF3, 0x9A, 0x38,
+	0x8D,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xE9, 0x86, 0x2C, 0xC8, 0x8F, 0xEC, 0x99, 0xCE,
+	0xEC, 0xE5, 0x0B, 0xD0, 0xF7, 0x1F, 0x3A, 0xE9,
+	0x3D, 0xFC, 0x95, 0x7D, 0x76, 0x1F, 0xC0, 0x89,
+	0x04, 0xD3, 0x4A, 0x56, 0x4A, 0xA9, 0x26, 0xA5,
+	0xF3, 0x62, 0x4D, 0x2E, 0xE3, 0xF8, 0x66, 0xFA,
+	0x4B, 0x93, 0x2B, 0xA6, 0x92, 0x00, 0x21, 0xC7,
+	0xAC, 0xB1,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xC9, 0x87, 0x2C, 0xC8, 0x8F, 0xEC, 0x99, 0xCE,
+	0xE7, 0xE5, 0x0B, 0xD0, 0xF7, 0x1F, 0x7A, 0xAA,
+	0xBC, 0xF9, 0x95, 0xF5, 0xB6, 0x15, 0x09, 0x09,
+	0x04, 0xD3, 0xFE, 0x5E, 0xBA, 0x3B, 0x2B, 0x21,
+	0xF3, 0x23, 0xC3, 0xBD, 0xCE, 0xEF, 0x08, 0x40,
+	0x31, 0xDE, 0xCF, 0xA2, 0x5A, 0xD5, 0x41, 0xB5,
+	0x91, 0x42, 0xEE,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xC9, 0x07, 0x28, 0xC8, 0x89, 0xEC, 0x99, 0xCE,
+	0x76, 0xDE, 0x0B, 0xD0, 0xF7, 0x1F, 0x7A, 0x2A,
+	0xDE, 0x6D, 0x99, 0xF5, 0x76, 0x5E, 0x07, 0x09,
+	0x05, 0xD3, 0xFE, 0xE6, 0xB1, 0x5E, 0xC5, 0xA9,
+	0xF3, 0x63, 0xD6, 0x90, 0x84, 0x87, 0xB0, 0xAC,
+	0x4E, 0xE0, 0x19, 0x9F, 0xB1, 0xDC, 0x1C, 0x06,
+	0x15, 0x7F, 0xFB, 0xF1,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xC9, 0x07, 0x68, 0xC8, 0x89, 0xAC, 0x99, 0xCE,
+	0x36, 0x3D, 0x20, 0xD0, 0xF7, 0x1F, 0x7A, 0x2A,
+	0x3B, 0x68, 0x9B, 0xE4, 0x76, 0x0E, 0xFF, 0x05,
+	0x86, 0xD3, 0xFE, 0xE6, 0x0D, 0x5C, 0x3D, 0x53,
+	0xB0, 0x63, 0xE6, 0xD8, 0x17, 0xC9, 0x62, 0x23,
+	0xFE, 0xEA, 0xBE, 0xDC, 0x9D, 0x1F, 0x2A, 0xD4,
+	0x90, 0x10, 0x57, 0x2F, 0x04,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xC9, 0x07, 0x68, 0xC8, 0x87, 0xAC, 0xB1, 0xCE,
+	0x36, 0x3D, 0x30, 0xCC, 0xF7, 0x1F, 0x7A, 0x2A,
+	0x1D, 0xE8, 0xAB, 0xEC, 0x7A, 0x0E, 0xFF, 0x00,
+	0x81, 0xA4, 0xFE, 0xE6, 0x0D, 0x9C, 0xBC, 0x53,
+	0xB0, 0x43, 0xE6, 0x58, 0x75, 0x53, 0xC9, 0x79,
+	0xC2, 0xFE, 0x59, 0x18, 0xA2, 0xF2, 0x0A, 0x2A,
+	0x33, 0xA6, 0xF0, 0x10, 0xA5, 0xE2,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xC9, 0x07, 0x68, 0xC8, 0x87, 0xAC, 0xB1, 0xDE,
+	0x36, 0x3D, 0x70, 0x84, 0xFB, 0x1F, 0x7A, 0x2A,
+	0x1C, 0x69, 0xA3, 0xED, 0x78, 0x0E, 0xFF, 0x00,
+	0x91, 0xB7, 0xFC, 0xE6, 0x0D, 0x9C, 0x78, 0x53,
+	0xB0, 0x43, 0xF6, 0x58, 0x75, 0x72, 0x29, 0x60,
+	0x2C, 0x79, 0xEA, 0x90, 0x8A, 0x63, 0x8C, 0xFD,
+	0xC0, 0x6D, 0xA7, 0xBD, 0x6F, 0x44, 0x7A,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x84, 0xC1, 0x20, 0x44,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xF8, 0x07, 0x68, 0xC8, 0x87, 0xEC, 0xB9, 0xDE,
+	0x36, 0x3D, 0x70, 0x84, 0x7B, 0x23, 0x7A, 0x2A,
+	0xBC, 0x49, 0x33, 0xFC, 0x7A, 0x4E, 0xF3, 0x00,
+	0x92, 0x97, 0xD3, 0xEF, 0x45, 0x9D, 0x78, 0x9B,
+	0xB0, 0x43, 0xF6, 0x21, 0x75, 0x72, 0x0C, 0xDF,
+	0x51, 0x1B, 0xAB, 0x21, 0x73, 0xEA, 0x4E, 0xA4,
+	0x19, 0xDF, 0xAB, 0x26, 0xFC, 0xC5, 0x8B, 0xB1,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x04, 0x25, 0x0C, 0x44,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xF8, 0x07, 0x68, 0xC8, 0x87, 0xEC, 0xB9, 0xDE,
+	0x36, 0x6D, 0x70, 0x84, 0x7B, 0x23, 0x1A, 0x2A,
+	0xBA, 0xC9, 0x13, 0xEF, 0x7E, 0x0D, 0x3F, 0xAE,
+	0x92, 0x37, 0xDB, 0xCA, 0xD4, 0xB8, 0x5A, 0x89,
+	0x7C, 0x41, 0xD7, 0x8A, 0x4E, 0x76, 0x0C, 0xDF,
+	0x59, 0x8B, 0x2A, 0x8C, 0xCB, 0xE7, 0x6F, 0x69,
+	0x8A, 0x21, 0x29, 0x45, 0xF1, 0x5D, 0x29, 0x80,
+	0xC5,
+
+	0x7C, 0xE0, 0xDC, 0x1A, 0x04, 0x25, 0x00, 0x59,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xF8, 0x07, 0x68, 0xC8, 0x87, 0xEC, 0xB9, 0xDE,
+	0x36, 0x6D, 0x72, 0x84, 0x7B, 0x23, 0x1A, 0xAA,
+	0x1B, 0xDA, 0x13, 0xEB, 0x1A, 0x0D, 0x4B, 0x7E,
+	0x90, 0x37, 0x5B, 0x8F, 0x57, 0x3A, 0xDB, 0x81,
+	0x7C, 0x91, 0xD6, 0xCA, 0x29, 0x45, 0x0C, 0xDF,
+	0x19, 0x4C, 0x2B, 0x27, 0xD6, 0x23, 0xC4, 0x03,
+	0xCA, 0x33, 0xC4, 0xA0, 0x88, 0xE3, 0x01, 0x51,
+	0xD9, 0x29,
+
+	0x71, 0xE0, 0xDC, 0x1A, 0x04, 0x25, 0x00, 0x1D,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xF8, 0x07, 0x68, 0xC8, 0x87, 0xEC, 0xB9, 0xDE,
+	0x16, 0x6B, 0x72, 0x9E, 0x7B, 0x23, 0x1A, 0xAA,
+	0xCF, 0x92, 0x13, 0xAB, 0x1B, 0x98, 0x4B, 0xEB,
+	0xB0, 0x33, 0x5B, 0x8F, 0x15, 0x3B, 0xFB, 0x82,
+	0x7C, 0x91, 0x02, 0x8A, 0x78, 0x16, 0x09, 0xDF,
+	0x39, 0x0E, 0x58, 0x65, 0xDC, 0x33, 0x05, 0x75,
+	0x5D, 0x37, 0xEA, 0x2D, 0x27, 0x30, 0x06, 0x7E,
+	0xC2, 0x28, 0x81,
+
+	0xEC, 0xDD, 0xDC, 0x1A, 0x04, 0x25, 0x00, 0x1D,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xF8, 0x07, 0x68, 0xC8, 0x87, 0xEC, 0xB9, 0xDE,
+	0x16, 0xAB, 0x74, 0x9E, 0x79, 0x23, 0x1A, 0xAA,
+	0x29, 0x4A, 0xB8, 0x2C, 0x1A, 0xA1, 0x39, 0xEB,
+	0x37, 0x37, 0x44, 0x8F, 0x65, 0x70, 0x3D, 0x22,
+	0xBC, 0x90, 0x02, 0x52, 0x1F, 0x72, 0x46, 0x50,
+	0x39, 0xBE, 0x19, 0x0F, 0x1C, 0x05, 0xC5, 0xFD,
+	0xC0, 0x11, 0xC8, 0x86, 0xF9, 0x00, 0xA2, 0x30,
+	0xBB, 0xF7, 0x5C, 0x47,
+
+	0xAC, 0x1D, 0xF1, 0x1A, 0x04, 0x25, 0x00, 0x1D,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xF8, 0x07, 0x68, 0xC8, 0x87, 0xEC, 0xB9, 0xDE,
+	0x16, 0xAB, 0x34, 0x8C, 0x79, 0x63, 0x1A, 0xAA,
+	0x2C, 0x51, 0x66, 0x76, 0x0F, 0x61, 0x3C, 0xD4,
+	0x82, 0x37, 0xD4, 0x89, 0x65, 0x10, 0x27, 0xA9,
+	0xE1, 0x15, 0x02, 0x52, 0xC3, 0x32, 0x17, 0x23,
+	0x79, 0xBE, 0xB9, 0x6F, 0x9F, 0xE6, 0x27, 0x5B,
+	0xD6, 0x6A, 0x4A, 0x1D, 0xD9, 0x25, 0xC4, 0xBD,
+	0xEC, 0xA5, 0x59, 0x68, 0xFE,
+
+	0xAC, 0x1D, 0xE1, 0x04, 0x04, 0x25, 0x00, 0x1D,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xF8, 0x07, 0x68, 0xC8, 0x87, 0xEC, 0xB9, 0xDE,
+	0x16, 0xAB, 0x34, 0x8C, 0x7F, 0x63, 0x2A, 0xAA,
+	0x9C, 0x51, 0x3E, 0x97, 0x6E, 0x62, 0x3D, 0xD8,
+	0x87, 0xB7, 0xD3, 0x90, 0x60, 0x10, 0xA7, 0x2F,
+	0x26, 0x9A, 0x02, 0x53, 0xC3, 0xD2, 0x56, 0x83,
+	0x79, 0xB2, 0xB9, 0x6F, 0x9D, 0xAE, 0xA6, 0x14,
+	0x35, 0xC9, 0x8B, 0x33, 0xDE, 0xF0, 0x61, 0xD4,
+	0xC2, 0xA3, 0x90, 0x69, 0x50, 0x59,
+
+	0xAC, 0x1D, 0xE1, 0x4E, 0x0A, 0x25, 0x00, 0x1D,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xF8, 0x07, 0x68, 0xC8, 0x87, 0xEC, 0xB9, 0xDE,
+	0x16, 0xAB, 0x34, 0x8C, 0x7F, 0x63, 0x2A, 0xAA,
+	0x90, 0xA8, 0x3E, 0x86, 0x8A, 0x6A, 0x38, 0x98,
+	0x06, 0x36, 0x93, 0x91, 0x50, 0x12, 0xA7, 0x37,
+	0x96, 0x09, 0x09, 0x53, 0xC3, 0xD2, 0xB2, 0x81,
+	0x79, 0xB2, 0xB1, 0x6F, 0x15, 0x8E, 0x60, 0x3C,
+	0x9A, 0xB4, 0xB0, 0x29, 0x9B, 0x0E, 0xD3, 0xF3,
+	0x12, 0x5E, 0x2A, 0xEC, 0x54, 0xAC, 0x2A,
+
+	0xAC, 0x1D, 0xE1, 0xCE, 0x94, 0x1B, 0x00, 0x1D,
+	0x68, 0xB2, 0xA9, 0x12, 0x4C, 0x52, 0x93, 0x46,
+	0xF8, 0x07, 0x68, 0xC8, 0x87, 0xEC, 0xB9, 0xDE,
+	0x26, 0xAB, 0x34, 0x8C, 0x7F, 0x23, 0x38, 0xAA,
+	0x10, 0x90, 0x0C, 0x84, 0xEA, 0x82, 0x7B, 0x15,
+	0x06, 0x36, 0x76, 0x81, 0x17, 0x68, 0xAC, 0x37,
+	0xD5, 0xA9, 0xE6, 0x16, 0xC2, 0xD0, 0xB2, 0x69,
+	0x79, 0xB2, 0xB1, 0x03, 0x15, 0x4E, 0x66, 0xEF,
+	0x16, 0x21, 0xAF, 0x14, 0x3F, 0x08, 0x14, 0x02,
+	0x4C, 0x04, 0x5A, 0x7C, 0x06, 0x58, 0xC7, 0x75,
+
+	0x7A, 0xC1, 0xA4, 0x51, 0xA5, 0xEF, 0x20, 0xCF,
+	0x77, 0xB6, 0xA9, 0xB4, 0xB3, 0x2C, 0x2C, 0xE7,
+	0x3E, 0xD9, 0x33, 0x49, 0x53, 0xF1, 0xB1, 0xFE,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0xD5, 0xF5, 0xC1, 0xB8, 0x9D, 0x02, 0x17, 0x13,
+	0xC0, 0xD1, 0x51, 0xDB, 0x99, 0x7E, 0x60, 0x82,
+	0xCD, 0x94, 0xA3, 0x9E, 0x68, 0xD2, 0xC9, 0xC2,
+	0x95, 0x9E, 0xC9, 0xBD, 0x23, 0xDD, 0x24, 0x5D,
+	0x21, 0xAD, 0x43, 0xA7, 0x4F, 0x51, 0x52, 0xDB,
+	0x91, 0x58, 0x96, 0xE6, 0xCC, 0xC2, 0xA7, 0xE4,
+	0x7A,
+
+	0x77, 0xC1, 0x9E, 0x51, 0xA5, 0xEF, 0x20, 0xCF,
+	0x77, 0xB6, 0xA9, 0xB4, 0xB3, 0x2C, 0x3C, 0xF8,
+	0x3E, 0xD9, 0x33, 0x49, 0x53, 0xF1, 0xB1, 0xFE,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0xF5, 0xF5, 0xC1, 0xB8, 0x9D, 0x3C, 0x17, 0x93,
+	0x31, 0xD0, 0x51, 0xD2, 0xA9, 0x7E, 0x10, 0x72,
+	0xC3, 0x94, 0xA3, 0x1F, 0x6D, 0x33, 0xDD, 0x4A,
+	0x95, 0x6E, 0xD0, 0x79, 0xE4, 0xFE, 0x14, 0x5C,
+	0x0A, 0x73, 0x00, 0x4A, 0x0A, 0x70, 0x39, 0x6B,
+	0xF8, 0x58, 0x05, 0x2F, 0xD9, 0xBD, 0xC9, 0xAC,
+	0x77, 0xEE,
+
+	0x77, 0xC1, 0x1E, 0x57, 0xA5, 0xEF, 0x20, 0xCF,
+	0x78, 0xB6, 0xA9, 0xB4, 0xB3, 0x2C, 0x3C, 0xB8,
+	0x3E, 0xD9, 0x33, 0x49, 0x53, 0xF1, 0xB1, 0xFE,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x7D, 0xF3, 0xC1, 0xB8, 0x9D, 0x3C, 0x15, 0x93,
+	0xC5, 0xD0, 0x51, 0x92, 0xA7, 0x43, 0x10, 0x7B,
+	0x92, 0x93, 0xA3, 0xCF, 0x7C, 0x33, 0xB5, 0xC3,
+	0x95, 0x6E, 0x24, 0x5B, 0x34, 0xAD, 0x2F, 0x5C,
+	0xEA, 0xE8, 0xA7, 0xEC, 0xA0, 0x5A, 0x46, 0x4D,
+	0xAA, 0x4C, 0x00, 0x91, 0x68, 0xD9, 0xE2, 0x10,
+	0x8C, 0x3F, 0x69,
+
+	0x77, 0x01, 0x05, 0x57, 0xBB, 0xEF, 0x20, 0xCF,
+	0xCA, 0x89, 0xA9, 0xB4, 0xB3, 0x2C, 0x3C, 0xB8,
+	0x3E, 0xD9, 0x33, 0x49, 0x53, 0xF1, 0xB1, 0xFE,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x7D, 0x53, 0x5C, 0xB8, 0x9D, 0x3C, 0x15, 0xDE,
+	0xAB, 0x2A, 0xF2, 0x9F, 0xA5, 0x57, 0xDE, 0x7A,
+	0x82, 0x1A, 0xB9, 0xCF, 0x5C, 0x1B, 0x12, 0xA7,
+	0x85, 0x6E, 0x24, 0xA3, 0x27, 0x49, 0xC0, 0x30,
+	0x1E, 0xF8, 0x41, 0xB9, 0xFE, 0xE0, 0x11, 0xB5,
+	0x65, 0x7D, 0x76, 0xDA, 0x81, 0x6C, 0xCA, 0xC5,
+	0xCF, 0xBC, 0xBB, 0x09,
+
+	0x77, 0x01, 0xE5, 0x51, 0x3B, 0xEF, 0x20, 0xCF,
+	0xCA, 0x4B, 0x86, 0xB4, 0xB3, 0x2C, 0x3C, 0xB8,
+	0x3E, 0xD9, 0x33, 0x49, 0x53, 0xF1, 0xB1, 0xFE,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x2E, 0x53, 0x94, 0x3D, 0x9D, 0x3C, 0x15, 0xDE,
+	0xAB, 0x3E, 0x0C, 0x19, 0xA0, 0xD7, 0xF7, 0xC5,
+	0xE2, 0x57, 0x4B, 0xDF, 0x5C, 0xAB, 0x23, 0xC7,
+	0xD1, 0x6A, 0x26, 0xA3, 0xDB, 0x43, 0x24, 0xCB,
+	0xE5, 0x9C, 0xB1, 0xB9, 0xA3, 0x00, 0x9B, 0x0B,
+	0xCD, 0xEB, 0x3D, 0x8C, 0xD0, 0x40, 0x80, 0xA9,
+	0x7A, 0x0F, 0x5E, 0xDE, 0xEE,
+
+	0x77, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0x60, 0xCF,
+	0xCA, 0x4B, 0xA6, 0x94, 0xB3, 0x2C, 0x3C, 0xB8,
+	0x3E, 0xD9, 0x33, 0x49, 0x53, 0xF1, 0xB1, 0xFE,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x2E, 0x13, 0x94, 0x3D, 0x9D, 0x3C, 0x15, 0xDE,
+	0x2B, 0x3E, 0x8C, 0x19, 0xA2, 0xD3, 0xF7, 0xC5,
+	0xE2, 0x57, 0x4B, 0xDF, 0x5C, 0xAB, 0x23, 0xC3,
+	0xD9, 0x62, 0x26, 0xA3, 0xDB, 0x43, 0x26, 0xCA,
+	0xA4, 0x98, 0xB5, 0xB9, 0xB7, 0x5E, 0x15, 0x2C,
+	0xDB, 0xB0, 0x4A, 0xE3, 0x61, 0xBF, 0x42, 0xCF,
+	0xC5, 0x1D, 0x84, 0x25, 0x50, 0xAD,
+
+	0x77, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xCA, 0x4B, 0xA6, 0xD4, 0xA3, 0x2C, 0x3C, 0xB8,
+	0x3E, 0xD9, 0x33, 0x49, 0x53, 0xF1, 0xB1, 0xFE,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x2E, 0x13, 0x94, 0x3D, 0x95, 0x38, 0x14, 0xDE,
+	0x3B, 0x7F, 0x8C, 0x1D, 0xA6, 0xC2, 0xF6, 0xC5,
+	0xF4, 0xD7, 0x49, 0xDD, 0x5C, 0xA3, 0x23, 0xC3,
+	0xC9, 0x76, 0x22, 0xA7, 0xDB, 0x43, 0x22, 0xCB,
+	0x76, 0x90, 0x31, 0xBB, 0x53, 0x5E, 0x78, 0x94,
+	0xAF, 0xB9, 0x7F, 0x21, 0xB9, 0x1C, 0xE1, 0x19,
+	0x2B, 0x83, 0x8C, 0xD5, 0xEE, 0x9B, 0xAF,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xCA, 0x4B, 0xA6, 0xD4, 0x23, 0x2C, 0x3C, 0xB8,
+	0x3E, 0xD9, 0x33, 0x49, 0x53, 0xF1, 0xB1, 0xFE,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x2E, 0x13, 0x94, 0x3D, 0x95, 0x28, 0x14, 0xDE,
+	0x3B, 0x7F, 0x8E, 0x1D, 0xAC, 0xCA, 0xEE, 0xC5,
+	0xE4, 0xD7, 0x4D, 0xCD, 0x5C, 0xE3, 0x23, 0xC3,
+	0xC9, 0x56, 0x12, 0xA1, 0xDB, 0x43, 0x22, 0xC3,
+	0x75, 0xB4, 0x21, 0xE7, 0x43, 0x6E, 0x79, 0x97,
+	0xDA, 0xB4, 0xAA, 0xC2, 0x32, 0x26, 0x07, 0xCA,
+	0x20, 0x83, 0x73, 0x3B, 0x8C, 0x41, 0x57, 0x55,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xCA, 0x6A, 0xA6, 0xD4, 0x23, 0x2C, 0x1C, 0xB8,
+	0x3E, 0xD9, 0x33, 0x49, 0xD3, 0x31, 0x81, 0xFE,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x22, 0x14, 0xA5, 0x11, 0x19, 0x29, 0x16, 0xDE,
+	0x3B, 0x7F, 0x8E, 0x9D, 0xCC, 0xCA, 0xE6, 0xC7,
+	0xE1, 0x95, 0x7D, 0x0E, 0x5C, 0x21, 0x2F, 0xD8,
+	0xC9, 0x46, 0x12, 0xB9, 0xC3, 0x41, 0x58, 0xDA,
+	0xF5, 0xD6, 0x37, 0xCB, 0x04, 0x1C, 0x90, 0x9B,
+	0x3E, 0xF9, 0x81, 0x78, 0x66, 0x4A, 0x8F, 0xD8,
+	0x02, 0x21, 0xE7, 0x60, 0xE8, 0xFF, 0x36, 0xE3,
+	0x16,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xCA, 0x6A, 0xA6, 0xD4, 0x23, 0x2C, 0x1C, 0x38,
+	0x3E, 0xD9, 0x33, 0x49, 0xD3, 0x31, 0xA1, 0xDF,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x22, 0x04, 0xAF, 0x51, 0x51, 0x29, 0x16, 0xDE,
+	0x37, 0x7E, 0x8E, 0x9D, 0xCC, 0xC8, 0xE6, 0xC7,
+	0x83, 0x93, 0x7D, 0x0F, 0xD8, 0x25, 0xAB, 0xCA,
+	0xC9, 0x46, 0x12, 0xB1, 0xCB, 0x41, 0x5E, 0xCA,
+	0x94, 0x5C, 0x67, 0xF8, 0xD2, 0x08, 0xC4, 0x95,
+	0x25, 0x52, 0x89, 0xF5, 0x41, 0x40, 0x0C, 0x24,
+	0x4B, 0x71, 0xE3, 0x69, 0x26, 0xAC, 0x82, 0xEC,
+	0xD9, 0x90,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEA, 0x6A, 0xA6, 0xF4, 0x23, 0x2C, 0x1C, 0x38,
+	0x2F, 0xD9, 0x33, 0x49, 0xD3, 0x31, 0xE1, 0x8E,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x22, 0x04, 0xBB, 0xD0, 0x01, 0x5D, 0x52, 0xDA,
+	0x17, 0x7E, 0x8E, 0x9D, 0xCC, 0x48, 0xC6, 0xC7,
+	0x96, 0x82, 0x7C, 0x4F, 0xD8, 0x68, 0xA9, 0x8E,
+	0xC9, 0x4E, 0x12, 0x81, 0xDB, 0x65, 0x56, 0xC8,
+	0xCC, 0x0D, 0x75, 0x54, 0x58, 0x5D, 0xB3, 0xC7,
+	0x35, 0x7D, 0x49, 0xFA, 0x0E, 0x5B, 0x65, 0xAF,
+	0x27, 0x8D, 0x94, 0x21, 0xFE, 0xC7, 0xB0, 0xB5,
+	0x9D, 0xEA, 0x0F,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEA, 0xEA, 0xA6, 0xF4, 0x23, 0x2C, 0x1C, 0x38,
+	0xAF, 0xD8, 0x33, 0x49, 0xD3, 0x31, 0xE1, 0x8E,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x22, 0x04, 0xBB, 0xC8, 0x01, 0x3D, 0x22, 0xDE,
+	0x17, 0x4E, 0x9A, 0x9D, 0xCC, 0x48, 0xC6, 0xC5,
+	0x9A, 0x9A, 0x54, 0x4F, 0xD8, 0x68, 0xAF, 0x8E,
+	0x49, 0xCA, 0x12, 0x81, 0xCB, 0x45, 0x66, 0xE8,
+	0xA1, 0x85, 0x24, 0x50, 0x9F, 0xCF, 0xD7, 0xA9,
+	0x77, 0xAC, 0xEF, 0x02, 0xAB, 0xC8, 0x61, 0x1F,
+	0x47, 0x0E, 0xB8, 0xE9, 0x95, 0x93, 0x39, 0x13,
+	0x91, 0x55, 0x3F, 0x86,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEA, 0xEA, 0xA6, 0xFC, 0xA3, 0x6F, 0x1C, 0x38,
+	0x6F, 0x38, 0x02, 0x49, 0xD3, 0x31, 0xE1, 0x8E,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x6E, 0x09, 0xBB, 0xC8, 0x1D, 0x3A, 0xD0, 0xBA,
+	0x34, 0x4E, 0x9A, 0x99, 0xCC, 0x48, 0xC6, 0xC5,
+	0x9C, 0x99, 0x48, 0x14, 0xDD, 0x2A, 0x8E, 0x29,
+	0x69, 0xCC, 0x52, 0x91, 0xCB, 0x5D, 0x24, 0x64,
+	0x0F, 0xE9, 0x69, 0x7C, 0xBA, 0x71, 0xCA, 0xC3,
+	0xEB, 0x7D, 0x7D, 0xB0, 0xA0, 0x61, 0xF1, 0x51,
+	0x90, 0xC1, 0x11, 0x78, 0xA0, 0xAA, 0x39, 0xD3,
+	0x6B, 0xFF, 0x73, 0xE0, 0x9F,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEA, 0xEA, 0xA6, 0xFC, 0xA3, 0x6F, 0x18, 0x38,
+	0x6F, 0x38, 0x20, 0x6B, 0xD3, 0x31, 0xE1, 0x8E,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0xE6, 0x91, 0x9B, 0xC8, 0x1D, 0x1A, 0xC2, 0xBA,
+	0x34, 0x0A, 0x9A, 0xD9, 0xC8, 0x4A, 0xC6, 0xC5,
+	0x54, 0x99, 0x40, 0x34, 0x9F, 0x2E, 0x8C, 0x0B,
+	0x69, 0xCC, 0x52, 0x91, 0xDB, 0x5D, 0x24, 0xEC,
+	0x29, 0x72, 0x48, 0xCC, 0xB6, 0xDB, 0xDC, 0x89,
+	0xAF, 0x7C, 0x31, 0x30, 0x21, 0xA9, 0x8A, 0x47,
+	0x9A, 0x2D, 0x9C, 0xAD, 0x48, 0xCD, 0x60, 0x1F,
+	0xA9, 0x50, 0xCE, 0x53, 0xA4, 0x42,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEA, 0xEA, 0xA6, 0xFC, 0x83, 0x6E, 0x18, 0x1C,
+	0x6F, 0x38, 0x60, 0x39, 0xC1, 0x31, 0xE1, 0x8E,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x76, 0x45, 0x94, 0xC8, 0x1D, 0x1A, 0xE6, 0x29,
+	0x34, 0x8A, 0xBE, 0xD9, 0xC8, 0xCA, 0xC6, 0xC5,
+	0x56, 0xD9, 0x40, 0x70, 0xBB, 0x73, 0xAC, 0x4B,
+	0xE9, 0x00, 0x9A, 0x91, 0xCB, 0x54, 0x24, 0x2C,
+	0x6B, 0x09, 0xB5, 0xCC, 0xD0, 0x5F, 0xA4, 0x91,
+	0xE3, 0x14, 0xE7, 0x7A, 0xF1, 0xB4, 0xBA, 0x44,
+	0x11, 0xA9, 0x17, 0x47, 0x95, 0xF7, 0x32, 0xF9,
+	0x4F, 0x15, 0x09, 0x97, 0xC9, 0x89, 0x75,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x6F, 0x38, 0x60, 0x39, 0x43, 0x33, 0xE1, 0x8E,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x87, 0x38, 0xB8,
+	0x76, 0xEF, 0x34, 0xC8, 0x1D, 0x1A, 0xE6, 0x01,
+	0x34, 0x8A, 0xBE, 0xDC, 0xC8, 0x8A, 0xD6, 0xC5,
+	0x56, 0xDB, 0x4A, 0x70, 0xB3, 0x5B, 0xDC, 0x63,
+	0x79, 0x80, 0x9A, 0xD1, 0xCB, 0x14, 0x25, 0x2C,
+	0x2B, 0xD9, 0x55, 0xA7, 0x7C, 0x47, 0x86, 0xA1,
+	0x68, 0x17, 0xB7, 0x3A, 0x79, 0x74, 0x3F, 0x0D,
+	0x95, 0x08, 0x1A, 0x1C, 0xDD, 0xC9, 0xD1, 0x44,
+	0x5A, 0x7A, 0xEA, 0x25, 0x33, 0x53, 0xF3, 0x2F,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x6F, 0x78, 0x60, 0x39, 0x43, 0x33, 0x81, 0x9E,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x65, 0x0A, 0xB8,
+	0x76, 0x8F, 0x18, 0xC8, 0x15, 0x3D, 0xCD, 0x19,
+	0x18, 0x09, 0x07, 0x60, 0x98, 0xAA, 0xD7, 0xC5,
+	0x57, 0xDB, 0x4A, 0xF0, 0x92, 0x5B, 0xD4, 0x65,
+	0x79, 0xC0, 0x9A, 0x0A, 0xCB, 0x36, 0x0B, 0x47,
+	0x0D, 0x68, 0x23, 0x17, 0x66, 0xC2, 0x8E, 0x41,
+	0x34, 0x37, 0xF0, 0xD1, 0x7A, 0x72, 0xF7, 0xE5,
+	0x6E, 0x4C, 0x33, 0xC6, 0x57, 0x79, 0x91, 0x40,
+	0x19, 0x2F, 0x6F, 0x33, 0xD6, 0x36, 0x1B, 0xEF,
+	0xD9,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x7E, 0x78, 0x26, 0x39, 0x43, 0x33, 0x81, 0x9E,
+	0x0E, 0xB6, 0xEE, 0x74, 0x25, 0x65, 0x0A, 0x9B,
+	0x77, 0x8F, 0x58, 0xC4, 0x5D, 0x7D, 0x4F, 0x09,
+	0x18, 0x39, 0x15, 0x21, 0x50, 0x26, 0xE7, 0xC5,
+	0x53, 0xD9, 0x4A, 0xF0, 0x92, 0x1B, 0xD4, 0x05,
+	0xAB, 0xCA, 0x9A, 0x0B, 0x47, 0x36, 0x0B, 0x77,
+	0xC9, 0x12, 0x43, 0xD7, 0xE4, 0xB2, 0xCD, 0x1B,
+	0xB3, 0x89, 0x01, 0xB1, 0x6C, 0x74, 0x37, 0x5C,
+	0x50, 0x9B, 0xCE, 0x04, 0x3A, 0xAA, 0x61, 0x33,
+	0x2F, 0x1C, 0xA5, 0xAE, 0xB7, 0xA7, 0x57, 0x8D,
+	0x00, 0x19,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5E, 0x70, 0x26, 0x19, 0x43, 0x33, 0x81, 0x9E,
+	0x1D, 0xB6, 0xEE, 0x74, 0x25, 0x65, 0x4A, 0xC8,
+	0xDF, 0x86, 0x58, 0xC4, 0x5D, 0xF5, 0x45, 0x2B,
+	0x18, 0x39, 0x21, 0x20, 0x89, 0xF2, 0xAF, 0xC5,
+	0x33, 0xD1, 0x4A, 0xF0, 0x92, 0x1B, 0xD6, 0x05,
+	0x9F, 0xD7, 0x98, 0x4A, 0x57, 0x7B, 0x0B, 0x6F,
+	0x18, 0x52, 0x7F, 0xE7, 0xA8, 0xC9, 0xF1, 0x14,
+	0xF3, 0xDF, 0x05, 0xBE, 0x7E, 0xF9, 0xBA, 0x83,
+	0x50, 0x2A, 0x0F, 0x30, 0xCE, 0x6F, 0x80, 0x89,
+	0x66, 0x9C, 0x91, 0x2C, 0x5A, 0x90, 0xC5, 0x8B,
+	0x54, 0x6C, 0x33,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5E, 0xB0, 0x27, 0x19, 0x47, 0x33, 0x81, 0x9E,
+	0x9E, 0xB5, 0xEE, 0x74, 0x25, 0x65, 0x4A, 0x48,
+	0x9F, 0x56, 0x59, 0xC4, 0x5D, 0x15, 0x05, 0x4B,
+	0x18, 0x39, 0x21, 0x18, 0x89, 0x12, 0x4F, 0xC9,
+	0x33, 0x91, 0x66, 0xF0, 0x92, 0x1B, 0xD6, 0x03,
+	0x97, 0xEF, 0x98, 0x4A, 0xD7, 0x7A, 0x05, 0x6F,
+	0x1A, 0x12, 0xFF, 0x96, 0xAC, 0x06, 0x51, 0x1F,
+	0x81, 0xDD, 0xAC, 0xA8, 0x72, 0xC9, 0x18, 0x0C,
+	0x39, 0xE8, 0xA1, 0xF4, 0x0D, 0x07, 0xA9, 0xAB,
+	0x9A, 0x10, 0x13, 0x88, 0x22, 0x81, 0xBA, 0x12,
+	0xD8, 0xDE, 0xC4, 0xBC,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5E, 0xB0, 0x47, 0x01, 0x47, 0x76, 0x81, 0x9E,
+	0x1E, 0x55, 0xDD, 0x74, 0x25, 0x65, 0x4A, 0x48,
+	0x8C, 0x32, 0x59, 0xCC, 0x5D, 0xCD, 0xB5, 0x49,
+	0x08, 0x38, 0x20, 0x18, 0xB5, 0x91, 0xB6, 0x35,
+	0x74, 0x91, 0x6E, 0x62, 0x92, 0x1B, 0xD6, 0x83,
+	0x97, 0xED, 0xA4, 0x71, 0xC9, 0xFB, 0x07, 0xB8,
+	0x52, 0x87, 0xEE, 0xBE, 0x77, 0x66, 0x83, 0x88,
+	0x6C, 0xB1, 0x30, 0x82, 0x6D, 0xB9, 0x01, 0x80,
+	0xD7, 0xE1, 0x85, 0xA8, 0x66, 0x0A, 0x03, 0x68,
+	0x4D, 0xFE, 0x00, 0x7A, 0xA8, 0xD3, 0xB2, 0x4E,
+	0xE5, 0x90, 0xD3, 0xC5, 0x94,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5E, 0xB0, 0x47, 0x01, 0x47, 0x76, 0xC1, 0x9E,
+	0x1E, 0x55, 0xD9, 0x50, 0x25, 0x65, 0x4A, 0x48,
+	0x8D, 0xB2, 0x59, 0xCC, 0x5F, 0xCD, 0xB5, 0x41,
+	0x01, 0x31, 0x21, 0x1A, 0xB5, 0xD1, 0xB4, 0x35,
+	0x74, 0x99, 0x6E, 0x62, 0xB2, 0x1B, 0xD6, 0x83,
+	0x87, 0xED, 0xE4, 0x31, 0x4B, 0xFF, 0x05, 0x9C,
+	0x66, 0x97, 0x67, 0x7A, 0x26, 0x10, 0x91, 0xF0,
+	0xF8, 0x30, 0x39, 0xCE, 0x43, 0xB8, 0x43, 0x84,
+	0x55, 0x45, 0x9D, 0x29, 0x6A, 0xA2, 0x38, 0xF2,
+	0xC5, 0x79, 0x5C, 0x54, 0x99, 0xFB, 0x18, 0x7F,
+	0xC3, 0x3B, 0xE3, 0x6D, 0x8D, 0x08,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5E, 0xB0, 0x47, 0x01, 0x67, 0x76, 0xC1, 0xBE,
+	0x1E, 0x55, 0xD9, 0x00, 0x31, 0x65, 0x4A, 0x48,
+	0x9E, 0xB3, 0x59, 0xE6, 0x5F, 0xC5, 0xB5, 0xC9,
+	0x11, 0xA4, 0xD4, 0x1A, 0xB4, 0xD1, 0xF0, 0x34,
+	0x74, 0x19, 0x46, 0x62, 0xBA, 0x19, 0xD6, 0x83,
+	0x87, 0x3C, 0xE4, 0x61, 0x0F, 0x7E, 0x06, 0x9C,
+	0x14, 0x2D, 0x88, 0xFA, 0x11, 0x3A, 0x29, 0x52,
+	0x78, 0x62, 0x48, 0x5B, 0x82, 0xE9, 0x0B, 0x32,
+	0x6D, 0x2D, 0xBD, 0xAB, 0x72, 0x91, 0x26, 0x19,
+	0x16, 0x22, 0x3D, 0x65, 0xCE, 0x1A, 0x83, 0x60,
+	0x06, 0xDE, 0x04, 0x9B, 0x3F, 0xC9, 0xC1,
+
+	0x76, 0x01, 0xE5, 0x51, 0x2B, 0xEF, 0xE0, 0xCF,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5F, 0xB0, 0x47, 0x01, 0x67, 0x36, 0xC1, 0xBE,
+	0x1E, 0x55, 0xD9, 0x00, 0xB5, 0x61, 0x4A, 0x48,
+	0xBE, 0xB2, 0x79, 0x76, 0x57, 0x87, 0xB5, 0xC9,
+	0x31, 0x84, 0xE5, 0x09, 0xBC, 0xD1, 0xF0, 0x7C,
+	0x74, 0x19, 0x46, 0x62, 0xBA, 0x89, 0xD6, 0x83,
+	0x07, 0x38, 0xF6, 0x61, 0x0F, 0x36, 0x56, 0x94,
+	0x44, 0xA2, 0x86, 0xD7, 0x39, 0x3B, 0x20, 0x72,
+	0x38, 0xF2, 0x6A, 0x5B, 0xE4, 0x44, 0x1F, 0xA0,
+	0x46, 0x29, 0x49, 0xDD, 0x73, 0xA1, 0x2D, 0x45,
+	0xDD, 0x54, 0xB5, 0xBE, 0x6E, 0xF3, 0xCC, 0xB7,
+	0xD8, 0x0B, 0x62, 0x6E, 0x3A, 0x60, 0x1D, 0x53,
+
+	0x76, 0x01, 0xE5, 0x51, 0xEB, 0x2F, 0xD4, 0xCF,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5F, 0xB0, 0x47, 0x01, 0x67, 0x36, 0xC1, 0xBE,
+	0x1E, 0x1C, 0xD9, 0x00, 0xB5, 0x61, 0x4A, 0x48,
+	0xBB, 0xB2, 0x7D, 0xB5, 0x57, 0x5D, 0xF9, 0x46,
+	0x31, 0x14, 0xE8, 0x20, 0xB0, 0xF7, 0xF8, 0x66,
+	0x38, 0x18, 0x74, 0x4F, 0xE6, 0x8D, 0xD6, 0x83,
+	0x07, 0x38, 0xF6, 0x61, 0x06, 0x36, 0xC6, 0x3A,
+	0x54, 0x2E, 0xDB, 0xC4, 0xCA, 0xA6, 0x87, 0x3E,
+	0x8C, 0xC4, 0x8B, 0x99, 0x2A, 0x38, 0xB2, 0x04,
+	0x42, 0x6B, 0xD0, 0xDD, 0xB9, 0xCA, 0xE8, 0x48,
+	0x8F, 0xC6, 0xA2, 0xD6, 0x54, 0x52, 0x25, 0x4D,
+	0x30, 0xB0, 0x12, 0x85, 0x1C, 0xE9, 0xB0, 0x07,
+	0xC2,
+
+	0x76, 0x01, 0xE5, 0x51, 0xEB, 0x2F, 0xF0, 0xEA,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5F, 0xB0, 0x47, 0x01, 0x67, 0x36, 0xC1, 0xBE,
+	0x1E, 0x1C, 0x99, 0x00, 0xB5, 0x61, 0x4A, 0x48,
+	0x19, 0xA6, 0x7D, 0x90, 0x43, 0x59, 0x79, 0x16,
+	0x22, 0x14, 0x28, 0x24, 0x99, 0x56, 0xF4, 0xE4,
+	0x38, 0x48, 0x56, 0x0F, 0xAF, 0x94, 0xC6, 0x83,
+	0x03, 0x38, 0xF6, 0x61, 0x06, 0x74, 0xC6, 0xBA,
+	0x1D, 0x2B, 0x09, 0x0B, 0x20, 0x64, 0xC1, 0x93,
+	0x88, 0x33, 0x9E, 0x40, 0x48, 0x3A, 0x32, 0xA4,
+	0x52, 0xC2, 0x49, 0x78, 0x88, 0x47, 0x00, 0x17,
+	0xE2, 0xF9, 0xB2, 0x05, 0x6A, 0x00, 0x6D, 0xB1,
+	0x43, 0xB5, 0x57, 0x08, 0xFB, 0x24, 0x60, 0x71,
+	0x45, 0xE0,
+
+	0x63, 0x01, 0xE5, 0x51, 0xEB, 0x2F, 0xF0, 0xBA,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5F, 0xB0, 0x47, 0x01, 0x67, 0x36, 0xC1, 0xBE,
+	0x1E, 0x16, 0x19, 0x22, 0xB5, 0x61, 0x4A, 0x48,
+	0x4D, 0x43, 0x7D, 0xD0, 0x43, 0x9C, 0x79, 0x02,
+	0x82, 0x1C, 0x28, 0x14, 0xDC, 0x77, 0xFC, 0xE4,
+	0x38, 0x48, 0x02, 0x0C, 0xFF, 0xC1, 0xB0, 0x93,
+	0xAB, 0x9E, 0xF6, 0x61, 0x06, 0xF4, 0xCC, 0xBA,
+	0x51, 0xD4, 0x2A, 0x5F, 0x73, 0x35, 0xDA, 0x8B,
+	0x45, 0x99, 0x97, 0x2D, 0x2A, 0x83, 0xE3, 0x6E,
+	0xBF, 0x03, 0x89, 0x95, 0x0E, 0x25, 0x62, 0x49,
+	0xE0, 0xD8, 0x4F, 0x22, 0xEF, 0x41, 0x4A, 0x3E,
+	0x0F, 0x26, 0xDD, 0x56, 0xDD, 0x96, 0xF4, 0x0C,
+	0x78, 0x04, 0x5E,
+
+	0xE6, 0x04, 0xE5, 0x51, 0xEB, 0x2F, 0xF0, 0x3A,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5F, 0xB0, 0x47, 0x01, 0x67, 0x36, 0xC1, 0xBE,
+	0x1E, 0x56, 0x19, 0x22, 0xB6, 0x61, 0x4A, 0x48,
+	0x15, 0x1B, 0x95, 0xD0, 0xC3, 0x99, 0x4F, 0x02,
+	0x82, 0xBC, 0x2A, 0x14, 0xFC, 0x36, 0xD8, 0x54,
+	0x38, 0x48, 0x02, 0x54, 0xF7, 0xA1, 0x51, 0x96,
+	0xAB, 0x0E, 0xDE, 0x61, 0x06, 0xF4, 0xCC, 0xB2,
+	0x72, 0x8B, 0x38, 0xD7, 0x09, 0x26, 0x0E, 0x4A,
+	0xDE, 0x88, 0xD4, 0x3A, 0xBC, 0xA8, 0x21, 0x7D,
+	0x5F, 0x9A, 0x94, 0xD5, 0x6E, 0x99, 0x35, 0x7D,
+	0xF8, 0x19, 0x42, 0x62, 0x35, 0x3C, 0x16, 0x4B,
+	0x50, 0xC2, 0x2A, 0x37, 0x40, 0xA1, 0xB4, 0x92,
+	0x66, 0xD3, 0x61, 0x6D,
+
+	0x26, 0xC4, 0xD0, 0x51, 0xEB, 0x2F, 0xF0, 0x3A,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5F, 0xB0, 0x47, 0x01, 0x67, 0x36, 0xC1, 0xBE,
+	0x1E, 0x56, 0xD9, 0x20, 0xB6, 0x41, 0x4A, 0x48,
+	0x15, 0x4E, 0xC9, 0x93, 0xC9, 0x59, 0x7E, 0x41,
+	0x93, 0xDC, 0xAA, 0x14, 0xFC, 0xB6, 0xB7, 0x51,
+	0x3D, 0x50, 0x00, 0x54, 0xAB, 0xA0, 0x31, 0x6B,
+	0xC3, 0x0E, 0x66, 0x43, 0x06, 0xF4, 0xCC, 0x32,
+	0x98, 0xEB, 0x10, 0x8E, 0x29, 0xA2, 0x3A, 0xF6,
+	0x4E, 0xA5, 0x40, 0xD3, 0x07, 0x0B, 0x2A, 0x29,
+	0x7D, 0xFD, 0x3F, 0xCF, 0x9B, 0x15, 0x85, 0x51,
+	0xDE, 0x0B, 0x26, 0x18, 0xA3, 0x0F, 0x06, 0x43,
+	0x66, 0xEF, 0x4B, 0x7F, 0xFB, 0x5A, 0x8A, 0x1F,
+	0xCA, 0x0D, 0x81, 0x79, 0xE4,
+
+	0x26, 0xC4, 0xD0, 0x77, 0xEB, 0x2F, 0xF0, 0x3A,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5F, 0xB0, 0x47, 0x01, 0x67, 0x36, 0xC1, 0xBE,
+	0x1E, 0x56, 0xD9, 0x20, 0xB5, 0x41, 0x46, 0x48,
+	0x4D, 0x4E, 0x41, 0xF1, 0x6B, 0x16, 0x7E, 0x41,
+	0x9A, 0x1D, 0xEE, 0x37, 0xFC, 0xB6, 0xB7, 0xD5,
+	0xB4, 0xE1, 0x00, 0x54, 0xAB, 0xC0, 0x33, 0xFB,
+	0xC3, 0x0A, 0x66, 0x43, 0x02, 0xF4, 0xCC, 0x32,
+	0x6C, 0x68, 0x28, 0xBB, 0x60, 0xEA, 0x4B, 0x91,
+	0xFA, 0x0B, 0x5E, 0x25, 0x45, 0x1F, 0x38, 0x51,
+	0x81, 0xBC, 0x85, 0x02, 0xD1, 0x6C, 0x93, 0xC5,
+	0x05, 0x0F, 0xBF, 0x18, 0xF8, 0xBA, 0x19, 0xB5,
+	0x69, 0x27, 0x84, 0x7F, 0x4B, 0x07, 0x74, 0x69,
+	0x85, 0x92, 0xD8, 0xA7, 0x9E, 0x23,
+
+	0x26, 0xC4, 0x90, 0x33, 0xFD, 0x2F, 0xF0, 0x3A,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5F, 0xB0, 0x47, 0x01, 0x67, 0x36, 0xC1, 0xBE,
+	0x1E, 0x56, 0xD9, 0x20, 0xB5, 0x41, 0xC6, 0x68,
+	0x5F, 0x17, 0x41, 0xE0, 0x0F, 0x77, 0x7E, 0x41,
+	0x1F, 0x3D, 0xAE, 0x31, 0xDC, 0xB7, 0xB7, 0x4D,
+	0x64, 0x34, 0x0C, 0x54, 0xAB, 0xC0, 0x57, 0x7A,
+	0xC3, 0x8A, 0x4E, 0x43, 0x82, 0x06, 0xCD, 0x32,
+	0xE6, 0x44, 0x9C, 0x73, 0xBF, 0xE6, 0x5F, 0x95,
+	0xF3, 0x3E, 0xF1, 0x1C, 0xEF, 0x5F, 0xAD, 0x31,
+	0xAC, 0xA5, 0x49, 0xC3, 0x24, 0x72, 0xC5, 0xE0,
+	0x01, 0xC6, 0xE5, 0x5C, 0x28, 0x4E, 0x0E, 0x1E,
+	0xCE, 0x72, 0xAE, 0x37, 0x43, 0xAA, 0xB9, 0xCB,
+	0x45, 0x6D, 0x47, 0x15, 0x58, 0x8A, 0xC4,
+
+	0x26, 0xC4, 0x90, 0x33, 0x79, 0x29, 0xF0, 0x3A,
+	0xEF, 0xEA, 0xA6, 0xFC, 0x83, 0x2E, 0x19, 0x1C,
+	0x5F, 0xB0, 0x47, 0x01, 0x67, 0x36, 0xC1, 0xBE,
+	0x1B, 0x56, 0xD9, 0x20, 0xB5, 0x41, 0xC5, 0x68,
+	0xDF, 0x15, 0xD1, 0xE0, 0x47, 0x1F, 0xCE, 0x41,
+	0x1F, 0xBC, 0x0F, 0xF1, 0xDC, 0x97, 0xB5, 0x4D,
+	0x64, 0x84, 0xAD, 0x4F, 0xAB, 0xC0, 0x57, 0x12,
+	0xC3, 0x8A, 0x4E, 0x47, 0x82, 0xC6, 0xCD, 0x32,
+	0x21, 0x4D, 0xCE, 0xC7, 0x18, 0x7B, 0xEB, 0x8F,
+	0xB1, 0x72, 0x6F, 0x0F, 0xBB, 0xCD, 0x61, 0x46,
+	0xDC, 0x7D, 0xEC, 0x58, 0x09, 0x99, 0x29, 0x98,
+	0x39, 0x8B, 0x2F, 0xB8, 0xE1, 0xFF, 0x14, 0x33,
+	0x63, 0x9E, 0xEB, 0x44, 0x9C, 0x46, 0x2F, 0x94,
+	0x4C, 0x6B, 0x0C, 0x89, 0x3C, 0x7A, 0x4B, 0xD1,
+
+	0x22, 0x74, 0x4A, 0x91, 0x03, 0xDE, 0xA0, 0x8D,
+	0x06, 0x0D, 0x89, 0xC4, 0x1F, 0x60, 0xAF, 0x09,
+	0x96, 0xFF, 0x1A, 0x30, 0x2B, 0xC3, 0xBE, 0x2C,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x9D, 0x1D, 0xF8, 0x1C, 0x66, 0xFB, 0xED, 0x1B,
+	0x00, 0x4A, 0x9C, 0xAD, 0x62, 0x6E, 0x1C, 0x18,
+	0x68, 0x63, 0x28, 0x33, 0xE9, 0x35, 0x30, 0x23,
+	0x4C, 0x24, 0xA0, 0xA1, 0xA7, 0xDD, 0xEA, 0x49,
+	0x4A, 0xD6, 0xE0, 0xDA, 0xD1, 0x32, 0xC1, 0xAF,
+	0xD8, 0x3E, 0x16, 0xD2, 0xD0, 0x2C, 0x61, 0xD1,
+	0x20, 0x88, 0x6F, 0x26, 0xA4, 0x83, 0x60, 0xFF,
+	0x6F, 0x96, 0x2D, 0x02, 0xA0, 0xD1, 0x01, 0x2E,
+	0xE2, 0xE2, 0x77, 0x22, 0x92, 0x47, 0x1F, 0xBE,
+	0x42, 0x49, 0xA3, 0xCE, 0xD0, 0xD9, 0xCB, 0x66,
+	0x55,
+
+	0x20, 0x74, 0x06, 0x91, 0x03, 0xDE, 0xA0, 0x8D,
+	0x06, 0x0D, 0x89, 0xC4, 0x1F, 0x60, 0x8E, 0x2E,
+	0x96, 0xFF, 0x1A, 0x30, 0x2B, 0xC3, 0xBE, 0x2C,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x89, 0x1D, 0xF8, 0x1C, 0x66, 0xF3, 0xED, 0x7B,
+	0xB2, 0x42, 0x9C, 0xAE, 0xBA, 0x6A, 0x1C, 0x6A,
+	0x69, 0x63, 0xA8, 0xBF, 0xD1, 0x14, 0x76, 0x29,
+	0x4C, 0x54, 0xA2, 0x69, 0xAE, 0x70, 0x8A, 0x49,
+	0x1D, 0xF7, 0x9D, 0x61, 0x06, 0x34, 0x6A, 0x27,
+	0xB0, 0x23, 0x47, 0xF2, 0x20, 0xAE, 0x3F, 0x8D,
+	0xA0, 0x15, 0x2E, 0x7C, 0xA1, 0xF4, 0xE5, 0x94,
+	0x58, 0xA5, 0xD0, 0xA1, 0x2F, 0x49, 0x86, 0xA3,
+	0xB5, 0x14, 0x0F, 0xFD, 0x97, 0x66, 0xEE, 0xC2,
+	0x78, 0xED, 0x93, 0x60, 0xC3, 0x19, 0x96, 0x4B,
+	0x0F, 0x94,
+
+	0x20, 0x77, 0x86, 0x9F, 0x03, 0xDE, 0xA0, 0x8D,
+	0x11, 0x0D, 0x89, 0xC4, 0x1F, 0x60, 0x8E, 0x6A,
+	0x96, 0xFF, 0x1A, 0x30, 0x2B, 0xC3, 0xBE, 0x2C,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x49, 0x8D, 0xF8, 0x1C, 0x66, 0x73, 0xCB, 0x7B,
+	0xC7, 0xA3, 0x9E, 0xEE, 0xBE, 0x3B, 0x1E, 0x06,
+	0x69, 0x69, 0xA8, 0xAF, 0x40, 0x20, 0x7E, 0x0B,
+	0x4C, 0x54, 0xD6, 0x60, 0x3E, 0x05, 0xEB, 0x29,
+	0x3B, 0xDD, 0x0D, 0x9E, 0x29, 0x7E, 0xEC, 0xEC,
+	0xA8, 0x2D, 0x5F, 0x37, 0x53, 0x16, 0xDA, 0x54,
+	0x62, 0x73, 0x7B, 0xB5, 0xDC, 0x26, 0xAC, 0x4E,
+	0x19, 0xB7, 0xB2, 0x6A, 0xEA, 0x0D, 0x84, 0x66,
+	0x78, 0x3F, 0x97, 0x5A, 0x3F, 0xB0, 0x4E, 0x66,
+	0x22, 0x1D, 0x27, 0x36, 0xF9, 0xCF, 0x7A, 0x4D,
+	0x2B, 0x52, 0x16,
+
+	0x20, 0xF7, 0x87, 0x9F, 0x07, 0xDE, 0xA0, 0x8D,
+	0x90, 0x0A, 0x89, 0xC4, 0x1F, 0x60, 0x8E, 0xEA,
+	0x96, 0xFF, 0x1A, 0x30, 0x2B, 0xC3, 0xBE, 0x2C,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x49, 0x2D, 0xE8, 0x1C, 0x66, 0x73, 0xCB, 0x7B,
+	0xD5, 0xDB, 0x1E, 0xAE, 0xBE, 0x39, 0x00, 0x06,
+	0x61, 0x3C, 0xAB, 0xAF, 0xE0, 0x81, 0x7E, 0x1B,
+	0x14, 0x54, 0xD6, 0x18, 0x3E, 0xF9, 0x0A, 0x0C,
+	0xD0, 0xDC, 0x8A, 0x12, 0x1E, 0xBA, 0x16, 0xF4,
+	0x2B, 0x3F, 0xC5, 0x87, 0x50, 0x53, 0x2A, 0xD4,
+	0xEF, 0x17, 0xF8, 0x98, 0xEA, 0x8B, 0x5A, 0x7B,
+	0x65, 0x79, 0x0F, 0x07, 0xD0, 0x2C, 0x04, 0x79,
+	0xAD, 0xE9, 0x96, 0x0D, 0x60, 0x2B, 0x27, 0x38,
+	0x06, 0xDB, 0xFD, 0x07, 0xC4, 0xAA, 0x25, 0x59,
+	0x00, 0xFE, 0x2E, 0xCE,
+
+	0x20, 0xF7, 0x67, 0x95, 0x07, 0xDB, 0xA0, 0x8D,
+	0xD0, 0xF9, 0xBE, 0xC4, 0x1F, 0x60, 0x8E, 0xEA,
+	0x96, 0xFF, 0x1A, 0x30, 0x2B, 0xC3, 0xBE, 0x2C,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x05, 0x2D, 0x08, 0x10, 0x66, 0x73, 0xCB, 0xFB,
+	0xD5, 0x72, 0x62, 0xD5, 0xB6, 0xFB, 0x07, 0xD1,
+	0x41, 0x3E, 0x85, 0xBD, 0xE0, 0x61, 0x69, 0x83,
+	0x55, 0x6C, 0xD6, 0x18, 0x42, 0x5A, 0x3B, 0xD1,
+	0xFB, 0x7B, 0xB8, 0x52, 0xD0, 0xC4, 0x1D, 0x86,
+	0x20, 0xFF, 0x0D, 0x0B, 0xFB, 0x9B, 0x1F, 0x45,
+	0xEC, 0x13, 0x29, 0x1D, 0x9A, 0x39, 0xC1, 0xF3,
+	0x8F, 0x2C, 0xD1, 0xBC, 0x7F, 0xE6, 0x5D, 0x3A,
+	0x2D, 0x96, 0x4D, 0xA3, 0xFA, 0x4E, 0x8F, 0x55,
+	0xF3, 0x49, 0x21, 0xA4, 0x5A, 0xAC, 0xE7, 0xFF,
+	0xE5, 0xE9, 0x56, 0xE0, 0xC2,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDB, 0xF0, 0x8D,
+	0xD0, 0xF9, 0x9E, 0xEC, 0x1F, 0x60, 0x8E, 0xEA,
+	0x96, 0xFF, 0x1A, 0x30, 0x2B, 0xC3, 0xBE, 0x2C,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x05, 0x3D, 0x08, 0x10, 0x66, 0x73, 0xCB, 0xFB,
+	0x75, 0x73, 0xC2, 0x55, 0x34, 0xF1, 0x07, 0xF1,
+	0x43, 0x3A, 0x80, 0xBD, 0xE4, 0x61, 0x69, 0x85,
+	0x5F, 0x46, 0xD6, 0x18, 0x42, 0xDA, 0x39, 0xD1,
+	0xEF, 0x7E, 0x99, 0x52, 0xA5, 0x4D, 0x15, 0xCF,
+	0x08, 0xFD, 0x2B, 0xF9, 0x69, 0xAA, 0x95, 0xEF,
+	0xC0, 0x02, 0xED, 0x6D, 0x8A, 0x09, 0x81, 0x17,
+	0x85, 0x86, 0xD1, 0xBE, 0x57, 0x4C, 0xF0, 0x38,
+	0x67, 0x8C, 0x0D, 0x18, 0x8F, 0x83, 0x29, 0xE9,
+	0x8B, 0x6A, 0x05, 0xA0, 0x63, 0x25, 0x3D, 0xA8,
+	0x55, 0x77, 0x3C, 0xAD, 0xA1, 0x7B,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x70, 0x8D,
+	0xD0, 0xF9, 0x9E, 0xAC, 0x07, 0x60, 0x8E, 0xEA,
+	0x96, 0xFF, 0x1A, 0x30, 0x2B, 0xC3, 0xBE, 0x2C,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x05, 0xBD, 0x18, 0x10, 0x6E, 0xF3, 0xCB, 0xFB,
+	0x7D, 0x12, 0xC0, 0x34, 0xB1, 0x70, 0x06, 0xB0,
+	0x41, 0x3E, 0x80, 0xAF, 0x44, 0x69, 0x69, 0x9D,
+	0x0F, 0x50, 0xF3, 0x18, 0x42, 0xDA, 0xBD, 0xD0,
+	0x5A, 0xE4, 0x12, 0x30, 0x84, 0x67, 0x05, 0xC6,
+	0x09, 0x49, 0x6B, 0x72, 0x02, 0x68, 0x9F, 0x27,
+	0xD8, 0x92, 0xCB, 0x0D, 0xCA, 0x41, 0x95, 0xF7,
+	0x05, 0x2D, 0xA0, 0xA8, 0x91, 0xD5, 0x31, 0x3F,
+	0xEC, 0xC8, 0x06, 0x0C, 0xE7, 0x23, 0xBE, 0x01,
+	0xF0, 0x52, 0x48, 0xF8, 0xA6, 0xDD, 0x29, 0x60,
+	0xA9, 0x7B, 0x94, 0x6B, 0xCA, 0x76, 0x1D,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xD0, 0xF9, 0x9E, 0xAC, 0x87, 0x68, 0x8E, 0xEA,
+	0x96, 0xFF, 0x1A, 0x30, 0x2B, 0xC3, 0xBE, 0x2C,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x05, 0xBD, 0x18, 0x10, 0x6E, 0xF3, 0x8A, 0xFB,
+	0x7D, 0x12, 0xF2, 0x34, 0x3B, 0xF8, 0x16, 0xB1,
+	0x41, 0x1F, 0x82, 0xAF, 0x55, 0x69, 0x6D, 0x9D,
+	0x0B, 0x50, 0xD1, 0x78, 0x42, 0xDA, 0xBD, 0x58,
+	0x64, 0xB1, 0x06, 0x31, 0x87, 0x17, 0x35, 0x4E,
+	0x19, 0xC1, 0x83, 0x82, 0x08, 0x59, 0x8C, 0x67,
+	0xC8, 0x46, 0x87, 0x0E, 0x08, 0x71, 0x14, 0x3F,
+	0xDD, 0x9C, 0xA1, 0xAA, 0x33, 0x5D, 0x31, 0x1D,
+	0xFE, 0x1B, 0x3E, 0x03, 0x46, 0x81, 0x6A, 0x0D,
+	0xC1, 0x26, 0x15, 0x6D, 0xF5, 0xBF, 0xB1, 0x23,
+	0x75, 0xB3, 0x5F, 0x93, 0xB6, 0x16, 0xD4, 0x69,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xD0, 0x89, 0x9E, 0xAC, 0x87, 0x68, 0x8E, 0xE2,
+	0x96, 0xFF, 0x1A, 0x30, 0xAB, 0x23, 0x86, 0x2C,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x89, 0x3E, 0xE8, 0x2E, 0x25, 0xF2, 0x8A, 0xFB,
+	0x7C, 0x12, 0xF2, 0x34, 0x5A, 0xF8, 0x1E, 0xF3,
+	0x4F, 0x9F, 0xA2, 0x5C, 0x51, 0x8C, 0xE0, 0x0E,
+	0x0B, 0x70, 0xE8, 0x74, 0xD8, 0xDF, 0x79, 0x4C,
+	0xC9, 0x74, 0x1F, 0x52, 0x46, 0xDB, 0x79, 0x8D,
+	0xC1, 0xCF, 0x83, 0x93, 0x86, 0xE7, 0x3C, 0x68,
+	0x24, 0x5E, 0x7C, 0xE4, 0x92, 0xF8, 0x6D, 0x1B,
+	0x0B, 0x87, 0xA2, 0xE2, 0x21, 0x1C, 0x15, 0x7D,
+	0xEC, 0x46, 0xC1, 0xEB, 0xA9, 0xB0, 0x4F, 0x48,
+	0xD2, 0xF8, 0x3B, 0x37, 0xFF, 0xA7, 0xD1, 0x98,
+	0xE6, 0x1B, 0x23, 0xCC, 0x0D, 0xF7, 0xD9, 0xEF,
+	0x35,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xC4, 0x89, 0xCE, 0xAC, 0x87, 0x68, 0x8E, 0x62,
+	0x96, 0xFF, 0x1A, 0x30, 0xAB, 0x23, 0x87, 0x05,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x89, 0xAE, 0xEA, 0x6E, 0x6F, 0x35, 0x9A, 0xFB,
+	0x34, 0x12, 0xF2, 0x34, 0x5A, 0xB8, 0x1E, 0xF3,
+	0x7D, 0x9A, 0xA0, 0x5C, 0xF5, 0x8C, 0x64, 0x9C,
+	0x1F, 0x70, 0xC8, 0x34, 0xF8, 0xFF, 0x79, 0xCC,
+	0xE0, 0xBC, 0x3F, 0x46, 0xD8, 0x75, 0xBA, 0x02,
+	0xCB, 0xF5, 0x76, 0x34, 0x1A, 0xDA, 0x1D, 0x8C,
+	0x3C, 0xF7, 0xF5, 0x16, 0xAE, 0x7B, 0x70, 0xBD,
+	0x99, 0x3B, 0xA1, 0xFC, 0x0D, 0x26, 0xA0, 0x79,
+	0xF6, 0x76, 0xDD, 0x1A, 0x98, 0x4E, 0x2E, 0x8E,
+	0xA6, 0xE9, 0xE9, 0xEB, 0x81, 0x88, 0xAE, 0xAB,
+	0x2E, 0x32, 0x86, 0x40, 0x59, 0x8F, 0xAF, 0x1B,
+	0x65, 0x7C,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xE4, 0x85, 0xCE, 0x9C, 0x87, 0x68, 0x8E, 0x62,
+	0x8F, 0xFF, 0x1A, 0x30, 0xAB, 0x23, 0x87, 0x55,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x89, 0xAE, 0x7E, 0x6F, 0x5B, 0x63, 0x99, 0xFF,
+	0x34, 0x93, 0xF2, 0x34, 0x5A, 0xB8, 0x1C, 0xF3,
+	0xE9, 0x0F, 0xA4, 0x5C, 0xED, 0xE9, 0x66, 0xB9,
+	0xBF, 0x74, 0xC8, 0x64, 0xEA, 0xBB, 0xF8, 0xCC,
+	0x26, 0x3F, 0x8F, 0x4B, 0x50, 0x30, 0x7B, 0x5E,
+	0xB3, 0xF1, 0x62, 0xB4, 0x41, 0xD6, 0x36, 0x66,
+	0xA3, 0xED, 0x6E, 0x0B, 0x79, 0xCD, 0x61, 0x44,
+	0xA5, 0xFF, 0x22, 0xFA, 0x1C, 0x9A, 0xC0, 0x3C,
+	0x21, 0x6C, 0x2F, 0x6A, 0x58, 0xE3, 0x4F, 0x6F,
+	0x22, 0x9F, 0xFF, 0x0D, 0xDD, 0xF2, 0xCE, 0x0B,
+	0xCF, 0x39, 0xAF, 0x1A, 0x32, 0xE0, 0x0B, 0xB9,
+	0x95, 0x12, 0x91,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xE4, 0xC5, 0xCA, 0x9C, 0x86, 0x68, 0x8E, 0x62,
+	0x06, 0xF6, 0x1A, 0x30, 0xAB, 0x23, 0x87, 0xD5,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x89, 0xAE, 0x7E, 0xF7, 0x53, 0x05, 0xEB, 0xD5,
+	0x34, 0xA3, 0xE6, 0x34, 0x5A, 0xB8, 0x1C, 0xF0,
+	0xF3, 0x97, 0x34, 0x4C, 0xED, 0xE8, 0x40, 0xB9,
+	0x2F, 0x70, 0xCC, 0x64, 0x8A, 0xFB, 0xD0, 0xFC,
+	0x1C, 0xFD, 0xAD, 0xF6, 0x0B, 0x30, 0xA9, 0xB8,
+	0xA2, 0x84, 0x6E, 0x24, 0x4A, 0x94, 0x02, 0x97,
+	0x68, 0xC2, 0xFE, 0x13, 0x69, 0x65, 0x5E, 0x40,
+	0x14, 0xDD, 0x63, 0x17, 0x20, 0x0C, 0x08, 0x5B,
+	0x22, 0x46, 0x30, 0x5E, 0xD2, 0x2F, 0x1E, 0xB4,
+	0xF3, 0xE4, 0x9D, 0x0E, 0x39, 0x25, 0x65, 0xF5,
+	0x5C, 0xCD, 0xF8, 0xF4, 0x1C, 0xA6, 0xBF, 0xCE,
+	0x04, 0xD8, 0x24, 0x21,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xE4, 0xC5, 0x8A, 0x8C, 0x06, 0x28, 0x8E, 0x62,
+	0xC6, 0x2F, 0x23, 0x30, 0xAB, 0x23, 0x87, 0xD5,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0xA3, 0xAA, 0x7E, 0xF7, 0xCF, 0x06, 0xBF, 0xBB,
+	0x75, 0xA3, 0xE6, 0x32, 0x5B, 0xB8, 0x1C, 0xF0,
+	0xF2, 0x7A, 0xAA, 0x47, 0xEA, 0x28, 0x41, 0x5E,
+	0x2F, 0x02, 0x44, 0x70, 0x8A, 0xAB, 0xCC, 0xFE,
+	0xC3, 0x81, 0x3C, 0x99, 0x19, 0x5B, 0xF4, 0xE3,
+	0x15, 0x87, 0xDF, 0x01, 0xF6, 0x37, 0x0E, 0x9A,
+	0x24, 0xB1, 0x0B, 0x5B, 0x05, 0x39, 0xB7, 0x97,
+	0xE5, 0x99, 0x41, 0xB5, 0x03, 0xE6, 0x4E, 0x0F,
+	0x7C, 0x32, 0x28, 0x7B, 0xD2, 0xDE, 0xA3, 0x03,
+	0x79, 0xEF, 0xAB, 0x71, 0x45, 0x49, 0x8E, 0x71,
+	0x09, 0xD6, 0x2B, 0x7D, 0x19, 0x46, 0xE1, 0xCB,
+	0x51, 0x11, 0x27, 0x4B, 0xB8,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xE4, 0xC5, 0x8A, 0x8C, 0x03, 0x28, 0x9A, 0x62,
+	0xC6, 0x2F, 0x01, 0x1A, 0xAB, 0x23, 0x87, 0xD5,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0x69, 0x8C, 0xFE, 0xF7, 0xCF, 0xA6, 0xED, 0x33,
+	0x75, 0xA3, 0xE6, 0x32, 0x5E, 0xB8, 0x1C, 0xF0,
+	0x5A, 0x7A, 0x2A, 0xE7, 0x88, 0xAD, 0x41, 0x7C,
+	0x2F, 0x42, 0x40, 0x70, 0x8E, 0xAB, 0x4C, 0xFC,
+	0x34, 0xA9, 0x84, 0xDF, 0x3A, 0x93, 0x4C, 0xA0,
+	0x5A, 0xB2, 0xFC, 0x00, 0x86, 0x25, 0xB2, 0x67,
+	0xC6, 0x32, 0xBC, 0x7B, 0x2D, 0xF8, 0x93, 0x9A,
+	0x41, 0x6F, 0xB0, 0xB8, 0x07, 0x55, 0x5F, 0x26,
+	0xA3, 0x2C, 0xBE, 0x34, 0x52, 0x5A, 0xE5, 0x3B,
+	0x75, 0xA3, 0xFA, 0xDF, 0xD4, 0x24, 0x72, 0x21,
+	0x83, 0xAF, 0x5F, 0xEE, 0x7A, 0xF6, 0xD5, 0xB9,
+	0x3B, 0x28, 0x70, 0x76, 0xAF, 0xBF,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xE4, 0xC5, 0x8A, 0x8C, 0x03, 0x20, 0x1A, 0x42,
+	0xC6, 0x2F, 0x01, 0x58, 0xB1, 0x23, 0x87, 0xD5,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0xB9, 0x12, 0xA1, 0xF7, 0xCF, 0xA6, 0x49, 0xA2,
+	0x75, 0x23, 0xF2, 0x32, 0x56, 0xF9, 0x1C, 0xF0,
+	0x40, 0x53, 0x2B, 0xAA, 0x2C, 0x48, 0x40, 0x3C,
+	0xAD, 0x40, 0x48, 0x77, 0x2E, 0xAE, 0x4C, 0xFC,
+	0x5D, 0xE0, 0x4F, 0x9E, 0x4C, 0x43, 0x73, 0x74,
+	0x4A, 0xC3, 0xA3, 0x2D, 0x82, 0x61, 0xD2, 0x1B,
+	0xE6, 0xBF, 0x09, 0xFF, 0x58, 0x50, 0x90, 0x7F,
+	0xA6, 0xD0, 0x25, 0x5D, 0x2E, 0x12, 0xAB, 0xAE,
+	0x7A, 0x66, 0xB6, 0x7B, 0xE3, 0x5C, 0xDD, 0x33,
+	0xC7, 0xE3, 0xE7, 0x2B, 0xD1, 0x49, 0xB5, 0x41,
+	0xF8, 0x85, 0xC0, 0x6C, 0x1B, 0x8A, 0xDC, 0x88,
+	0xD7, 0x34, 0x0E, 0xE0, 0xD4, 0x8E, 0x4B,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xC6, 0x2F, 0x01, 0x58, 0x39, 0x29, 0x87, 0xD5,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0x6E, 0x97, 0xF6,
+	0xB9, 0x9A, 0x03, 0xFF, 0x67, 0xA6, 0x49, 0x0A,
+	0x75, 0x23, 0xF2, 0x36, 0x56, 0xB9, 0x99, 0xF0,
+	0xC0, 0x5B, 0x01, 0xAA, 0x06, 0xE0, 0x68, 0x3C,
+	0x2D, 0x40, 0x58, 0x36, 0x2A, 0xBA, 0x49, 0xFC,
+	0x13, 0x60, 0x4E, 0x94, 0x74, 0x89, 0xD9, 0xB7,
+	0x40, 0xCC, 0xEC, 0x61, 0xDF, 0x33, 0xD6, 0x0F,
+	0xCE, 0xBF, 0x95, 0x59, 0xF1, 0xD4, 0x84, 0x4D,
+	0xB7, 0x80, 0x72, 0x3A, 0x17, 0x17, 0x3E, 0x82,
+	0xF1, 0xD0, 0xB2, 0xA4, 0xC0, 0xDE, 0x81, 0x02,
+	0x99, 0xD6, 0x09, 0xBD, 0xA8, 0xD7, 0xC1, 0x48,
+	0x4C, 0x32, 0xBA, 0xDB, 0x12, 0xBB, 0x1A, 0xE1,
+	0x9E, 0xEC, 0x66, 0x5D, 0xAA, 0xDA, 0xFF, 0x94,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0x46, 0x4B, 0x01, 0x58, 0x39, 0x29, 0x87, 0xC1,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0xB4, 0xAD, 0xF6,
+	0xB9, 0x3A, 0x21, 0xF7, 0x7A, 0xE6, 0xD9, 0x0E,
+	0xD9, 0x20, 0xC2, 0x88, 0xCF, 0x31, 0x9B, 0xF0,
+	0xC2, 0x5B, 0x01, 0xAA, 0x13, 0xE0, 0x28, 0x78,
+	0x28, 0x80, 0x68, 0xCD, 0x2E, 0xD6, 0xE5, 0x97,
+	0x99, 0xDD, 0x08, 0x7B, 0x9C, 0x6D, 0xEA, 0xE4,
+	0x02, 0x9E, 0xF8, 0xB3, 0xA8, 0x30, 0x29, 0x64,
+	0xE7, 0xA0, 0x08, 0x0D, 0xBF, 0x76, 0x98, 0x72,
+	0xBE, 0xE0, 0xEC, 0x06, 0x31, 0x2F, 0x82, 0xAA,
+	0x3E, 0x20, 0x83, 0x0E, 0xC2, 0xE5, 0xCE, 0x6E,
+	0xB5, 0x16, 0x9C, 0x8D, 0x30, 0xC8, 0x22, 0x93,
+	0x86, 0x7A, 0x71, 0x72, 0x1B, 0x41, 0x1D, 0xDC,
+	0xED, 0x0F, 0x07, 0x1D, 0xC2, 0xEA, 0x4F, 0x89,
+	0x5E,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0x52, 0x4B, 0x11, 0x58, 0x39, 0x29, 0x87, 0x41,
+	0x7C, 0xBB, 0x59, 0xBA, 0x31, 0xB4, 0xAF, 0xDD,
+	0xB9, 0x3A, 0xE1, 0x35, 0x42, 0xA2, 0xDA, 0x1E,
+	0xD9, 0x90, 0xD0, 0xE8, 0x65, 0x6F, 0xAB, 0xF0,
+	0x93, 0x5B, 0x01, 0xAA, 0x13, 0xB0, 0x28, 0x38,
+	0x9A, 0xB9, 0x68, 0xE7, 0xC2, 0xD2, 0xC9, 0x27,
+	0x9B, 0x1B, 0x5D, 0x34, 0xB7, 0x99, 0x69, 0x6A,
+	0x03, 0x32, 0xED, 0x44, 0xF8, 0xD6, 0xD9, 0x1B,
+	0x79, 0x98, 0x33, 0x4D, 0x17, 0xB3, 0x15, 0x56,
+	0xBA, 0xD9, 0x3A, 0x0E, 0xAB, 0xE9, 0x53, 0xC3,
+	0x75, 0xB3, 0xC0, 0x87, 0x6F, 0x43, 0xFA, 0x46,
+	0x62, 0xC0, 0x57, 0xBF, 0x2A, 0xEA, 0xF4, 0xF6,
+	0xB0, 0xD0, 0x55, 0x74, 0x02, 0x0F, 0xF2, 0xB9,
+	0x5C, 0x01, 0xF8, 0xB8, 0x16, 0x12, 0xAB, 0x16,
+	0x60, 0x77,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xF2, 0x47, 0x91, 0x58, 0x39, 0x29, 0x87, 0x41,
+	0x67, 0xBB, 0x59, 0xBA, 0x31, 0xB4, 0xEF, 0x87,
+	0xB1, 0x33, 0xE1, 0x25, 0x16, 0xC8, 0x51, 0x0E,
+	0xD9, 0x90, 0x64, 0x69, 0xB1, 0xB1, 0xDC, 0xE4,
+	0x93, 0x50, 0x01, 0xAA, 0x13, 0xB0, 0x0A, 0x38,
+	0x2E, 0x78, 0x49, 0xA7, 0xC1, 0x97, 0xC9, 0x4F,
+	0x2E, 0x5B, 0x3A, 0xB5, 0x99, 0xA8, 0x61, 0xE7,
+	0x61, 0x35, 0x1D, 0x30, 0xC9, 0xDB, 0x32, 0x67,
+	0x7D, 0xC1, 0x26, 0x81, 0x04, 0x52, 0x6E, 0x7F,
+	0x5C, 0xDB, 0xBF, 0x76, 0xAB, 0xF7, 0xE2, 0xBE,
+	0xB2, 0x7A, 0x57, 0x8D, 0xAB, 0x6B, 0x99, 0x99,
+	0x1E, 0x0E, 0x4F, 0xE8, 0x23, 0x8B, 0x79, 0x5B,
+	0x52, 0xFA, 0xD6, 0xA3, 0xCE, 0xC7, 0xFF, 0xC3,
+	0xB7, 0x51, 0x17, 0x97, 0xB1, 0xB4, 0x62, 0x09,
+	0x90, 0x43, 0x89,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xF2, 0x87, 0x91, 0x58, 0x2F, 0x29, 0x87, 0x41,
+	0xED, 0xB0, 0x59, 0xBA, 0x31, 0xB4, 0xEF, 0x87,
+	0x31, 0xB3, 0xE1, 0x25, 0xB6, 0x48, 0x13, 0x5E,
+	0xD9, 0x90, 0x64, 0xD1, 0xF1, 0x51, 0x7E, 0xF7,
+	0x93, 0x10, 0x15, 0xAA, 0x13, 0xB0, 0x0A, 0x3F,
+	0x28, 0xC0, 0x89, 0xA5, 0x41, 0x9C, 0xE7, 0x4E,
+	0x66, 0x5D, 0x7A, 0x9C, 0x9C, 0x3A, 0x70, 0x92,
+	0x77, 0x37, 0xB4, 0x84, 0x09, 0xB9, 0x8E, 0x0B,
+	0x55, 0x69, 0xA8, 0x73, 0x0E, 0x42, 0x4F, 0x7E,
+	0x10, 0xF6, 0x3F, 0x59, 0x43, 0xDB, 0x14, 0xBE,
+	0x4C, 0x7A, 0x95, 0x87, 0xE4, 0xEC, 0x3A, 0xA1,
+	0x7E, 0x7A, 0xBE, 0x52, 0xB0, 0x7F, 0x7D, 0x77,
+	0xBE, 0x75, 0x1F, 0x92, 0xF5, 0x5F, 0x41, 0xF8,
+	0xC8, 0xD9, 0xFA, 0x36, 0x0F, 0x42, 0x17, 0x7C,
+	0x5B, 0xB3, 0xDA, 0x55,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xF2, 0x87, 0x11, 0x50, 0x2F, 0x7A, 0x87, 0x41,
+	0xAD, 0x4B, 0x62, 0xBA, 0x31, 0xB4, 0xEF, 0x87,
+	0x30, 0x82, 0x9B, 0x38, 0xB6, 0x68, 0x60, 0x20,
+	0x7B, 0x10, 0x64, 0xD1, 0x4D, 0xD2, 0xAC, 0x41,
+	0xC4, 0x10, 0x4D, 0xEE, 0x13, 0xB0, 0x0A, 0x3F,
+	0x2C, 0x8B, 0x35, 0xAA, 0x47, 0xDD, 0xF7, 0x11,
+	0x98, 0xC7, 0xE0, 0xD9, 0xCB, 0x03, 0x18, 0x81,
+	0x0A, 0x0C, 0x05, 0x0D, 0x73, 0xC1, 0x07, 0xCF,
+	0x2B, 0xDF, 0xE0, 0xAF, 0x90, 0xCF, 0xB0, 0xAD,
+	0x45, 0x01, 0x18, 0x12, 0x83, 0x7F, 0x34, 0xFC,
+	0xF8, 0x6D, 0x8F, 0x2A, 0x6F, 0xB2, 0xE3, 0xFA,
+	0x2F, 0x60, 0xD0, 0x28, 0xE0, 0x0E, 0x98, 0x66,
+	0xFD, 0x83, 0x8A, 0x21, 0xEE, 0x6D, 0xE3, 0xE3,
+	0xB5, 0xC6, 0x92, 0xA8, 0xB7, 0x88, 0x91, 0x4D,
+	0x6C, 0x4B, 0x1A, 0x51, 0xC7,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xF2, 0x87, 0x11, 0x50, 0x39, 0x7A, 0x9F, 0x41,
+	0xAD, 0x4B, 0x46, 0x96, 0x31, 0xB4, 0xEF, 0x87,
+	0xF0, 0x07, 0x83, 0x68, 0xA6, 0x68, 0xE0, 0x23,
+	0x70, 0x0B, 0xE4, 0xD1, 0x4D, 0x12, 0xEE, 0x40,
+	0xC4, 0x18, 0x4D, 0xEE, 0x16, 0xB1, 0x0A, 0x3F,
+	0x9C, 0x8B, 0xB5, 0x6A, 0x45, 0x9F, 0xF7, 0x31,
+	0x24, 0xE3, 0x27, 0xD4, 0xC6, 0x05, 0x9F, 0xF7,
+	0xF7, 0x30, 0x44, 0xFB, 0x9E, 0x4B, 0x5A, 0x5B,
+	0xAE, 0xE6, 0xA6, 0xCF, 0xF2, 0xE1, 0xFD, 0xF8,
+	0xBE, 0x0A, 0x07, 0xA5, 0xD0, 0x79, 0xB6, 0x1E,
+	0xBF, 0xFD, 0x5A, 0xDD, 0x78, 0x7C, 0x3E, 0x2B,
+	0x5C, 0x9E, 0x2A, 0x4A, 0x7B, 0x03, 0x3C, 0xEB,
+	0x78, 0xCE, 0xDA, 0x6A, 0x19, 0x1B, 0xD7, 0xBA,
+	0xA7, 0x65, 0xE1, 0x40, 0xD5, 0x90, 0xAC, 0xC9,
+	0xE6, 0x19, 0xD7, 0x29, 0x53, 0x00,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xF2, 0x87, 0x11, 0x50, 0x19, 0x78, 0x1F, 0x51,
+	0xAD, 0x4B, 0x06, 0xD2, 0x2D, 0xB4, 0xEF, 0x87,
+	0xF5, 0x4D, 0x0A, 0x69, 0x82, 0x64, 0xE0, 0x3B,
+	0x61, 0x14, 0xB0, 0xD1, 0x4D, 0x12, 0x2A, 0x01,
+	0xC4, 0x98, 0x5D, 0xEE, 0x16, 0xF0, 0x0A, 0x3F,
+	0x88, 0xEA, 0xB5, 0x9A, 0x81, 0x52, 0xF6, 0x71,
+	0x5B, 0x83, 0xE9, 0xC4, 0x9F, 0x4C, 0xA1, 0x3B,
+	0xDD, 0x11, 0x15, 0x75, 0x93, 0xC7, 0xFF, 0x98,
+	0x8D, 0x1A, 0xED, 0xD7, 0x1A, 0xD1, 0xA4, 0x35,
+	0x73, 0x8C, 0x5C, 0xD2, 0x92, 0x2C, 0x01, 0x1C,
+	0x30, 0x73, 0x3A, 0x7A, 0x4C, 0x01, 0x0B, 0x37,
+	0xE6, 0xD8, 0x8C, 0x69, 0x76, 0x04, 0x21, 0xBA,
+	0x38, 0xFD, 0x94, 0x6A, 0x8D, 0xA9, 0x0C, 0x52,
+	0xE5, 0xED, 0x20, 0x15, 0x84, 0x41, 0xCC, 0x15,
+	0x2C, 0x06, 0x20, 0xB1, 0x6C, 0x91, 0xEB,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0xDF, 0x74, 0x8D,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xE2, 0x87, 0x11, 0x50, 0x19, 0x38, 0x1F, 0x51,
+	0xAD, 0x4B, 0x06, 0xD2, 0xA9, 0xB8, 0xEF, 0x87,
+	0x75, 0x4E, 0x08, 0x79, 0x83, 0x60, 0xE0, 0x3B,
+	0x21, 0x34, 0x83, 0xF0, 0x45, 0x12, 0x2A, 0xC9,
+	0xC4, 0x98, 0x5D, 0xEF, 0x16, 0xE0, 0x6A, 0x3F,
+	0x88, 0xEE, 0x87, 0x9B, 0x81, 0x9A, 0x36, 0x70,
+	0x49, 0x1A, 0xB5, 0xCA, 0x97, 0x28, 0x22, 0xC3,
+	0x15, 0xE1, 0x3F, 0x77, 0xD0, 0xEC, 0x12, 0xC0,
+	0xF0, 0x54, 0x7D, 0xAE, 0x2B, 0x11, 0x9D, 0xA6,
+	0xB3, 0x04, 0xA4, 0x63, 0xB2, 0x24, 0xD1, 0x3D,
+	0x29, 0xBC, 0x5C, 0x51, 0x67, 0xBF, 0x81, 0x33,
+	0x2F, 0xE2, 0xF5, 0xB0, 0xF7, 0x88, 0x15, 0x0C,
+	0xC5, 0xF1, 0xE8, 0x41, 0xF7, 0xDD, 0x4E, 0x44,
+	0xE8, 0xD8, 0xA0, 0x58, 0xC0, 0x0F, 0x4D, 0x7C,
+	0x04, 0x4D, 0x1E, 0x95, 0xE5, 0x2B, 0xBB, 0xCB,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0x0B, 0x48, 0x8D,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xE2, 0x87, 0x11, 0x50, 0x19, 0x38, 0x1F, 0x51,
+	0x2D, 0x63, 0x06, 0xD2, 0xA9, 0xB8, 0xAF, 0x95,
+	0x72, 0x0E, 0x04, 0xCA, 0x81, 0x08, 0x2D, 0x7C,
+	0x21, 0x14, 0x88, 0x86, 0xC1, 0x66, 0xB8, 0xC5,
+	0x08, 0x1B, 0x7D, 0xD0, 0x7F, 0xE0, 0x68, 0x3F,
+	0x89, 0xEE, 0x87, 0x1B, 0xB1, 0x9A, 0xBE, 0x55,
+	0x2A, 0xEF, 0xEF, 0x13, 0xDC, 0x16, 0xEF, 0xF5,
+	0x23, 0x6E, 0xCF, 0x9E, 0xC6, 0x2F, 0x18, 0x5A,
+	0xB9, 0xA6, 0x71, 0xBA, 0x6C, 0x22, 0xFC, 0xE5,
+	0x91, 0x06, 0x3B, 0x6A, 0xB5, 0x67, 0x81, 0xF5,
+	0xDB, 0xCE, 0x44, 0xF1, 0x30, 0x28, 0xD6, 0x29,
+	0xF0, 0xC2, 0x05, 0x09, 0xA7, 0x21, 0xDC, 0xE5,
+	0x25, 0xD0, 0xD2, 0xAC, 0xEA, 0x20, 0xA5, 0x27,
+	0xE6, 0xA3, 0x5B, 0xB6, 0xF4, 0xCE, 0x5F, 0x27,
+	0xAE, 0x4F, 0x84, 0x57, 0x09, 0x0B, 0x9C, 0xD0,
+	0x75,
+
+	0x20, 0xF7, 0x67, 0x95, 0x03, 0x0B, 0x6C, 0xA0,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xE2, 0x87, 0x11, 0x50, 0x19, 0x38, 0x1F, 0x51,
+	0x29, 0x63, 0x4E, 0xD2, 0xA9, 0xB8, 0xAF, 0x15,
+	0x10, 0x19, 0x04, 0xCE, 0x35, 0x08, 0xA9, 0xAC,
+	0x37, 0x14, 0x48, 0x8B, 0xC9, 0x43, 0xE8, 0xC9,
+	0x08, 0xCB, 0x7F, 0x14, 0x34, 0x5A, 0x29, 0x3D,
+	0xCC, 0xEF, 0x87, 0x1B, 0xB1, 0x82, 0xBE, 0xD5,
+	0xA1, 0xA7, 0x4B, 0x66, 0xB2, 0x5F, 0x07, 0x4A,
+	0xE3, 0x05, 0x5D, 0x00, 0xC9, 0x86, 0x85, 0x7B,
+	0x62, 0x1B, 0x2B, 0x35, 0x3B, 0xBE, 0xBC, 0x6D,
+	0xB7, 0x04, 0xDB, 0x8B, 0x23, 0x62, 0xCC, 0x5F,
+	0x88, 0x9B, 0xF0, 0x43, 0x8C, 0x07, 0x71, 0x50,
+	0x3C, 0xC8, 0x6D, 0x55, 0x58, 0xC4, 0x0D, 0x5C,
+	0xB8, 0x87, 0xA9, 0xB4, 0x17, 0xF0, 0xE0, 0x17,
+	0xBB, 0x1C, 0xE0, 0x18, 0xD7, 0xA8, 0xFA, 0x43,
+	0x5E, 0xF3, 0x40, 0x45, 0x83, 0x24, 0x78, 0x9F,
+	0xB1, 0x37,
+
+	0x3D, 0xF7, 0x67, 0x95, 0x03, 0x0B, 0x6C, 0xE0,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xE2, 0x87, 0x11, 0x50, 0x19, 0x38, 0x1F, 0x51,
+	0x89, 0x6B, 0x4E, 0xE0, 0xA9, 0xB8, 0xAF, 0x15,
+	0xC4, 0x9C, 0x02, 0xCE, 0x3D, 0x7D, 0xA9, 0xC9,
+	0x13, 0x16, 0x48, 0xD3, 0xCD, 0x43, 0xE8, 0xC2,
+	0x09, 0xCB, 0xAB, 0xD5, 0x24, 0x2D, 0x5E, 0x3D,
+	0xEC, 0x5F, 0x87, 0x1B, 0xB1, 0x02, 0xA6, 0xD5,
+	0x5B, 0xD2, 0x3E, 0xE2, 0xB7, 0x41, 0xA4, 0xDD,
+	0x5F, 0xCC, 0x00, 0x04, 0xAC, 0x53, 0xB5, 0x3A,
+	0x53, 0x8A, 0x6B, 0x88, 0xA8, 0xF9, 0x2B, 0x90,
+	0x4F, 0x8C, 0xDE, 0xB1, 0xE9, 0x8B, 0x27, 0xDD,
+	0x07, 0x62, 0x01, 0x9A, 0x1C, 0xCC, 0x8F, 0xFE,
+	0xFF, 0x56, 0xC3, 0x1B, 0xCD, 0xF1, 0x7E, 0x9F,
+	0x82, 0xEF, 0x82, 0x37, 0xD4, 0x16, 0x91, 0x81,
+	0xA5, 0xF3, 0x73, 0x2E, 0x73, 0x68, 0x9C, 0x82,
+	0x2F, 0xC5, 0xA8, 0x4A, 0x13, 0xB8, 0xCD, 0x48,
+	0x65, 0xE6, 0x9B,
+
+	0xB4, 0xFA, 0x67, 0x95, 0x03, 0x0B, 0x6C, 0x60,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xE2, 0x87, 0x11, 0x50, 0x19, 0x38, 0x1F, 0x51,
+	0x89, 0xEB, 0x4A, 0xE0, 0xA3, 0xB8, 0xAF, 0x15,
+	0xC4, 0x44, 0xD2, 0xCD, 0xBD, 0x75, 0x9F, 0xC9,
+	0x1B, 0x72, 0x48, 0xD3, 0xED, 0x60, 0xEB, 0xE3,
+	0x09, 0xCB, 0xAB, 0x0D, 0x24, 0x5F, 0x3D, 0x2B,
+	0xEC, 0xFF, 0xA6, 0x1B, 0xB1, 0x02, 0xA6, 0xC4,
+	0x96, 0xAB, 0x6E, 0xA5, 0xEF, 0x99, 0xBA, 0xDF,
+	0x4C, 0xC6, 0xC2, 0x3B, 0x4A, 0xC1, 0xF2, 0x3A,
+	0x82, 0x1B, 0x2E, 0x97, 0x33, 0x65, 0xC2, 0xE0,
+	0x1D, 0x9C, 0x6D, 0x19, 0xAA, 0xE5, 0xBD, 0xD4,
+	0xBF, 0x9D, 0x5E, 0x2B, 0x45, 0xB4, 0x3F, 0xF7,
+	0x61, 0x36, 0x4C, 0x7F, 0xD8, 0x95, 0x37, 0xD5,
+	0xA5, 0x4E, 0x1B, 0x26, 0xD6, 0x9A, 0x5E, 0x13,
+	0x0A, 0x74, 0xA2, 0x1D, 0x5A, 0xBF, 0xF1, 0x99,
+	0xDE, 0x77, 0x79, 0x7F, 0x72, 0x79, 0x1A, 0x80,
+	0xF9, 0x71, 0xDC, 0x23,
+
+	0xF4, 0x12, 0x5A, 0x95, 0x03, 0x0B, 0x6C, 0x60,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xE2, 0x87, 0x11, 0x50, 0x19, 0x38, 0x1F, 0x51,
+	0x89, 0xEB, 0x2A, 0xF2, 0x23, 0xC3, 0xAF, 0x15,
+	0xC5, 0x3E, 0x0E, 0xE2, 0xB5, 0x75, 0x92, 0x3E,
+	0x2F, 0x6C, 0xA2, 0xDD, 0xED, 0xA0, 0xFF, 0x50,
+	0xFE, 0xCF, 0xAB, 0x0D, 0xF8, 0x7C, 0x2F, 0x54,
+	0xBD, 0xFF, 0x86, 0x9B, 0xB1, 0x02, 0xA6, 0x44,
+	0xBD, 0x10, 0xFE, 0xAA, 0x85, 0x09, 0x71, 0x16,
+	0x32, 0x57, 0xDD, 0xBB, 0x7F, 0xE9, 0xC9, 0x76,
+	0xAE, 0x5D, 0x89, 0x83, 0x9E, 0x3E, 0xF0, 0x7F,
+	0xF6, 0x96, 0x81, 0xAD, 0x8A, 0x75, 0xA4, 0x9D,
+	0x01, 0xCF, 0xB6, 0x52, 0xEE, 0x6C, 0xD7, 0xAE,
+	0xCE, 0x55, 0x3E, 0x31, 0x85, 0x09, 0xCE, 0x2A,
+	0x10, 0x25, 0xAF, 0x7F, 0x5E, 0xDB, 0x42, 0xD9,
+	0x0F, 0x69, 0x7D, 0xF2, 0x6D, 0x0E, 0x35, 0x33,
+	0x13, 0xF2, 0x91, 0xFE, 0x61, 0x29, 0xF2, 0x46,
+	0x96, 0x09, 0xD6, 0x93, 0xA9,
+
+	0xF4, 0x12, 0x50, 0xBB, 0x03, 0x0B, 0x6C, 0x60,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xE2, 0x87, 0x11, 0x50, 0x19, 0x38, 0x1F, 0x51,
+	0x89, 0xEB, 0x2A, 0xF2, 0x25, 0xC3, 0xEB, 0x15,
+	0x75, 0x3F, 0x9E, 0x02, 0x17, 0x79, 0x92, 0x1E,
+	0x2D, 0x2C, 0xA2, 0x8C, 0xEB, 0xA0, 0x7F, 0x5F,
+	0x75, 0xC8, 0xAB, 0x0D, 0xF8, 0x9C, 0x2D, 0xD5,
+	0xBD, 0xEB, 0x86, 0x1B, 0x81, 0x03, 0xA6, 0x44,
+	0x85, 0x97, 0x61, 0x98, 0xD1, 0x97, 0xBD, 0xED,
+	0x14, 0x01, 0xC4, 0x43, 0x7F, 0xB4, 0x49, 0x09,
+	0x9A, 0xEE, 0x67, 0xA0, 0x78, 0x29, 0x82, 0x7E,
+	0x4E, 0x53, 0xB0, 0x8C, 0x91, 0xDB, 0x68, 0x26,
+	0xA2, 0xBC, 0x81, 0x32, 0x3B, 0xD1, 0x8A, 0x79,
+	0x8B, 0x48, 0xA2, 0xEC, 0x12, 0xC4, 0x52, 0x53,
+	0x56, 0xBC, 0x74, 0xD2, 0x22, 0xDF, 0x3B, 0xC4,
+	0x12, 0x02, 0x33, 0xEC, 0xD5, 0x9C, 0x7F, 0xBF,
+	0xFB, 0x0D, 0x6B, 0x95, 0x82, 0x2D, 0x0D, 0x34,
+	0x6A, 0xB3, 0xB1, 0x56, 0x35, 0xB7,
+
+	0xF4, 0x12, 0x50, 0xED, 0x1D, 0x0B, 0x6C, 0x60,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xE2, 0x87, 0x11, 0x50, 0x19, 0x38, 0x1F, 0x51,
+	0x89, 0xEB, 0x2A, 0xF2, 0x25, 0xCC, 0x6B, 0x25,
+	0x7D, 0x4E, 0x9E, 0x22, 0xF2, 0x10, 0x92, 0x1E,
+	0x3B, 0x3C, 0x28, 0xB0, 0xAF, 0xA4, 0x7F, 0xCF,
+	0xE4, 0x97, 0xB6, 0x09, 0xF8, 0x9C, 0xC9, 0x94,
+	0xBD, 0x6B, 0xBE, 0x1B, 0x49, 0x02, 0xA6, 0x44,
+	0xA2, 0x23, 0xC6, 0x1E, 0x2F, 0x99, 0x8C, 0xED,
+	0x7E, 0xB4, 0xFC, 0xFA, 0xFD, 0xE4, 0x0F, 0x4C,
+	0x50, 0xCF, 0xAA, 0x2C, 0x0A, 0x66, 0x9D, 0x8C,
+	0x8E, 0x0A, 0x2B, 0x1C, 0xA9, 0xF5, 0xA2, 0x68,
+	0x92, 0x5B, 0x8B, 0x3E, 0xA8, 0xB6, 0xBC, 0x11,
+	0x3A, 0xAA, 0x26, 0x5D, 0xCD, 0xE0, 0xE1, 0x0C,
+	0x2D, 0x5D, 0x28, 0x16, 0xB6, 0xD7, 0x79, 0x76,
+	0x53, 0x16, 0x1A, 0xA1, 0x33, 0x13, 0x11, 0xB4,
+	0xBE, 0x16, 0xCF, 0x63, 0xE9, 0xF1, 0x4C, 0xDB,
+	0xDD, 0x91, 0xD9, 0x64, 0xD7, 0xD9, 0x97,
+
+	0xF4, 0x12, 0x50, 0x6D, 0x95, 0x05, 0x6C, 0x60,
+	0xF0, 0xC5, 0x8A, 0x8C, 0x03, 0x60, 0x1E, 0x42,
+	0xE2, 0x87, 0x11, 0x50, 0x19, 0x38, 0x1F, 0x51,
+	0x8C, 0xEB, 0x2A, 0xF2, 0x25, 0x8C, 0x6F, 0x25,
+	0x7D, 0x42, 0xB6, 0x23, 0xD0, 0xF8, 0xAA, 0x1F,
+	0x3B, 0x3D, 0x2A, 0xA0, 0xEF, 0xE4, 0x7E, 0xCF,
+	0xF0, 0x35, 0x15, 0x54, 0xB8, 0x9C, 0xC9, 0x7C,
+	0xBD, 0x6B, 0xBE, 0x0A, 0x49, 0x82, 0xF7, 0x44,
+	0x88, 0x81, 0x5E, 0xBC, 0x8D, 0x14, 0x5C, 0xD7,
+	0xFA, 0x2B, 0x33, 0xB5, 0xED, 0xFE, 0x97, 0xE7,
+	0xB8, 0xD7, 0x00, 0x94, 0x46, 0x07, 0xF0, 0xB0,
+	0x50, 0x22, 0xE0, 0xC1, 0x16, 0x15, 0x28, 0xE3,
+	0x06, 0x8D, 0x9D, 0x3D, 0x5F, 0x1B, 0xC5, 0x0A,
+	0xC3, 0x08, 0x22, 0x1B, 0x05, 0x49, 0x1C, 0x46,
+	0x00, 0x90, 0xA8, 0x98, 0x39, 0x0B, 0x15, 0x38,
+	0x9F, 0xA8, 0x96, 0xD9, 0x0D, 0xE3, 0x29, 0x92,
+	0x41, 0x80, 0x1D, 0xF6, 0x98, 0x56, 0x92, 0xDD,
+	0x18, 0xC6, 0x90, 0xC4, 0xDF, 0xB1, 0x8B, 0xB0,
+
+	0x43, 0x33, 0x4C, 0x57, 0x52, 0x2D, 0x9C, 0xC6,
+	0xA0, 0xAE, 0xB1, 0x17, 0x8D, 0x32, 0xEA, 0x9A,
+	0x16, 0x42, 0xB3, 0x0A, 0xE9, 0x9F, 0xBA, 0xCE,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x39, 0xDC, 0x39, 0x52, 0xBF, 0x3C, 0x49, 0x30,
+	0xB1, 0x88, 0xB4, 0xDE, 0x3C, 0xA4, 0x7E, 0xA7,
+	0xC5, 0xBA, 0x20, 0x87, 0xE2, 0x6C, 0xF5, 0x90,
+	0x4A, 0x15, 0xB0, 0x19, 0x51, 0x56, 0xB3, 0x7B,
+	0x83, 0xC5, 0xED, 0x58, 0xF9, 0x00, 0xFE, 0x81,
+	0x97, 0xC1, 0xA4, 0x8D, 0x69, 0xA1, 0x32, 0xC4,
+	0x49, 0x30, 0x4C, 0x06, 0xEE, 0x98, 0xE7, 0xF0,
+	0xA4, 0x05, 0x17, 0x98, 0x14, 0x21, 0x1F, 0xEC,
+	0x9F, 0xF5, 0xED, 0x36, 0xF7, 0xC4, 0x74, 0xCD,
+	0xCE, 0x54, 0xBD, 0xA8, 0x17, 0x15, 0x82, 0x03,
+	0x99, 0x99, 0xFC, 0x78, 0xE7, 0xD0, 0x40, 0x0A,
+	0x06, 0x25, 0xE8, 0x82, 0x2E, 0x49, 0x85, 0xC5,
+	0x77, 0x6F, 0xA3, 0xB3, 0xE0, 0x3A, 0x9A, 0xBB,
+	0x6C, 0x56, 0x64, 0xEF, 0x68, 0x78, 0x5C, 0x8C,
+	0x5A,
+
+	0x52, 0x33, 0x00, 0x57, 0x52, 0x2D, 0x9C, 0x46,
+	0xA0, 0xAE, 0xB1, 0x17, 0x8D, 0x32, 0xE2, 0xB5,
+	0x16, 0x42, 0xB3, 0x0A, 0xE9, 0x9F, 0xBA, 0xCE,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x9C, 0xDD, 0x39, 0x52, 0xBF, 0x34, 0x49, 0xF0,
+	0x93, 0x89, 0xB4, 0xFE, 0x88, 0xA5, 0xFE, 0x57,
+	0xD6, 0xBA, 0xE0, 0x0E, 0xD0, 0x8C, 0xA0, 0x91,
+	0x4A, 0xE5, 0xB2, 0x59, 0x9A, 0xB5, 0xB3, 0x7B,
+	0xD7, 0x4C, 0xD9, 0x2F, 0x08, 0xCD, 0xEC, 0x93,
+	0x7F, 0x82, 0x1A, 0xDE, 0x28, 0x65, 0x88, 0x77,
+	0x49, 0xA7, 0x0F, 0xCB, 0xED, 0x1A, 0x23, 0x58,
+	0x03, 0x4D, 0x91, 0xF9, 0xB6, 0x90, 0x6D, 0x6A,
+	0xD6, 0x12, 0xDA, 0x5F, 0xBE, 0xF1, 0xC5, 0x7E,
+	0x9F, 0xD2, 0x74, 0x8E, 0xA8, 0xE7, 0x0C, 0x1F,
+	0xD3, 0x90, 0xE4, 0x95, 0x51, 0xB8, 0x38, 0x6D,
+	0x20, 0x4C, 0xFC, 0x40, 0x8F, 0xB2, 0xEB, 0x7B,
+	0x73, 0xC9, 0xE4, 0x72, 0x6F, 0x26, 0x54, 0xDA,
+	0x21, 0x17, 0x3B, 0xAE, 0x36, 0xC8, 0xD9, 0xBE,
+	0x98, 0x14,
+
+	0xD2, 0x3F, 0x80, 0x51, 0x52, 0x2D, 0x9C, 0x46,
+	0xBF, 0xAE, 0xB1, 0x17, 0x8D, 0x32, 0xE2, 0xF2,
+	0x16, 0x42, 0xB3, 0x0A, 0xE9, 0x9F, 0xBA, 0xCE,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x14, 0x4A, 0x39, 0x52, 0xBF, 0xB4, 0x41, 0xF0,
+	0x67, 0x20, 0xA5, 0xBE, 0x91, 0x98, 0xFE, 0x52,
+	0xF2, 0xBC, 0xE0, 0x0E, 0x17, 0xC1, 0x89, 0xBB,
+	0x4A, 0xE5, 0x46, 0x58, 0x4A, 0x62, 0xC9, 0xFF,
+	0x7D, 0x69, 0x6A, 0x01, 0x1D, 0xEC, 0xE4, 0x5D,
+	0x4C, 0x05, 0xFA, 0xD2, 0x6B, 0x3E, 0xFB, 0xF7,
+	0x64, 0xD7, 0xC9, 0xE6, 0x9C, 0x85, 0xF0, 0x5C,
+	0xF0, 0x8A, 0x42, 0x2C, 0x0F, 0x03, 0x16, 0x95,
+	0x24, 0x59, 0x7C, 0xE8, 0x52, 0xD2, 0x67, 0x5B,
+	0x6A, 0xD1, 0x4D, 0x44, 0x9E, 0xDF, 0xCB, 0x22,
+	0x6A, 0x5A, 0x6E, 0x0F, 0x1F, 0x4D, 0x99, 0x24,
+	0xF7, 0x83, 0x76, 0xBF, 0x32, 0x9A, 0x94, 0x97,
+	0xB4, 0x4B, 0x91, 0x32, 0xA6, 0x9E, 0x32, 0x5E,
+	0x6F, 0xA8, 0x9B, 0xC4, 0x29, 0xC1, 0x99, 0x2A,
+	0x1E, 0xC4, 0x5E,
+
+	0xD2, 0x7F, 0x85, 0x51, 0x54, 0x2D, 0x9C, 0x46,
+	0x37, 0xA1, 0xB1, 0x17, 0x8D, 0x32, 0xE2, 0x72,
+	0x16, 0x42, 0xB3, 0x0A, 0xE9, 0x9F, 0xBA, 0xCE,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x14, 0x1A, 0x59, 0x52, 0xBF, 0xB4, 0x41, 0xEA,
+	0xC9, 0xD8, 0xE5, 0x8E, 0x91, 0x97, 0x80, 0x52,
+	0xF3, 0x9E, 0xE1, 0x0E, 0xB7, 0x60, 0x98, 0x9B,
+	0xC2, 0xE5, 0x46, 0xA0, 0x6A, 0xA6, 0x4A, 0xC6,
+	0x6B, 0x99, 0xB7, 0xEF, 0x6A, 0x8A, 0x77, 0x95,
+	0x86, 0x51, 0xE8, 0x42, 0x51, 0x2A, 0x4B, 0x37,
+	0xF2, 0x4A, 0x5B, 0x55, 0xE8, 0x14, 0x6C, 0x17,
+	0x54, 0xC7, 0xD7, 0xCB, 0x48, 0x31, 0xE0, 0xDA,
+	0xEF, 0x24, 0xC3, 0xA1, 0xCD, 0x07, 0x1E, 0xC8,
+	0x50, 0xF3, 0xD0, 0x51, 0x40, 0xCF, 0x97, 0xF9,
+	0xF6, 0x23, 0xA0, 0xE1, 0x77, 0x02, 0x7F, 0xCE,
+	0x58, 0x5D, 0x32, 0xC3, 0xB9, 0xAD, 0x67, 0x02,
+	0xEC, 0x97, 0x25, 0xC1, 0x7E, 0xE6, 0xB2, 0xC3,
+	0x3B, 0xFB, 0xA6, 0x58, 0xF3, 0xDC, 0x30, 0x21,
+	0x52, 0x3B, 0x2F, 0xFA,
+
+	0xD2, 0x7F, 0x45, 0x56, 0xD4, 0x4F, 0x9C, 0x46,
+	0xF7, 0x64, 0x8E, 0x17, 0x8D, 0x32, 0xE2, 0x72,
+	0x16, 0x42, 0xB3, 0x0A, 0xE9, 0x9F, 0xBA, 0xCE,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x75, 0x1A, 0xA1, 0xDF, 0xBF, 0xB4, 0x41, 0xEA,
+	0xCD, 0x10, 0x1A, 0xC1, 0x87, 0xD7, 0xA4, 0xED,
+	0x01, 0x58, 0x21, 0x07, 0xB7, 0xB0, 0x01, 0x15,
+	0x0B, 0xC4, 0x46, 0xA0, 0x96, 0xA5, 0xBA, 0x29,
+	0x56, 0x57, 0xC6, 0xEA, 0x62, 0x32, 0x99, 0xA2,
+	0xE7, 0x21, 0xBA, 0xD6, 0x4A, 0x80, 0x21, 0xE8,
+	0x9D, 0x6D, 0xE5, 0x01, 0x17, 0xA0, 0xDE, 0xA6,
+	0xBC, 0x63, 0x18, 0x8E, 0xB2, 0xC5, 0x74, 0xD9,
+	0x2D, 0x0C, 0x81, 0x52, 0x04, 0x04, 0xBE, 0xE9,
+	0xAE, 0xC8, 0x37, 0x7F, 0xC5, 0x56, 0xD0, 0xA6,
+	0x1B, 0x0A, 0xA3, 0x60, 0x2B, 0x1D, 0xA0, 0x9D,
+	0x41, 0xC4, 0xAA, 0xD4, 0x77, 0x38, 0x03, 0xB8,
+	0xA2, 0xFF, 0x16, 0x0C, 0x06, 0x0B, 0x40, 0x22,
+	0x84, 0x7A, 0x9A, 0x0A, 0x1B, 0x2B, 0x9D, 0x7E,
+	0x19, 0x94, 0xAE, 0x2F, 0x19,
+
+	0xD2, 0x7F, 0x45, 0x56, 0xD4, 0x4F, 0x9C, 0x46,
+	0xF7, 0x64, 0x9E, 0x27, 0x8D, 0x32, 0xE2, 0x72,
+	0x16, 0x42, 0xB3, 0x0A, 0xE9, 0x9F, 0xBA, 0xCE,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x75, 0x3A, 0xA1, 0xDF, 0xBB, 0xB5, 0x41, 0xEA,
+	0x0D, 0x14, 0x5A, 0xC1, 0x84, 0xDC, 0xA4, 0xFD,
+	0x0D, 0x58, 0x07, 0x07, 0xB6, 0xB0, 0x01, 0x11,
+	0x07, 0x8A, 0x46, 0xA2, 0x96, 0xA5, 0xB9, 0xA9,
+	0x52, 0x05, 0x13, 0xE0, 0x77, 0x1A, 0xC2, 0x03,
+	0x6C, 0x23, 0xEE, 0x1A, 0x46, 0x82, 0xAB, 0xBF,
+	0xA5, 0xBE, 0x84, 0x09, 0x4B, 0x00, 0x54, 0xC3,
+	0x8C, 0xA6, 0x96, 0xD1, 0x82, 0x6F, 0x06, 0xCE,
+	0xB3, 0x0B, 0xBB, 0xEA, 0xDD, 0x83, 0xC7, 0x0B,
+	0xCB, 0x7A, 0x0B, 0xE3, 0x88, 0xED, 0x51, 0xD0,
+	0x6C, 0xA1, 0x04, 0xA1, 0x3D, 0x61, 0xAF, 0xC6,
+	0x7A, 0x1B, 0x30, 0x0C, 0x21, 0xD5, 0xD6, 0x48,
+	0x25, 0xBB, 0xA4, 0xA5, 0x19, 0xD1, 0xC0, 0x9A,
+	0x45, 0x89, 0x76, 0x4D, 0xD8, 0xC7, 0xD9, 0x99,
+	0x0B, 0x4F, 0xCB, 0xBD, 0x68, 0xA2,
+
+	0xD2, 0x7F, 0x45, 0x56, 0xD4, 0x4F, 0x1C, 0x46,
+	0xF7, 0x64, 0xDE, 0x47, 0xAD, 0x32, 0xE2, 0x72,
+	0x16, 0x42, 0xB3, 0x0A, 0xE9, 0x9F, 0xBA, 0xCE,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x75, 0xBA, 0xE1, 0xDF, 0xB3, 0xB5, 0x40, 0xEA,
+	0x2D, 0x9D, 0x5A, 0xC0, 0x80, 0xDE, 0xA2, 0xBF,
+	0x19, 0x58, 0x83, 0x07, 0xA6, 0xA1, 0x01, 0x11,
+	0x97, 0x9A, 0xCF, 0xA3, 0x96, 0xA5, 0xBD, 0xAB,
+	0x60, 0x2C, 0x12, 0x2F, 0x5B, 0x56, 0xC6, 0xC5,
+	0x2E, 0xB7, 0xBD, 0x32, 0x77, 0x8A, 0x2F, 0x29,
+	0xF7, 0x8E, 0x51, 0x68, 0xBF, 0x30, 0x5E, 0x58,
+	0x88, 0x90, 0x0D, 0xC3, 0xCF, 0x4B, 0x01, 0xD9,
+	0xF2, 0xBB, 0x7F, 0xDA, 0x2C, 0x88, 0x81, 0x55,
+	0xF8, 0x29, 0x33, 0x80, 0x6F, 0xE4, 0x42, 0xC9,
+	0xB6, 0x2F, 0x7F, 0xFF, 0x72, 0x0F, 0x71, 0xB3,
+	0xFE, 0x01, 0xAF, 0xE6, 0x17, 0xAE, 0xEC, 0x82,
+	0xCB, 0xB6, 0xA4, 0x73, 0x5A, 0xED, 0x3B, 0xA6,
+	0xD0, 0x63, 0xE6, 0xC1, 0x37, 0x81, 0xEF, 0xDD,
+	0x25, 0xD1, 0x9B, 0x7B, 0x33, 0x45, 0x46,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xF7, 0x64, 0xDE, 0x47, 0x3D, 0x22, 0xE2, 0x72,
+	0x16, 0x42, 0xB3, 0x0A, 0xE9, 0x9F, 0xBA, 0xCE,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x75, 0xBA, 0xE1, 0xDE, 0xB3, 0xB5, 0x42, 0xEA,
+	0xAD, 0x9D, 0x58, 0xC0, 0xC0, 0xD6, 0xAB, 0xBE,
+	0x39, 0x5C, 0x03, 0x07, 0x85, 0x69, 0x09, 0x11,
+	0x16, 0xBA, 0xEB, 0xE5, 0x96, 0xA5, 0xBD, 0xA3,
+	0x20, 0xE0, 0x12, 0xB5, 0x51, 0x02, 0xDE, 0x19,
+	0x26, 0xB6, 0xB3, 0x73, 0x5B, 0xE1, 0xAB, 0x18,
+	0xF3, 0xFF, 0x11, 0xC5, 0xCF, 0xB2, 0x5E, 0x58,
+	0xDC, 0x83, 0x5F, 0xE5, 0x2F, 0xCF, 0xB5, 0x25,
+	0x81, 0x94, 0x8E, 0xBB, 0xE7, 0x97, 0x3F, 0x19,
+	0xA5, 0xFD, 0x1E, 0x99, 0x2B, 0xB0, 0x4D, 0x7A,
+	0x95, 0x48, 0x12, 0x7D, 0x6E, 0x19, 0xFB, 0x9E,
+	0x78, 0x77, 0x7A, 0x4F, 0xDF, 0x97, 0x0D, 0x12,
+	0xF7, 0x14, 0x7A, 0xA4, 0x64, 0x2C, 0xDA, 0x82,
+	0x52, 0x41, 0x9C, 0xC9, 0x6A, 0xB7, 0x1C, 0x90,
+	0xB4, 0x96, 0x6A, 0xDD, 0xEF, 0x45, 0xF7, 0xE1,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xF7, 0x64, 0xDE, 0x47, 0x3D, 0x22, 0xE2, 0x72,
+	0x16, 0x42, 0xB3, 0x0A, 0x29, 0x5F, 0xBA, 0xCE,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x79, 0xBE, 0xD1, 0xEE, 0xB1, 0xB5, 0x42, 0xEA,
+	0xAD, 0x9D, 0x58, 0xC0, 0xC0, 0xD6, 0xB3, 0xBC,
+	0x31, 0x9C, 0x03, 0x04, 0x85, 0x61, 0x05, 0x05,
+	0x16, 0x9A, 0xCB, 0xEF, 0x8E, 0xC5, 0xDD, 0xA3,
+	0x67, 0x87, 0x82, 0x9F, 0xC2, 0xB2, 0x04, 0x0B,
+	0x5E, 0xB4, 0xD0, 0x6A, 0xC1, 0xE1, 0x5B, 0x19,
+	0xF1, 0xE3, 0x0D, 0xDF, 0x8F, 0xE1, 0x5D, 0xD1,
+	0x96, 0x3B, 0x7F, 0x2D, 0x2C, 0x8D, 0xFD, 0xC5,
+	0xBA, 0x47, 0xF1, 0x8C, 0x9B, 0x03, 0x79, 0xED,
+	0x94, 0x60, 0xD1, 0xD5, 0x74, 0xCA, 0xA2, 0xD0,
+	0x44, 0xF8, 0x3A, 0xE7, 0xD9, 0xC8, 0xDC, 0xA3,
+	0xBA, 0xAC, 0x97, 0x7B, 0xF0, 0xB0, 0xE0, 0x87,
+	0x71, 0x73, 0xA2, 0xD8, 0xCF, 0xBE, 0x45, 0xB2,
+	0x59, 0x6D, 0xCB, 0xFD, 0x1C, 0xE4, 0xBB, 0xD1,
+	0x44, 0x42, 0x39, 0x27, 0xE5, 0x0D, 0xDF, 0x44,
+	0x05,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xE7, 0x64, 0xFE, 0x47, 0x3D, 0x22, 0xE2, 0x72,
+	0x16, 0x42, 0xB3, 0x0A, 0x29, 0x5F, 0xAA, 0xFF,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x79, 0xAE, 0xDA, 0xAF, 0xFD, 0xFD, 0x52, 0xE8,
+	0x2D, 0x9D, 0x58, 0xC0, 0xC0, 0x96, 0xB3, 0xBC,
+	0x22, 0x9A, 0x03, 0x05, 0x45, 0x63, 0x01, 0x14,
+	0x0E, 0x9A, 0xCB, 0xE5, 0x82, 0xC5, 0xFF, 0x2B,
+	0x0E, 0xE5, 0x2A, 0x9C, 0xC5, 0xF2, 0x4D, 0x4A,
+	0x96, 0xA4, 0x12, 0xAF, 0xCA, 0xCD, 0xFF, 0xBB,
+	0xB5, 0xF0, 0x1E, 0x95, 0xD3, 0x21, 0x39, 0x4B,
+	0x10, 0x1B, 0xE4, 0x4F, 0x9C, 0x05, 0x5F, 0xFD,
+	0x23, 0xCD, 0x12, 0xDB, 0x36, 0x5D, 0x50, 0x9F,
+	0xB0, 0xE4, 0x16, 0xA1, 0x12, 0x5D, 0x68, 0x19,
+	0x8C, 0xEF, 0x46, 0xC7, 0xCC, 0xEE, 0x1F, 0xB6,
+	0x41, 0x4E, 0x49, 0xD2, 0xCC, 0xFC, 0xF6, 0xC5,
+	0x28, 0x21, 0x85, 0x8F, 0x12, 0xCB, 0x27, 0xF4,
+	0xD8, 0xBC, 0xB9, 0x90, 0xBB, 0x1C, 0xD6, 0xF1,
+	0xF7, 0xCD, 0x49, 0x0E, 0xA9, 0x1D, 0xEF, 0xBC,
+	0x6B, 0x33,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xC7, 0x74, 0x7E, 0x07, 0x3D, 0x22, 0xE2, 0x72,
+	0x37, 0x42, 0xB3, 0x0A, 0x29, 0x5F, 0xAA, 0x9E,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x79, 0xAE, 0xCE, 0xAD, 0xAC, 0x8D, 0xDC, 0xE8,
+	0x0D, 0x95, 0x58, 0xC0, 0xC0, 0x96, 0xF1, 0xBC,
+	0x36, 0xE4, 0x11, 0x05, 0x64, 0xCA, 0x01, 0x85,
+	0x0E, 0x9A, 0xCB, 0xA5, 0xDE, 0xC5, 0xF7, 0x2B,
+	0x4B, 0xBC, 0x0A, 0xBD, 0x68, 0x46, 0xCC, 0xB6,
+	0xB2, 0x92, 0x66, 0x76, 0x41, 0xFE, 0x39, 0x1C,
+	0x7A, 0xE8, 0x97, 0xF3, 0xE1, 0xB3, 0xFB, 0x12,
+	0xA5, 0x5B, 0x5C, 0x7E, 0xAE, 0x21, 0x88, 0xBC,
+	0x23, 0x59, 0x22, 0xFF, 0x64, 0xE7, 0x45, 0x54,
+	0x09, 0x2A, 0x22, 0x2B, 0x3C, 0xCE, 0x89, 0x58,
+	0xB2, 0x10, 0x0F, 0x41, 0x4B, 0x13, 0x6C, 0x99,
+	0x75, 0xF2, 0xD1, 0x12, 0x0E, 0x67, 0xF3, 0x83,
+	0x2C, 0x2B, 0xB0, 0xF4, 0x6C, 0x66, 0xBA, 0x97,
+	0xD9, 0x0A, 0x3E, 0x86, 0x06, 0x30, 0xD3, 0x7A,
+	0x46, 0x52, 0x73, 0x34, 0xBA, 0xAB, 0x5B, 0x42,
+	0xB3, 0xC4, 0xBE,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xC7, 0x74, 0x76, 0x07, 0x3D, 0x22, 0xE2, 0x72,
+	0xB7, 0x53, 0xB3, 0x0A, 0x29, 0x5F, 0xAA, 0x9E,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x69, 0xAF, 0xCE, 0xB5, 0xAD, 0xEF, 0xB8, 0xAE,
+	0x0D, 0xB5, 0x50, 0xC0, 0xC0, 0x96, 0xF1, 0xBF,
+	0x68, 0xFC, 0x38, 0x0D, 0x64, 0xCA, 0x45, 0x85,
+	0x2C, 0x1A, 0xC3, 0xA5, 0xBE, 0xC5, 0xF7, 0x0B,
+	0x7B, 0x28, 0x63, 0xB6, 0xE1, 0xDC, 0xEB, 0xB7,
+	0xD0, 0x08, 0x27, 0xD6, 0x63, 0xEE, 0xA3, 0xE4,
+	0x17, 0x9A, 0x8B, 0xE7, 0xB1, 0xB8, 0x63, 0xB3,
+	0x01, 0x59, 0xFE, 0xE6, 0x8F, 0x8B, 0xE8, 0x12,
+	0x59, 0xF9, 0xEC, 0x0A, 0x4E, 0x45, 0xF5, 0x7D,
+	0x0F, 0xED, 0x1C, 0x64, 0x09, 0x6E, 0x61, 0x3C,
+	0x0F, 0x25, 0x32, 0x3E, 0xB5, 0xB6, 0x10, 0xF6,
+	0xE7, 0x21, 0xC4, 0x49, 0xB2, 0x9F, 0x61, 0x37,
+	0x94, 0x0C, 0xC9, 0x6B, 0x2C, 0x69, 0xC0, 0x60,
+	0x2A, 0xEE, 0x7C, 0x29, 0x5D, 0x9E, 0x9A, 0xF2,
+	0x6D, 0xF1, 0xB7, 0xE1, 0x16, 0x92, 0x2F, 0x27,
+	0xFF, 0x01, 0x3D, 0xCC,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xC7, 0x74, 0xD6, 0x07, 0x3D, 0x23, 0xE2, 0x72,
+	0x77, 0x92, 0xB2, 0x0A, 0x29, 0x5F, 0xAA, 0x9E,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0x69, 0xBF, 0xCE, 0xB5, 0xB1, 0xE7, 0xC8, 0xD6,
+	0x0D, 0xB5, 0x48, 0xC8, 0xC0, 0x96, 0xF1, 0x3F,
+	0x68, 0xFB, 0x24, 0x2D, 0x64, 0x8A, 0x44, 0x9A,
+	0x0C, 0xFA, 0x23, 0xA5, 0xBE, 0xA5, 0xC7, 0x09,
+	0x69, 0x52, 0xA9, 0x8F, 0xE4, 0x9C, 0x46, 0xAB,
+	0xEA, 0x2A, 0x9F, 0x9F, 0x3E, 0xE9, 0x68, 0xDC,
+	0x95, 0xBA, 0x0C, 0xF5, 0x72, 0xF4, 0x5A, 0x83,
+	0x0C, 0x19, 0x7D, 0xC6, 0x28, 0x5F, 0x8B, 0x2A,
+	0x62, 0x75, 0xBA, 0x41, 0xF0, 0xBD, 0x2B, 0x0A,
+	0x46, 0x55, 0x6D, 0x88, 0x6F, 0xDD, 0xE9, 0xAF,
+	0x54, 0x5E, 0x2B, 0x8D, 0xE6, 0xA9, 0x80, 0x48,
+	0xC8, 0x6E, 0x74, 0xE5, 0x37, 0xC9, 0xA2, 0x6D,
+	0x63, 0x19, 0x1C, 0x5D, 0xDF, 0xCA, 0x8C, 0x5F,
+	0x7F, 0x02, 0xD3, 0xCC, 0x0E, 0x32, 0x41, 0x3A,
+	0x29, 0xB7, 0xC4, 0xB0, 0x18, 0x6A, 0x19, 0xA6,
+	0x9B, 0xCA, 0x43, 0x42, 0x13,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xC7, 0x74, 0xD6, 0x07, 0x2D, 0x23, 0xE2, 0x72,
+	0x77, 0x92, 0xB0, 0x38, 0x29, 0x5F, 0xAA, 0x9E,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0xA5, 0xFB, 0xCE, 0xB5, 0xB1, 0xC7, 0xCB, 0xD6,
+	0x0D, 0xF1, 0x48, 0x88, 0xC6, 0x96, 0xF1, 0x3F,
+	0xE0, 0xFB, 0xEC, 0x0D, 0x47, 0x81, 0x44, 0xA8,
+	0x18, 0xBA, 0x23, 0xAC, 0xBF, 0xA5, 0x47, 0x83,
+	0xFC, 0x9E, 0xBD, 0xAB, 0x54, 0x54, 0x9A, 0x8A,
+	0x4B, 0x5C, 0xC5, 0x5D, 0x1F, 0xF9, 0xF9, 0xBF,
+	0x76, 0x38, 0x39, 0x95, 0x16, 0x77, 0x6B, 0x31,
+	0x2E, 0x2D, 0x74, 0xB6, 0x38, 0xC5, 0xC7, 0x51,
+	0x80, 0xC2, 0x37, 0x70, 0xBE, 0x67, 0x10, 0x83,
+	0x6D, 0xF9, 0x7C, 0xB1, 0x5C, 0xB7, 0xCB, 0xCD,
+	0x5F, 0xF9, 0x67, 0xCF, 0x10, 0x23, 0x7B, 0xBA,
+	0x17, 0x6B, 0xF3, 0xF7, 0xC8, 0x0F, 0x8A, 0xF9,
+	0x8C, 0x34, 0x59, 0xE5, 0xF1, 0x93, 0xB8, 0x32,
+	0xAE, 0xD4, 0x16, 0xDE, 0x72, 0xA9, 0x55, 0x2C,
+	0xD8, 0x43, 0x4D, 0xE1, 0x24, 0x6D, 0xD0, 0xF9,
+	0x5C, 0xB9, 0xA0, 0x7E, 0x7B, 0x0B,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xC7, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xE2, 0x32,
+	0x77, 0x92, 0xB0, 0x5A, 0x0B, 0x5F, 0xAA, 0x9E,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0xB5, 0x63, 0x46, 0xB4, 0xB3, 0xC7, 0xEF, 0xD4,
+	0x0D, 0xF1, 0x48, 0x88, 0x8E, 0x96, 0xF1, 0x3F,
+	0xC0, 0x72, 0xEC, 0x09, 0x63, 0xA3, 0x42, 0xAA,
+	0x08, 0xB2, 0x6B, 0xC8, 0xBF, 0xB5, 0x47, 0x83,
+	0xC9, 0x9D, 0xA6, 0x2B, 0xA5, 0x51, 0x2E, 0xBA,
+	0x80, 0x5E, 0x93, 0x98, 0xA3, 0x9F, 0x7F, 0x5B,
+	0xD0, 0x87, 0x92, 0x9C, 0xB6, 0xBF, 0x38, 0x55,
+	0xA9, 0xBF, 0xA5, 0xB5, 0x06, 0xA5, 0xBE, 0x99,
+	0x1B, 0x3D, 0x6D, 0xD1, 0xE2, 0xDF, 0xA3, 0x62,
+	0x91, 0x20, 0xCC, 0x09, 0x20, 0xFE, 0xF0, 0xF3,
+	0xC6, 0xC4, 0xE5, 0x81, 0xF0, 0x11, 0xA2, 0x88,
+	0x61, 0x06, 0xC4, 0xC0, 0x48, 0x09, 0x3E, 0x95,
+	0x11, 0x98, 0xE3, 0xDF, 0xDE, 0x69, 0xF5, 0x52,
+	0x59, 0xED, 0x1E, 0x9C, 0x95, 0x01, 0x3F, 0x26,
+	0xB6, 0x48, 0x70, 0xFF, 0x8D, 0x0A, 0x92, 0x9F,
+	0x9C, 0x60, 0xD7, 0x90, 0x3A, 0xCF, 0xFB,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x77, 0x92, 0xB0, 0xDA, 0x99, 0x4D, 0xAA, 0x9E,
+	0x1C, 0x7D, 0xB0, 0x63, 0xEB, 0x78, 0x6D, 0xD1,
+	0xA4, 0xC1, 0xE2, 0xB0, 0xBB, 0xC7, 0xEF, 0xFC,
+	0x0D, 0xF1, 0x48, 0x8D, 0x8E, 0xD6, 0xD5, 0x3F,
+	0x40, 0x62, 0xA6, 0x09, 0x63, 0x8B, 0x3B, 0x8B,
+	0x28, 0xB2, 0x7B, 0x98, 0xBF, 0xB1, 0x4F, 0x83,
+	0xC9, 0xC6, 0x7F, 0x44, 0x85, 0xDD, 0xB2, 0x9F,
+	0x3D, 0xF5, 0x81, 0x0A, 0xA1, 0xCF, 0xF7, 0x5B,
+	0xFA, 0x0E, 0xB9, 0x93, 0xB6, 0xF1, 0xB8, 0x53,
+	0x6B, 0xC2, 0xB7, 0x22, 0x22, 0xA7, 0x7C, 0x17,
+	0x48, 0x3C, 0xD8, 0xCB, 0x4F, 0x8B, 0xEA, 0x4E,
+	0xC0, 0x41, 0xF5, 0x7F, 0x27, 0x06, 0x3B, 0x3E,
+	0x9E, 0x09, 0x15, 0xC7, 0xDA, 0x8E, 0x65, 0xAD,
+	0xBF, 0xD6, 0x6E, 0x92, 0x56, 0xCB, 0x3F, 0x3D,
+	0x71, 0x0C, 0x92, 0xDC, 0x80, 0x4F, 0x4E, 0xBB,
+	0x3B, 0xF0, 0x4F, 0x72, 0x0A, 0xF9, 0x53, 0x21,
+	0x98, 0x9B, 0x26, 0xF0, 0x44, 0x32, 0xDC, 0xEA,
+	0x82, 0x21, 0x38, 0x7E, 0xD8, 0x13, 0x12, 0x7B,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x77, 0x92, 0xB0, 0xDA, 0x99, 0x4D, 0xAA, 0x9F,
+	0x1C, 0x7D, 0xB0, 0x63, 0x6B, 0xB8, 0x6F, 0xD1,
+	0xA4, 0x61, 0xD2, 0xB0, 0x2B, 0xC7, 0xCD, 0xFC,
+	0x21, 0xF5, 0xF8, 0x3D, 0x87, 0xD2, 0xD5, 0x3F,
+	0x40, 0x62, 0xA6, 0x89, 0x66, 0x8B, 0x7B, 0xBF,
+	0x28, 0x32, 0x7B, 0x8A, 0xBF, 0xB4, 0x63, 0x9B,
+	0xE9, 0x2C, 0xA2, 0x3F, 0xA9, 0x8D, 0x3D, 0x1F,
+	0xB5, 0xAF, 0x51, 0xCE, 0x02, 0x6D, 0xBE, 0x21,
+	0xB4, 0x9D, 0xB8, 0x59, 0x9E, 0xFB, 0x00, 0x43,
+	0x31, 0x7F, 0xF3, 0xD4, 0x33, 0x5C, 0xE7, 0x73,
+	0x2C, 0x2C, 0x3B, 0x54, 0x74, 0x22, 0x01, 0xDF,
+	0x7E, 0x3C, 0x42, 0x8E, 0x97, 0xC7, 0xB6, 0xB3,
+	0xB7, 0xE7, 0x43, 0xF1, 0xBF, 0x9B, 0xF0, 0x22,
+	0xFC, 0x88, 0xBB, 0x23, 0x0E, 0x61, 0x4C, 0x29,
+	0x8D, 0xD6, 0x69, 0x65, 0x1F, 0xD8, 0x8D, 0x9B,
+	0xDA, 0xAD, 0xE6, 0xA2, 0x8B, 0x85, 0x22, 0xAA,
+	0x93, 0xD4, 0x8F, 0x31, 0xBF, 0xDC, 0xDD, 0x6B,
+	0xAE, 0x34, 0x1F, 0xC1, 0x98, 0xD9, 0x92, 0x18,
+	0x17,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x6F, 0x92, 0xB0, 0xDA, 0x99, 0x4D, 0xAA, 0x9F,
+	0x1C, 0x7D, 0xB0, 0x63, 0x6B, 0xB8, 0x7E, 0xE2,
+	0xB4, 0x61, 0x72, 0xB0, 0x27, 0xE5, 0x09, 0x6D,
+	0x21, 0xC5, 0xFB, 0xFD, 0x0B, 0x56, 0xC5, 0x3D,
+	0xC0, 0x63, 0xA6, 0x89, 0x66, 0xCB, 0x7B, 0xBF,
+	0x6B, 0x34, 0x7B, 0xB9, 0x73, 0xB0, 0x83, 0xA9,
+	0xC0, 0x07, 0x2B, 0xCB, 0xB8, 0xCF, 0xBD, 0xD7,
+	0x93, 0xB6, 0xF3, 0xCA, 0xE0, 0x81, 0xFE, 0x9D,
+	0x73, 0xAD, 0xA3, 0x27, 0x12, 0xD0, 0xA5, 0x43,
+	0x19, 0x0F, 0xC3, 0x56, 0x56, 0x87, 0x66, 0xFB,
+	0xAB, 0x44, 0x12, 0x54, 0x80, 0x5E, 0x4F, 0x15,
+	0x54, 0xC5, 0x00, 0xA1, 0x2B, 0x6A, 0xDF, 0xCD,
+	0x78, 0x2E, 0x6B, 0x98, 0xE6, 0x43, 0xEA, 0xC3,
+	0x7C, 0x92, 0x0C, 0x03, 0x06, 0xED, 0xCF, 0xD7,
+	0x51, 0x24, 0x65, 0x64, 0xD3, 0x3B, 0xC6, 0x2E,
+	0xA8, 0xAA, 0x1B, 0xDC, 0xCB, 0x27, 0xE3, 0xEC,
+	0xD5, 0x8F, 0xDB, 0x51, 0xB8, 0x3A, 0xC4, 0x2C,
+	0xD9, 0x52, 0x32, 0x23, 0x5D, 0x54, 0xA6, 0x00,
+	0x6A, 0x5A,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x4F, 0x92, 0xB0, 0xD8, 0x99, 0x4D, 0xAA, 0x9F,
+	0x3F, 0x7D, 0xB0, 0x63, 0x6B, 0xB8, 0x7E, 0x83,
+	0xB4, 0x61, 0x72, 0xF0, 0x37, 0xFD, 0x6D, 0xED,
+	0x21, 0xC5, 0xCF, 0xFF, 0xDB, 0xCE, 0x4D, 0x2D,
+	0x80, 0x77, 0xA6, 0x89, 0x66, 0xCB, 0x7F, 0xBF,
+	0x5F, 0xB2, 0x79, 0xF9, 0x72, 0x3D, 0x83, 0x29,
+	0xA7, 0x47, 0xA0, 0xBB, 0xB8, 0x4E, 0xEE, 0xF0,
+	0xF8, 0x31, 0xD5, 0xE6, 0x80, 0x38, 0x4B, 0xE0,
+	0x2B, 0x9B, 0xAD, 0x3E, 0xAA, 0x20, 0x29, 0x43,
+	0x1E, 0xC7, 0x85, 0x30, 0x56, 0xBB, 0x54, 0xA1,
+	0x2D, 0x68, 0xBF, 0x26, 0x14, 0x79, 0x91, 0x27,
+	0x5E, 0xFF, 0x78, 0xEB, 0x14, 0x5B, 0x1B, 0x14,
+	0x29, 0xB1, 0x00, 0x90, 0xC2, 0x37, 0xF5, 0x4C,
+	0x24, 0x2E, 0x00, 0x4D, 0xBA, 0x04, 0xD0, 0xCA,
+	0x12, 0xA9, 0xFE, 0xAD, 0xA8, 0x2F, 0x6F, 0xD8,
+	0xB4, 0xAF, 0x49, 0x05, 0x85, 0xB7, 0x99, 0x8A,
+	0x9F, 0xA9, 0xB8, 0x04, 0x8D, 0xB5, 0x23, 0x32,
+	0xB1, 0x1A, 0xAD, 0xA2, 0xBA, 0x91, 0x57, 0x31,
+	0x3C, 0xA8, 0xA2,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x4F, 0x52, 0xB9, 0xD8, 0xB9, 0x4D, 0xAA, 0x9F,
+	0xAE, 0x6E, 0xB0, 0x63, 0x6B, 0xB8, 0x7E, 0x83,
+	0x94, 0x30, 0x7B, 0xF0, 0xA7, 0x1D, 0x71, 0xAD,
+	0x21, 0xC5, 0xCF, 0xC7, 0xDE, 0x2E, 0x99, 0x6D,
+	0x80, 0x57, 0xA6, 0x89, 0x66, 0xCB, 0x7F, 0x98,
+	0x69, 0x8A, 0x78, 0xFB, 0x73, 0x3C, 0xED, 0x2B,
+	0x00, 0x57, 0xC0, 0x4E, 0x5B, 0xA0, 0x6E, 0xDA,
+	0x90, 0xF5, 0x1A, 0xDF, 0xB6, 0x40, 0x25, 0x0B,
+	0x6C, 0xC7, 0xE8, 0x42, 0x73, 0x2E, 0x7A, 0x91,
+	0xC3, 0xAC, 0x86, 0xE3, 0xFE, 0x92, 0xAF, 0x84,
+	0xE1, 0xEF, 0x2D, 0xF3, 0xC8, 0x94, 0x37, 0x7F,
+	0x97, 0x5E, 0xCB, 0xAB, 0xC2, 0xD8, 0x6E, 0x06,
+	0xE8, 0xDF, 0xE8, 0xF8, 0x67, 0x1F, 0x73, 0xC8,
+	0x8C, 0xCB, 0xCB, 0x6A, 0xE2, 0x21, 0x1E, 0x14,
+	0x08, 0x7C, 0x2B, 0x0E, 0x89, 0x2A, 0x5B, 0x4D,
+	0xDC, 0x15, 0x5C, 0x0E, 0xFA, 0xEF, 0xCF, 0xA0,
+	0xF1, 0x6D, 0x77, 0xF1, 0xCF, 0x9B, 0x8A, 0x66,
+	0x9A, 0x93, 0x81, 0xDA, 0x74, 0xFF, 0x82, 0xCA,
+	0x56, 0xAA, 0xD1, 0x96,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x4F, 0x52, 0xB9, 0xD9, 0x39, 0x4E, 0xAA, 0x9F,
+	0xEE, 0xAC, 0xB3, 0x63, 0x6B, 0xB8, 0x7E, 0x83,
+	0xBC, 0x31, 0x3B, 0xF0, 0xA7, 0x4D, 0x01, 0x9D,
+	0x29, 0xC1, 0xCF, 0xC7, 0xE2, 0x2E, 0x69, 0x9D,
+	0x81, 0x57, 0x8E, 0x95, 0x66, 0xCB, 0x7F, 0x98,
+	0x69, 0xAE, 0x44, 0x87, 0x67, 0x3C, 0xED, 0x04,
+	0x9B, 0x36, 0x0B, 0x6C, 0xC1, 0x09, 0xC8, 0x2A,
+	0xBF, 0x35, 0x42, 0x64, 0xB3, 0x4A, 0xEF, 0x0F,
+	0x5C, 0x91, 0xC9, 0x51, 0xCF, 0x7E, 0xDE, 0xE1,
+	0xA2, 0x21, 0xAD, 0x73, 0xD2, 0xCF, 0xF0, 0xBA,
+	0xDD, 0xB3, 0x9E, 0x59, 0x91, 0x84, 0x4F, 0x24,
+	0x79, 0x3A, 0xF3, 0x4B, 0xA4, 0x2B, 0xB1, 0x34,
+	0x69, 0xD4, 0x89, 0x6C, 0x13, 0xEA, 0x20, 0xAA,
+	0x89, 0x2E, 0x79, 0x69, 0x45, 0x19, 0x00, 0x92,
+	0x40, 0xD5, 0x7E, 0x49, 0x1D, 0xCD, 0x47, 0x89,
+	0x7C, 0xFF, 0xC6, 0xF3, 0xCF, 0xA4, 0xFD, 0x19,
+	0x66, 0x6B, 0x9A, 0x41, 0x0C, 0x1F, 0xCD, 0x3D,
+	0xA1, 0x10, 0xCE, 0x8C, 0x25, 0x38, 0x15, 0x34,
+	0x39, 0x8F, 0x42, 0xB3, 0x0E,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x4F, 0x52, 0xB9, 0xD9, 0x29, 0x4E, 0xAA, 0x9F,
+	0xEE, 0xAC, 0x97, 0x57, 0x6B, 0xB8, 0x7E, 0x83,
+	0x9D, 0xB5, 0x3F, 0xF0, 0xB4, 0x4D, 0x81, 0x9E,
+	0x24, 0xCC, 0xCF, 0xC7, 0xE2, 0x6E, 0x4A, 0x8D,
+	0x81, 0x17, 0x8E, 0x15, 0x60, 0xCB, 0x7F, 0x98,
+	0xB9, 0xAE, 0x54, 0xC5, 0xA4, 0x38, 0xED, 0x24,
+	0x90, 0xA4, 0x84, 0x10, 0xC4, 0x7F, 0x7A, 0x4E,
+	0x8D, 0xEB, 0x7D, 0xEC, 0x8C, 0x4A, 0xEF, 0x93,
+	0xCD, 0x17, 0xD4, 0x75, 0xAB, 0x3B, 0x9E, 0x48,
+	0x1B, 0x3A, 0x8D, 0x8B, 0xDA, 0x85, 0xB0, 0xE5,
+	0xF0, 0x4E, 0x17, 0x2E, 0x87, 0xF5, 0xBC, 0x4F,
+	0xBD, 0x06, 0x03, 0x6D, 0x28, 0x0C, 0x39, 0xE8,
+	0x9D, 0xE7, 0x55, 0x55, 0x4B, 0x29, 0x09, 0xAB,
+	0x77, 0x6D, 0x91, 0xF7, 0x4E, 0x86, 0xEF, 0xFF,
+	0xCF, 0x08, 0x57, 0x9B, 0x2B, 0x96, 0x75, 0x29,
+	0x85, 0x1F, 0x0D, 0xA7, 0x46, 0xA9, 0x1C, 0x8F,
+	0x60, 0x8F, 0x26, 0xC9, 0xB3, 0xD1, 0x0E, 0x70,
+	0xAD, 0x3D, 0xA0, 0x5E, 0xD4, 0x33, 0x70, 0xE3,
+	0x99, 0x6D, 0xB0, 0x5F, 0x7F, 0xEB,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x4F, 0x52, 0xB9, 0xD9, 0x09, 0x4C, 0xAA, 0xD7,
+	0xEE, 0xAC, 0xD7, 0x17, 0x4F, 0xB8, 0x7E, 0x83,
+	0x94, 0x1F, 0x3B, 0xF0, 0xB4, 0x5D, 0x81, 0x8E,
+	0x34, 0xDD, 0xE7, 0xC7, 0xE2, 0x6E, 0x0E, 0x8F,
+	0x81, 0x17, 0x8E, 0x15, 0xE8, 0x8B, 0x7E, 0x98,
+	0x9D, 0x3F, 0x50, 0xC4, 0xE0, 0xBA, 0xEF, 0x24,
+	0x3C, 0xC8, 0x3C, 0xD8, 0x4D, 0x3F, 0x5E, 0x26,
+	0x2E, 0xDD, 0x70, 0x9F, 0xC9, 0xBA, 0x9F, 0xBF,
+	0xC5, 0x87, 0xF0, 0xB5, 0xA2, 0x3D, 0x54, 0x60,
+	0x0D, 0x7E, 0x8E, 0xD9, 0xD1, 0x8D, 0xB7, 0x27,
+	0x7C, 0x78, 0x2F, 0x56, 0x20, 0xFF, 0xF0, 0x84,
+	0x0D, 0x4A, 0xA7, 0x96, 0x21, 0xE0, 0x59, 0xE9,
+	0x12, 0x74, 0x76, 0x8B, 0x31, 0xAD, 0x84, 0x89,
+	0x84, 0x01, 0xC4, 0x46, 0xEA, 0xDE, 0x0F, 0xA1,
+	0x1C, 0x4F, 0x82, 0xC1, 0xD8, 0x35, 0x6A, 0x7D,
+	0xD2, 0x2F, 0xC0, 0x41, 0x35, 0xD5, 0xE9, 0x81,
+	0x39, 0x39, 0x2C, 0xE7, 0xC5, 0x5D, 0xAB, 0xC1,
+	0xC0, 0x42, 0xA9, 0xE5, 0xAA, 0x7D, 0x09, 0x04,
+	0x66, 0x62, 0x1B, 0x39, 0x49, 0xF1, 0xB8,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0x0F, 0x14, 0x46,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x67, 0x52, 0xB9, 0xD9, 0x09, 0x4C, 0xAA, 0xD7,
+	0xEE, 0xAC, 0xD7, 0x17, 0xDF, 0xAC, 0x7E, 0x83,
+	0xB4, 0x39, 0xBF, 0x60, 0xF5, 0x5D, 0x81, 0x8E,
+	0x15, 0xFD, 0xC2, 0xD0, 0xEA, 0x6E, 0x0E, 0xC7,
+	0x81, 0x17, 0x8E, 0x1C, 0xE8, 0x9B, 0x5C, 0x98,
+	0x9D, 0x3F, 0x12, 0xC4, 0xF0, 0xF2, 0xDE, 0x24,
+	0x28, 0xAB, 0x2A, 0x75, 0x96, 0x7C, 0x76, 0xB2,
+	0xD3, 0xCD, 0x24, 0x64, 0x0C, 0x79, 0xA3, 0x64,
+	0x7A, 0xB6, 0x48, 0x91, 0x31, 0x39, 0x76, 0xA9,
+	0x6F, 0x5A, 0x86, 0xB8, 0x6A, 0xF3, 0x3E, 0x02,
+	0xC0, 0x0D, 0x54, 0x8A, 0x9A, 0x53, 0x24, 0x74,
+	0x1C, 0x2F, 0x3E, 0x71, 0xC1, 0x39, 0xCD, 0x5F,
+	0x80, 0x98, 0xC3, 0x15, 0x29, 0x7C, 0x30, 0x32,
+	0xB0, 0x1B, 0x4C, 0x48, 0x7E, 0x77, 0x93, 0x17,
+	0x09, 0x7A, 0x14, 0xD4, 0x39, 0xFB, 0x2B, 0x58,
+	0x3D, 0x78, 0x57, 0x40, 0x13, 0xBD, 0x72, 0x78,
+	0xA5, 0x20, 0xE1, 0xE6, 0x76, 0xAC, 0xB2, 0x46,
+	0xDC, 0x88, 0xCB, 0xC4, 0xDB, 0x14, 0x6A, 0x54,
+	0x5D, 0x92, 0x2A, 0x85, 0x03, 0x64, 0x32, 0x90,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0xCF, 0x10, 0x46,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x67, 0x52, 0xB9, 0xD9, 0x09, 0x4C, 0xAA, 0xD7,
+	0xEE, 0xAD, 0xD7, 0x17, 0xDF, 0xAC, 0x3E, 0x81,
+	0xB4, 0x39, 0xBF, 0x61, 0xF5, 0x5D, 0xCD, 0x9A,
+	0x15, 0xF5, 0xE3, 0x58, 0x72, 0x4E, 0x08, 0xC5,
+	0xCD, 0x17, 0xAC, 0xA5, 0xFB, 0x9F, 0x5C, 0x98,
+	0x9D, 0x3F, 0x12, 0x44, 0xF9, 0xF2, 0xCE, 0x04,
+	0x27, 0x8B, 0x4E, 0x8C, 0x04, 0xCD, 0xC0, 0xBE,
+	0x85, 0x2B, 0x39, 0xAC, 0xAF, 0x3B, 0x23, 0xA4,
+	0x12, 0x97, 0x08, 0xD1, 0xC3, 0x63, 0xAA, 0x63,
+	0xC3, 0xF0, 0xEF, 0x31, 0xC7, 0x71, 0xAE, 0x0B,
+	0xEE, 0xA4, 0x1B, 0xA5, 0x42, 0xCB, 0xB2, 0x43,
+	0x1F, 0xC1, 0x38, 0xBF, 0x16, 0x80, 0xE4, 0x59,
+	0x02, 0x3D, 0x36, 0xBC, 0x68, 0xDB, 0xCD, 0x51,
+	0x21, 0x7F, 0xE8, 0x86, 0xE7, 0xED, 0xE7, 0x2B,
+	0xA4, 0xD6, 0xDB, 0x35, 0x33, 0x94, 0x0F, 0x94,
+	0xDF, 0xFF, 0x28, 0x4D, 0xDD, 0xA0, 0x4D, 0x48,
+	0xD6, 0x61, 0x9F, 0x47, 0x50, 0xBD, 0x39, 0x1C,
+	0xD2, 0x37, 0x0B, 0x19, 0xEE, 0x33, 0x4D, 0x81,
+	0xC4, 0xB2, 0x79, 0xD8, 0x55, 0x1C, 0xA0, 0x0F,
+	0x41,
+
+	0xD3, 0x7F, 0x45, 0x56, 0xD4, 0xCF, 0x20, 0x73,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x67, 0x52, 0xB9, 0xD9, 0x09, 0x4C, 0xAA, 0xD7,
+	0xEE, 0xAD, 0xF5, 0x17, 0xDF, 0xAC, 0x3E, 0x01,
+	0xC7, 0x2F, 0xBF, 0x51, 0xA1, 0x5D, 0x89, 0xC8,
+	0x07, 0xF5, 0xE3, 0x10, 0x7E, 0xCE, 0x48, 0x57,
+	0xCD, 0x47, 0xAF, 0xA5, 0xB6, 0x82, 0x5D, 0x98,
+	0xA7, 0x3F, 0x12, 0x44, 0xF9, 0x90, 0xCE, 0x24,
+	0x06, 0xA1, 0xAC, 0x88, 0xA1, 0xC6, 0xD7, 0x64,
+	0x0D, 0x1E, 0xB1, 0xD4, 0x80, 0xA9, 0x53, 0x09,
+	0x31, 0x87, 0x8B, 0x81, 0xE3, 0xE2, 0xF9, 0xAB,
+	0x47, 0xC0, 0xD9, 0x5A, 0x9B, 0x7A, 0xEE, 0xCB,
+	0x75, 0x0C, 0x28, 0x1A, 0x1B, 0x6F, 0xC8, 0xAD,
+	0x5F, 0x3A, 0xF6, 0xBC, 0x91, 0xEE, 0xB4, 0x6F,
+	0x50, 0xA6, 0x91, 0x16, 0x1B, 0xF8, 0xE3, 0x12,
+	0x7D, 0xC8, 0xF4, 0x1D, 0x63, 0xD7, 0x95, 0x28,
+	0x8E, 0x44, 0x4C, 0x1E, 0x7A, 0x52, 0xD5, 0xCF,
+	0xFE, 0xED, 0xBA, 0x26, 0xC5, 0x25, 0xA7, 0x77,
+	0xBB, 0x97, 0x54, 0x0A, 0xD2, 0xCF, 0xEE, 0xCA,
+	0x5F, 0xCB, 0x3C, 0x03, 0xA1, 0x7D, 0x07, 0x4B,
+	0x4E, 0x06, 0xD9, 0x6F, 0x4D, 0xF8, 0x0C, 0x43,
+	0xF2, 0x0A,
+
+	0xF6, 0x7F, 0x45, 0x56, 0xD4, 0xCF, 0x60, 0x36,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x67, 0x52, 0xB9, 0xD9, 0x09, 0x4C, 0xAA, 0xD7,
+	0xEE, 0xAF, 0xF5, 0x1F, 0xDF, 0xAC, 0x3E, 0x01,
+	0x93, 0x85, 0xA3, 0x13, 0xA0, 0xC0, 0x89, 0x4C,
+	0xAF, 0xF5, 0xE3, 0x90, 0x2F, 0xC6, 0xC8, 0xD5,
+	0xCD, 0x47, 0xFB, 0xAF, 0xE7, 0x5B, 0x50, 0x98,
+	0x8F, 0x1D, 0x12, 0x44, 0xF9, 0x90, 0x84, 0x24,
+	0x56, 0x71, 0xB2, 0x9E, 0xB4, 0xA7, 0x67, 0xA2,
+	0x94, 0x72, 0x0A, 0xAE, 0x80, 0x21, 0xEF, 0x0E,
+	0x3B, 0xA6, 0x91, 0x4F, 0xF9, 0x7A, 0x02, 0xD9,
+	0x27, 0xC3, 0x94, 0x21, 0x18, 0x9B, 0x24, 0xCC,
+	0x14, 0x45, 0xED, 0x88, 0xE1, 0x28, 0x56, 0x4A,
+	0x1B, 0x6D, 0x42, 0x1B, 0xAE, 0xCC, 0xCD, 0x7C,
+	0x3B, 0x94, 0x0F, 0x57, 0xB2, 0x6C, 0xB2, 0xA9,
+	0x28, 0x04, 0xB9, 0x90, 0xE5, 0x4F, 0x54, 0x42,
+	0x07, 0x2A, 0xD4, 0x59, 0x4E, 0xC9, 0x62, 0x0F,
+	0xA4, 0x37, 0x27, 0x2E, 0x93, 0x05, 0x12, 0x06,
+	0xB0, 0xC9, 0x0E, 0x02, 0x2E, 0xC9, 0xDB, 0xB1,
+	0x33, 0x6B, 0x8F, 0xD7, 0x2F, 0x88, 0x85, 0xEB,
+	0xA1, 0xF5, 0xC2, 0xDC, 0x48, 0x0C, 0x40, 0xC0,
+	0x91, 0x2F, 0xCD,
+
+	0x63, 0x6A, 0x45, 0x56, 0xD4, 0xCF, 0x60, 0xB6,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x67, 0x52, 0xB9, 0xD9, 0x09, 0x4C, 0xAA, 0xD7,
+	0xEE, 0x2F, 0xF7, 0x1F, 0xF6, 0xAC, 0x3E, 0x01,
+	0x83, 0xDD, 0x12, 0x10, 0x21, 0xD4, 0xCF, 0x4C,
+	0x87, 0x7D, 0xE3, 0x90, 0x2F, 0xA2, 0x48, 0xC5,
+	0xCD, 0x47, 0xFB, 0xF7, 0x6E, 0x2F, 0x35, 0xCF,
+	0x8F, 0x9D, 0x3E, 0x44, 0xF9, 0x90, 0x84, 0x04,
+	0xAD, 0x08, 0x5A, 0xDE, 0xA6, 0xEC, 0x0B, 0x43,
+	0xC8, 0x58, 0x62, 0xE9, 0x00, 0x94, 0x96, 0xAE,
+	0x5E, 0x7B, 0x14, 0xA2, 0x09, 0xFA, 0x5B, 0x23,
+	0x23, 0xB3, 0xFE, 0x0A, 0x80, 0xAC, 0xBC, 0x66,
+	0x96, 0x2C, 0x77, 0x4B, 0xEF, 0xD5, 0x9D, 0x1F,
+	0xFD, 0xAB, 0x72, 0xB4, 0xD9, 0x9B, 0x11, 0x47,
+	0x38, 0x5B, 0x8F, 0x17, 0x2C, 0x7B, 0xAA, 0x91,
+	0x8B, 0x39, 0x84, 0x0C, 0x13, 0x1B, 0x6A, 0x8E,
+	0x60, 0xE8, 0xE6, 0xD4, 0x7E, 0xDE, 0x06, 0x0A,
+	0x62, 0x68, 0x92, 0x3E, 0xFB, 0x12, 0x6D, 0xD7,
+	0x1C, 0x84, 0xE5, 0x48, 0x21, 0x83, 0x62, 0x78,
+	0x8E, 0x29, 0xC3, 0x3A, 0x16, 0x6B, 0x00, 0x22,
+	0x56, 0x54, 0x6D, 0xAD, 0x8A, 0x32, 0x0F, 0xE2,
+	0x56, 0x9A, 0x1B, 0xEF,
+
+	0x23, 0xAA, 0x40, 0x56, 0xD4, 0xCF, 0x60, 0xB6,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x67, 0x52, 0xB9, 0xD9, 0x09, 0x4C, 0xAA, 0xD7,
+	0xEE, 0x2F, 0xD7, 0x1F, 0xF6, 0xA5, 0x3E, 0x01,
+	0x83, 0x84, 0x4E, 0xA0, 0x60, 0xD4, 0xCE, 0xDB,
+	0x9F, 0x7D, 0x63, 0x90, 0x2F, 0x02, 0x18, 0xC4,
+	0xC8, 0x4F, 0xFB, 0xF7, 0x32, 0x2F, 0x45, 0x3E,
+	0x85, 0x9D, 0xA6, 0x06, 0xF9, 0x90, 0x84, 0x84,
+	0x5D, 0x1A, 0x8F, 0x56, 0xC1, 0x58, 0x26, 0x6C,
+	0x1A, 0x17, 0xDD, 0x00, 0x59, 0x2E, 0x5F, 0xEE,
+	0x50, 0x82, 0x09, 0xED, 0x61, 0xB7, 0xC4, 0x7D,
+	0x29, 0xB0, 0xFF, 0x77, 0xD5, 0x1F, 0x3D, 0xDC,
+	0xFE, 0xF3, 0x4F, 0x2D, 0x4B, 0x38, 0x47, 0x75,
+	0xD6, 0xE5, 0x52, 0x34, 0xE1, 0x6B, 0xCA, 0x2F,
+	0xBC, 0x6A, 0xD9, 0x7D, 0x18, 0x40, 0x28, 0x7C,
+	0xFE, 0xD2, 0xF2, 0x87, 0xD3, 0x52, 0xDD, 0x96,
+	0x67, 0x74, 0xC3, 0x01, 0x38, 0x50, 0x16, 0x47,
+	0x5E, 0xB5, 0x18, 0xEC, 0x80, 0x3D, 0xCB, 0x2D,
+	0xF6, 0xC2, 0x9A, 0x66, 0x1F, 0xA8, 0x69, 0x70,
+	0x27, 0xB9, 0x04, 0x41, 0x33, 0x29, 0x76, 0xD3,
+	0xF6, 0xC4, 0x25, 0x67, 0x92, 0x3F, 0xB8, 0x9F,
+	0x27, 0x68, 0xBC, 0x7E, 0xFA,
+
+	0x23, 0xAA, 0x72, 0x60, 0xD4, 0xCF, 0x60, 0xB6,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x67, 0x52, 0xB9, 0xD9, 0x09, 0x4C, 0xAA, 0xD7,
+	0xEE, 0x2F, 0xD7, 0x1F, 0xFF, 0xA5, 0x72, 0x01,
+	0xDB, 0x84, 0xDE, 0xC0, 0x63, 0xD1, 0xCF, 0xC9,
+	0xB6, 0x7F, 0x69, 0x18, 0x35, 0x02, 0xD8, 0xCE,
+	0x45, 0xDA, 0xFB, 0xF7, 0x32, 0x4F, 0x46, 0xB6,
+	0x85, 0xBD, 0xA6, 0x86, 0xF9, 0x90, 0x84, 0x84,
+	0x5E, 0x90, 0x7B, 0x4B, 0xE8, 0xC4, 0x40, 0x7F,
+	0x46, 0x65, 0xF5, 0x50, 0x75, 0x6A, 0x9A, 0x80,
+	0xD2, 0xC6, 0xC6, 0xA4, 0xD1, 0x4B, 0x85, 0x5F,
+	0xC1, 0x66, 0x2C, 0x67, 0x47, 0x5C, 0x6B, 0xA0,
+	0x70, 0xE0, 0xC4, 0xDB, 0x91, 0xE7, 0x7F, 0xE6,
+	0x24, 0x45, 0x9C, 0x87, 0x92, 0x47, 0xF9, 0x7F,
+	0x87, 0xF3, 0xD8, 0xD1, 0xBF, 0x34, 0x78, 0x5A,
+	0xD2, 0x64, 0xAD, 0xFB, 0x17, 0x02, 0x9F, 0xBD,
+	0xA9, 0x52, 0x06, 0xF0, 0x6D, 0x94, 0x4C, 0x83,
+	0x66, 0xD5, 0x34, 0x43, 0xAD, 0x73, 0xA4, 0x44,
+	0x03, 0xC1, 0x78, 0x1F, 0x76, 0x2D, 0x1C, 0xCF,
+	0x92, 0xCB, 0x9E, 0x26, 0x53, 0x6B, 0x0A, 0x13,
+	0xA0, 0xCE, 0xFF, 0x06, 0xE3, 0x95, 0xD9, 0x2C,
+	0x86, 0xA2, 0x1C, 0x5A, 0xF8, 0x03,
+
+	0x23, 0xAA, 0x32, 0x24, 0xF2, 0xCF, 0x60, 0xB6,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x67, 0x52, 0xB9, 0xD9, 0x09, 0x4C, 0xAA, 0xD7,
+	0xEE, 0x2F, 0xD7, 0x1F, 0xDF, 0xA4, 0x72, 0x45,
+	0xF9, 0x45, 0xDE, 0x41, 0x07, 0x7F, 0xC7, 0xC9,
+	0x22, 0xFD, 0xE1, 0x58, 0x15, 0x01, 0xD8, 0xCE,
+	0xD4, 0x8B, 0x61, 0xB7, 0x32, 0x4F, 0x22, 0xB4,
+	0x85, 0x3D, 0xEA, 0x86, 0x31, 0x92, 0x84, 0x84,
+	0x99, 0x74, 0x38, 0x84, 0x6A, 0x45, 0x04, 0x38,
+	0x6A, 0x73, 0x3E, 0xD4, 0x05, 0x20, 0x92, 0xDB,
+	0xC9, 0xF6, 0xD5, 0x49, 0x0D, 0x3A, 0x0F, 0x7B,
+	0xE8, 0xEA, 0xE0, 0x6E, 0xE6, 0x64, 0x89, 0x41,
+	0x45, 0x16, 0xD8, 0x5D, 0x9B, 0x70, 0xB1, 0x53,
+	0x1E, 0xA0, 0xBE, 0xB5, 0x87, 0x83, 0x8C, 0xD8,
+	0xF3, 0x90, 0x65, 0x90, 0x84, 0xAA, 0xBA, 0xF0,
+	0xDB, 0x9F, 0x6C, 0x32, 0x23, 0xC1, 0x66, 0xD8,
+	0xF7, 0x15, 0xB4, 0x42, 0x41, 0xA4, 0x7C, 0x92,
+	0x6C, 0xA0, 0xE3, 0xB0, 0x86, 0x2D, 0xCC, 0x31,
+	0xC7, 0xCC, 0x67, 0xD6, 0xF8, 0xA3, 0xDC, 0x68,
+	0x9C, 0xF0, 0xFE, 0xC0, 0xD2, 0x90, 0x0D, 0x9B,
+	0xFE, 0x8E, 0xE0, 0x5F, 0x5C, 0xD5, 0x02, 0xC1,
+	0x20, 0x16, 0x5E, 0x80, 0xC8, 0x94, 0xB1,
+
+	0x23, 0xAA, 0x32, 0x24, 0x70, 0xD9, 0x60, 0xB6,
+	0xE2, 0x74, 0xD6, 0x07, 0x2D, 0x22, 0xEA, 0x32,
+	0x67, 0x52, 0xB9, 0xD9, 0x09, 0x4C, 0xAA, 0xD7,
+	0xEE, 0x2F, 0xD7, 0x1F, 0xDF, 0xA4, 0x78, 0x45,
+	0x79, 0x47, 0x84, 0x41, 0x27, 0x16, 0x46, 0xC9,
+	0x02, 0xF9, 0x85, 0xD8, 0x1F, 0xA8, 0xDB, 0xCE,
+	0xD5, 0x2B, 0xD4, 0xC2, 0x32, 0x4E, 0x22, 0xDC,
+	0x85, 0x3D, 0xEA, 0x87, 0x31, 0x02, 0xAE, 0x84,
+	0x6E, 0x70, 0x65, 0x85, 0xD0, 0x1B, 0x21, 0x11,
+	0xEE, 0xCD, 0x36, 0x44, 0xB5, 0xC6, 0x36, 0xAA,
+	0x69, 0x77, 0xD3, 0x76, 0x80, 0x3D, 0xF4, 0x11,
+	0xD5, 0xBE, 0x7A, 0x95, 0x60, 0x43, 0x2D, 0x03,
+	0xE0, 0x60, 0x45, 0x02, 0xCD, 0x22, 0x9E, 0xB8,
+	0x61, 0x22, 0x51, 0x77, 0x1E, 0x0D, 0xD9, 0xB5,
+	0x49, 0xFB, 0x21, 0x6D, 0x82, 0xEE, 0xB8, 0x57,
+	0x99, 0x9F, 0x70, 0xFC, 0x99, 0xB7, 0x86, 0x9F,
+	0xBD, 0x55, 0x2E, 0x90, 0x14, 0x0C, 0xE5, 0x0C,
+	0xE9, 0x13, 0xA8, 0x16, 0x5F, 0xC1, 0x4E, 0x5E,
+	0xD2, 0xF2, 0xB6, 0xC9, 0x62, 0xEC, 0xE2, 0xA4,
+	0x12, 0x67, 0x5F, 0x6D, 0x38, 0xF4, 0x50, 0x9F,
+	0xF5, 0x82, 0xD5, 0x00, 0xED, 0x88, 0x95, 0xA9,
+	0xCD, 0xA4, 0x6F, 0x07, 0xD3, 0x47, 0xC1, 0x5E,
+
+	0x95, 0x56, 0x3D, 0x00, 0x2B, 0x53, 0xCF, 0x19,
+	0x47, 0x6D, 0x09, 0xE9, 0x1D, 0x65, 0xF5, 0x9A,
+	0xFA, 0x59, 0x54, 0x8C, 0xAB, 0xEF, 0x38, 0xE5,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0xBF, 0xB0, 0x42, 0xA6, 0xA6, 0xE0, 0xC6, 0xD8,
+	0x40, 0x4C, 0x06, 0x89, 0x6F, 0x87, 0x1F, 0x9E,
+	0x38, 0x3F, 0xBF, 0xE8, 0x5F, 0xA7, 0xDD, 0x97,
+	0xDA, 0xDA, 0x7F, 0x75, 0x71, 0xB7, 0x08, 0x95,
+	0xFB, 0x43, 0xB3, 0x9B, 0x7F, 0x5F, 0x51, 0xCD,
+	0x4B, 0xC8, 0xF6, 0x6A, 0xA8, 0xF8, 0x18, 0x22,
+	0x98, 0x4F, 0x13, 0xB8, 0x7C, 0xDA, 0xA6, 0xD1,
+	0x83, 0x04, 0xD3, 0x13, 0xDF, 0x0B, 0xC0, 0x0E,
+	0x9F, 0xFA, 0x13, 0x87, 0xA6, 0x6F, 0x3D, 0x70,
+	0x00, 0xC7, 0xDC, 0xE8, 0x8A, 0xF4, 0xAB, 0xBE,
+	0x3D, 0x30, 0xC7, 0x3B, 0x43, 0x0C, 0x80, 0x1E,
+	0xF8, 0x5E, 0xAC, 0xAA, 0xB5, 0x55, 0x7F, 0xAD,
+	0x99, 0xFF, 0x43, 0xAA, 0xD9, 0x0E, 0xBA, 0xDC,
+	0x36, 0x6F, 0xA6, 0xB4, 0xDF, 0x9D, 0xD2, 0xED,
+	0xD5, 0x68, 0x05, 0xC6, 0xD3, 0x34, 0x21, 0xDD,
+	0xA7, 0x1B, 0x05, 0xF4, 0xA8, 0x49, 0xFE, 0x8B,
+	0x9C, 0xEA, 0x69, 0xE9, 0x64, 0x39, 0x9A, 0x94,
+	0x58, 0x76, 0x56, 0x67, 0xA8, 0x46, 0xA6, 0x61,
+	0xDE,
+
+	0x9E, 0x56, 0x77, 0x00, 0x2B, 0x53, 0xCF, 0x19,
+	0x47, 0x6D, 0x09, 0xE9, 0x1D, 0x65, 0xE7, 0xAD,
+	0xFA, 0x59, 0x54, 0x8C, 0xAB, 0xEF, 0x38, 0xE5,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x29, 0xB0, 0x42, 0xA6, 0xA6, 0xA4, 0xC6, 0x18,
+	0x83, 0x4C, 0x07, 0xA9, 0x13, 0x83, 0x73, 0xEC,
+	0x33, 0x3F, 0x3F, 0xAF, 0x76, 0x87, 0xD9, 0x8E,
+	0xDA, 0xAA, 0x74, 0x35, 0xBC, 0xFA, 0x08, 0x94,
+	0x7A, 0x89, 0xFA, 0x92, 0xCC, 0xBE, 0x9F, 0x09,
+	0x6F, 0x9B, 0x97, 0x68, 0x04, 0xB3, 0x69, 0x11,
+	0xB6, 0x12, 0x50, 0xF3, 0xDB, 0x5A, 0x24, 0xC6,
+	0x70, 0x4C, 0xED, 0x0A, 0x9C, 0xDE, 0x21, 0x1D,
+	0x9A, 0xA3, 0x90, 0x0C, 0xD4, 0xB0, 0x24, 0xE3,
+	0xEB, 0xA7, 0xF0, 0x34, 0x3B, 0xBC, 0x6E, 0xCF,
+	0x97, 0x28, 0x85, 0x33, 0x53, 0x4A, 0x5D, 0x00,
+	0x79, 0x24, 0x6B, 0xDC, 0x5B, 0x2A, 0x23, 0x50,
+	0xD0, 0x54, 0x5F, 0x36, 0xCD, 0x82, 0x91, 0xC8,
+	0xAC, 0xC6, 0x17, 0x5E, 0x26, 0x11, 0xE9, 0x3A,
+	0x94, 0x23, 0x68, 0xA7, 0x3B, 0xAA, 0xC4, 0x4E,
+	0x66, 0x3C, 0xA0, 0x2F, 0x68, 0x9D, 0x54, 0xB5,
+	0x59, 0xA3, 0x78, 0xF7, 0xF8, 0x49, 0x2E, 0xB8,
+	0x0C, 0x62, 0xE1, 0x2D, 0x04, 0xD6, 0x95, 0x34,
+	0x18, 0xCD,
+
+	0xBE, 0x54, 0xF7, 0x02, 0x2B, 0x53, 0xCF, 0x19,
+	0x60, 0x6D, 0x09, 0xE9, 0x1D, 0x65, 0xA7, 0xCB,
+	0xFA, 0x59, 0x54, 0x8C, 0xAB, 0xEF, 0x38, 0xE5,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x89, 0x98, 0x43, 0xA6, 0xA6, 0x24, 0xC8, 0x18,
+	0xF7, 0xCA, 0x00, 0xA9, 0x34, 0x9F, 0x73, 0x75,
+	0xA3, 0x2F, 0x3F, 0xA7, 0xFA, 0x06, 0x7D, 0x86,
+	0xDA, 0xAA, 0x00, 0x15, 0xE1, 0x6B, 0x10, 0x95,
+	0xBA, 0xD2, 0x39, 0x4E, 0x51, 0x55, 0xE3, 0xDE,
+	0x45, 0x48, 0xF1, 0x68, 0xD2, 0x50, 0xFA, 0x96,
+	0xAA, 0x96, 0x6D, 0x3B, 0xE5, 0x7F, 0xDF, 0x11,
+	0x8E, 0x0B, 0x86, 0xD5, 0x5F, 0x18, 0x80, 0xD0,
+	0x51, 0x34, 0xCB, 0x96, 0x9B, 0xBB, 0x8A, 0x9D,
+	0x9E, 0x21, 0x9B, 0x41, 0x75, 0x2E, 0xFA, 0x56,
+	0xBB, 0xFF, 0xDA, 0x8B, 0x16, 0xCE, 0xDC, 0x6E,
+	0x8E, 0x31, 0xCB, 0xA1, 0x20, 0xBE, 0x31, 0xF5,
+	0xD5, 0x31, 0xAF, 0xA6, 0xAF, 0x5D, 0x73, 0xA7,
+	0x7D, 0xFE, 0x13, 0xCA, 0x0D, 0xB5, 0xDF, 0xA6,
+	0xAA, 0xC9, 0xC0, 0x64, 0xF1, 0x94, 0xB8, 0x5D,
+	0xCD, 0xD5, 0xF6, 0x6B, 0xA0, 0xE3, 0xFE, 0xD4,
+	0xD5, 0x65, 0xC1, 0xA1, 0xB1, 0x18, 0xCB, 0xD3,
+	0xD3, 0x95, 0x76, 0xA4, 0x44, 0x4C, 0xD1, 0x63,
+	0x1B, 0x0F, 0x4B,
+
+	0xBE, 0x14, 0xFC, 0x02, 0x07, 0x53, 0xCF, 0x19,
+	0xE2, 0x7A, 0x09, 0xE9, 0x1D, 0x65, 0xA7, 0x4B,
+	0xFA, 0x59, 0x54, 0x8C, 0xAB, 0xEF, 0x38, 0xE5,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x89, 0xC8, 0xDB, 0xA6, 0xA6, 0x24, 0xC8, 0x3C,
+	0xB3, 0xB3, 0x39, 0x83, 0xB4, 0x89, 0x05, 0x75,
+	0xA5, 0x42, 0x35, 0xA7, 0x5A, 0xE6, 0x79, 0x86,
+	0xFA, 0xAA, 0x00, 0x6D, 0xE4, 0x8B, 0xE5, 0x9C,
+	0xE5, 0x42, 0x7F, 0xF4, 0x2C, 0x6F, 0x19, 0x57,
+	0x8B, 0xAD, 0x4C, 0x04, 0x9D, 0x6C, 0x8E, 0x91,
+	0xE5, 0xC6, 0xED, 0x76, 0x24, 0x98, 0x9C, 0x00,
+	0xCA, 0xDC, 0x15, 0xE9, 0x7B, 0x4B, 0xA4, 0x0A,
+	0x1F, 0x23, 0x36, 0xF8, 0x73, 0x80, 0xA6, 0x1A,
+	0x9D, 0x75, 0xA8, 0x05, 0x06, 0x2D, 0xFD, 0xB7,
+	0x31, 0x49, 0xBA, 0xB0, 0x52, 0xF4, 0xF9, 0x6B,
+	0xA3, 0x77, 0xD6, 0xFF, 0xB8, 0xF3, 0x6D, 0x0E,
+	0xA0, 0xB6, 0xD5, 0xB6, 0x02, 0x7A, 0x15, 0x55,
+	0x51, 0x82, 0x8C, 0x21, 0xDB, 0x1D, 0x16, 0x1D,
+	0x92, 0xE4, 0xFC, 0xE0, 0x58, 0x46, 0x8E, 0x0E,
+	0xA7, 0x0E, 0xFE, 0x99, 0xFA, 0xD6, 0x51, 0x91,
+	0x59, 0xCA, 0xF9, 0xF0, 0x43, 0x1F, 0xF7, 0x25,
+	0x5B, 0xAE, 0x5D, 0x0E, 0xA5, 0x4E, 0x91, 0xE8,
+	0x9C, 0xF4, 0xEC, 0x76,
+
+	0xBE, 0x14, 0x3C, 0x00, 0x07, 0x54, 0xCF, 0x19,
+	0xE2, 0xB8, 0x0E, 0xE9, 0x1D, 0x65, 0xA7, 0x4B,
+	0xFA, 0x59, 0x54, 0x8C, 0xAB, 0xEF, 0x38, 0xE5,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x88, 0xC8, 0xBB, 0x84, 0xA6, 0x24, 0xC8, 0xBC,
+	0xB3, 0xBC, 0x45, 0x63, 0x95, 0x09, 0x02, 0x72,
+	0x4D, 0xCE, 0xB5, 0xA6, 0x5A, 0x26, 0x68, 0x86,
+	0xCE, 0xB2, 0x00, 0x6D, 0x98, 0x85, 0x01, 0xDD,
+	0xD9, 0x61, 0x3F, 0x1F, 0xC9, 0xD1, 0x20, 0x33,
+	0xF3, 0x36, 0xA3, 0xCA, 0xFC, 0xBB, 0xA2, 0x48,
+	0xB8, 0xC0, 0xB2, 0xF0, 0x29, 0xEC, 0x42, 0x29,
+	0x07, 0x7F, 0xF2, 0xBD, 0x25, 0x76, 0x57, 0x3C,
+	0x73, 0x85, 0x65, 0x15, 0x97, 0x85, 0x78, 0x4E,
+	0x5F, 0x8B, 0xC2, 0x40, 0xB3, 0xFA, 0xD6, 0xB6,
+	0x58, 0x1E, 0x10, 0x8E, 0x4C, 0x4D, 0x42, 0x9B,
+	0x14, 0x7F, 0x92, 0xE3, 0xE2, 0x15, 0xB7, 0xAF,
+	0x91, 0x64, 0x08, 0xD5, 0x98, 0x0B, 0x6F, 0xB5,
+	0x2E, 0x41, 0x21, 0x8E, 0x13, 0x4C, 0xF2, 0x3F,
+	0x95, 0x37, 0x6A, 0x60, 0xE0, 0xBC, 0xCA, 0xCC,
+	0x42, 0x0D, 0x8F, 0x69, 0x2D, 0xD7, 0x60, 0xA8,
+	0x7F, 0xD4, 0x86, 0xE9, 0x98, 0x50, 0x24, 0x60,
+	0xAA, 0xAC, 0x23, 0xD1, 0x89, 0x12, 0xF8, 0x1F,
+	0x2E, 0xA0, 0x58, 0x6E, 0xF0,
+
+	0xBE, 0x14, 0x3C, 0x00, 0x0B, 0x54, 0xDF, 0x19,
+	0xE2, 0xB8, 0x2E, 0xD1, 0x1D, 0x65, 0xA7, 0x4B,
+	0xFA, 0x59, 0x54, 0x8C, 0xAB, 0xEF, 0x38, 0xE5,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x88, 0xE8, 0xBB, 0x84, 0xE1, 0x24, 0xC8, 0xBC,
+	0x53, 0xBC, 0x25, 0xE2, 0x16, 0x8A, 0x03, 0x7A,
+	0x4F, 0xCE, 0xF1, 0x22, 0x4A, 0x26, 0x68, 0x80,
+	0xC0, 0x3C, 0x81, 0x6D, 0x98, 0x05, 0x02, 0xFD,
+	0xE2, 0x63, 0x0B, 0x1F, 0xE7, 0x55, 0x30, 0x73,
+	0x7B, 0x7A, 0x97, 0x04, 0x7C, 0xDB, 0x27, 0x0F,
+	0x1C, 0x30, 0x35, 0x28, 0x33, 0xC0, 0x48, 0x52,
+	0x2B, 0xB1, 0xFC, 0x33, 0x1B, 0x14, 0x47, 0x32,
+	0x6D, 0x43, 0x93, 0x8B, 0xA1, 0xDC, 0xAD, 0xCF,
+	0x17, 0x1A, 0x12, 0xCE, 0x51, 0x13, 0x16, 0xA0,
+	0xC9, 0x1E, 0x1B, 0x6C, 0xC0, 0x34, 0x4E, 0xB9,
+	0x5D, 0x02, 0xE8, 0x7B, 0xFD, 0xA2, 0x7B, 0x15,
+	0xD7, 0x99, 0x2B, 0x11, 0x2B, 0xCB, 0x2C, 0xCF,
+	0x2C, 0xB2, 0xB8, 0x00, 0x8B, 0x50, 0x6E, 0x96,
+	0xBA, 0x59, 0xAD, 0xA0, 0xD5, 0xE5, 0xA7, 0xED,
+	0x8D, 0x2E, 0x59, 0x83, 0xEA, 0x91, 0x43, 0x7C,
+	0xFC, 0xA1, 0xAA, 0xF5, 0xC3, 0x81, 0x92, 0xCC,
+	0x87, 0xFB, 0x54, 0x4A, 0x20, 0x7F, 0x69, 0x2C,
+	0x7B, 0x9F, 0x20, 0xD7, 0xF0, 0xFD,
+
+	0xBE, 0x14, 0x3C, 0x00, 0x2B, 0x50, 0xDF, 0x19,
+	0xE2, 0xB8, 0x2E, 0x91, 0x35, 0x65, 0xA7, 0x4B,
+	0xFA, 0x59, 0x54, 0x8C, 0xAB, 0xEF, 0x38, 0xE5,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x88, 0xE8, 0xAB, 0x84, 0xE1, 0x60, 0xC8, 0xBC,
+	0x53, 0x1D, 0x24, 0x47, 0x90, 0x8C, 0x86, 0x78,
+	0x40, 0xCC, 0xF0, 0x27, 0x6A, 0x36, 0x68, 0x98,
+	0xF0, 0x26, 0xCA, 0xED, 0x9A, 0x05, 0x86, 0xFF,
+	0xC0, 0x6E, 0x44, 0x3B, 0x75, 0x1A, 0x51, 0x23,
+	0x71, 0x1F, 0x84, 0x24, 0x17, 0x03, 0x6D, 0x89,
+	0x1C, 0x24, 0x34, 0x89, 0x30, 0x91, 0x12, 0xD0,
+	0x21, 0xFD, 0x7D, 0x23, 0xC4, 0x7E, 0xC8, 0x5F,
+	0x85, 0xD0, 0xC7, 0xBC, 0x8B, 0x04, 0x23, 0xD7,
+	0x15, 0xFC, 0xB1, 0xF0, 0x34, 0xB5, 0xB2, 0xC9,
+	0xE8, 0x8D, 0x56, 0xCE, 0x1A, 0x77, 0x73, 0x17,
+	0x0E, 0x64, 0x89, 0xE6, 0xE2, 0x06, 0x5C, 0x4A,
+	0x24, 0xC0, 0xC0, 0x42, 0x7E, 0x84, 0xFE, 0x5D,
+	0x52, 0xCA, 0x8B, 0x89, 0xA5, 0xD6, 0x07, 0x64,
+	0xF3, 0x17, 0x49, 0x3A, 0x83, 0x01, 0xF7, 0x33,
+	0x56, 0xDD, 0xC1, 0xA3, 0x4F, 0xD3, 0xAD, 0x4A,
+	0x10, 0x82, 0x73, 0xF5, 0x88, 0x62, 0x7F, 0x13,
+	0xA9, 0x95, 0xD9, 0x37, 0xD8, 0x67, 0x9A, 0x7C,
+	0x42, 0xA2, 0x94, 0x9B, 0xB7, 0x5A, 0x96,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB8, 0x2E, 0x11, 0xAD, 0x7D, 0xA7, 0x4B,
+	0xFA, 0x59, 0x54, 0x8C, 0xAB, 0xEF, 0x38, 0xE5,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x88, 0xE8, 0xAB, 0xA4, 0xE1, 0x70, 0xC0, 0xBC,
+	0xD3, 0x15, 0x46, 0x47, 0xF2, 0x04, 0x2F, 0x7C,
+	0x60, 0xCA, 0xF6, 0x27, 0x6A, 0x3E, 0x68, 0x98,
+	0x35, 0x06, 0xEC, 0x6A, 0x12, 0x05, 0x86, 0x77,
+	0xC8, 0x3F, 0x56, 0x3C, 0x05, 0xCA, 0x95, 0x33,
+	0xF9, 0x1E, 0x0D, 0xC9, 0xF7, 0x6C, 0x45, 0xB8,
+	0xCC, 0x95, 0xA8, 0x45, 0x10, 0xEB, 0xAA, 0xD4,
+	0xA8, 0xF4, 0x2E, 0x66, 0x44, 0x89, 0x58, 0xBB,
+	0x07, 0xB7, 0x17, 0xB5, 0x33, 0xBF, 0x82, 0x2F,
+	0x61, 0xB3, 0xB8, 0x35, 0xF6, 0x68, 0x3E, 0xA9,
+	0x75, 0x0E, 0xE2, 0xDD, 0x11, 0xCD, 0xC4, 0xE5,
+	0x79, 0x41, 0xFE, 0x89, 0x85, 0x86, 0x51, 0xBC,
+	0x8E, 0xA2, 0x0A, 0x05, 0x7C, 0x33, 0x8D, 0x73,
+	0x7F, 0xF6, 0x4D, 0xF8, 0xCF, 0x93, 0xDC, 0x10,
+	0xBB, 0x0F, 0x86, 0xC5, 0x52, 0x01, 0xC1, 0x18,
+	0x76, 0x26, 0xEF, 0x7E, 0x07, 0xB9, 0xE2, 0xF8,
+	0x74, 0xD8, 0x22, 0x58, 0x56, 0xF9, 0x83, 0x95,
+	0x80, 0xED, 0xEF, 0xDD, 0x44, 0xFD, 0x83, 0x63,
+	0xD1, 0x74, 0x2C, 0x11, 0x51, 0x76, 0x5D, 0xF0,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2E, 0x11, 0xAD, 0x7D, 0xE7, 0x4B,
+	0xFA, 0x59, 0x54, 0x8C, 0xAB, 0x2F, 0x30, 0xE5,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x04, 0xE8, 0x9A, 0x96, 0xA3, 0xF0, 0xC0, 0xBC,
+	0xD3, 0x15, 0x46, 0x47, 0xF3, 0x04, 0x3F, 0x73,
+	0x61, 0x8A, 0xFE, 0x0C, 0x6B, 0x36, 0xE4, 0x9C,
+	0x35, 0x36, 0xFD, 0x60, 0x0A, 0x65, 0xA2, 0x73,
+	0xEC, 0x1E, 0x46, 0x17, 0x2E, 0xB4, 0x57, 0x35,
+	0x15, 0x27, 0x75, 0x75, 0x57, 0x6C, 0x5F, 0xB5,
+	0xCC, 0xDD, 0x04, 0x2E, 0xC0, 0xB6, 0x12, 0x90,
+	0x2C, 0xA3, 0xFE, 0xC6, 0x50, 0xE2, 0x6E, 0x1B,
+	0xBE, 0x36, 0xA1, 0x62, 0xBD, 0xF2, 0x97, 0x32,
+	0xE5, 0x4A, 0x98, 0xAC, 0xAA, 0x97, 0xBB, 0xF2,
+	0x5A, 0x9C, 0x3C, 0x0F, 0xC0, 0xF0, 0x87, 0x8B,
+	0xA1, 0xEA, 0x51, 0x3C, 0x67, 0xD4, 0xE3, 0x50,
+	0xEC, 0x54, 0xA7, 0x37, 0xBB, 0x58, 0x94, 0x60,
+	0x60, 0xE5, 0xE8, 0x13, 0xB3, 0x30, 0x9F, 0x75,
+	0x99, 0x29, 0xF2, 0x0F, 0xD8, 0x1F, 0xD0, 0x34,
+	0xED, 0x66, 0xB1, 0xDC, 0x7E, 0x1A, 0x41, 0xC6,
+	0x18, 0x61, 0x56, 0x8C, 0xDF, 0xAB, 0xB4, 0xEA,
+	0xBC, 0xE1, 0x42, 0x71, 0xBC, 0xD2, 0x58, 0xAE,
+	0xD3, 0x2B, 0x4A, 0x78, 0x6D, 0xCC, 0x74, 0x62,
+	0x69,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x6C, 0x11, 0xAD, 0x7D, 0xE7, 0x4B,
+	0xFA, 0x59, 0x54, 0x8C, 0xAB, 0x2F, 0x00, 0xDC,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x04, 0x78, 0x99, 0xB6, 0xED, 0x12, 0xC1, 0xBE,
+	0xD3, 0x15, 0x46, 0x47, 0xF3, 0x34, 0x3F, 0x73,
+	0x42, 0x9F, 0xFD, 0x15, 0xAF, 0x36, 0x40, 0x0C,
+	0x25, 0x36, 0xBD, 0x6B, 0x06, 0x63, 0xA7, 0xFF,
+	0x00, 0x83, 0xD4, 0x9D, 0xE3, 0x94, 0x39, 0x66,
+	0x0F, 0x0F, 0x29, 0x95, 0xB4, 0x5B, 0x9D, 0x57,
+	0xCA, 0xCE, 0x36, 0x05, 0x70, 0x66, 0x24, 0x32,
+	0x66, 0x0A, 0xAE, 0xBD, 0x54, 0x21, 0xFA, 0xA8,
+	0x02, 0x1D, 0xCF, 0x26, 0x15, 0x22, 0xCB, 0x43,
+	0x1C, 0xF3, 0x0A, 0x66, 0xCF, 0x6D, 0x80, 0xF5,
+	0xA4, 0xA9, 0x95, 0xB0, 0x7D, 0x5F, 0x3C, 0x0B,
+	0x0B, 0x37, 0x0A, 0xFD, 0xAA, 0x37, 0x9F, 0x95,
+	0xEE, 0xD7, 0x82, 0xC4, 0xF8, 0x31, 0x70, 0x63,
+	0x9E, 0x4B, 0x6D, 0x41, 0xD6, 0x4E, 0x3F, 0x22,
+	0x17, 0x5A, 0xB3, 0xE7, 0x83, 0xE8, 0x51, 0x2A,
+	0x90, 0x4D, 0x1C, 0x96, 0x2B, 0xFA, 0x76, 0x36,
+	0xCF, 0x75, 0xEB, 0x52, 0x87, 0x40, 0xF1, 0xA4,
+	0xEB, 0x92, 0x83, 0x8D, 0x6D, 0x15, 0x40, 0x92,
+	0xF2, 0x92, 0x56, 0xCC, 0x84, 0x65, 0x02, 0x98,
+	0x66, 0x59,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x6C, 0x43, 0xAD, 0x7D, 0xE7, 0x4B,
+	0xD3, 0x59, 0x54, 0x8C, 0xAB, 0x2F, 0x40, 0xBD,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x04, 0x78, 0x0D, 0xB4, 0x9C, 0x48, 0xEE, 0xBE,
+	0xD3, 0x1A, 0x45, 0x47, 0xF3, 0x34, 0x2F, 0x73,
+	0xD4, 0xDD, 0x70, 0x55, 0xAE, 0x93, 0x40, 0xBD,
+	0x45, 0x32, 0xBD, 0x7B, 0x00, 0x21, 0x87, 0xCE,
+	0x6A, 0x08, 0xCA, 0x43, 0x0F, 0x6F, 0xA4, 0x2E,
+	0x3E, 0x8B, 0xA3, 0x57, 0x2F, 0x32, 0xC1, 0xD4,
+	0x64, 0x9C, 0x6C, 0x48, 0xA3, 0x72, 0x3E, 0x40,
+	0x8D, 0xCF, 0x5D, 0x7E, 0x22, 0x91, 0x90, 0x9E,
+	0x1A, 0x15, 0xE5, 0x4E, 0xEA, 0x00, 0x46, 0xC5,
+	0x81, 0xE2, 0x01, 0xDF, 0x90, 0x59, 0x72, 0x2C,
+	0x14, 0x29, 0xA6, 0xAF, 0x84, 0xB5, 0xB9, 0x11,
+	0x48, 0x03, 0x4C, 0xD7, 0x07, 0x05, 0xF7, 0x80,
+	0x0B, 0x34, 0xA2, 0xB3, 0x2C, 0xA1, 0x54, 0xE3,
+	0x18, 0xC9, 0x1C, 0xD4, 0x03, 0x97, 0x34, 0x08,
+	0x45, 0x1E, 0xB4, 0x5B, 0x28, 0xF8, 0xDB, 0x3A,
+	0xDC, 0xC0, 0xE6, 0x62, 0xCC, 0x08, 0x92, 0x91,
+	0x2F, 0xEA, 0xB0, 0x52, 0x54, 0x2F, 0xE9, 0x20,
+	0x88, 0xAC, 0x96, 0xB4, 0x95, 0x2C, 0x68, 0x1F,
+	0x55, 0x5B, 0x42, 0x5F, 0x81, 0xC4, 0x6D, 0xA4,
+	0xAD, 0xA1, 0x1C,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x6C, 0x43, 0x9E, 0x7D, 0xE7, 0x4B,
+	0x53, 0x40, 0x54, 0x8C, 0xAB, 0x2F, 0x40, 0x3D,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x14, 0x78, 0x0D, 0x2C, 0x1D, 0x28, 0x88, 0xBE,
+	0xD3, 0x3A, 0x06, 0x47, 0xF3, 0x34, 0x2F, 0x70,
+	0x6C, 0x44, 0x59, 0x5C, 0xAE, 0x9B, 0x26, 0xBF,
+	0x06, 0xF2, 0xB5, 0x7B, 0x40, 0x27, 0x8B, 0xCE,
+	0x8D, 0x96, 0xD6, 0xA4, 0x65, 0xCB, 0xB7, 0x68,
+	0x7F, 0x51, 0xB9, 0x42, 0xA2, 0xF4, 0xF1, 0x67,
+	0x19, 0x9F, 0x77, 0x2D, 0xAB, 0xE3, 0xCB, 0x49,
+	0xDF, 0x66, 0xCD, 0xB6, 0x05, 0x7C, 0x05, 0xC3,
+	0xCD, 0xFF, 0xD8, 0x39, 0xA1, 0xA5, 0x88, 0x3B,
+	0x50, 0x5C, 0xB0, 0xC9, 0x27, 0xFA, 0x25, 0xAF,
+	0x6C, 0x1A, 0x85, 0x7B, 0xE0, 0x25, 0x9E, 0xE1,
+	0x0C, 0x2D, 0xAF, 0x86, 0x27, 0xC4, 0x8E, 0x00,
+	0xB2, 0x4A, 0xCE, 0x9E, 0x9B, 0x96, 0xAB, 0x64,
+	0x3D, 0x4D, 0x7B, 0x65, 0x47, 0x25, 0x8D, 0x80,
+	0x84, 0x34, 0x69, 0x84, 0x32, 0x59, 0xB9, 0x75,
+	0x9E, 0x13, 0x44, 0x96, 0xC6, 0x25, 0x19, 0xC2,
+	0x1B, 0xF4, 0x1D, 0xF2, 0xB0, 0xE2, 0x3A, 0x47,
+	0xA8, 0x3C, 0x78, 0x1C, 0xCD, 0xC4, 0x13, 0x8D,
+	0xBD, 0xE1, 0x81, 0x28, 0x09, 0xFD, 0x6C, 0xE9,
+	0x4B, 0xA2, 0x02, 0x3A,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0x9E, 0x6F, 0xE7, 0x4B,
+	0x53, 0x89, 0x5D, 0x8C, 0xAB, 0x2F, 0x40, 0x3D,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x3E, 0x7C, 0x0D, 0x2C, 0x81, 0x28, 0xFE, 0xEC,
+	0xD1, 0x3A, 0x3E, 0x02, 0xF3, 0x34, 0x2F, 0x70,
+	0x6C, 0xCC, 0xC5, 0xAC, 0xAE, 0x9B, 0x26, 0xB8,
+	0x16, 0xD0, 0xD9, 0x7F, 0x40, 0x67, 0xCB, 0xC4,
+	0x7B, 0xA6, 0x91, 0x89, 0x1E, 0xE8, 0x0B, 0xE5,
+	0x74, 0x70, 0xF0, 0x70, 0x32, 0x64, 0x77, 0x58,
+	0x49, 0x50, 0x1E, 0x37, 0x31, 0x3F, 0xDE, 0x91,
+	0x2A, 0x84, 0xCD, 0xD7, 0x2C, 0x08, 0x7E, 0x7B,
+	0x87, 0x92, 0x91, 0x99, 0x55, 0x94, 0x16, 0xD8,
+	0x95, 0x63, 0x89, 0x79, 0xF1, 0x3E, 0x2B, 0x8F,
+	0x71, 0xC0, 0xAC, 0x91, 0xDC, 0xF7, 0xD9, 0x4F,
+	0x09, 0x12, 0x24, 0x1D, 0x09, 0xF8, 0x6E, 0x31,
+	0x9E, 0xE6, 0xB4, 0x11, 0xFD, 0x7C, 0x4F, 0x50,
+	0x77, 0xF3, 0x8B, 0x14, 0x35, 0xC9, 0xD7, 0x0E,
+	0x1C, 0x02, 0xEB, 0xED, 0x8A, 0x94, 0x40, 0x50,
+	0x32, 0xAB, 0xC1, 0xD0, 0xB9, 0x6C, 0x23, 0x50,
+	0x96, 0x12, 0x7D, 0x15, 0xC0, 0xEE, 0xCB, 0x0A,
+	0x10, 0x4E, 0x10, 0x99, 0x22, 0x7B, 0xAA, 0xBC,
+	0xE3, 0x80, 0x9D, 0x62, 0x9A, 0xAF, 0x09, 0x89,
+	0xC8, 0x24, 0xBF, 0x7E, 0x68,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0x8F, 0x6F, 0xA7, 0x4B,
+	0x53, 0x89, 0x75, 0xB6, 0xAB, 0x2F, 0x40, 0x3D,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0xB0, 0xB2, 0x0D, 0x2C, 0x81, 0x88, 0xFD, 0x6C,
+	0xD1, 0x3A, 0x3E, 0x42, 0xB3, 0x34, 0x2F, 0x70,
+	0xC4, 0xCE, 0x2D, 0x0C, 0xAD, 0x93, 0x26, 0xAA,
+	0x48, 0x91, 0x9F, 0x6F, 0x48, 0x67, 0xCB, 0x4E,
+	0x02, 0x87, 0xE5, 0xC6, 0x04, 0xEC, 0x29, 0x76,
+	0xA1, 0x0F, 0x3A, 0x10, 0x17, 0xA4, 0xA4, 0xA9,
+	0x13, 0x57, 0x2E, 0xD8, 0x09, 0x30, 0x64, 0x71,
+	0x22, 0xE4, 0xCC, 0x0E, 0x1C, 0x3E, 0x24, 0x10,
+	0xA4, 0xA0, 0x2F, 0x62, 0x7C, 0x5B, 0x38, 0x83,
+	0x1F, 0x64, 0xC5, 0x7D, 0x9E, 0x68, 0x8A, 0x4E,
+	0x86, 0xC4, 0x10, 0xF4, 0x1B, 0x0F, 0xA6, 0xDC,
+	0x8A, 0xB4, 0x02, 0x5D, 0x7E, 0x44, 0x8F, 0xC0,
+	0x16, 0x47, 0x4F, 0x50, 0xBC, 0x8B, 0xF2, 0x59,
+	0xDD, 0xB0, 0x11, 0x56, 0x01, 0x49, 0x64, 0x24,
+	0xBC, 0x5D, 0x2A, 0x0F, 0xB8, 0xFE, 0x1E, 0x27,
+	0x55, 0x7A, 0x0A, 0x6A, 0xDA, 0x42, 0xFE, 0x08,
+	0x2C, 0xF4, 0xEA, 0x0E, 0xA8, 0xCB, 0x7C, 0xE8,
+	0x30, 0x8E, 0x61, 0xDF, 0xE6, 0x15, 0xBB, 0x26,
+	0xCA, 0xFD, 0x19, 0xDD, 0x89, 0x36, 0x16, 0xE5,
+	0xA9, 0xE7, 0x7D, 0x0A, 0xB2, 0xBF,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x7B, 0x27, 0x0B,
+	0x53, 0x89, 0x75, 0xF4, 0x81, 0x2F, 0x40, 0x3D,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x00, 0x68, 0x8C, 0x0C, 0x81, 0x88, 0x59, 0x6E,
+	0xD1, 0x3A, 0x7A, 0x42, 0xBB, 0x30, 0x2E, 0x70,
+	0xEC, 0x2F, 0x2D, 0x88, 0x09, 0x1D, 0x26, 0xAA,
+	0xC8, 0x93, 0x5F, 0x6A, 0x0C, 0x63, 0xCB, 0x86,
+	0x70, 0xE2, 0xE6, 0x72, 0x6B, 0x7C, 0xFE, 0xC4,
+	0x59, 0x6C, 0x1A, 0x20, 0x11, 0x54, 0xC1, 0x89,
+	0x27, 0xFA, 0xF8, 0xD0, 0x2C, 0x38, 0x0A, 0xE3,
+	0x12, 0xFC, 0xFB, 0x7D, 0xB4, 0x5E, 0xAC, 0xB8,
+	0x80, 0xDF, 0x51, 0xB6, 0xDE, 0xA1, 0xD8, 0x72,
+	0xB3, 0x12, 0x40, 0xAE, 0x35, 0xA7, 0xA1, 0xD9,
+	0xE7, 0xAC, 0x1B, 0xD1, 0xF9, 0x5F, 0x21, 0x46,
+	0xE5, 0x0E, 0xFB, 0x22, 0x5D, 0x75, 0x10, 0xFE,
+	0xE5, 0xE3, 0x42, 0x1A, 0x6D, 0x84, 0x9D, 0xE5,
+	0xBD, 0x20, 0xD2, 0x3A, 0x07, 0x74, 0x17, 0x28,
+	0x2A, 0xCF, 0xD2, 0x07, 0x49, 0xEC, 0x5B, 0xE7,
+	0x07, 0x68, 0x7A, 0xCC, 0xC6, 0xD4, 0x2B, 0x7B,
+	0x9E, 0x53, 0x28, 0x1D, 0x96, 0x35, 0x49, 0x10,
+	0xDF, 0x78, 0x48, 0xA3, 0xFC, 0x53, 0xC2, 0xE4,
+	0x43, 0xD1, 0xE6, 0xC8, 0xE0, 0xF1, 0x26, 0x99,
+	0x03, 0xB3, 0xC1, 0xC7, 0xEF, 0xEA, 0x16,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x53, 0x89, 0x75, 0x74, 0x11, 0x35, 0x40, 0x3D,
+	0x68, 0x0D, 0xC9, 0xC4, 0x8B, 0x29, 0x70, 0x40,
+	0x05, 0x88, 0x3A, 0x20, 0x21, 0x88, 0x59, 0xC6,
+	0xD1, 0x3A, 0x7A, 0x76, 0xBB, 0x20, 0x27, 0x70,
+	0x6C, 0x2D, 0x6F, 0x8A, 0x01, 0xB4, 0x9F, 0xA9,
+	0x08, 0x37, 0x4F, 0x2A, 0x0E, 0x2F, 0xC6, 0x86,
+	0xBF, 0x56, 0xE4, 0x3F, 0xC9, 0xFC, 0xC4, 0x6D,
+	0x0D, 0x1C, 0x0A, 0xB6, 0x7C, 0xA7, 0x67, 0xA3,
+	0xAF, 0x65, 0x38, 0x70, 0x07, 0xFC, 0x28, 0x6D,
+	0x5C, 0xC3, 0xF9, 0xB4, 0xF7, 0x48, 0x4C, 0xEC,
+	0xDE, 0xED, 0x77, 0xB9, 0x6F, 0x2E, 0x6A, 0xC8,
+	0xE5, 0x4E, 0xF0, 0x21, 0x95, 0xF3, 0xB5, 0x6E,
+	0xC7, 0xFF, 0x33, 0xEE, 0x34, 0x55, 0x4F, 0x30,
+	0x78, 0x42, 0x6D, 0x17, 0xD7, 0x27, 0x5D, 0x06,
+	0x65, 0x1B, 0x2C, 0xA1, 0xDA, 0x84, 0x82, 0x4A,
+	0x46, 0x5D, 0xD8, 0x6C, 0x6A, 0x50, 0xF8, 0x3E,
+	0x92, 0x95, 0x46, 0x28, 0x02, 0x52, 0x13, 0x8C,
+	0x33, 0xBA, 0xA1, 0x3D, 0x5A, 0x9E, 0x22, 0xBC,
+	0x30, 0x19, 0x86, 0x66, 0xFD, 0xE5, 0x0A, 0x15,
+	0xAD, 0x7F, 0x39, 0x1A, 0xBB, 0xA0, 0x11, 0x12,
+	0xDF, 0x76, 0x08, 0x0B, 0x97, 0xD5, 0x97, 0xDD,
+	0xD1, 0x01, 0xD8, 0xAE, 0x77, 0x6A, 0xA2, 0xC9,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x53, 0x9C, 0x75, 0x74, 0x11, 0x35, 0x60, 0x3D,
+	0x68, 0x0D, 0xC9, 0xC4, 0x0B, 0xE9, 0x7A, 0x40,
+	0x05, 0x30, 0x38, 0x32, 0x79, 0x99, 0x79, 0xC3,
+	0x7D, 0x3A, 0xCA, 0x84, 0x89, 0x24, 0x27, 0x70,
+	0x6C, 0x2D, 0x6F, 0x0A, 0x00, 0xB4, 0x97, 0xBB,
+	0x09, 0x37, 0x47, 0x23, 0x0E, 0x04, 0x6A, 0x4E,
+	0xB8, 0x5D, 0xE9, 0x9C, 0xB5, 0xC8, 0x6A, 0x9D,
+	0x0E, 0x34, 0xC2, 0xB7, 0x47, 0x02, 0x85, 0x55,
+	0xA5, 0x41, 0xFC, 0x51, 0x44, 0xC4, 0xB0, 0xBD,
+	0x93, 0xB6, 0x53, 0x23, 0x76, 0xE5, 0xCD, 0xBA,
+	0x85, 0x9A, 0x81, 0x11, 0x5A, 0x28, 0x4C, 0x1E,
+	0x72, 0x76, 0xEC, 0xE1, 0x66, 0x5E, 0xEB, 0x0B,
+	0xB2, 0x81, 0x64, 0x39, 0x42, 0xE9, 0x9E, 0x9F,
+	0x3C, 0xE9, 0x95, 0xBC, 0xBE, 0x1F, 0xD9, 0x62,
+	0x88, 0x90, 0xA4, 0x1E, 0xB8, 0xD6, 0x8D, 0x01,
+	0xE9, 0xC6, 0xB8, 0x1E, 0x6E, 0x96, 0x17, 0xE4,
+	0x66, 0xF0, 0x18, 0x29, 0x8E, 0xD2, 0x83, 0xE0,
+	0xC9, 0x5A, 0x30, 0x66, 0xD9, 0x33, 0x05, 0x1A,
+	0xBF, 0x3F, 0xC3, 0xDA, 0x94, 0x71, 0x9E, 0xB2,
+	0x1E, 0xC8, 0x65, 0x39, 0x18, 0x86, 0x8F, 0x81,
+	0x7F, 0x7E, 0x72, 0x71, 0xC8, 0xD7, 0xC5, 0x08,
+	0xFF, 0x5C, 0x17, 0x71, 0xF5, 0xB6, 0x75, 0x28,
+	0x93,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x4E, 0x9C, 0x41, 0x74, 0x11, 0x35, 0x60, 0xBD,
+	0x68, 0x0D, 0xC9, 0xC4, 0x0B, 0xE9, 0x73, 0x7B,
+	0x01, 0x30, 0x38, 0xB1, 0x0D, 0x9D, 0x5F, 0x43,
+	0x7D, 0x8A, 0xC9, 0x44, 0x47, 0x6A, 0xB7, 0x70,
+	0x34, 0x2D, 0x6F, 0x0A, 0x00, 0xB2, 0x97, 0xFB,
+	0x3A, 0x33, 0x47, 0x38, 0xE2, 0x04, 0x66, 0xFE,
+	0x2C, 0x08, 0x3B, 0x18, 0x2A, 0x39, 0xFA, 0x85,
+	0x7A, 0x36, 0x34, 0xBF, 0x72, 0xEA, 0x93, 0x5C,
+	0xD1, 0x48, 0x8F, 0xC3, 0x9B, 0x83, 0xB5, 0x3D,
+	0x63, 0xA6, 0xC3, 0x73, 0xB6, 0xC2, 0x65, 0xDD,
+	0x66, 0x17, 0x03, 0x8F, 0xCA, 0x9D, 0x11, 0xBD,
+	0x8C, 0x82, 0x34, 0xE2, 0x86, 0x2B, 0x4E, 0x66,
+	0x4C, 0x06, 0x19, 0xBF, 0xDA, 0xCA, 0xCB, 0xD0,
+	0xE7, 0xB1, 0xDF, 0x97, 0x06, 0xEE, 0xB3, 0xB9,
+	0xF5, 0xDD, 0x87, 0x06, 0xA0, 0xE0, 0xB3, 0x7E,
+	0x1D, 0x87, 0x8A, 0xDB, 0x06, 0x68, 0xDF, 0xFF,
+	0x3F, 0xFC, 0x05, 0xF5, 0xA5, 0xD3, 0xC7, 0x7F,
+	0x0C, 0x91, 0xCD, 0x73, 0xA2, 0x80, 0x01, 0xD1,
+	0xE6, 0x07, 0xA4, 0x1F, 0xEE, 0x20, 0x24, 0x3D,
+	0x0B, 0x0B, 0x08, 0xB5, 0x53, 0xAC, 0xD0, 0xA2,
+	0x7A, 0xD0, 0xA5, 0x02, 0x75, 0x4F, 0x43, 0x42,
+	0x15, 0x94, 0x85, 0x05, 0x44, 0x3F, 0xDC, 0x1A,
+	0xB2, 0xC6,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x6E, 0x89, 0xC1, 0x62, 0x11, 0x35, 0x60, 0xBD,
+	0x43, 0x0D, 0xC9, 0xC4, 0x0B, 0xE9, 0x33, 0x39,
+	0x00, 0x20, 0x38, 0xE1, 0x85, 0x9D, 0x5E, 0x42,
+	0x7F, 0x8A, 0x7D, 0x06, 0x97, 0xD0, 0xAC, 0x60,
+	0x34, 0x69, 0x6C, 0x0A, 0x00, 0x32, 0x95, 0xFB,
+	0x8C, 0x61, 0x40, 0x38, 0xCA, 0xA9, 0x66, 0x7A,
+	0xB9, 0x0E, 0x40, 0xF8, 0xE4, 0xB1, 0x64, 0xAD,
+	0x95, 0xBB, 0x58, 0x27, 0x89, 0x8B, 0xE6, 0x96,
+	0x21, 0xEC, 0x1E, 0x34, 0xE1, 0xFF, 0xFA, 0xBD,
+	0x85, 0x16, 0xDD, 0xC7, 0x97, 0x77, 0x5E, 0x1C,
+	0x04, 0x30, 0x7E, 0xD1, 0x76, 0xBD, 0x87, 0x3A,
+	0xB4, 0x34, 0xBE, 0xCB, 0x3F, 0x4E, 0xEE, 0x0D,
+	0xED, 0x15, 0x78, 0x53, 0x42, 0xBD, 0x11, 0x99,
+	0xE2, 0xCD, 0x52, 0xD6, 0xA9, 0x53, 0xEC, 0x24,
+	0x18, 0x91, 0xDA, 0x28, 0xE7, 0x6C, 0x3E, 0x21,
+	0x98, 0xF3, 0x10, 0x98, 0x76, 0xCA, 0x17, 0x3B,
+	0x0C, 0x7F, 0xF5, 0xCB, 0xA9, 0x14, 0x56, 0x8A,
+	0x10, 0x9E, 0x56, 0x49, 0xDE, 0xD2, 0xD5, 0xF8,
+	0xD8, 0x92, 0xEB, 0x97, 0x9C, 0xD2, 0x04, 0x3F,
+	0x77, 0x06, 0xCD, 0x3D, 0xA4, 0x5C, 0x9A, 0xBC,
+	0xF0, 0xE7, 0xFE, 0xCF, 0x85, 0x46, 0xAF, 0xB7,
+	0x14, 0xB7, 0x4A, 0x68, 0xB3, 0x19, 0x84, 0x7D,
+	0x99, 0x23, 0xE1,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x6E, 0x49, 0xC4, 0x62, 0x24, 0x35, 0x60, 0xBD,
+	0xDB, 0x16, 0xC9, 0xC4, 0x0B, 0xE9, 0x33, 0x39,
+	0x05, 0xA8, 0x39, 0xE1, 0x65, 0x58, 0x7C, 0x22,
+	0x77, 0x8A, 0x7D, 0xBE, 0x96, 0x34, 0x5A, 0x3A,
+	0x34, 0x79, 0x69, 0x0A, 0x00, 0x32, 0x95, 0xED,
+	0x0A, 0xD9, 0x59, 0x3B, 0xCA, 0xA8, 0x68, 0x7B,
+	0x45, 0x1B, 0xC1, 0xC8, 0xF0, 0x16, 0x59, 0xF1,
+	0xE9, 0x9D, 0x9E, 0x38, 0x79, 0xFE, 0x8D, 0x7E,
+	0x6D, 0x24, 0x0A, 0x97, 0xB9, 0x34, 0xAC, 0x68,
+	0x38, 0x86, 0x6F, 0x0D, 0xFF, 0x3D, 0xD5, 0xF9,
+	0x9B, 0x0A, 0xCD, 0x87, 0xE2, 0x4E, 0xFC, 0xBC,
+	0x5A, 0x21, 0x29, 0x85, 0x3A, 0x95, 0x28, 0xE6,
+	0x53, 0xD0, 0x94, 0x30, 0xCA, 0xF5, 0xAF, 0xA0,
+	0x48, 0x64, 0x9C, 0x6C, 0x6E, 0x0F, 0x61, 0xA9,
+	0x0A, 0xDD, 0x41, 0x89, 0xD8, 0x92, 0x2D, 0xE7,
+	0xE1, 0x69, 0x26, 0x25, 0xC5, 0x87, 0x73, 0x34,
+	0xDF, 0x78, 0x6F, 0x00, 0x12, 0xBD, 0xAB, 0x4B,
+	0x75, 0x31, 0xA8, 0xB7, 0x5F, 0xB5, 0x67, 0xBE,
+	0xBC, 0x37, 0xB8, 0xF8, 0x49, 0x03, 0xFD, 0x77,
+	0xE8, 0x2D, 0xF2, 0x6E, 0x80, 0xCB, 0x3B, 0xB0,
+	0xBF, 0x3F, 0x0C, 0xF7, 0xAE, 0x29, 0x4C, 0x33,
+	0xF0, 0x95, 0x5A, 0xCC, 0x36, 0xAF, 0x41, 0xD2,
+	0x1C, 0x1B, 0xC5, 0x02,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x6E, 0x49, 0x64, 0x67, 0xA4, 0x25, 0x60, 0xBD,
+	0xDB, 0xDE, 0xC2, 0xC4, 0x0B, 0xE9, 0x33, 0x39,
+	0x6C, 0xB8, 0xA9, 0xE5, 0x65, 0xA8, 0xBE, 0x26,
+	0x0A, 0xA2, 0x7D, 0xBE, 0x2A, 0x64, 0xAA, 0xC0,
+	0x25, 0x79, 0x71, 0x44, 0x00, 0x32, 0x95, 0x6D,
+	0x0A, 0xF0, 0xE5, 0x7F, 0xCB, 0xA8, 0x62, 0x10,
+	0xD1, 0x52, 0x94, 0x8C, 0x12, 0x5F, 0x2A, 0xD9,
+	0xCE, 0x11, 0x54, 0xB9, 0x66, 0x6F, 0xC9, 0x77,
+	0x2A, 0x34, 0x58, 0x77, 0xA2, 0xE9, 0x26, 0x1E,
+	0xAC, 0xAC, 0x12, 0x8B, 0x6E, 0xD9, 0x86, 0xD9,
+	0x95, 0xC2, 0x2D, 0x4B, 0xB6, 0xC1, 0xB3, 0x04,
+	0x1B, 0x9E, 0x5D, 0x74, 0xA9, 0x45, 0x10, 0x30,
+	0x3D, 0xB6, 0x55, 0xA7, 0x79, 0x01, 0x41, 0x4B,
+	0x3D, 0xF6, 0x72, 0x36, 0xC7, 0x6F, 0xA2, 0xB8,
+	0x4B, 0xEC, 0x11, 0x65, 0x4B, 0x20, 0x5D, 0x10,
+	0x64, 0x26, 0x53, 0x4C, 0xD0, 0x14, 0xAF, 0xBA,
+	0xB9, 0x7F, 0x41, 0x05, 0x4C, 0x47, 0x33, 0x4C,
+	0x3B, 0xEE, 0x8D, 0x87, 0x71, 0xBF, 0x4F, 0x3D,
+	0xF2, 0x84, 0x23, 0x1D, 0x8C, 0xB6, 0xC6, 0x9F,
+	0x4B, 0xCE, 0x0A, 0xD0, 0x1F, 0xEE, 0x51, 0x2B,
+	0xBE, 0x51, 0x4F, 0xE5, 0xF2, 0x4D, 0x18, 0x41,
+	0xF5, 0xA4, 0xD5, 0x76, 0xD0, 0x9C, 0x32, 0xBF,
+	0xA4, 0x86, 0x56, 0x40, 0xED,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x6E, 0x49, 0x64, 0x67, 0xB0, 0x25, 0x48, 0xBD,
+	0xDB, 0xDE, 0xD2, 0xF8, 0x0B, 0xE9, 0x33, 0x39,
+	0x61, 0xB8, 0xF9, 0x3D, 0x79, 0xA8, 0xBE, 0x26,
+	0x05, 0x4D, 0x3D, 0xBD, 0x2A, 0xA4, 0x29, 0xD0,
+	0x25, 0x19, 0x71, 0x44, 0x02, 0x32, 0x95, 0x6D,
+	0x5A, 0xF6, 0x35, 0xBF, 0x88, 0xA8, 0x62, 0x38,
+	0xD6, 0x43, 0x31, 0xAD, 0x5B, 0xA3, 0x4E, 0x92,
+	0x16, 0x85, 0x77, 0x8A, 0x3A, 0xED, 0x35, 0xF1,
+	0xD9, 0x72, 0x5F, 0xD4, 0x35, 0x2F, 0x51, 0x8B,
+	0xAA, 0x6A, 0x08, 0x71, 0x20, 0xB8, 0xE0, 0xBE,
+	0xE3, 0x49, 0x3D, 0x86, 0xBC, 0xAA, 0xEB, 0x36,
+	0x74, 0x88, 0xBF, 0xE9, 0xE3, 0xB8, 0xFD, 0xD4,
+	0xDD, 0x89, 0x62, 0xF8, 0x30, 0xCC, 0xFC, 0xA9,
+	0x9C, 0xBA, 0xA2, 0x5F, 0xFD, 0x5B, 0xFE, 0x82,
+	0xEE, 0x0C, 0x7E, 0x58, 0x64, 0xA8, 0x3A, 0xA7,
+	0xCB, 0xD9, 0x38, 0x60, 0xDA, 0xD5, 0xC2, 0xE8,
+	0xAF, 0xD7, 0xB2, 0x27, 0x57, 0xF4, 0x80, 0xC8,
+	0xFD, 0xF9, 0x57, 0x13, 0xA7, 0x75, 0x0D, 0xC5,
+	0x29, 0xB1, 0x77, 0xB4, 0x5E, 0x1D, 0x2F, 0xCF,
+	0x28, 0x0B, 0x9E, 0x03, 0x0C, 0x65, 0x4D, 0x6C,
+	0xC4, 0x4B, 0xB1, 0xDA, 0x97, 0xF9, 0xC1, 0x6C,
+	0xA8, 0x81, 0x01, 0x56, 0x41, 0xC7, 0xF1, 0x06,
+	0xEF, 0x66, 0x86, 0x6E, 0x59, 0xD1,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x6E, 0x49, 0x64, 0x67, 0x90, 0x35, 0x48, 0xAD,
+	0xDB, 0xDE, 0x92, 0x94, 0x27, 0xE9, 0x33, 0x39,
+	0x62, 0x58, 0x70, 0x60, 0x79, 0xAF, 0xBE, 0x26,
+	0x14, 0x56, 0xE2, 0xBC, 0x2A, 0xA4, 0xED, 0xD0,
+	0x25, 0x99, 0x71, 0x44, 0x82, 0x31, 0x95, 0x6D,
+	0x7A, 0x56, 0x35, 0x8F, 0x4C, 0xAA, 0x6D, 0x78,
+	0xE5, 0xA7, 0x93, 0x05, 0xE8, 0xB7, 0x40, 0x6D,
+	0x0E, 0xA0, 0xDF, 0xFC, 0xA1, 0xD8, 0x08, 0x65,
+	0x29, 0x8C, 0xD8, 0x5D, 0xA5, 0x0B, 0xF1, 0xA4,
+	0x2C, 0xA9, 0x41, 0x3A, 0x08, 0xFF, 0x5C, 0xDE,
+	0xC8, 0xEC, 0x72, 0x58, 0x87, 0x68, 0x46, 0x56,
+	0x10, 0x4F, 0xF9, 0x1F, 0x40, 0x09, 0x97, 0xD1,
+	0xC0, 0xB9, 0x9B, 0x38, 0x59, 0x9E, 0xE4, 0x52,
+	0x93, 0x79, 0x49, 0x64, 0x0B, 0x2B, 0xC9, 0x98,
+	0xCE, 0x8B, 0x39, 0xB1, 0xBC, 0x7F, 0x06, 0xE2,
+	0x2B, 0x97, 0xA4, 0x1E, 0x23, 0x98, 0xE1, 0x82,
+	0x8D, 0x01, 0xBE, 0x0C, 0xF1, 0xED, 0xD6, 0x8F,
+	0xFB, 0x63, 0xBA, 0xB2, 0x2E, 0x5C, 0xD5, 0x32,
+	0x40, 0x36, 0x75, 0x95, 0x5D, 0xF7, 0x25, 0x38,
+	0xE0, 0xF6, 0x15, 0x1E, 0x50, 0x45, 0xFC, 0xF3,
+	0x82, 0x39, 0xD8, 0x42, 0x0A, 0x6F, 0x35, 0xD1,
+	0xF2, 0x75, 0xB1, 0xA3, 0x6C, 0x3E, 0x7D, 0xF2,
+	0x49, 0xA5, 0xA9, 0x9C, 0x3C, 0x0E, 0xEF,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x2B, 0x10, 0xD3, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x57, 0x49, 0x64, 0x67, 0x90, 0x75, 0x42, 0xAD,
+	0xDB, 0xDE, 0x92, 0x94, 0xAF, 0xF5, 0x33, 0x39,
+	0x62, 0x79, 0x36, 0xE0, 0x3A, 0xE3, 0xB6, 0x26,
+	0x55, 0x16, 0xC5, 0xDB, 0x2A, 0xA4, 0xED, 0x18,
+	0x25, 0x99, 0x71, 0x6D, 0x82, 0xB1, 0x96, 0x6D,
+	0x7A, 0x5A, 0x05, 0x8C, 0xA4, 0x62, 0x24, 0x79,
+	0x26, 0x5F, 0x9F, 0x11, 0xA3, 0x93, 0x60, 0xBB,
+	0x2B, 0x24, 0xDE, 0x87, 0xE2, 0x03, 0xC7, 0xD7,
+	0xF9, 0x84, 0x2B, 0x61, 0xCE, 0xEB, 0x4A, 0xB2,
+	0x08, 0x05, 0x9D, 0x13, 0x9E, 0xA6, 0xC2, 0x66,
+	0x2A, 0xEC, 0xE3, 0x7C, 0xE1, 0x43, 0x70, 0x55,
+	0x3D, 0xEB, 0xBC, 0x3A, 0xAC, 0xDA, 0x41, 0x10,
+	0x39, 0xC8, 0x58, 0x75, 0x08, 0x68, 0xEC, 0x42,
+	0xD7, 0xA0, 0xB4, 0x72, 0xA7, 0x02, 0xCE, 0x1F,
+	0x1F, 0xDE, 0x63, 0x3E, 0xBA, 0xC9, 0x02, 0x27,
+	0x0B, 0x36, 0x73, 0xC3, 0xCE, 0xA9, 0x28, 0x73,
+	0x5A, 0x9C, 0xE1, 0x73, 0x91, 0x42, 0x61, 0x65,
+	0x85, 0xF8, 0x22, 0x5B, 0x37, 0x44, 0xEB, 0xB4,
+	0xE8, 0xEC, 0xB3, 0xAE, 0xF0, 0x74, 0xF1, 0x41,
+	0xBF, 0xAA, 0x37, 0xF6, 0x9B, 0x60, 0x19, 0x03,
+	0x18, 0x19, 0xBF, 0x88, 0x8A, 0x44, 0x1F, 0x44,
+	0x1B, 0x84, 0x0F, 0x72, 0x32, 0x67, 0x3B, 0x29,
+	0xFF, 0x70, 0x8E, 0x19, 0x13, 0xE5, 0x33, 0xA9,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x6B, 0xD8, 0xDF, 0x19,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x57, 0x49, 0x64, 0x67, 0x90, 0x75, 0x42, 0xAD,
+	0xDB, 0xC6, 0x92, 0x94, 0xAF, 0xF5, 0x53, 0x3F,
+	0x60, 0xB9, 0x3E, 0xC3, 0x3A, 0xC3, 0x7A, 0x6E,
+	0x55, 0xB6, 0xE5, 0xDB, 0x3A, 0xA5, 0xED, 0x1A,
+	0xE9, 0xFB, 0x63, 0x5E, 0x96, 0xB1, 0x96, 0x6D,
+	0x7A, 0x5A, 0x05, 0x8C, 0xBC, 0x62, 0x24, 0x19,
+	0xBC, 0x95, 0xAB, 0xEC, 0xD6, 0xF2, 0xE8, 0xFB,
+	0xA7, 0xC1, 0xCF, 0x29, 0x85, 0x61, 0x14, 0x17,
+	0x10, 0x82, 0xB9, 0x28, 0x26, 0x58, 0x8F, 0xC4,
+	0x8F, 0xDF, 0xD5, 0x0C, 0x3C, 0x2C, 0xCA, 0xF2,
+	0x8C, 0x42, 0xBB, 0x78, 0x90, 0x53, 0x00, 0x31,
+	0x80, 0x4E, 0x7F, 0x95, 0xA5, 0x44, 0x8D, 0x26,
+	0x1D, 0x9D, 0x3E, 0xE0, 0xC4, 0x03, 0x92, 0xC7,
+	0xFC, 0x8E, 0xE2, 0x14, 0x3C, 0x02, 0x9E, 0x69,
+	0x4F, 0x92, 0x5B, 0xBB, 0x27, 0x61, 0x0F, 0xB7,
+	0x28, 0xCE, 0x8B, 0xB9, 0x57, 0xD2, 0xFB, 0x20,
+	0xEF, 0x84, 0xEC, 0x9C, 0x32, 0xBF, 0x0B, 0xE6,
+	0xCB, 0x6F, 0x2C, 0x41, 0x36, 0xE3, 0xE7, 0xBD,
+	0x0B, 0xB8, 0x04, 0xA0, 0xB3, 0xFB, 0x62, 0xEF,
+	0x41, 0x26, 0xB1, 0x7B, 0xF9, 0x87, 0xA2, 0x55,
+	0x26, 0xB1, 0x64, 0x62, 0xD8, 0x28, 0xCE, 0x8C,
+	0xD3, 0x9C, 0x81, 0x9A, 0x36, 0x50, 0xA5, 0x67,
+	0xD2, 0x54, 0x2D, 0xAF, 0xEB, 0x4A, 0x61, 0x37,
+	0xCA,
+
+	0x9F, 0x14, 0x3C, 0x00, 0x6B, 0xD8, 0xC6, 0x24,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x57, 0x49, 0x64, 0x67, 0x90, 0x75, 0x42, 0xAD,
+	0xD9, 0xC6, 0xF8, 0x94, 0xAF, 0xF5, 0x53, 0xBF,
+	0x53, 0xBD, 0x3E, 0xEA, 0x4E, 0xC3, 0x8E, 0xBF,
+	0x46, 0xB6, 0x85, 0x97, 0x11, 0x25, 0x9B, 0xC2,
+	0xE9, 0x2B, 0xE0, 0x1F, 0xD9, 0x5B, 0xD6, 0x6E,
+	0x58, 0x5A, 0x05, 0x8C, 0xBC, 0x00, 0x24, 0x19,
+	0xD0, 0x90, 0x72, 0x79, 0x1D, 0x22, 0xC4, 0x28,
+	0xE5, 0xBB, 0x7F, 0xCB, 0xA4, 0x22, 0x1C, 0xCE,
+	0xF9, 0x46, 0x61, 0x06, 0xA7, 0xEF, 0x29, 0x03,
+	0xDF, 0x1A, 0x74, 0x04, 0xE0, 0x52, 0xF6, 0x74,
+	0x74, 0x01, 0x95, 0xC5, 0xE2, 0x92, 0xE1, 0x58,
+	0xA8, 0x0C, 0xE1, 0x0D, 0x00, 0x1F, 0x8E, 0x9A,
+	0xDF, 0xE4, 0xD3, 0x58, 0x51, 0xFF, 0xD4, 0x03,
+	0x82, 0x01, 0x3A, 0xBB, 0x3E, 0x7A, 0xC4, 0xD5,
+	0x72, 0x3A, 0x46, 0x83, 0x40, 0x20, 0xEF, 0xE6,
+	0x10, 0x24, 0x4B, 0x6D, 0x1C, 0x63, 0x57, 0x64,
+	0x4B, 0x48, 0x33, 0x64, 0x2B, 0x2B, 0x76, 0x01,
+	0xA1, 0xF8, 0x6F, 0xE7, 0x1B, 0x3F, 0xF2, 0x0C,
+	0x3B, 0x66, 0xB8, 0x50, 0xC6, 0x96, 0xF9, 0x1A,
+	0x08, 0x04, 0xDD, 0x37, 0x15, 0x32, 0x50, 0xE5,
+	0x47, 0xD8, 0xF3, 0x7A, 0x68, 0x44, 0x5A, 0xC8,
+	0xF4, 0xE6, 0x08, 0xE7, 0x48, 0xEE, 0x51, 0xEB,
+	0xF4, 0x06, 0x3F, 0x3B, 0xFD, 0x41, 0xE4, 0xCC,
+	0x99, 0x79,
+
+	0xB2, 0x14, 0x3C, 0x00, 0x6B, 0xD8, 0x86, 0x68,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x57, 0x49, 0x64, 0x67, 0x90, 0x75, 0x42, 0xAD,
+	0xF9, 0xD0, 0xF8, 0xD4, 0xAF, 0xF5, 0x53, 0xBF,
+	0x87, 0xB3, 0x36, 0xAA, 0x4A, 0x76, 0x8E, 0xEA,
+	0xB4, 0xB2, 0x85, 0xD7, 0x1F, 0x24, 0x3E, 0xE6,
+	0xE9, 0x2B, 0x34, 0x1F, 0x88, 0x00, 0xFF, 0x6E,
+	0xD0, 0x74, 0x05, 0x8C, 0xBC, 0x80, 0x24, 0x19,
+	0x3F, 0xD6, 0x76, 0xA3, 0xCB, 0x73, 0xAF, 0x0D,
+	0xA6, 0xEB, 0x1C, 0x20, 0x9A, 0x2B, 0x52, 0x0D,
+	0xB7, 0xAE, 0x38, 0x90, 0xAF, 0x25, 0x8B, 0x55,
+	0xBF, 0x1B, 0x73, 0x31, 0x50, 0xF6, 0x0F, 0x60,
+	0xA5, 0xC8, 0x3A, 0xD2, 0xFA, 0x13, 0xEB, 0x6A,
+	0x90, 0x62, 0x17, 0xAE, 0x20, 0x86, 0x70, 0xD1,
+	0xAB, 0xA1, 0x46, 0x04, 0x95, 0x5D, 0xCC, 0x7D,
+	0x8E, 0x86, 0xFA, 0x45, 0xF5, 0x63, 0xF0, 0x1E,
+	0xA2, 0x87, 0x2B, 0x0E, 0xCD, 0xAD, 0x5D, 0xBE,
+	0xEC, 0xBB, 0x58, 0x82, 0xF8, 0x46, 0x8B, 0x8D,
+	0xD4, 0xFC, 0xAB, 0x00, 0x60, 0xA6, 0x51, 0x83,
+	0x51, 0x5B, 0x94, 0x70, 0xB5, 0x07, 0xC9, 0xB6,
+	0x3A, 0x21, 0x7F, 0x33, 0x35, 0xB3, 0xE8, 0x39,
+	0x17, 0x9A, 0x40, 0x3B, 0x61, 0xC3, 0x6C, 0x0A,
+	0xE5, 0x74, 0x46, 0xCF, 0x13, 0xFD, 0xA8, 0xDD,
+	0x49, 0x94, 0xC9, 0x38, 0x4A, 0xB9, 0x51, 0x19,
+	0x32, 0x31, 0x61, 0x79, 0xC6, 0x7E, 0xB1, 0xCA,
+	0xE1, 0xA5, 0x72,
+
+	0x2A, 0x09, 0x3C, 0x00, 0x6B, 0xD8, 0x86, 0xE8,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x57, 0x49, 0x64, 0x67, 0x90, 0x75, 0x42, 0xAD,
+	0xF9, 0x50, 0xF2, 0xD4, 0xB7, 0xF5, 0x53, 0xBF,
+	0x93, 0x6B, 0x37, 0x28, 0x4A, 0x6E, 0xCE, 0xEA,
+	0xF6, 0x32, 0x8F, 0xD7, 0x3F, 0x00, 0xBE, 0x57,
+	0xE9, 0x2B, 0x34, 0xC7, 0x8D, 0x60, 0x98, 0x4E,
+	0xD0, 0x74, 0x85, 0x8C, 0xBC, 0x80, 0x24, 0x31,
+	0x53, 0xE2, 0xFC, 0xB9, 0x0B, 0xFB, 0xF5, 0x68,
+	0xB2, 0xB3, 0x1D, 0x6A, 0xAF, 0x4A, 0xF6, 0xA2,
+	0xD8, 0x6C, 0x34, 0x41, 0x9A, 0xCE, 0xC9, 0x75,
+	0xCB, 0xA9, 0x54, 0x90, 0xB9, 0xCE, 0x29, 0x03,
+	0xAE, 0x86, 0x88, 0xB7, 0x48, 0xA9, 0xB9, 0x1A,
+	0x75, 0x4C, 0x6B, 0x50, 0x0F, 0x54, 0x85, 0x60,
+	0xD5, 0x4C, 0x3B, 0xD6, 0xB7, 0xE9, 0x8C, 0x4D,
+	0x5F, 0xD1, 0x70, 0x06, 0x4B, 0x2F, 0x3E, 0x62,
+	0xD8, 0xA2, 0x0D, 0xEA, 0xAF, 0xC5, 0xF5, 0x80,
+	0x26, 0x34, 0x03, 0xAA, 0xD7, 0x4D, 0xF3, 0xE7,
+	0x21, 0xC1, 0x76, 0xA7, 0x50, 0x83, 0x80, 0x7E,
+	0xFC, 0x19, 0xC6, 0xC5, 0x23, 0xEF, 0x05, 0x81,
+	0x23, 0x46, 0x4A, 0x0D, 0x70, 0x8F, 0xEF, 0x2E,
+	0x55, 0xBD, 0x1A, 0x71, 0x71, 0x59, 0xCA, 0x1F,
+	0xEF, 0x74, 0x12, 0x0A, 0x66, 0xC5, 0x9A, 0x81,
+	0x2D, 0x9A, 0x68, 0x8D, 0x55, 0xCE, 0x1A, 0xE3,
+	0xB7, 0x08, 0xFC, 0x99, 0xC9, 0xF8, 0x3E, 0x6B,
+	0xBD, 0x65, 0x7B, 0x16,
+
+	0x6A, 0xC9, 0x31, 0x00, 0x6B, 0xD8, 0x86, 0xE8,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x57, 0x49, 0x64, 0x67, 0x90, 0x75, 0x42, 0xAD,
+	0xF9, 0x50, 0x92, 0xD0, 0xB7, 0xEF, 0x53, 0xBF,
+	0x93, 0x55, 0xEB, 0x90, 0x48, 0x2E, 0xC3, 0xF8,
+	0x46, 0x30, 0x4F, 0xD5, 0x3F, 0x20, 0xFD, 0x43,
+	0xFD, 0x2B, 0x34, 0xC7, 0x51, 0x60, 0xC8, 0x2D,
+	0xD0, 0x74, 0x3D, 0x8D, 0xBC, 0x80, 0x24, 0x31,
+	0xE3, 0xD4, 0xE2, 0x38, 0xC3, 0x53, 0x26, 0x1B,
+	0xDC, 0x1C, 0x9B, 0x8B, 0x91, 0x3B, 0xAC, 0xB1,
+	0xC8, 0x11, 0xE3, 0xB1, 0x7F, 0xFC, 0xA8, 0x11,
+	0xA6, 0x3F, 0x76, 0x98, 0xE9, 0x8F, 0x5B, 0xF2,
+	0x97, 0x68, 0xEA, 0xF4, 0x6C, 0xD5, 0x3A, 0x48,
+	0x31, 0xE4, 0xAB, 0x78, 0x5E, 0x63, 0xB9, 0x7C,
+	0xBE, 0xD3, 0x44, 0xA5, 0xD6, 0x5F, 0xE7, 0x59,
+	0x27, 0x5A, 0x73, 0x66, 0x27, 0x6C, 0x9C, 0xA0,
+	0x49, 0xAB, 0x56, 0x7F, 0xDC, 0x0A, 0x8A, 0x4D,
+	0xCD, 0x43, 0x44, 0xAF, 0x84, 0xDA, 0xB6, 0x6D,
+	0x53, 0xC4, 0x02, 0x11, 0xE2, 0x27, 0xBE, 0x0B,
+	0x35, 0x6C, 0xA3, 0xA3, 0xA8, 0x83, 0xAE, 0x48,
+	0x49, 0x09, 0xF2, 0xEF, 0xA8, 0x38, 0x60, 0xC7,
+	0x8B, 0x28, 0x3C, 0xB9, 0xC4, 0x57, 0x50, 0x5D,
+	0xD7, 0xB6, 0xA4, 0x04, 0x2D, 0x24, 0xD2, 0x9A,
+	0x30, 0x15, 0xBF, 0xE4, 0xC6, 0x1C, 0x0C, 0x36,
+	0xDB, 0x08, 0x03, 0x70, 0x18, 0x91, 0xB3, 0x58,
+	0x8C, 0x9D, 0x20, 0x9C, 0x99,
+
+	0x6A, 0xC9, 0x3D, 0x3E, 0x6B, 0xD8, 0x86, 0xE8,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x57, 0x49, 0x64, 0x67, 0x90, 0x75, 0x42, 0xAD,
+	0xF9, 0x50, 0x92, 0xD0, 0xAF, 0xEF, 0x27, 0xBF,
+	0xEB, 0x55, 0x4B, 0x70, 0xCB, 0x2A, 0xC3, 0xDA,
+	0x07, 0xF2, 0x4C, 0xD5, 0x27, 0x20, 0x7D, 0xC4,
+	0xF2, 0x2A, 0x15, 0xC5, 0x51, 0x80, 0xEB, 0x2C,
+	0xD0, 0x58, 0x3D, 0x8D, 0x28, 0x80, 0x24, 0x31,
+	0xC3, 0x3A, 0x52, 0x7E, 0xF3, 0x74, 0x42, 0xD4,
+	0x01, 0x33, 0x5A, 0x9B, 0xD9, 0xD1, 0xAA, 0xC4,
+	0xA7, 0xEB, 0xD0, 0x1E, 0xAB, 0x10, 0x8E, 0x52,
+	0x63, 0x76, 0x2A, 0xB8, 0x99, 0xC2, 0x57, 0x90,
+	0x8D, 0x51, 0x16, 0xCE, 0xE8, 0x26, 0x29, 0x64,
+	0xEB, 0xDB, 0x65, 0x89, 0x05, 0x7F, 0x70, 0xA4,
+	0xF2, 0xE8, 0x54, 0xD9, 0xB4, 0x57, 0xBF, 0x47,
+	0xD4, 0x50, 0x70, 0xF7, 0xCC, 0xD8, 0x29, 0xB2,
+	0x29, 0x07, 0x65, 0x01, 0x3A, 0xD5, 0x8F, 0xCF,
+	0x87, 0xC4, 0x95, 0xBB, 0xD2, 0x4D, 0x48, 0xD9,
+	0x5C, 0x3F, 0x6E, 0x00, 0x5A, 0xE8, 0xBD, 0x2F,
+	0x10, 0x4E, 0x86, 0xC2, 0x9A, 0x84, 0xBB, 0x32,
+	0x01, 0x89, 0x31, 0xA1, 0xB7, 0x68, 0x12, 0x39,
+	0xA7, 0x29, 0xFB, 0x25, 0x42, 0xDA, 0x70, 0x04,
+	0x8E, 0x6A, 0xA7, 0x04, 0x73, 0x97, 0x59, 0x37,
+	0x5A, 0xE1, 0x64, 0x89, 0xB2, 0xF7, 0xFA, 0xB2,
+	0xD3, 0x47, 0x50, 0xC4, 0x9A, 0x69, 0x14, 0x84,
+	0x63, 0x26, 0x27, 0x1D, 0x99, 0x7C,
+
+	0x6A, 0xC9, 0x3D, 0x70, 0x45, 0xD8, 0x86, 0xE8,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x57, 0x49, 0x64, 0x67, 0x90, 0x75, 0x42, 0xAD,
+	0xF9, 0x50, 0x92, 0xD0, 0xAF, 0xFD, 0x27, 0xA3,
+	0xCB, 0xE4, 0x4B, 0xEC, 0x2F, 0xD0, 0xCC, 0x98,
+	0x1E, 0xD8, 0x84, 0xD5, 0x63, 0x20, 0x7D, 0x5C,
+	0x62, 0xF9, 0x95, 0xC5, 0x51, 0x80, 0x0F, 0x7E,
+	0xD0, 0xD8, 0x7D, 0x8D, 0xE0, 0xC0, 0x24, 0x31,
+	0x19, 0xFD, 0x93, 0x50, 0xEB, 0xA8, 0x02, 0x35,
+	0x39, 0x15, 0x2A, 0xF2, 0xBB, 0xA1, 0xD3, 0xBA,
+	0x85, 0x62, 0x7D, 0xBE, 0x52, 0x7C, 0x54, 0xFE,
+	0x55, 0x93, 0x09, 0x39, 0x59, 0xD7, 0x41, 0xA1,
+	0x91, 0x75, 0x57, 0x36, 0x36, 0x6E, 0xD5, 0xF2,
+	0x9A, 0xF5, 0xD2, 0x5C, 0xC0, 0x35, 0xE2, 0x14,
+	0xEB, 0xD6, 0xFB, 0x93, 0x64, 0xEE, 0xDD, 0xC0,
+	0x2B, 0x63, 0x50, 0xBD, 0x89, 0x0C, 0x27, 0x7B,
+	0xAB, 0xB8, 0xAB, 0xE1, 0x91, 0x49, 0xDE, 0xC9,
+	0x49, 0x10, 0x2F, 0x78, 0xA1, 0x86, 0x5F, 0xB7,
+	0x50, 0x68, 0x7C, 0x07, 0xC2, 0x95, 0xAC, 0xF5,
+	0xDC, 0x99, 0xBF, 0x4D, 0x94, 0x4E, 0xC1, 0xB6,
+	0xA9, 0x4E, 0xB6, 0x28, 0x22, 0xD3, 0xF4, 0x75,
+	0xBC, 0x6F, 0x8D, 0x69, 0x69, 0x31, 0x6B, 0x46,
+	0x1C, 0xA1, 0xAE, 0x5E, 0xA2, 0xA7, 0xF3, 0xE0,
+	0xFD, 0x35, 0x32, 0xA2, 0x41, 0xDF, 0x80, 0x41,
+	0x15, 0x63, 0xC7, 0x96, 0x4F, 0x2A, 0x7C, 0x7C,
+	0x41, 0x46, 0x95, 0xA2, 0x22, 0x0E, 0xC3,
+
+	0x6A, 0xC9, 0x3D, 0x70, 0xDD, 0xC6, 0x86, 0xE8,
+	0xE2, 0xB9, 0x2C, 0x47, 0xAF, 0x3B, 0x27, 0x0B,
+	0x57, 0x49, 0x64, 0x67, 0x90, 0x75, 0x42, 0xAD,
+	0xD8, 0x50, 0x92, 0xD0, 0xAF, 0xBD, 0x22, 0xA3,
+	0x4B, 0xF2, 0x91, 0xED, 0x37, 0x38, 0xCD, 0xBB,
+	0x7E, 0xDA, 0x57, 0x44, 0x2B, 0x22, 0x70, 0x5C,
+	0xE3, 0x11, 0xB2, 0x98, 0x51, 0x80, 0x0F, 0x96,
+	0xD0, 0xD8, 0x7D, 0x98, 0xE0, 0x80, 0xA4, 0x31,
+	0x12, 0xA8, 0x33, 0xF5, 0x4A, 0x51, 0x6D, 0x69,
+	0x93, 0x37, 0x63, 0x51, 0xD7, 0x93, 0x07, 0x06,
+	0xE8, 0x3F, 0xDD, 0xE3, 0xC2, 0x99, 0xD0, 0x0E,
+	0x27, 0xF0, 0xBC, 0x5F, 0x0F, 0x0F, 0xEF, 0x69,
+	0x78, 0x7A, 0x8C, 0x55, 0x6E, 0x6F, 0x4D, 0x37,
+	0x79, 0x7E, 0xA0, 0x0A, 0x75, 0x56, 0x4C, 0x02,
+	0xCD, 0xEB, 0xAD, 0xD4, 0x6D, 0x82, 0xCC, 0xD6,
+	0xC0, 0xA7, 0x02, 0x9F, 0xB6, 0x7F, 0xCC, 0xCE,
+	0xB9, 0x32, 0xB8, 0xBE, 0xFD, 0x0F, 0xDB, 0xF7,
+	0xDB, 0xE1, 0x01, 0xEF, 0xF2, 0x11, 0xAB, 0x29,
+	0x7D, 0x25, 0x76, 0xE4, 0x4B, 0x29, 0xA6, 0x3F,
+	0x26, 0xC2, 0xD1, 0x48, 0x68, 0x1A, 0x03, 0x1E,
+	0x2B, 0xA5, 0x3C, 0x5E, 0x77, 0xF2, 0x6E, 0x81,
+	0x2F, 0xA7, 0x97, 0x7A, 0x3A, 0xB7, 0xD2, 0x71,
+	0xF0, 0x54, 0x52, 0x30, 0xAD, 0xF3, 0x26, 0xCC,
+	0xB7, 0x46, 0x16, 0x80, 0x5F, 0x35, 0x98, 0x15,
+	0x43, 0x65, 0x64, 0x48, 0x5C, 0xCA, 0x36, 0x7E,
+	0x9B, 0x58, 0xA8, 0x0B, 0xE5, 0x3D, 0xFA, 0x70,
+
+	0xE4, 0x82, 0x49, 0x99, 0x2E, 0xED, 0x02, 0x79,
+	0xB5, 0x6C, 0xC4, 0x75, 0x49, 0xE6, 0x9A, 0x08,
+	0xD8, 0x68, 0x38, 0xF7, 0x69, 0x01, 0x2F, 0x8B,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0xD7, 0x2C, 0x16, 0xE4, 0xDD, 0xFF, 0xA2, 0x5F,
+	0x09, 0x69, 0x47, 0x5F, 0x13, 0x75, 0x49, 0x0C,
+	0x42, 0x13, 0x11, 0x3F, 0x5C, 0x29, 0x29, 0x91,
+	0x80, 0xE1, 0x3B, 0x5C, 0x44, 0x08, 0x4D, 0xB9,
+	0xC5, 0xAE, 0xD5, 0xD8, 0x11, 0x14, 0xC5, 0xF8,
+	0xB7, 0x9F, 0xB9, 0x96, 0xBD, 0x0E, 0x74, 0x58,
+	0x0A, 0x48, 0x25, 0x75, 0xDA, 0x05, 0x82, 0xA5,
+	0x3E, 0xF8, 0xEE, 0x3D, 0x87, 0xB1, 0x5C, 0x71,
+	0x5A, 0xB1, 0xC2, 0x0B, 0x35, 0xB8, 0x02, 0x1A,
+	0x20, 0x79, 0xDB, 0xE6, 0x68, 0x40, 0x08, 0x3B,
+	0x07, 0xA5, 0x8D, 0x91, 0xF4, 0x74, 0xF7, 0x6A,
+	0x83, 0xBA, 0xF3, 0xC4, 0x05, 0xCF, 0x19, 0x0F,
+	0xA5, 0xE0, 0x1F, 0x47, 0x3C, 0x29, 0xBA, 0xA5,
+	0xD3, 0xBE, 0x11, 0x5D, 0x00, 0x39, 0x30, 0x14,
+	0x8F, 0xD0, 0x19, 0x5D, 0x83, 0x4B, 0x2B, 0x19,
+	0x0B, 0x9F, 0x1D, 0x25, 0x53, 0xFC, 0x24, 0xE3,
+	0xEC, 0xC1, 0x17, 0x4C, 0x00, 0x22, 0x0D, 0x8C,
+	0x19, 0xBD, 0x08, 0xD8, 0x56, 0x84, 0x7F, 0x1D,
+	0xF1, 0x9D, 0xB7, 0x17, 0xEF, 0x99, 0x85, 0xE9,
+	0x41, 0x5B, 0x44, 0x6F, 0x12, 0x5E, 0x06, 0xEC,
+	0x64, 0x8D, 0xC6, 0x0B, 0x0D, 0x83, 0xC7, 0x06,
+	0x6E, 0x5A, 0x2C, 0x79, 0x56, 0xAF, 0x55, 0x23,
+	0xB7,
+
+	0xF4, 0x82, 0x11, 0x99, 0x2E, 0xED, 0x02, 0xF9,
+	0xB5, 0x6C, 0xC4, 0x75, 0x49, 0xE6, 0x9A, 0x37,
+	0xD8, 0x68, 0x38, 0xF7, 0x69, 0x01, 0x2F, 0x8B,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x63, 0x2E, 0x16, 0xE4, 0xDD, 0xEF, 0xA2, 0x7F,
+	0xDA, 0x67, 0x47, 0x7C, 0xCF, 0x73, 0xC9, 0xFF,
+	0x4B, 0x13, 0x51, 0xB8, 0x50, 0xAB, 0x6F, 0x89,
+	0x80, 0x11, 0x30, 0x3C, 0x8B, 0xA7, 0x4D, 0xB9,
+	0xB6, 0x9C, 0x22, 0xD5, 0x2A, 0x11, 0x4A, 0x24,
+	0x01, 0xC5, 0xF4, 0xA4, 0x81, 0x4D, 0xDA, 0xFC,
+	0xEA, 0x22, 0x64, 0x93, 0x2F, 0xDB, 0x16, 0x7D,
+	0x53, 0xE9, 0x06, 0x0A, 0xEB, 0x04, 0xEB, 0x3E,
+	0x6D, 0xE7, 0xFB, 0xED, 0x35, 0xD5, 0x62, 0xB5,
+	0xBD, 0x0F, 0x8E, 0x12, 0x7E, 0x20, 0x5E, 0xE4,
+	0xA9, 0x2D, 0x14, 0x10, 0x4F, 0x81, 0x9B, 0xF4,
+	0xB7, 0x5A, 0x0D, 0xC7, 0xD3, 0x4F, 0x32, 0xA5,
+	0x38, 0xE4, 0xB8, 0xF5, 0x21, 0xB6, 0x15, 0xD7,
+	0x73, 0x4B, 0xB6, 0x84, 0x20, 0x4D, 0x92, 0xEC,
+	0x82, 0x22, 0x41, 0x20, 0x45, 0x71, 0x01, 0x5F,
+	0x88, 0xE8, 0x5E, 0xBB, 0x0B, 0xE4, 0xF3, 0x38,
+	0x32, 0xEC, 0x12, 0x1D, 0xA4, 0xB2, 0x7E, 0xFF,
+	0x64, 0x24, 0xF3, 0xC0, 0xA7, 0xF3, 0xDA, 0x93,
+	0x52, 0x72, 0xE0, 0x0E, 0x52, 0xB4, 0x43, 0xD4,
+	0x2A, 0xBE, 0x15, 0x56, 0xBA, 0xB1, 0xA3, 0xD5,
+	0xC0, 0x71, 0xE4, 0xD7, 0x0F, 0xC6, 0x79, 0x50,
+	0x7F, 0x85, 0x58, 0x07, 0x10, 0x09, 0xC7, 0xFE,
+	0x3F, 0x70,
+
+	0xD4, 0x94, 0x91, 0x93, 0x2E, 0xED, 0x02, 0xF9,
+	0x9A, 0x6C, 0xC4, 0x75, 0x49, 0xE6, 0x9A, 0x5D,
+	0xD8, 0x68, 0x38, 0xF7, 0x69, 0x01, 0x2F, 0x8B,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x03, 0x08, 0x16, 0xE4, 0xDD, 0x6F, 0xE2, 0x7F,
+	0x2E, 0xF9, 0xEC, 0x7C, 0xCD, 0xCE, 0xCC, 0xF7,
+	0xE7, 0x11, 0x51, 0x78, 0x45, 0xF8, 0x0F, 0xD6,
+	0x80, 0x11, 0xC4, 0x3E, 0x62, 0xFC, 0xA1, 0x99,
+	0x11, 0xF4, 0xAB, 0x3D, 0x2F, 0xCA, 0x6F, 0x39,
+	0xD2, 0xED, 0xE4, 0x72, 0xD1, 0x61, 0xB8, 0xE2,
+	0x69, 0x7A, 0xF6, 0x74, 0x54, 0x5F, 0x1A, 0x40,
+	0x18, 0x77, 0xED, 0xEF, 0xE0, 0xDD, 0x4E, 0xBD,
+	0xBE, 0xEA, 0xBE, 0x34, 0xAA, 0xB0, 0x5D, 0x11,
+	0x16, 0x4E, 0x03, 0xE3, 0x23, 0x04, 0x46, 0xE4,
+	0x00, 0xD0, 0x51, 0x6B, 0x47, 0x96, 0x72, 0xDD,
+	0xBC, 0xF3, 0x47, 0x22, 0xB7, 0x88, 0xF1, 0x09,
+	0x16, 0x04, 0x1A, 0x10, 0xDB, 0x69, 0xD9, 0x40,
+	0x8C, 0x97, 0xB3, 0x7D, 0x52, 0x75, 0xB6, 0x6A,
+	0xB8, 0x94, 0x24, 0x19, 0x93, 0x65, 0x2C, 0xE6,
+	0xF0, 0x28, 0x07, 0x8C, 0x6A, 0x8E, 0x25, 0x19,
+	0xFC, 0xA1, 0xF3, 0xDB, 0x13, 0xC4, 0x86, 0x52,
+	0x97, 0x14, 0x8C, 0x23, 0xE9, 0x44, 0xF9, 0x75,
+	0x76, 0xCA, 0x69, 0x30, 0x39, 0xB8, 0xDF, 0x53,
+	0xCE, 0xE3, 0x0E, 0x70, 0xCB, 0x93, 0x24, 0x45,
+	0x18, 0x20, 0x3A, 0x3D, 0x75, 0xF9, 0x45, 0x02,
+	0x99, 0x1C, 0xD9, 0x44, 0x61, 0x1C, 0x45, 0x42,
+	0x86, 0x86, 0x40,
+
+	0xD4, 0x94, 0x98, 0x93, 0x27, 0xED, 0x02, 0xF9,
+	0x19, 0x73, 0xC4, 0x75, 0x49, 0xE6, 0x9A, 0xDD,
+	0xD8, 0x68, 0x38, 0xF7, 0x69, 0x01, 0x2F, 0x8B,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x03, 0x48, 0x1C, 0xE4, 0xDD, 0x6F, 0xE2, 0x6B,
+	0x3A, 0x01, 0x3D, 0x7F, 0x4D, 0xC9, 0xD2, 0xF7,
+	0x77, 0xD1, 0x5E, 0x78, 0xC5, 0x5A, 0x8F, 0x36,
+	0x80, 0x11, 0xC4, 0xC6, 0x67, 0x3C, 0x56, 0xBE,
+	0x8E, 0x04, 0xDC, 0x97, 0xE2, 0x86, 0x9D, 0x73,
+	0x5B, 0x85, 0x7E, 0xEE, 0xEF, 0x9C, 0xCA, 0x66,
+	0x1F, 0x36, 0xC2, 0x9C, 0xDD, 0x44, 0x2E, 0xF5,
+	0x66, 0x88, 0x36, 0x27, 0x74, 0xD9, 0xE8, 0x42,
+	0x1E, 0x1F, 0x4E, 0x93, 0x23, 0x31, 0xCA, 0xA2,
+	0x6A, 0xB2, 0x19, 0x0B, 0x65, 0x8C, 0x5C, 0x3F,
+	0x9D, 0x9D, 0xD6, 0x36, 0x6E, 0x71, 0x8C, 0xA9,
+	0x53, 0x50, 0x0F, 0x82, 0xA4, 0xA9, 0x59, 0x94,
+	0x67, 0x37, 0x1A, 0x9A, 0x20, 0xAF, 0x66, 0xDE,
+	0x72, 0xF8, 0xFE, 0x86, 0xD8, 0x69, 0x2F, 0xE5,
+	0xF9, 0x08, 0x08, 0x81, 0xD7, 0x8E, 0x1A, 0x4A,
+	0xD7, 0x87, 0x17, 0x8F, 0x2A, 0xF7, 0x96, 0x0B,
+	0xDD, 0x2B, 0x18, 0xBC, 0x24, 0xB6, 0x75, 0xD1,
+	0x57, 0x6E, 0x13, 0xDB, 0x9D, 0x2C, 0x6F, 0x33,
+	0x2B, 0x57, 0x41, 0xED, 0xB2, 0xFD, 0xC4, 0x65,
+	0xA1, 0x4C, 0x43, 0xFB, 0x22, 0x4E, 0x4A, 0x10,
+	0xC6, 0x05, 0x7C, 0x8F, 0x8C, 0xB1, 0x40, 0xF3,
+	0xF6, 0x30, 0x81, 0xC0, 0xAA, 0xB7, 0x5D, 0x5A,
+	0x0A, 0xEC, 0x78, 0x42,
+
+	0xD4, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x02, 0xF9,
+	0x99, 0xB7, 0xCB, 0x75, 0x49, 0xE6, 0x9A, 0xDD,
+	0xD8, 0x68, 0x38, 0xF7, 0x69, 0x01, 0x2F, 0x8B,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x17, 0x48, 0x14, 0xE4, 0xDD, 0x6F, 0xE2, 0xEB,
+	0x3B, 0xC7, 0xC1, 0x3B, 0xDC, 0xC9, 0xD7, 0x60,
+	0xC6, 0xE0, 0xDB, 0x7D, 0xC5, 0xDA, 0x5F, 0x75,
+	0xA0, 0x71, 0xC4, 0xC6, 0x9B, 0x1C, 0xAB, 0x0D,
+	0xFE, 0x34, 0x50, 0x81, 0xDC, 0xCA, 0x69, 0xAA,
+	0x39, 0x15, 0xEE, 0x68, 0x33, 0x41, 0xCA, 0xB1,
+	0x6E, 0xA2, 0xCE, 0xCF, 0xD9, 0xB9, 0x9A, 0xC0,
+	0x7A, 0x2C, 0xD9, 0x68, 0x8F, 0x79, 0x70, 0x45,
+	0x96, 0xE0, 0xCC, 0x87, 0xEB, 0xB8, 0x3A, 0xE5,
+	0x4A, 0x98, 0xA1, 0xEA, 0x74, 0x55, 0x24, 0xCF,
+	0x67, 0xE3, 0x71, 0xBF, 0x41, 0xBB, 0x73, 0x43,
+	0xF3, 0xA2, 0xD8, 0x93, 0xB0, 0x59, 0x2A, 0xD9,
+	0x04, 0x72, 0x5A, 0x86, 0x0B, 0xF7, 0x0F, 0x54,
+	0x50, 0x1E, 0x8B, 0x08, 0xB6, 0xD0, 0xE7, 0x8C,
+	0xF2, 0x51, 0x2C, 0x52, 0x92, 0xAD, 0x58, 0x9B,
+	0xAC, 0xA8, 0x4D, 0xB1, 0x4B, 0x89, 0x1C, 0x73,
+	0x96, 0x82, 0xC2, 0x2B, 0xBA, 0x34, 0x03, 0xB8,
+	0x46, 0x6F, 0xD1, 0x28, 0x8E, 0x27, 0x08, 0xFA,
+	0x8D, 0xC1, 0x5B, 0x10, 0x2D, 0x88, 0x0D, 0x16,
+	0x06, 0x09, 0xFD, 0xA6, 0x5E, 0x5F, 0xC7, 0xFC,
+	0x66, 0x8B, 0xED, 0x8D, 0xAD, 0x28, 0xD5, 0x34,
+	0x6D, 0xEC, 0x21, 0x4B, 0x6B, 0xA5, 0x71, 0x5E,
+	0x43, 0x36, 0x67, 0xE7, 0xFB,
+
+	0xD4, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x02, 0xF9,
+	0x99, 0xB7, 0xCB, 0x75, 0x49, 0xE6, 0x9A, 0xDD,
+	0xD8, 0x68, 0x38, 0xF7, 0x69, 0x01, 0x2F, 0x8B,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x17, 0x48, 0x14, 0xE4, 0xDD, 0x6F, 0xE2, 0xEB,
+	0x3B, 0xC7, 0xC1, 0x3B, 0xDC, 0xC9, 0xD7, 0x60,
+	0xC6, 0xE0, 0xDB, 0x7D, 0xC5, 0xDA, 0x5F, 0x75,
+	0xA0, 0x71, 0xC4, 0xC6, 0x9B, 0x1C, 0xAB, 0x0D,
+	0xFE, 0x34, 0x50, 0x81, 0xDC, 0xCA, 0x69, 0xAA,
+	0x39, 0x15, 0xEE, 0x68, 0x33, 0x41, 0xCA, 0xB1,
+	0x6E, 0xA2, 0xCE, 0xCF, 0xD9, 0xB9, 0x9A, 0xC0,
+	0x7A, 0x2C, 0xD9, 0x68, 0x8F, 0x79, 0x70, 0x45,
+	0x96, 0xE0, 0xCC, 0x87, 0xEB, 0xB8, 0x3A, 0xE5,
+	0x4A, 0x98, 0xA1, 0xEA, 0x74, 0x55, 0x24, 0xCF,
+	0x67, 0xE3, 0x71, 0xBF, 0x41, 0xBB, 0x73, 0x43,
+	0xF3, 0xA2, 0xD8, 0x93, 0xB0, 0x59, 0x2A, 0xD9,
+	0x04, 0x72, 0x5A, 0x86, 0x0B, 0xF7, 0x0F, 0x54,
+	0x50, 0x1E, 0x8B, 0x08, 0xB6, 0xD0, 0xE7, 0x8C,
+	0xF2, 0x51, 0x2C, 0x52, 0x92, 0xAD, 0x58, 0x9B,
+	0xAC, 0xA8, 0x4D, 0xB1, 0x4B, 0x89, 0x1C, 0x73,
+	0x96, 0x82, 0xC2, 0x2B, 0xBA, 0x34, 0x03, 0xB8,
+	0x46, 0x6F, 0xD1, 0x28, 0x8E, 0x27, 0x08, 0xFA,
+	0x8D, 0xC1, 0x5B, 0x10, 0x2D, 0x88, 0x0D, 0x16,
+	0x06, 0x09, 0xFD, 0xA6, 0x5E, 0x5F, 0xC7, 0xFC,
+	0x66, 0x8B, 0xED, 0x8D, 0xAD, 0xBE, 0x20, 0x96,
+	0xFA, 0xDD, 0x23, 0xB6, 0x33, 0x60, 0x07, 0xF6,
+	0xA2, 0x5F, 0x1A, 0xD2, 0xEC, 0xC6,
+
+	0xD4, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x02, 0x99,
+	0x99, 0xB7, 0xCB, 0x25, 0x79, 0xE6, 0x9A, 0xDD,
+	0xD8, 0x68, 0x38, 0xF7, 0x69, 0x01, 0x2F, 0x8B,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x17, 0xC8, 0x74, 0xE4, 0xDD, 0xEB, 0xE2, 0xEB,
+	0x1B, 0x06, 0xC3, 0x7F, 0xDA, 0xC6, 0xD3, 0x60,
+	0xDA, 0xEC, 0xD6, 0x39, 0x47, 0xDA, 0x5F, 0x75,
+	0xB1, 0x6D, 0x48, 0xC6, 0x99, 0x1C, 0xAF, 0x0E,
+	0x9E, 0x2C, 0x46, 0x40, 0x78, 0xF0, 0xED, 0x1A,
+	0x6E, 0xAE, 0xD4, 0x58, 0xDA, 0x89, 0xFB, 0x37,
+	0xC2, 0xBB, 0x05, 0x0F, 0x94, 0x98, 0x2A, 0x45,
+	0x41, 0xAC, 0x1C, 0x50, 0xC4, 0x0B, 0x53, 0x0C,
+	0x53, 0x8D, 0xA7, 0x15, 0x59, 0x9A, 0xDC, 0xF2,
+	0xB9, 0xDC, 0xA0, 0x60, 0x12, 0x44, 0x27, 0x7C,
+	0xF2, 0x5F, 0x96, 0x04, 0x3F, 0xCE, 0x5E, 0x0B,
+	0x0D, 0x00, 0x74, 0x2B, 0x22, 0x2E, 0x16, 0xCA,
+	0x32, 0xC7, 0xEF, 0xCB, 0x64, 0x8C, 0x64, 0x79,
+	0xD6, 0x89, 0x15, 0xF6, 0x53, 0x26, 0x31, 0x2C,
+	0xF9, 0xDB, 0xDB, 0xD6, 0x4E, 0xD0, 0xA9, 0xA4,
+	0x94, 0x30, 0x43, 0x34, 0xF4, 0xE3, 0xD9, 0xEF,
+	0x33, 0x8C, 0xBE, 0xA5, 0x49, 0x68, 0x61, 0x72,
+	0x52, 0xB6, 0xD1, 0xCF, 0x9B, 0x71, 0x81, 0x7E,
+	0x3A, 0xD3, 0xAE, 0x94, 0xA3, 0x7E, 0x71, 0xDF,
+	0xCB, 0x73, 0xF3, 0x72, 0xC5, 0xB6, 0x8D, 0x96,
+	0xDC, 0x64, 0xBA, 0x64, 0x18, 0xCF, 0x79, 0x04,
+	0x2B, 0xF2, 0xB6, 0x99, 0x99, 0xA2, 0xE6, 0x75,
+	0x73, 0x5F, 0x53, 0x30, 0x5C, 0x18, 0x85,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0x99, 0xB7, 0xCB, 0x25, 0xF9, 0xC6, 0x9A, 0xDD,
+	0xD8, 0x68, 0x38, 0xF7, 0x69, 0x01, 0x2F, 0x8B,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x17, 0xC8, 0x74, 0xA5, 0xDD, 0xFB, 0xE2, 0xEB,
+	0x99, 0x06, 0x41, 0x7F, 0xDA, 0xCC, 0xF1, 0x64,
+	0xFA, 0xEC, 0xD2, 0x39, 0x07, 0xDA, 0x4F, 0x75,
+	0xB3, 0x4D, 0x60, 0x4E, 0x98, 0x1C, 0xAF, 0x06,
+	0x1A, 0x28, 0x46, 0x45, 0xAE, 0xEC, 0x35, 0x32,
+	0xC6, 0x80, 0xD0, 0xD8, 0xE2, 0x03, 0x7A, 0xF4,
+	0xC1, 0x8A, 0x24, 0x1A, 0xD3, 0x10, 0xBB, 0x71,
+	0x49, 0xAA, 0x38, 0xB0, 0x60, 0x83, 0x51, 0x80,
+	0xA9, 0x10, 0xB1, 0xA8, 0xFA, 0x1D, 0xAE, 0x39,
+	0x2E, 0x0B, 0x79, 0x66, 0x9A, 0x55, 0x69, 0x75,
+	0x7F, 0xEA, 0xCB, 0xF0, 0x6C, 0x6D, 0xBE, 0x79,
+	0xDC, 0x2A, 0xF2, 0xCF, 0x08, 0x3D, 0xE4, 0x90,
+	0x4B, 0x16, 0x22, 0x5C, 0x20, 0x16, 0xC3, 0x32,
+	0xF4, 0xAF, 0xFA, 0x53, 0x65, 0x11, 0x19, 0x3E,
+	0x2B, 0x26, 0x8C, 0x5E, 0x79, 0x30, 0x85, 0x75,
+	0x62, 0x18, 0xBE, 0x61, 0x5B, 0xBB, 0x6C, 0x31,
+	0xAA, 0x67, 0x16, 0x3E, 0x3E, 0x10, 0x31, 0x8A,
+	0xDD, 0x2A, 0x8F, 0xFB, 0x1C, 0xB6, 0xC0, 0x1B,
+	0x1C, 0x0C, 0x6E, 0x80, 0x00, 0xB7, 0x90, 0x53,
+	0xC8, 0xC7, 0xA8, 0x91, 0x73, 0x5C, 0x36, 0x22,
+	0x0B, 0xA5, 0xB4, 0x9E, 0x6F, 0x3B, 0xE1, 0xF0,
+	0x18, 0xC7, 0x60, 0x79, 0x04, 0x32, 0x77, 0xA7,
+	0x16, 0xB6, 0x00, 0x07, 0x24, 0x11, 0x81, 0xFC,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0x99, 0x96, 0xCB, 0x25, 0xF9, 0xC6, 0xDA, 0xDD,
+	0xD8, 0x68, 0x38, 0xF7, 0xE9, 0xC1, 0x3F, 0x8B,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x1B, 0x4B, 0x44, 0x91, 0x9A, 0xF7, 0xE2, 0xEB,
+	0x98, 0x06, 0x41, 0x7F, 0xDA, 0xCC, 0xE9, 0xEE,
+	0xFB, 0xAC, 0xD2, 0x7A, 0x05, 0xDA, 0x43, 0x60,
+	0xB3, 0x7D, 0x40, 0xC8, 0x98, 0x1D, 0xE3, 0x0E,
+	0xC9, 0x29, 0x0F, 0x06, 0xFB, 0x96, 0xF5, 0x8F,
+	0xCA, 0x25, 0x56, 0xC3, 0x72, 0x52, 0xD0, 0x76,
+	0xE6, 0xCE, 0x15, 0x57, 0x82, 0x25, 0xC1, 0x0D,
+	0x41, 0x1A, 0xBB, 0x2C, 0xC3, 0x93, 0x51, 0x81,
+	0xB1, 0x41, 0xDD, 0xF6, 0x24, 0x04, 0xA0, 0x77,
+	0x28, 0xD1, 0xFB, 0x74, 0xD7, 0x28, 0xDF, 0xDE,
+	0x13, 0x44, 0x7A, 0x51, 0x3F, 0xFD, 0x73, 0x34,
+	0xE4, 0x92, 0x92, 0xF4, 0x86, 0x30, 0x61, 0x93,
+	0xD0, 0x44, 0x38, 0xD7, 0x42, 0x9A, 0xCA, 0x20,
+	0xD2, 0x4F, 0x51, 0xF4, 0x3D, 0xCC, 0x10, 0xFA,
+	0x61, 0x92, 0xA0, 0xA6, 0x97, 0x1A, 0x9A, 0xCB,
+	0x82, 0x20, 0x70, 0x8D, 0xA8, 0xA2, 0x10, 0xC5,
+	0x0C, 0x37, 0xC2, 0x97, 0x27, 0x8C, 0xAD, 0x2E,
+	0xA7, 0x71, 0x79, 0xF3, 0x57, 0x26, 0x90, 0x15,
+	0x51, 0xA5, 0xE2, 0x3E, 0x8B, 0x93, 0x5D, 0xF0,
+	0xA1, 0xA7, 0x8D, 0x94, 0x8B, 0xE6, 0xFB, 0x37,
+	0xF6, 0xD8, 0x7E, 0x9D, 0x7B, 0x73, 0xCE, 0x66,
+	0x76, 0x03, 0xD6, 0xCE, 0x1D, 0xE9, 0x2F, 0x3F,
+	0x1A, 0x0A, 0xD1, 0xA7, 0xEE, 0xBB, 0xDB, 0xC6,
+	0xA4,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0x99, 0x96, 0xCB, 0x25, 0xF9, 0xC6, 0xDA, 0x5D,
+	0xD8, 0x68, 0x38, 0xF7, 0xE9, 0xC1, 0x3F, 0x8A,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x1B, 0x5B, 0x4C, 0xD1, 0xDA, 0xFF, 0xE2, 0xEB,
+	0x90, 0x06, 0x41, 0x7F, 0xDA, 0xCE, 0xE9, 0xEE,
+	0x9B, 0xAC, 0xD2, 0x7B, 0x01, 0xDA, 0x43, 0x70,
+	0xB3, 0x7D, 0x40, 0xC8, 0xB8, 0x1D, 0xE3, 0x0E,
+	0xC8, 0x2C, 0x9F, 0x26, 0xFB, 0xD2, 0xB5, 0xFF,
+	0x82, 0x25, 0x5B, 0xC7, 0x52, 0xC2, 0xD0, 0xD6,
+	0xE4, 0xDF, 0x05, 0x56, 0x16, 0xA5, 0xC0, 0x4B,
+	0xC1, 0xB3, 0xBB, 0x2F, 0xF3, 0x9B, 0xD5, 0x03,
+	0xB0, 0x60, 0x5D, 0x05, 0x24, 0xC3, 0xF5, 0xE7,
+	0xDA, 0xDB, 0x57, 0x58, 0x66, 0x03, 0xEC, 0xC1,
+	0x6A, 0xC9, 0x5C, 0x10, 0x01, 0xEC, 0xAA, 0x1F,
+	0xF6, 0x3E, 0x22, 0x78, 0xA0, 0x2E, 0x6E, 0x73,
+	0x7E, 0x2A, 0xDA, 0xDC, 0x7C, 0x91, 0x1C, 0xE0,
+	0x1E, 0xD8, 0xA4, 0x65, 0x54, 0xA9, 0x17, 0x12,
+	0x22, 0xE2, 0x76, 0xED, 0xF1, 0xFA, 0x23, 0xE0,
+	0xFE, 0xF7, 0xD7, 0x49, 0xF9, 0x05, 0x36, 0xD8,
+	0x91, 0xBF, 0x56, 0x8A, 0x7D, 0x0A, 0x99, 0x06,
+	0x65, 0xC0, 0x3A, 0x94, 0x70, 0x8B, 0xD9, 0x1B,
+	0x1A, 0x80, 0x74, 0xD7, 0x4D, 0x61, 0x6A, 0x3D,
+	0x9E, 0x53, 0xC0, 0xF6, 0x1B, 0x31, 0xAB, 0x70,
+	0x2E, 0xDB, 0x53, 0x64, 0xE4, 0x73, 0x2A, 0x73,
+	0x59, 0xD3, 0x8B, 0x0A, 0xE4, 0x3B, 0x5C, 0xB6,
+	0x8A, 0xBC, 0x93, 0x99, 0x96, 0x9A, 0x70, 0xFE,
+	0x6A, 0xD3,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0x99, 0x9E, 0x4B, 0x07, 0xF9, 0xC6, 0xDA, 0x5D,
+	0xE9, 0x68, 0x38, 0xF7, 0xE9, 0xC1, 0x7F, 0xFA,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x1B, 0x5B, 0x58, 0xD2, 0xCA, 0xA3, 0xAE, 0xEB,
+	0xB8, 0x80, 0x41, 0x7F, 0xDA, 0xCE, 0x8B, 0xEE,
+	0x8F, 0xE3, 0xD3, 0x3B, 0x10, 0x17, 0x43, 0xB5,
+	0x1B, 0x6D, 0x40, 0x90, 0xA0, 0x17, 0xEA, 0x0E,
+	0xC8, 0xC9, 0xC2, 0x22, 0xE2, 0x47, 0x6E, 0x03,
+	0x58, 0x5D, 0x0F, 0x8D, 0xD8, 0xD2, 0x58, 0x01,
+	0x61, 0xCF, 0x41, 0x82, 0x0D, 0xF8, 0xC3, 0x5E,
+	0x75, 0x52, 0xBD, 0xB6, 0xB7, 0x3F, 0xE8, 0x07,
+	0x70, 0x5D, 0xB6, 0xAF, 0x34, 0x1A, 0x2E, 0x1D,
+	0xE3, 0xDD, 0xA5, 0xCE, 0x5A, 0x53, 0xB6, 0xF7,
+	0xB5, 0x9E, 0x15, 0xCA, 0x9A, 0x72, 0xB0, 0x2E,
+	0x0B, 0x03, 0xCA, 0xA3, 0x3D, 0x61, 0x43, 0x7A,
+	0xD9, 0xEA, 0xC7, 0x5D, 0xB9, 0x56, 0xF3, 0x61,
+	0xCA, 0x86, 0x77, 0x24, 0xA1, 0xD1, 0x4B, 0x8B,
+	0x91, 0x9F, 0xB8, 0x6E, 0x0D, 0xAE, 0x8F, 0x5E,
+	0x98, 0x16, 0x96, 0x5C, 0x0B, 0x65, 0x19, 0x8E,
+	0x48, 0xCA, 0xC2, 0x96, 0x3A, 0x16, 0x72, 0x3B,
+	0x37, 0xA4, 0x55, 0x1E, 0xAE, 0xED, 0x9A, 0x64,
+	0x66, 0xB7, 0xE0, 0x5E, 0x6B, 0x11, 0xE0, 0x6F,
+	0x11, 0xA7, 0x90, 0xBB, 0xE8, 0xA0, 0x6A, 0x7E,
+	0xE0, 0xAC, 0x92, 0xB8, 0x0A, 0x7E, 0x17, 0x82,
+	0xF1, 0x96, 0xD9, 0x94, 0xB7, 0xF5, 0x20, 0x7B,
+	0x8D, 0xED, 0xE3, 0xF5, 0x36, 0x5C, 0xA8, 0x80,
+	0x10, 0x59, 0xBB,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0x99, 0x5E, 0x4B, 0x07, 0xFA, 0xC6, 0xDA, 0x5D,
+	0x48, 0x49, 0x38, 0xF7, 0xE9, 0xC1, 0x7F, 0x7A,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x1B, 0x5B, 0x58, 0xCA, 0xC8, 0xC7, 0xD6, 0xE3,
+	0xB8, 0xB0, 0x51, 0x7E, 0xDA, 0xCE, 0x8B, 0xEF,
+	0x0F, 0xFB, 0xD1, 0x3B, 0x90, 0x17, 0xC1, 0xB1,
+	0x1D, 0xAD, 0x41, 0x90, 0xE0, 0x53, 0xC2, 0x2A,
+	0xA4, 0x38, 0x2F, 0x3E, 0xED, 0x77, 0x49, 0x8D,
+	0x98, 0x65, 0x5B, 0x3C, 0x8B, 0xD3, 0x59, 0xF5,
+	0x57, 0x49, 0x11, 0xA2, 0x8D, 0xDB, 0xF5, 0x14,
+	0x54, 0xDA, 0x3C, 0xCA, 0x46, 0xAC, 0x60, 0x9B,
+	0x40, 0xE0, 0x46, 0x0D, 0xD2, 0x14, 0xBB, 0x41,
+	0xA6, 0x99, 0x21, 0x39, 0x03, 0xB2, 0x50, 0xC0,
+	0x3E, 0x9F, 0x9E, 0xA2, 0x24, 0x6A, 0x01, 0x0E,
+	0x2F, 0xCA, 0x7D, 0x1D, 0x21, 0xDB, 0xF5, 0xA0,
+	0xF1, 0xDD, 0xE9, 0x82, 0x4F, 0xE8, 0xA4, 0x58,
+	0x36, 0x65, 0x04, 0xE2, 0x8C, 0x61, 0xBA, 0xA9,
+	0xEC, 0x59, 0xD3, 0xEE, 0xE2, 0x01, 0xE1, 0x99,
+	0xD8, 0xBF, 0x42, 0x2D, 0xAC, 0x0F, 0x18, 0xD5,
+	0x7F, 0x08, 0xB7, 0x52, 0x7C, 0x16, 0xD9, 0x31,
+	0x41, 0xE6, 0x3D, 0x48, 0xEE, 0xC3, 0x0A, 0xC0,
+	0xDF, 0x17, 0x42, 0x71, 0x5A, 0x5B, 0x36, 0x0C,
+	0xFB, 0x1B, 0xF8, 0x1F, 0x6E, 0x2E, 0x03, 0x52,
+	0x3F, 0x60, 0x0C, 0x49, 0x38, 0xAA, 0xA0, 0x06,
+	0x47, 0xD7, 0x5B, 0xEC, 0x7D, 0xD6, 0x03, 0x56,
+	0xC5, 0xF1, 0x02, 0xB1, 0x10, 0x3D, 0x2D, 0x50,
+	0x71, 0xB8, 0x47, 0x2D,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0x99, 0x5E, 0xAB, 0x0F, 0xFA, 0xE5, 0xDA, 0x5D,
+	0x48, 0x88, 0x29, 0xF7, 0xE9, 0xC1, 0x7F, 0x7A,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x10, 0x53, 0x58, 0xCA, 0xD4, 0xC6, 0xA6, 0x87,
+	0x9B, 0xB0, 0x51, 0x72, 0xDA, 0xCE, 0x8B, 0x6F,
+	0x0F, 0xBF, 0xCD, 0x4A, 0x80, 0xD7, 0xC1, 0xF6,
+	0xB7, 0xCF, 0x25, 0x98, 0xE0, 0x43, 0xA2, 0x0A,
+	0xA3, 0x3B, 0xB3, 0x2E, 0xAC, 0x5C, 0xFA, 0xEC,
+	0x52, 0x70, 0x61, 0x36, 0x83, 0x4C, 0x77, 0x10,
+	0x44, 0x64, 0xF2, 0xF0, 0x14, 0x0B, 0xFD, 0x44,
+	0xFB, 0xD9, 0xF2, 0x8B, 0x25, 0x18, 0xAE, 0x1B,
+	0xCF, 0x78, 0xF4, 0x8F, 0x52, 0xCF, 0x16, 0x3C,
+	0xFB, 0x30, 0x9B, 0x55, 0x32, 0x87, 0xB3, 0x4B,
+	0x07, 0x3F, 0xF6, 0x1C, 0x5B, 0xA9, 0x00, 0xF7,
+	0xFA, 0x9E, 0x44, 0x48, 0x51, 0x26, 0x17, 0x56,
+	0x35, 0x73, 0x08, 0x76, 0x0A, 0xD3, 0xCE, 0x38,
+	0xC7, 0x75, 0x01, 0x52, 0xC4, 0xE6, 0xC2, 0x20,
+	0x74, 0x6B, 0x48, 0x7D, 0xE3, 0x07, 0xF5, 0xAD,
+	0xBD, 0x60, 0x42, 0xF6, 0x74, 0x37, 0xF9, 0x36,
+	0xE7, 0xF4, 0x28, 0x33, 0xBE, 0xE6, 0x7D, 0x48,
+	0xD8, 0x60, 0xA2, 0x63, 0x78, 0xC6, 0x3C, 0x38,
+	0x1A, 0xE4, 0xC4, 0x0E, 0x5A, 0x3D, 0x30, 0x04,
+	0x06, 0xF2, 0xE4, 0x97, 0x6E, 0x3B, 0xD3, 0x82,
+	0x05, 0xE3, 0x93, 0x4A, 0x67, 0x51, 0x18, 0xD7,
+	0x71, 0x68, 0xA6, 0xE0, 0x05, 0x08, 0x35, 0x44,
+	0xAD, 0xE1, 0x2D, 0xD2, 0xAD, 0xD4, 0xDC, 0x32,
+	0xAC, 0xC7, 0x1D, 0x1E, 0x35,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0x99, 0x5E, 0xAB, 0x0F, 0xFB, 0xE5, 0xDE, 0x5D,
+	0x48, 0x88, 0x29, 0xF5, 0xE9, 0xC1, 0x7F, 0x7A,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x90, 0x53, 0x78, 0xCA, 0xD4, 0xE6, 0xB6, 0x07,
+	0x9B, 0xB0, 0x51, 0x32, 0xCA, 0xCE, 0x8B, 0x6F,
+	0x47, 0xBF, 0xCD, 0x6A, 0x20, 0xD7, 0xC1, 0xF4,
+	0xA7, 0xCF, 0x25, 0x98, 0xE0, 0x43, 0x22, 0x8A,
+	0xA7, 0x3F, 0x22, 0x0E, 0x0E, 0x54, 0xEA, 0xE4,
+	0x1A, 0x64, 0x69, 0xB2, 0x07, 0x2C, 0x65, 0x14,
+	0x74, 0x65, 0xF2, 0xFA, 0x30, 0x0D, 0xDD, 0x46,
+	0xFA, 0xD5, 0xF6, 0x83, 0x21, 0x1A, 0xEF, 0x1F,
+	0x6E, 0xF3, 0xC9, 0xA1, 0xE3, 0x1E, 0xA4, 0x6C,
+	0xE9, 0xF2, 0x6A, 0x38, 0x8F, 0xD7, 0xF2, 0x07,
+	0xED, 0xD8, 0xFF, 0x82, 0x7E, 0x78, 0x0B, 0xF2,
+	0x5C, 0x18, 0x50, 0xB0, 0x16, 0xB2, 0x29, 0xAB,
+	0x4A, 0x50, 0x4F, 0x1C, 0x09, 0xCF, 0x03, 0xF1,
+	0x8B, 0x19, 0xAA, 0x8A, 0x36, 0xC4, 0xB4, 0xC8,
+	0xBD, 0xDD, 0x99, 0x17, 0x88, 0xE1, 0x34, 0xDF,
+	0xCB, 0x70, 0x83, 0x9B, 0xA3, 0x27, 0x15, 0x0D,
+	0xE9, 0x65, 0x91, 0xBA, 0xA0, 0xFD, 0x0C, 0x42,
+	0x10, 0xC0, 0x5E, 0x80, 0x0B, 0x83, 0x86, 0xD4,
+	0x08, 0x47, 0x53, 0x4C, 0xA7, 0xE1, 0xDE, 0xE7,
+	0xE5, 0x8D, 0xA7, 0x47, 0x13, 0x06, 0x9B, 0x92,
+	0x02, 0x1B, 0x83, 0x68, 0xCB, 0x35, 0x81, 0x8D,
+	0x41, 0x03, 0x64, 0x00, 0x05, 0x06, 0xD8, 0x89,
+	0x24, 0x1E, 0xC6, 0x1B, 0xCD, 0x7F, 0xB1, 0x15,
+	0xFB, 0xD9, 0xE2, 0x0F, 0x6B, 0x65,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0x99, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xDE, 0x5D,
+	0x48, 0x88, 0x29, 0xA5, 0xDB, 0xC1, 0x7F, 0x7A,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x00, 0x8F, 0x74, 0xCA, 0xD4, 0xE6, 0x92, 0x14,
+	0x9B, 0x30, 0x51, 0x32, 0x8A, 0xCC, 0x8B, 0x6F,
+	0x65, 0x7E, 0xCB, 0xEA, 0x04, 0xC4, 0xC6, 0xB4,
+	0x33, 0xC3, 0x2F, 0xFC, 0xE9, 0x52, 0x22, 0x82,
+	0xC9, 0xCC, 0xBC, 0x82, 0x44, 0x11, 0xE4, 0x45,
+	0xFD, 0x8D, 0x79, 0xB2, 0xFF, 0xA0, 0xA1, 0x7A,
+	0x57, 0x6B, 0xF0, 0x21, 0xF3, 0x05, 0x9F, 0xB1,
+	0xC6, 0x65, 0xC8, 0x8A, 0x9D, 0x1C, 0xF6, 0xCD,
+	0x2D, 0x4B, 0xF5, 0x7D, 0x59, 0xE7, 0x3B, 0x52,
+	0x75, 0xFB, 0xDF, 0xE6, 0x7C, 0x43, 0x24, 0x6E,
+	0x27, 0xC9, 0xA4, 0xEA, 0x75, 0x1E, 0xB7, 0x07,
+	0xA6, 0x82, 0x4C, 0x86, 0xFF, 0xBF, 0xCB, 0x90,
+	0x30, 0x70, 0x67, 0xFE, 0xE0, 0x78, 0xED, 0x79,
+	0x09, 0xB2, 0x89, 0xA1, 0xCB, 0x1D, 0x7F, 0x22,
+	0x87, 0x81, 0x13, 0xAD, 0x47, 0x08, 0x95, 0xC5,
+	0x11, 0xCB, 0xBE, 0x95, 0xD7, 0xE8, 0x9F, 0x55,
+	0xDF, 0xE4, 0xC1, 0xEF, 0x52, 0x99, 0xB7, 0x50,
+	0x06, 0x28, 0xB5, 0x95, 0x03, 0x91, 0x09, 0x04,
+	0x8E, 0xC9, 0x15, 0x70, 0x27, 0x49, 0x0B, 0x1F,
+	0xC1, 0x7B, 0x88, 0xE6, 0xAB, 0xE6, 0xAE, 0x40,
+	0x71, 0xF5, 0xC3, 0x77, 0x24, 0xE9, 0xB8, 0xB9,
+	0x93, 0x17, 0xDF, 0xE1, 0x65, 0xCE, 0xBB, 0x22,
+	0x02, 0x49, 0x7E, 0xD6, 0x27, 0x34, 0x7C, 0x8B,
+	0x44, 0x9C, 0xFA, 0x55, 0x2C, 0xC3, 0x42,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x48, 0x88, 0x29, 0x25, 0x7B, 0xE3, 0x7F, 0x7A,
+	0x21, 0xFB, 0x2B, 0x5D, 0xC3, 0x2B, 0x96, 0x34,
+	0x02, 0xA7, 0xDC, 0xC2, 0xFC, 0xE6, 0x92, 0x3C,
+	0x9B, 0x30, 0x51, 0x37, 0x8A, 0x8C, 0x8F, 0x6E,
+	0x65, 0x5C, 0x01, 0xEA, 0x2C, 0xEE, 0xCC, 0xB6,
+	0xA3, 0x43, 0x27, 0xE8, 0xAD, 0x16, 0x33, 0x82,
+	0xB7, 0x8D, 0x04, 0x23, 0xCC, 0xF0, 0x7A, 0x7A,
+	0x8E, 0xDB, 0x6A, 0xFA, 0xB4, 0x04, 0xC9, 0x5A,
+	0xEA, 0x09, 0xDE, 0x63, 0x72, 0x8B, 0x13, 0x19,
+	0x55, 0x38, 0xC8, 0x04, 0xE7, 0x12, 0x37, 0xAF,
+	0xE5, 0xB6, 0xB3, 0xDC, 0xE5, 0x2E, 0xF4, 0xB3,
+	0xBE, 0x54, 0xF8, 0x75, 0xB3, 0x36, 0xC9, 0x10,
+	0x25, 0x26, 0xC9, 0xF2, 0x60, 0x9A, 0x4A, 0x6C,
+	0xCF, 0x24, 0x3E, 0x85, 0x7F, 0x74, 0x2D, 0xC0,
+	0x0E, 0x4B, 0xD2, 0xAF, 0x93, 0xB3, 0x12, 0xD6,
+	0xE6, 0x4F, 0x28, 0x2B, 0x86, 0x7D, 0xC3, 0x49,
+	0x08, 0xA3, 0x4D, 0xEB, 0x00, 0x6E, 0x4F, 0xB1,
+	0x54, 0xBD, 0xEB, 0x24, 0xC1, 0xCC, 0x1E, 0xD2,
+	0x3C, 0xE6, 0x66, 0x0C, 0xFE, 0x77, 0xB1, 0x65,
+	0xC4, 0xF0, 0xF1, 0xB5, 0xB9, 0x9D, 0x7D, 0x9D,
+	0xCC, 0xE6, 0xC2, 0xEB, 0x83, 0x03, 0x32, 0x0E,
+	0x3F, 0x18, 0x6C, 0xF4, 0x2D, 0x20, 0xD1, 0x2B,
+	0xCA, 0x90, 0x5F, 0x45, 0x61, 0x82, 0xA3, 0x78,
+	0x62, 0xDB, 0x93, 0x66, 0x0C, 0x58, 0x10, 0xF5,
+	0x70, 0x56, 0x2D, 0x17, 0x1F, 0x48, 0x1A, 0x61,
+	0x74, 0x06, 0x4B, 0x25, 0x8D, 0x6B, 0x99, 0x25,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0xC8, 0xA8, 0x29, 0x25, 0x7B, 0xE3, 0x1F, 0x7B,
+	0x21, 0xFB, 0x2B, 0x5D, 0x43, 0xEB, 0x84, 0x34,
+	0x02, 0x17, 0x7E, 0x4A, 0x34, 0xE4, 0x22, 0x3C,
+	0xB7, 0x33, 0xE1, 0x93, 0x81, 0x84, 0x8F, 0x6E,
+	0x65, 0x5C, 0x01, 0xEA, 0x08, 0xEE, 0xCC, 0xB4,
+	0xA4, 0xC3, 0x35, 0xEB, 0xAD, 0x14, 0x1E, 0x9F,
+	0xA1, 0x29, 0x8B, 0x32, 0x7E, 0xF0, 0x1C, 0xBB,
+	0x47, 0xCF, 0xF6, 0x7E, 0xDA, 0x33, 0x0A, 0x69,
+	0xC6, 0xB9, 0xB7, 0x12, 0x9A, 0x89, 0xF3, 0x09,
+	0x63, 0x34, 0x59, 0x1E, 0x92, 0x23, 0x79, 0x8F,
+	0x93, 0xCA, 0x15, 0x9F, 0x42, 0xE7, 0xFD, 0xEA,
+	0x03, 0x21, 0xC5, 0x4D, 0x55, 0xF6, 0x8F, 0x6F,
+	0x93, 0xDF, 0x87, 0x23, 0x16, 0xC7, 0x33, 0xBA,
+	0x30, 0xAF, 0xB2, 0xB7, 0x22, 0xFB, 0x4D, 0x04,
+	0xD8, 0xCE, 0x70, 0x83, 0x88, 0x18, 0x54, 0x97,
+	0xF9, 0xF3, 0x55, 0x2A, 0x61, 0x19, 0x28, 0x2C,
+	0x4C, 0xAC, 0xCF, 0x54, 0xEB, 0xA3, 0x59, 0x83,
+	0x96, 0x39, 0xA4, 0x0A, 0x9D, 0x51, 0x57, 0xA0,
+	0xA0, 0x15, 0x51, 0x0D, 0x7A, 0x15, 0x71, 0xB6,
+	0x5F, 0xD5, 0x0C, 0xFC, 0xB2, 0x28, 0xB3, 0xE6,
+	0xC6, 0x61, 0x61, 0x13, 0x7B, 0x61, 0x1A, 0xA4,
+	0xDC, 0x4C, 0x81, 0x26, 0xD7, 0x56, 0xC9, 0xD3,
+	0xEC, 0x29, 0x50, 0xDA, 0xD7, 0xC7, 0xF7, 0xD4,
+	0x60, 0x3D, 0xCC, 0x1A, 0xC0, 0x8F, 0x4B, 0x01,
+	0x93, 0xC6, 0xA9, 0x3F, 0x4B, 0x71, 0x6F, 0x51,
+	0xA0, 0xD4, 0xA0, 0x4A, 0x35, 0xBC, 0xDF, 0xC8,
+	0xF2,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0xC9, 0xA8, 0x2B, 0x25, 0x7B, 0xE3, 0x1F, 0xFB,
+	0x21, 0xFB, 0x2B, 0x5D, 0x43, 0xEB, 0x84, 0x37,
+	0x02, 0x17, 0xFE, 0x4A, 0x34, 0xC4, 0x22, 0x3C,
+	0xB7, 0x03, 0xF1, 0x5B, 0x41, 0x8C, 0xAF, 0x6E,
+	0x65, 0x5C, 0x01, 0xEA, 0x08, 0xE8, 0xCC, 0xB4,
+	0xC4, 0xC3, 0x35, 0xE9, 0xA1, 0x14, 0x1E, 0xAF,
+	0xA1, 0x7B, 0x1A, 0x35, 0x6E, 0xEC, 0xB0, 0x9F,
+	0x38, 0xED, 0x76, 0x3F, 0x9D, 0xFF, 0xE7, 0xFD,
+	0x44, 0xF9, 0xAE, 0x82, 0xFE, 0xAB, 0xF3, 0x41,
+	0x67, 0x3F, 0x71, 0x8F, 0xEE, 0x23, 0x79, 0x83,
+	0x65, 0x35, 0xCB, 0xF7, 0x72, 0xF9, 0x66, 0xED,
+	0xAE, 0x45, 0x60, 0xFD, 0x66, 0x81, 0x1C, 0x5A,
+	0x8E, 0x37, 0x7D, 0x03, 0x77, 0x26, 0x9F, 0x6D,
+	0xAC, 0x26, 0x83, 0xCD, 0xD4, 0x4D, 0x79, 0x8B,
+	0xD6, 0x30, 0x6A, 0x30, 0x04, 0xCA, 0x10, 0x35,
+	0x83, 0x8D, 0x2F, 0x9A, 0x11, 0x07, 0x30, 0x96,
+	0xC7, 0x30, 0xAC, 0x6E, 0x1E, 0xC8, 0x2B, 0x0F,
+	0x16, 0x3E, 0x2A, 0xA4, 0x60, 0xBB, 0x56, 0x4C,
+	0x97, 0x8F, 0x91, 0xF0, 0xC8, 0x55, 0x73, 0xC4,
+	0xF2, 0xBC, 0xBD, 0x9D, 0x29, 0xF7, 0x8D, 0xD0,
+	0x5E, 0x23, 0x81, 0x46, 0x74, 0x3F, 0x8C, 0x6D,
+	0x33, 0xC5, 0xA2, 0x7C, 0x61, 0x0F, 0x6D, 0x5A,
+	0x18, 0x24, 0x73, 0x18, 0x5C, 0x28, 0xB2, 0x5C,
+	0x1C, 0xE0, 0x48, 0x76, 0x7B, 0x8D, 0x44, 0x47,
+	0x71, 0xCA, 0xA3, 0xD1, 0x18, 0x97, 0xC0, 0xAF,
+	0xA9, 0x79, 0x9C, 0x0C, 0xE1, 0x80, 0xCF, 0x11,
+	0xF7, 0x26,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x69, 0xA0, 0xAB, 0x43, 0x7B, 0xE3, 0x1F, 0xFB,
+	0x12, 0xFB, 0x2B, 0x5D, 0x43, 0xEB, 0x84, 0x56,
+	0x3B, 0x16, 0xFE, 0xD2, 0xF0, 0x40, 0x8A, 0xBA,
+	0xB6, 0x03, 0xC5, 0x4A, 0xD1, 0x50, 0xE4, 0x6E,
+	0x25, 0x52, 0x03, 0xEA, 0x08, 0x68, 0xE8, 0xB4,
+	0xF0, 0xFC, 0x3B, 0xAB, 0x80, 0x99, 0x1A, 0x73,
+	0x98, 0x8B, 0xB6, 0xE9, 0x95, 0xF5, 0x35, 0x56,
+	0x73, 0x3E, 0x9E, 0xBE, 0x1F, 0x46, 0xFD, 0x9F,
+	0x60, 0x5D, 0x16, 0x69, 0x27, 0x02, 0x13, 0x99,
+	0x27, 0x37, 0x84, 0x24, 0x67, 0x0B, 0x2A, 0x76,
+	0x25, 0x7A, 0xB2, 0xC3, 0xD2, 0x28, 0x9F, 0xCB,
+	0xF4, 0x9A, 0xB7, 0x98, 0x2B, 0xA3, 0xD3, 0x31,
+	0x36, 0xE7, 0x3B, 0x09, 0x09, 0x95, 0x02, 0xD4,
+	0x49, 0xB6, 0xF1, 0x91, 0x50, 0xCB, 0x02, 0xD9,
+	0x49, 0xD7, 0xCF, 0x0B, 0x0D, 0xF5, 0xB4, 0x94,
+	0x55, 0x20, 0xC7, 0x13, 0x35, 0x86, 0xEE, 0x29,
+	0x34, 0xA3, 0xF1, 0x30, 0x32, 0x5E, 0x2D, 0x34,
+	0x5A, 0xD1, 0xAC, 0xC5, 0xE5, 0xDA, 0x22, 0x1D,
+	0xA1, 0x72, 0x06, 0xE2, 0x81, 0x33, 0x0E, 0xA9,
+	0x15, 0x1B, 0x04, 0x0F, 0xC4, 0xC8, 0x11, 0x3D,
+	0x8C, 0x1E, 0xCC, 0x7E, 0xD8, 0xD1, 0x41, 0x37,
+	0x36, 0x91, 0x0C, 0xBC, 0xA7, 0x56, 0x7B, 0x61,
+	0xE6, 0xE8, 0x83, 0x28, 0x52, 0x2C, 0x61, 0x52,
+	0xE3, 0x9E, 0x41, 0xBB, 0x15, 0x78, 0xAC, 0x39,
+	0x51, 0xED, 0xF0, 0xD9, 0xE6, 0x46, 0x03, 0x4B,
+	0xAC, 0xFE, 0x81, 0x77, 0x20, 0x47, 0x4A, 0xE5,
+	0x7F, 0xF9, 0x18,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x69, 0xA0, 0xBA, 0x43, 0x7E, 0xE3, 0x1F, 0xFB,
+	0xB3, 0xD8, 0x2B, 0x5D, 0x43, 0xEB, 0x84, 0xD6,
+	0xBA, 0x52, 0xFF, 0xD2, 0x60, 0x48, 0xEA, 0xCA,
+	0xBE, 0x03, 0xC5, 0x72, 0xCF, 0xB1, 0x0C, 0xEC,
+	0x25, 0x32, 0x03, 0xEA, 0x08, 0x68, 0xE8, 0xF1,
+	0xF0, 0xC4, 0x01, 0xAB, 0x80, 0x99, 0x14, 0x73,
+	0x31, 0xEE, 0xF6, 0x53, 0x51, 0xF2, 0xD6, 0x1F,
+	0xB5, 0xE6, 0xB7, 0x50, 0x1B, 0x8D, 0x80, 0x61,
+	0x60, 0xFD, 0x4C, 0x43, 0x91, 0x93, 0x32, 0xD4,
+	0xF4, 0x18, 0x1D, 0x03, 0x96, 0x30, 0x0C, 0x95,
+	0xF6, 0x7E, 0xBA, 0xD2, 0xB9, 0x27, 0x62, 0x28,
+	0x59, 0xC4, 0x66, 0xCC, 0x23, 0x9E, 0xD4, 0xD3,
+	0x78, 0x7C, 0x68, 0x4F, 0xC5, 0x9B, 0xBB, 0xD4,
+	0x68, 0x7A, 0xC6, 0xA1, 0x8F, 0xD8, 0x0B, 0x3F,
+	0xBA, 0x5D, 0xED, 0xCF, 0xBD, 0x29, 0xF8, 0x7E,
+	0xF3, 0xD4, 0x6C, 0x8C, 0xC0, 0xA6, 0x6C, 0x1F,
+	0xE7, 0x38, 0xDA, 0x1A, 0x6C, 0xB8, 0xCF, 0x65,
+	0x69, 0xAA, 0xF8, 0xD9, 0x90, 0x96, 0x83, 0x2D,
+	0xA9, 0x13, 0x9D, 0x5E, 0x64, 0x2C, 0xAD, 0x9E,
+	0x5B, 0xD0, 0x06, 0x18, 0x85, 0x2A, 0xBE, 0xC8,
+	0xC9, 0x19, 0xD6, 0x11, 0x93, 0xC1, 0xBD, 0xEF,
+	0x92, 0xBE, 0xC8, 0x27, 0xFC, 0xBE, 0xD7, 0x01,
+	0xB4, 0xA4, 0x91, 0x40, 0x90, 0x72, 0x9A, 0x3D,
+	0x61, 0x22, 0x63, 0x7B, 0x3D, 0xBF, 0x1C, 0x6B,
+	0x66, 0x55, 0x3D, 0x0A, 0x8A, 0xD9, 0xCE, 0x78,
+	0x6F, 0x25, 0x74, 0x18, 0xB0, 0x86, 0xD9, 0x57,
+	0x82, 0x27, 0xB4, 0x05,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x69, 0xA0, 0x7A, 0x4B, 0xFE, 0xC6, 0x1F, 0xFB,
+	0xB3, 0x1B, 0x38, 0x5D, 0x43, 0xEB, 0x84, 0xD6,
+	0xEA, 0x14, 0x51, 0xD3, 0x60, 0xC8, 0x2E, 0x4A,
+	0x7F, 0x07, 0xC5, 0x72, 0xF3, 0xB8, 0xFC, 0x30,
+	0x03, 0x32, 0x13, 0xF8, 0x08, 0x68, 0xE8, 0xF1,
+	0xF2, 0xF7, 0x3D, 0xAE, 0x82, 0x59, 0x17, 0x1C,
+	0xEC, 0xA5, 0x83, 0x9B, 0x2A, 0xFA, 0x51, 0xDD,
+	0x9E, 0x50, 0xC7, 0x11, 0x3E, 0xF2, 0x88, 0xCE,
+	0x9C, 0xBF, 0x54, 0xE2, 0x01, 0xD5, 0x8F, 0xB8,
+	0x61, 0xCD, 0x80, 0x48, 0xED, 0xCE, 0x0E, 0x64,
+	0x82, 0x7B, 0x36, 0x97, 0x87, 0x40, 0x75, 0xB1,
+	0x25, 0x27, 0x68, 0xC3, 0xE8, 0xAD, 0x83, 0x82,
+	0x1C, 0x26, 0x73, 0x0F, 0x3D, 0xA8, 0xAF, 0xDF,
+	0xCB, 0xC8, 0x23, 0xB9, 0x7A, 0xC5, 0xF7, 0x08,
+	0x75, 0x1B, 0x5F, 0x8A, 0x89, 0x72, 0xA2, 0xFF,
+	0xF3, 0x83, 0x68, 0x59, 0x06, 0x48, 0xE8, 0xB6,
+	0x32, 0x27, 0x19, 0x77, 0x1B, 0xBA, 0xA9, 0x25,
+	0x68, 0x4E, 0xDE, 0x97, 0x92, 0x21, 0x28, 0x3D,
+	0x9D, 0x5A, 0xCE, 0xA2, 0x74, 0x25, 0x68, 0xD6,
+	0xBF, 0x13, 0x60, 0x5B, 0x2A, 0x8D, 0x4D, 0x92,
+	0xE3, 0x1F, 0xF6, 0xCE, 0xFD, 0xF3, 0x2C, 0x85,
+	0x63, 0xE3, 0xB2, 0x2C, 0x73, 0x0C, 0xD9, 0xF6,
+	0xD7, 0xF7, 0x5F, 0xC9, 0x93, 0xC0, 0xA4, 0xA3,
+	0xBE, 0xDE, 0x9E, 0x68, 0x28, 0x00, 0xFF, 0xFA,
+	0xD0, 0x89, 0xC1, 0xE8, 0x1E, 0x99, 0xE1, 0x84,
+	0x50, 0xFA, 0xBD, 0xF7, 0x23, 0x74, 0xA1, 0x5E,
+	0x31, 0xB5, 0xAD, 0x75, 0xFA,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x69, 0xA0, 0x7A, 0x4B, 0xFE, 0xC6, 0x1F, 0xFB,
+	0xB3, 0x1B, 0x3C, 0x59, 0x43, 0xEB, 0x84, 0xD6,
+	0xEA, 0x94, 0x51, 0xD1, 0x60, 0xC8, 0xAE, 0x4A,
+	0x7E, 0x06, 0xC5, 0x72, 0xF3, 0xF8, 0xFC, 0x30,
+	0x03, 0x3A, 0x13, 0x78, 0x28, 0x68, 0xE8, 0xF1,
+	0xE2, 0xF7, 0x2D, 0xEE, 0xC2, 0x59, 0x17, 0x1C,
+	0x6A, 0x0D, 0x93, 0x91, 0x2A, 0xD8, 0xD3, 0xD3,
+	0x86, 0x01, 0x82, 0x10, 0x3A, 0xE3, 0xC8, 0x8F,
+	0x1C, 0x3F, 0x54, 0x62, 0x01, 0xD7, 0xAB, 0x90,
+	0x11, 0xD5, 0x80, 0x50, 0xE5, 0xCA, 0x4E, 0x24,
+	0xC9, 0xE3, 0x71, 0x9E, 0x25, 0x42, 0x14, 0x79,
+	0x60, 0x6B, 0x50, 0x7B, 0xD8, 0x6A, 0xC1, 0x52,
+	0x70, 0xB0, 0x6E, 0x4B, 0x57, 0x51, 0x0A, 0x3E,
+	0x9F, 0xA1, 0xD4, 0x41, 0xF0, 0xD4, 0x76, 0x2A,
+	0x7C, 0xF7, 0x81, 0x2F, 0x4B, 0x36, 0xD2, 0xC5,
+	0x93, 0x0A, 0x81, 0x90, 0x26, 0x5D, 0xB9, 0xDF,
+	0x49, 0x77, 0x20, 0xC3, 0xF0, 0x4D, 0x7B, 0x57,
+	0x55, 0x69, 0x9F, 0xCF, 0xA1, 0xE7, 0xFB, 0x41,
+	0xA5, 0xBF, 0x25, 0x6A, 0xC6, 0xE4, 0x5F, 0x83,
+	0xC4, 0x9E, 0x26, 0xA4, 0x89, 0x06, 0xFA, 0x0D,
+	0x37, 0x42, 0x00, 0xCE, 0x55, 0xAA, 0x6E, 0xB1,
+	0x4A, 0x9F, 0x92, 0xA1, 0xA6, 0xBD, 0x41, 0x4B,
+	0xCB, 0xD3, 0x8A, 0xFC, 0x71, 0x93, 0x2F, 0x0D,
+	0x15, 0x30, 0x87, 0xD4, 0xF7, 0x7D, 0x18, 0xDF,
+	0x84, 0x25, 0x39, 0xFB, 0xAF, 0x19, 0xAD, 0x65,
+	0x5A, 0x7C, 0xE8, 0x36, 0xAD, 0x44, 0x7A, 0x42,
+	0x6C, 0x0F, 0x17, 0xD9, 0x2C, 0x99,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x69, 0xA0, 0x7A, 0x4B, 0xDE, 0xCE, 0x1F, 0xBB,
+	0xB3, 0x1B, 0x3C, 0x2D, 0x77, 0xEB, 0x84, 0xD6,
+	0xF2, 0x91, 0xD1, 0xDB, 0x60, 0xDA, 0xAE, 0x42,
+	0x6E, 0x1B, 0x59, 0x72, 0xF3, 0xF8, 0xB8, 0x93,
+	0x03, 0x3A, 0x53, 0x78, 0x20, 0xEA, 0xE8, 0xF1,
+	0xE2, 0xA7, 0x29, 0x2F, 0x86, 0x5E, 0x10, 0x5C,
+	0x57, 0x05, 0xD5, 0xD1, 0x38, 0xB6, 0x01, 0xE2,
+	0x9F, 0xC9, 0x5D, 0x92, 0xE4, 0x48, 0xC9, 0x0B,
+	0xAD, 0xBC, 0x5B, 0xF7, 0x35, 0xDE, 0x66, 0x63,
+	0x05, 0x96, 0xF7, 0x5E, 0x60, 0x43, 0x12, 0x68,
+	0x78, 0xF5, 0x8C, 0x50, 0xBB, 0xE9, 0x39, 0xA8,
+	0x29, 0x2F, 0x47, 0xE4, 0xF8, 0x7D, 0xD9, 0x36,
+	0xC4, 0x2F, 0xC5, 0xB1, 0x32, 0xFC, 0x3E, 0xB3,
+	0x1F, 0xD5, 0x77, 0x18, 0xAE, 0xC0, 0x2D, 0xF6,
+	0x81, 0x9C, 0xFE, 0x53, 0x9A, 0x7A, 0x46, 0xD1,
+	0x63, 0xCF, 0xFC, 0x3E, 0x93, 0xD4, 0x2D, 0xCA,
+	0xFC, 0xE1, 0x61, 0x73, 0x8F, 0xD9, 0x8A, 0xD7,
+	0xE4, 0x78, 0xA8, 0x8A, 0x2E, 0xD2, 0x25, 0x6B,
+	0x6F, 0x54, 0xDC, 0x13, 0xE1, 0x56, 0xD2, 0x75,
+	0x67, 0xCF, 0x8C, 0x4B, 0x7A, 0x92, 0xDA, 0x7F,
+	0xEA, 0x38, 0x1E, 0x29, 0xBA, 0xAA, 0x3C, 0xD5,
+	0x67, 0x70, 0x1D, 0xF8, 0x8C, 0x2A, 0x54, 0xD5,
+	0x0F, 0xC2, 0x64, 0x49, 0x21, 0x20, 0xD7, 0x25,
+	0x17, 0xC3, 0xB6, 0x26, 0xA8, 0xA9, 0x4A, 0x35,
+	0x8C, 0xF0, 0xEF, 0xB5, 0xF2, 0xE9, 0xB1, 0x4E,
+	0xD4, 0xF0, 0x21, 0xC2, 0x15, 0x1F, 0xA6, 0xF4,
+	0x28, 0xB3, 0x6E, 0xE1, 0x21, 0x21, 0x44,
+
+	0x94, 0x94, 0x58, 0x90, 0x27, 0xEE, 0x12, 0x99,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x20, 0xA0, 0x7A, 0x4B, 0xDE, 0x8E, 0x0D, 0xBB,
+	0xB3, 0x1B, 0x3C, 0x2D, 0xF7, 0xCF, 0x84, 0xD6,
+	0xE2, 0xBD, 0xD0, 0xCB, 0x24, 0x98, 0xAE, 0x42,
+	0x6C, 0x3B, 0x60, 0xFD, 0xBB, 0xF8, 0xB8, 0xDB,
+	0x03, 0x3A, 0x53, 0x70, 0x20, 0x6A, 0xC8, 0xF1,
+	0x62, 0x83, 0xAB, 0x2F, 0xDE, 0x16, 0x52, 0x56,
+	0xD7, 0x4A, 0xF6, 0x79, 0xB1, 0xB8, 0x88, 0x86,
+	0xBE, 0x82, 0xD7, 0x02, 0x4E, 0x88, 0x0D, 0x19,
+	0xC5, 0x78, 0xC9, 0x25, 0x37, 0x8E, 0x6B, 0xA2,
+	0xEB, 0xA2, 0x3B, 0x75, 0x7E, 0xFE, 0x1A, 0x44,
+	0x1F, 0x14, 0x7B, 0x23, 0xEF, 0x3B, 0x8E, 0x2F,
+	0x90, 0x32, 0x42, 0x10, 0x43, 0x71, 0xD8, 0x96,
+	0x96, 0xF9, 0x78, 0xDA, 0x12, 0x4F, 0x4C, 0xA1,
+	0x6C, 0x5E, 0xA1, 0x15, 0x48, 0x73, 0x5E, 0x1E,
+	0x71, 0x3D, 0xFC, 0xA1, 0x0D, 0xC8, 0x6F, 0xAE,
+	0x42, 0x87, 0x1F, 0xEC, 0x09, 0x62, 0x18, 0xBA,
+	0x8F, 0xFE, 0xBB, 0xE0, 0xFF, 0xCD, 0x3F, 0xC0,
+	0x6A, 0xD3, 0x54, 0x68, 0x3A, 0x91, 0x74, 0xF2,
+	0xA5, 0x6C, 0xF5, 0x96, 0x6F, 0x15, 0xA2, 0x11,
+	0xCE, 0x27, 0x9F, 0x3A, 0xF4, 0xB5, 0x6A, 0xA9,
+	0x74, 0xA8, 0x0F, 0xBF, 0xBA, 0x46, 0x82, 0x3C,
+	0x9E, 0x7C, 0x50, 0xEF, 0xBF, 0x41, 0xEE, 0x5C,
+	0xB3, 0x69, 0xCC, 0x3A, 0x09, 0xBA, 0x6D, 0x23,
+	0xDD, 0x63, 0xFD, 0x3D, 0x76, 0x70, 0xCA, 0x76,
+	0xA8, 0xF3, 0x18, 0xD5, 0xCA, 0xD1, 0xAB, 0xC2,
+	0x3C, 0xD9, 0x86, 0x44, 0x85, 0xA6, 0x62, 0x1B,
+	0x5C, 0x81, 0x6A, 0xA4, 0xB6, 0x73, 0x5A, 0xF8,
+
+	0x94, 0x94, 0x58, 0x90, 0x67, 0x2E, 0x06, 0x99,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x20, 0xA0, 0x7A, 0x4B, 0xDE, 0x8E, 0x0D, 0xBB,
+	0xB3, 0x12, 0x3C, 0x2D, 0xF7, 0xCF, 0x84, 0xDC,
+	0xE5, 0xBC, 0xD4, 0x98, 0x24, 0x9B, 0xE2, 0xCF,
+	0x6C, 0x3B, 0x45, 0x5D, 0x39, 0xB0, 0xBC, 0xDB,
+	0x4F, 0x3B, 0x73, 0x45, 0x71, 0x2A, 0xC9, 0xF1,
+	0x62, 0x83, 0xAB, 0x2F, 0xF6, 0x16, 0xD2, 0x76,
+	0xBF, 0x4F, 0xF1, 0x71, 0xD3, 0xD1, 0xDB, 0x66,
+	0x0E, 0x2A, 0xEA, 0x0C, 0x63, 0xA9, 0x41, 0xD2,
+	0xD2, 0x04, 0xD7, 0x59, 0x5C, 0xA6, 0xB3, 0x82,
+	0x63, 0x42, 0x90, 0xD7, 0x3D, 0xFE, 0x9E, 0xCA,
+	0x0E, 0x2A, 0xFD, 0x8B, 0x50, 0x80, 0xE8, 0x6A,
+	0x6F, 0x09, 0x95, 0x8C, 0x62, 0x5A, 0x76, 0xA5,
+	0xDF, 0x87, 0x00, 0x44, 0x7B, 0x93, 0x21, 0xA1,
+	0x47, 0x19, 0x82, 0xC8, 0x4D, 0x63, 0x9C, 0x4E,
+	0xBA, 0x69, 0xEA, 0xF9, 0x6A, 0xE0, 0x8D, 0xCD,
+	0x50, 0xF5, 0x95, 0x38, 0x9A, 0x1F, 0x43, 0xE6,
+	0xCF, 0xB2, 0xEC, 0xF7, 0x2B, 0x6D, 0x09, 0x67,
+	0xFB, 0x99, 0x39, 0x47, 0xFF, 0x91, 0x06, 0x3C,
+	0xDA, 0x7B, 0xC0, 0xA0, 0xF5, 0x98, 0xCB, 0xF9,
+	0xC8, 0x10, 0xE8, 0x97, 0x19, 0x1D, 0x72, 0x3B,
+	0x9C, 0x93, 0x5E, 0x3E, 0x48, 0x96, 0x39, 0xBD,
+	0xD3, 0x0B, 0x79, 0x28, 0x1A, 0x66, 0xC7, 0xBC,
+	0xC8, 0x21, 0x26, 0x45, 0xDA, 0xFD, 0xD1, 0xD7,
+	0xB2, 0xDC, 0x96, 0xA6, 0x98, 0x88, 0x2F, 0x0B,
+	0x42, 0xB2, 0xCC, 0x03, 0x2F, 0x16, 0x6A, 0xBC,
+	0x60, 0x09, 0x3F, 0x18, 0x83, 0x92, 0x25, 0x94,
+	0x64, 0x1A, 0x50, 0xF3, 0x85, 0x87, 0x15, 0x34,
+	0x0D,
+
+	0x94, 0x94, 0x58, 0x90, 0x67, 0x2E, 0x06, 0x9C,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x20, 0xA0, 0x7A, 0x4B, 0xDE, 0x8E, 0x0D, 0xBB,
+	0xB3, 0x12, 0x34, 0x2D, 0xF7, 0xCF, 0x84, 0xDC,
+	0xB5, 0xBC, 0xD4, 0x9C, 0x30, 0x9B, 0xF6, 0x9F,
+	0x6E, 0x3B, 0x45, 0x5D, 0x98, 0x10, 0xBC, 0xD3,
+	0x4F, 0x6B, 0x73, 0x15, 0x30, 0x3B, 0xC9, 0xF1,
+	0x6A, 0x83, 0xAB, 0x2F, 0xF6, 0x1E, 0xD2, 0xD6,
+	0xBC, 0x4E, 0xAB, 0xB0, 0xA7, 0xD9, 0xDE, 0x77,
+	0x8E, 0x6E, 0xC8, 0x0F, 0x44, 0xCF, 0x5B, 0xD6,
+	0xD7, 0x50, 0x44, 0x59, 0xC5, 0xF0, 0xEB, 0xD1,
+	0x62, 0x03, 0x9E, 0xDB, 0x1A, 0x5C, 0xC6, 0xAB,
+	0xF5, 0xF5, 0x04, 0x20, 0x4D, 0x7D, 0x8E, 0x81,
+	0x9C, 0x41, 0xC7, 0x8C, 0x6E, 0x1D, 0xB0, 0x99,
+	0x36, 0x6A, 0xF0, 0x60, 0x85, 0x33, 0x94, 0xBF,
+	0x71, 0x0F, 0x32, 0x59, 0xAD, 0x4E, 0x42, 0x43,
+	0xDB, 0x77, 0x91, 0xBE, 0xCB, 0xF8, 0xAC, 0xC2,
+	0x58, 0x8C, 0x86, 0x53, 0xA4, 0xC1, 0xF9, 0xC3,
+	0x30, 0xDC, 0x9D, 0x9A, 0x38, 0xAB, 0xB4, 0xEF,
+	0x83, 0x91, 0xB7, 0xA4, 0xD5, 0xE3, 0xAB, 0xD8,
+	0xF1, 0x6C, 0x23, 0x9A, 0x34, 0x84, 0x94, 0xA9,
+	0xE1, 0x55, 0x1F, 0xE9, 0x7E, 0x77, 0xC1, 0x7B,
+	0xF5, 0x8C, 0x62, 0xB5, 0xA8, 0x6A, 0xD7, 0x18,
+	0x93, 0xC2, 0x99, 0x82, 0x70, 0xDD, 0xF7, 0x32,
+	0x02, 0x9B, 0x76, 0x80, 0x40, 0x71, 0x02, 0x91,
+	0xB8, 0x33, 0xAD, 0xF7, 0x9B, 0xCD, 0xEA, 0x58,
+	0xB3, 0x3B, 0x56, 0x64, 0xDF, 0x41, 0x4E, 0x85,
+	0x5F, 0x67, 0x1F, 0xB3, 0x05, 0xF4, 0xC9, 0x43,
+	0x47, 0xD3, 0xBC, 0x01, 0x94, 0xD5, 0xE9, 0x8E,
+	0x80, 0x63,
+
+	0xA1, 0x94, 0x58, 0x90, 0x67, 0x2E, 0x46, 0xD8,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x20, 0xA0, 0x7A, 0x4B, 0xDE, 0x8E, 0x0D, 0xBB,
+	0xB3, 0x18, 0xB4, 0x05, 0xF7, 0xCF, 0x84, 0xDC,
+	0xE1, 0x2B, 0xD5, 0xDC, 0x04, 0xEA, 0xF6, 0x0F,
+	0xCE, 0x31, 0x45, 0xFD, 0xCA, 0x98, 0x34, 0xDF,
+	0x4E, 0x6B, 0x27, 0x14, 0x20, 0xE6, 0x51, 0xB1,
+	0xEA, 0xA1, 0xAB, 0x2F, 0xF6, 0x9E, 0xF2, 0xD6,
+	0x4C, 0x93, 0xA2, 0x82, 0x86, 0xDF, 0xEE, 0x83,
+	0x2C, 0x65, 0x33, 0x98, 0x7F, 0x15, 0xF0, 0x27,
+	0xC0, 0x23, 0x71, 0x5E, 0x15, 0xA1, 0x23, 0x9F,
+	0xCD, 0x45, 0x88, 0x9D, 0x1B, 0x53, 0x1E, 0xFE,
+	0xBC, 0xC1, 0x86, 0x64, 0x2E, 0x1B, 0xAF, 0x1B,
+	0xE4, 0xAA, 0x1B, 0x68, 0xE8, 0x05, 0xD5, 0x68,
+	0x32, 0xFC, 0xBE, 0x0E, 0x6F, 0x59, 0x54, 0x44,
+	0x21, 0xAA, 0xE4, 0x67, 0x1E, 0xE7, 0x2D, 0x0A,
+	0xE0, 0x71, 0x5E, 0xA1, 0x3E, 0x80, 0x14, 0xFE,
+	0xF1, 0xD5, 0x8B, 0x59, 0xD1, 0x1D, 0x56, 0xDE,
+	0x3D, 0x5A, 0x09, 0x68, 0xAD, 0x88, 0x5B, 0x8E,
+	0x13, 0xED, 0x14, 0x9A, 0xA0, 0x4A, 0x18, 0xF9,
+	0x57, 0xBA, 0x0E, 0x62, 0x44, 0x92, 0xEF, 0x1F,
+	0x06, 0xF9, 0xF0, 0x80, 0xA3, 0x21, 0x00, 0xB6,
+	0xDA, 0x4F, 0x6D, 0xC7, 0x38, 0xEC, 0xD4, 0x7C,
+	0x88, 0x9F, 0xDA, 0x75, 0xA9, 0x39, 0xFD, 0x7A,
+	0x9C, 0xC9, 0xA0, 0x2A, 0x1E, 0xCD, 0x2C, 0x53,
+	0xD4, 0x8E, 0xC9, 0x9E, 0x5C, 0xB8, 0xA8, 0xDD,
+	0x00, 0x2D, 0xA4, 0x57, 0x49, 0xB9, 0x04, 0x76,
+	0x26, 0x68, 0x5D, 0xFC, 0xBA, 0x3B, 0xF4, 0x1C,
+	0x10, 0xA1, 0x43, 0x41, 0x50, 0x2C, 0xFC, 0x9F,
+	0x48, 0xC5, 0x7F,
+
+	0x20, 0xB1, 0x58, 0x90, 0x67, 0x2E, 0x46, 0x58,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x20, 0xA0, 0x7A, 0x4B, 0xDE, 0x8E, 0x0D, 0xBB,
+	0xB3, 0x98, 0xB4, 0x05, 0xF6, 0xCF, 0x84, 0xDC,
+	0xF5, 0x73, 0xF7, 0xD8, 0x04, 0xCF, 0xC0, 0x0F,
+	0xCA, 0x73, 0x56, 0xFD, 0xBA, 0xD8, 0x35, 0xFB,
+	0x4F, 0x69, 0x27, 0x4C, 0x22, 0x06, 0x38, 0x34,
+	0xEA, 0x81, 0x8F, 0x2E, 0xF6, 0x9E, 0xF2, 0x9E,
+	0x00, 0x49, 0xA0, 0xEC, 0xEA, 0xF2, 0x6A, 0x4C,
+	0x77, 0xF0, 0xF2, 0x38, 0x1E, 0x8C, 0x35, 0x2A,
+	0xA3, 0xEC, 0x26, 0x98, 0x87, 0x43, 0x72, 0x01,
+	0x96, 0x41, 0xF8, 0x6E, 0x3B, 0xD2, 0x6E, 0x82,
+	0x60, 0xE1, 0xE9, 0xB3, 0xE0, 0x8A, 0xA5, 0x18,
+	0x2A, 0x8B, 0x16, 0x84, 0x18, 0x32, 0xEF, 0x5C,
+	0xF7, 0x72, 0x04, 0xBD, 0x22, 0x39, 0xEA, 0xDC,
+	0xA5, 0xFE, 0x2C, 0x19, 0x29, 0x5E, 0x45, 0xB6,
+	0xBD, 0xBC, 0x6A, 0x92, 0x9F, 0x79, 0xD2, 0x75,
+	0x75, 0x4B, 0xF1, 0x79, 0x02, 0x37, 0x6F, 0x29,
+	0x74, 0xEE, 0xCF, 0xA3, 0x14, 0xF3, 0xBB, 0xEA,
+	0xF8, 0x55, 0xA1, 0x45, 0x50, 0x1F, 0x23, 0xBA,
+	0xE4, 0xDC, 0x69, 0x09, 0xF6, 0x77, 0x7B, 0xE6,
+	0xCA, 0x2B, 0x8C, 0x5C, 0x1E, 0x06, 0xB5, 0x37,
+	0x0C, 0x03, 0x2C, 0xF1, 0xD4, 0xF1, 0x14, 0xE6,
+	0xD7, 0x3D, 0x4C, 0x76, 0xC0, 0x46, 0xF9, 0xDD,
+	0xDA, 0x5F, 0x3E, 0x55, 0x96, 0xDA, 0x67, 0x53,
+	0xFF, 0x52, 0x11, 0x90, 0xC1, 0x43, 0x3F, 0x00,
+	0x9F, 0x5F, 0x22, 0x47, 0x7E, 0x60, 0xBD, 0x80,
+	0xB5, 0x45, 0x72, 0xEC, 0x63, 0xAD, 0x1F, 0xE8,
+	0xFD, 0xB7, 0x06, 0xE8, 0x4F, 0x5A, 0x4E, 0x0D,
+	0x57, 0x4D, 0x17, 0x14,
+
+	0x20, 0x64, 0x4D, 0x90, 0x67, 0x2E, 0x46, 0x58,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x20, 0xA0, 0x7A, 0x4B, 0xDE, 0x8E, 0x0D, 0xBB,
+	0xB3, 0x98, 0x54, 0x0D, 0x76, 0xCD, 0x84, 0xDC,
+	0xF7, 0x74, 0xAB, 0x9D, 0x01, 0x4F, 0xC4, 0x58,
+	0x52, 0xD1, 0xB6, 0xFD, 0xBA, 0x98, 0x31, 0xFD,
+	0x49, 0x69, 0x27, 0x4C, 0x7E, 0x05, 0x4B, 0x41,
+	0xE0, 0x81, 0x27, 0x08, 0xF6, 0x9E, 0xF2, 0x1E,
+	0x79, 0x38, 0x90, 0x50, 0x8C, 0x94, 0x05, 0xA6,
+	0x42, 0x74, 0xF9, 0xFA, 0x1B, 0x24, 0x37, 0x6B,
+	0xA3, 0x96, 0xEB, 0x59, 0xC6, 0x72, 0x6E, 0x51,
+	0x66, 0x48, 0x98, 0xDE, 0xB0, 0xD2, 0xCC, 0xBA,
+	0xA9, 0x3C, 0x00, 0xF0, 0xDC, 0x5A, 0xE9, 0xD3,
+	0x92, 0xD7, 0xA3, 0xCC, 0x90, 0x3A, 0xB6, 0x60,
+	0x22, 0x70, 0xAD, 0x75, 0x74, 0x08, 0xF9, 0xF8,
+	0xB8, 0xB0, 0x48, 0xBA, 0xDF, 0xBA, 0x32, 0x42,
+	0x03, 0x0B, 0xB3, 0xB7, 0xE1, 0x80, 0xB4, 0xB4,
+	0x50, 0x2C, 0x56, 0x99, 0x05, 0xC6, 0x5F, 0x53,
+	0xE9, 0x67, 0x97, 0x74, 0xA9, 0xAB, 0xFD, 0x13,
+	0x0F, 0x62, 0x74, 0xF0, 0x2C, 0x7E, 0xA8, 0x38,
+	0x72, 0xBD, 0x77, 0x5F, 0xAF, 0x2F, 0xDF, 0x83,
+	0x46, 0xA9, 0x6B, 0x8D, 0xA1, 0x50, 0x0D, 0x0C,
+	0x40, 0xBF, 0x3C, 0x8D, 0x31, 0x75, 0xF4, 0x1D,
+	0x09, 0x04, 0xA4, 0x5B, 0xE2, 0x4D, 0xE8, 0xB9,
+	0x5B, 0xD2, 0x71, 0xFD, 0x60, 0xD5, 0xDF, 0x90,
+	0x99, 0xD3, 0xEB, 0x0D, 0x47, 0x67, 0xD2, 0x48,
+	0xC2, 0x6B, 0xB6, 0xF2, 0x74, 0x8A, 0x7C, 0xA3,
+	0x03, 0x81, 0xB1, 0x9A, 0x0C, 0x7A, 0xD9, 0x60,
+	0x4C, 0x90, 0x06, 0xEA, 0x33, 0x77, 0x37, 0xB8,
+	0x53, 0xEE, 0x57, 0x32, 0xBE,
+
+	0x20, 0x64, 0x4D, 0x96, 0x67, 0x2E, 0x46, 0x58,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x20, 0xA0, 0x7A, 0x4B, 0xDE, 0x8E, 0x0D, 0xBB,
+	0xB3, 0x98, 0x54, 0x0D, 0x77, 0xCD, 0x84, 0xDC,
+	0xAF, 0x74, 0x8B, 0xFD, 0x81, 0x4F, 0xC4, 0x58,
+	0x13, 0x91, 0xB6, 0xDF, 0xB9, 0x98, 0xB1, 0x7D,
+	0x88, 0x78, 0x27, 0x4C, 0x7E, 0x65, 0x4B, 0xC1,
+	0xE0, 0x85, 0x27, 0x48, 0xE6, 0x9E, 0xF2, 0x1E,
+	0x09, 0x38, 0x13, 0x4B, 0x45, 0xA6, 0x17, 0x00,
+	0x01, 0x5B, 0x6D, 0x2E, 0x18, 0x00, 0x76, 0x66,
+	0x65, 0x1F, 0x7A, 0x18, 0x50, 0x1B, 0x4E, 0x51,
+	0xE3, 0x88, 0x80, 0x3F, 0xB2, 0x72, 0xC5, 0x8A,
+	0x64, 0x61, 0x6C, 0xEB, 0xD3, 0xD9, 0xA6, 0x4C,
+	0xAD, 0x9A, 0x63, 0x5C, 0x8C, 0x26, 0x67, 0xC4,
+	0x6D, 0x45, 0x2F, 0xC4, 0x8F, 0x25, 0x62, 0x7E,
+	0xBA, 0x81, 0x1C, 0x9B, 0xFD, 0xA2, 0xF9, 0xD0,
+	0x01, 0x05, 0x72, 0x53, 0xFE, 0xFA, 0x9F, 0x95,
+	0x8F, 0x47, 0x8E, 0xEA, 0x00, 0x2A, 0x59, 0x28,
+	0x81, 0x7B, 0x8A, 0xDA, 0x2B, 0x8B, 0xAB, 0x39,
+	0x9F, 0xF0, 0xB4, 0x64, 0xE1, 0xB4, 0x24, 0xF6,
+	0x67, 0xCF, 0x1B, 0xAF, 0x5F, 0x7D, 0x57, 0xA9,
+	0xC3, 0xBE, 0xBE, 0xB4, 0x9D, 0x60, 0x51, 0xCA,
+	0xA9, 0x2B, 0xE9, 0xA8, 0x58, 0xB1, 0xCD, 0x13,
+	0x5A, 0x49, 0x45, 0x89, 0x44, 0xBE, 0x5E, 0xC9,
+	0xF2, 0x5D, 0x6F, 0x3C, 0x6D, 0xAD, 0x5A, 0x22,
+	0xD3, 0x00, 0x0B, 0xA3, 0x94, 0xE4, 0x8C, 0xDF,
+	0xE4, 0x2C, 0xFA, 0x94, 0x13, 0xAD, 0xEF, 0xD7,
+	0xCD, 0x55, 0xCD, 0x51, 0x24, 0x68, 0xA3, 0x5D,
+	0x94, 0x5C, 0xB1, 0xF9, 0x6E, 0x22, 0xDF, 0xAA,
+	0x94, 0x3F, 0x7E, 0x7E, 0x25, 0x66,
+
+	0x20, 0x64, 0x0D, 0xE0, 0x51, 0x2E, 0x46, 0x58,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x20, 0xA0, 0x7A, 0x4B, 0xDE, 0x8E, 0x0D, 0xBB,
+	0xB3, 0x98, 0x54, 0x0D, 0x57, 0xCF, 0x04, 0x90,
+	0x9D, 0xAD, 0x89, 0xE4, 0xE7, 0x1C, 0xA3, 0x58,
+	0x83, 0xF4, 0x36, 0xFF, 0xB9, 0x8A, 0xB1, 0x7D,
+	0x19, 0xED, 0x4C, 0x6C, 0x7E, 0x65, 0x2F, 0xC2,
+	0xE0, 0x85, 0x43, 0x48, 0x26, 0x8E, 0xF2, 0x1E,
+	0x5B, 0x24, 0x98, 0x8D, 0x30, 0xA7, 0xF0, 0xB8,
+	0xDC, 0x0C, 0xB7, 0xE3, 0x05, 0x23, 0xD0, 0x0B,
+	0x85, 0x45, 0xB3, 0x0B, 0x0A, 0x1E, 0x48, 0x83,
+	0x1E, 0x82, 0x1A, 0xB9, 0x8A, 0x2B, 0x81, 0xC0,
+	0x49, 0xC3, 0x43, 0xB5, 0x1A, 0xCE, 0x95, 0xD8,
+	0x05, 0x24, 0xAE, 0x4F, 0x36, 0xC7, 0x8F, 0xCD,
+	0xC2, 0x46, 0xE9, 0x36, 0xCB, 0xCD, 0x2E, 0xA8,
+	0xF8, 0x93, 0xE7, 0xCE, 0xB1, 0xFE, 0x13, 0xC7,
+	0xE5, 0x6B, 0xFB, 0x73, 0xB4, 0x41, 0xF5, 0x6D,
+	0x0C, 0xF9, 0xE7, 0xC5, 0xFA, 0x07, 0xCF, 0xE8,
+	0xCA, 0x36, 0x11, 0xD2, 0x06, 0x68, 0xA3, 0xBA,
+	0xEA, 0x26, 0x4F, 0xE6, 0x50, 0xA3, 0xAB, 0x0C,
+	0x5C, 0x8F, 0x09, 0xCB, 0x9D, 0x4F, 0x2F, 0x82,
+	0x1E, 0xAC, 0xC6, 0x4F, 0x33, 0x0D, 0x2E, 0xC9,
+	0x40, 0xF8, 0xF7, 0x3D, 0xC9, 0x83, 0x12, 0xDD,
+	0x7E, 0xCC, 0x76, 0xFA, 0x34, 0x11, 0xD6, 0xB9,
+	0xFF, 0x7D, 0x08, 0xE6, 0xCF, 0xC9, 0xB9, 0xF6,
+	0x7C, 0xA8, 0x1F, 0x0F, 0xF1, 0x65, 0xC4, 0xD0,
+	0x12, 0xE2, 0x1A, 0xF3, 0xD2, 0x44, 0xCB, 0x8E,
+	0xB6, 0x96, 0x39, 0x2E, 0xF3, 0xF3, 0x1D, 0x97,
+	0xFE, 0x5A, 0x6D, 0xE0, 0xBD, 0xC5, 0x57, 0xE1,
+	0xF8, 0xA0, 0xBC, 0xC5, 0x71, 0x01, 0x87,
+
+	0x20, 0x64, 0x0D, 0xE0, 0xD5, 0x08, 0x46, 0x58,
+	0xDD, 0x5E, 0xAB, 0x0F, 0xFB, 0xF4, 0xCF, 0x5D,
+	0x20, 0xA0, 0x7A, 0x4B, 0xDE, 0x8E, 0x0D, 0xBB,
+	0xB6, 0x98, 0x54, 0x0D, 0x57, 0x8F, 0x07, 0x90,
+	0x9D, 0xAB, 0x11, 0xE4, 0xED, 0x74, 0x41, 0x5E,
+	0x23, 0xF4, 0xBA, 0x7F, 0xBC, 0xCA, 0xB1, 0x7D,
+	0x1B, 0x4F, 0xA5, 0x4F, 0x3E, 0x65, 0x2F, 0xAA,
+	0xE0, 0x85, 0x43, 0x45, 0x26, 0x1E, 0xF2, 0x1E,
+	0xEB, 0x6E, 0xDC, 0xEF, 0x97, 0xB7, 0x89, 0x9F,
+	0x6D, 0x56, 0x07, 0x18, 0x8A, 0x2F, 0x98, 0xAF,
+	0x26, 0xC4, 0x9B, 0xF9, 0x08, 0x5B, 0x25, 0x72,
+	0xCB, 0x8F, 0xC4, 0xE0, 0x8C, 0xB2, 0xE7, 0x41,
+	0xA3, 0x6C, 0x2F, 0xE4, 0xBB, 0xD9, 0x25, 0x1A,
+	0x83, 0xE6, 0xBD, 0x91, 0xA2, 0x1B, 0x68, 0x0E,
+	0x4D, 0x42, 0xD8, 0x1E, 0x8E, 0x3A, 0xD7, 0x23,
+	0xBD, 0x75, 0x8A, 0x08, 0x4D, 0xC8, 0x4F, 0x9D,
+	0xA8, 0x71, 0x25, 0x28, 0x62, 0x06, 0xE8, 0x5A,
+	0xF6, 0xA2, 0xC1, 0x85, 0x57, 0x7C, 0x87, 0x78,
+	0x80, 0x4E, 0xCD, 0x6D, 0xE1, 0x4C, 0x56, 0xF9,
+	0xA3, 0xCB, 0x08, 0xAF, 0x63, 0x0B, 0x08, 0x65,
+	0x8D, 0x22, 0x70, 0x7B, 0xC5, 0x2B, 0xD3, 0x4C,
+	0xE4, 0x59, 0x38, 0xCD, 0xAA, 0x76, 0xE9, 0xDA,
+	0x22, 0x41, 0x8E, 0xF4, 0x91, 0x54, 0xA4, 0xB0,
+	0x42, 0x0A, 0xD8, 0x5D, 0xD6, 0xC2, 0x9B, 0x7E,
+	0xC4, 0xB2, 0x7D, 0xE5, 0xCE, 0x5F, 0x2F, 0xD0,
+	0x82, 0x54, 0xFC, 0xD3, 0x72, 0x07, 0xF0, 0xED,
+	0x7F, 0xE0, 0x3E, 0x7F, 0xE4, 0x60, 0x05, 0x0E,
+	0xAC, 0xF0, 0x27, 0x1D, 0xAE, 0x37, 0x20, 0xBC,
+	0xC9, 0xE1, 0x4C, 0xD1, 0x60, 0xF4, 0x30, 0x38,
+	0x5B, 0x31, 0x37, 0x40, 0xA7, 0x75, 0x99, 0xDF,
+
+	0xE1, 0xDA, 0x93, 0x96, 0xE0, 0x9D, 0xDC, 0x0E,
+	0x84, 0x50, 0x3D, 0xCA, 0xBB, 0x9E, 0xD1, 0x89,
+	0x81, 0x35, 0x5A, 0x20, 0xF6, 0x46, 0x7C, 0x94,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xA0, 0x44, 0x5C, 0x82, 0x09, 0xD8, 0xB7, 0x0E,
+	0x29, 0x82, 0x04, 0x8B, 0xF5, 0x68, 0xF6, 0x4F,
+	0x09, 0x88, 0x8E, 0xDF, 0x9F, 0xA3, 0x83, 0x33,
+	0xDA, 0xCB, 0x2A, 0x09, 0x4D, 0xBA, 0x77, 0x68,
+	0x0A, 0xD0, 0xA0, 0x7B, 0xBD, 0xAC, 0x6D, 0x8F,
+	0xF4, 0x45, 0xF5, 0xE3, 0xEB, 0x2D, 0xFB, 0x41,
+	0x75, 0x9C, 0xEA, 0x65, 0x21, 0xB5, 0x09, 0x0C,
+	0xFB, 0x5B, 0x87, 0x36, 0xD3, 0xE3, 0xA3, 0x86,
+	0x23, 0x8F, 0x73, 0x99, 0x30, 0xA0, 0x41, 0x2D,
+	0x82, 0x1C, 0xB0, 0x15, 0x6F, 0x81, 0x77, 0x32,
+	0x83, 0xD2, 0x04, 0x26, 0xA9, 0x51, 0x05, 0xE0,
+	0x88, 0xDA, 0xFF, 0x56, 0xD5, 0xF1, 0xD9, 0x62,
+	0xD0, 0x78, 0x8C, 0x4F, 0x7A, 0x6B, 0x89, 0x3F,
+	0xE6, 0x8E, 0xAE, 0x53, 0x9D, 0xA8, 0x3E, 0x2E,
+	0x34, 0x14, 0x9E, 0xFD, 0x2A, 0x05, 0x40, 0x79,
+	0x25, 0xA7, 0xF1, 0x10, 0xF6, 0x02, 0xF9, 0x50,
+	0x45, 0x9A, 0x52, 0x6A, 0x7D, 0x1C, 0x52, 0x93,
+	0x7C, 0x38, 0x1B, 0x75, 0xA2, 0x79, 0x3E, 0xC9,
+	0x32, 0xBB, 0x25, 0x6A, 0x5B, 0xD0, 0x82, 0x17,
+	0xC5, 0x40, 0x05, 0x15, 0xB6, 0x01, 0xB3, 0x93,
+	0x18, 0xED, 0x40, 0x3D, 0xED, 0x63, 0xC1, 0xA6,
+	0xB3, 0xDD, 0x2A, 0xAE, 0x27, 0xEA, 0x3A, 0x42,
+	0xA3, 0x43, 0xCB, 0xEC, 0xCC, 0xE4, 0x5E, 0x07,
+	0x4E, 0x4A, 0x7B, 0x34, 0x68, 0x98, 0xCE, 0x0B,
+	0x9A, 0xE8, 0x94, 0x61, 0xE2, 0x0A, 0xE9, 0x73,
+	0xFA, 0x84, 0x49, 0xD2, 0x64, 0xA8, 0x0A, 0xB5,
+	0xC7,
+
+	0xE2, 0xDA, 0x95, 0x96, 0xE0, 0x9D, 0xDC, 0x8E,
+	0x84, 0x50, 0x3D, 0xCA, 0xBB, 0x9E, 0xD2, 0x8E,
+	0x81, 0x35, 0x5A, 0x20, 0xF6, 0x46, 0x7C, 0x94,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xB0, 0x44, 0x5C, 0x82, 0x09, 0xD4, 0xB7, 0x0E,
+	0x99, 0xE3, 0x04, 0x8D, 0xE9, 0x68, 0xA2, 0x3F,
+	0x08, 0x88, 0x8E, 0xDF, 0xBF, 0x43, 0x87, 0x33,
+	0xDA, 0xBB, 0x2A, 0xC9, 0xAC, 0xB3, 0x67, 0x68,
+	0x24, 0xD0, 0x96, 0x67, 0xD8, 0xCA, 0xA9, 0x0F,
+	0xAC, 0x62, 0xCF, 0xC8, 0x92, 0x2E, 0xBA, 0xDC,
+	0xF4, 0xB9, 0x6E, 0x68, 0x67, 0xC1, 0x00, 0x58,
+	0xD9, 0x19, 0xAF, 0xF5, 0x42, 0xFE, 0x59, 0x9A,
+	0x66, 0x57, 0x90, 0xB4, 0x47, 0xC0, 0x0D, 0x41,
+	0x24, 0xA3, 0x61, 0xAC, 0x61, 0x0A, 0xC3, 0xAB,
+	0x02, 0xD2, 0x18, 0xA5, 0x5E, 0x15, 0x0B, 0xBC,
+	0x17, 0x0F, 0xAC, 0xAC, 0x76, 0x6D, 0x58, 0x11,
+	0xB2, 0x17, 0x5E, 0xE9, 0xBD, 0x04, 0x9E, 0xDB,
+	0xF4, 0xC1, 0xAC, 0x35, 0x3B, 0xB0, 0x72, 0xFF,
+	0xF5, 0x2D, 0x26, 0xB6, 0x6F, 0x35, 0x54, 0x8F,
+	0xBA, 0x47, 0x05, 0x67, 0x4A, 0xE5, 0x40, 0xCE,
+	0x48, 0x42, 0x2F, 0xDE, 0xF6, 0xE9, 0xC6, 0xAC,
+	0xF8, 0x32, 0xA0, 0xF2, 0x52, 0xCF, 0x25, 0x04,
+	0x07, 0x39, 0xBE, 0x89, 0x06, 0xCB, 0x5F, 0x6D,
+	0x59, 0x5B, 0x13, 0x04, 0x5F, 0xB3, 0xE0, 0xB5,
+	0x2C, 0xF7, 0x40, 0xB9, 0x24, 0x10, 0x60, 0x60,
+	0xA4, 0xA3, 0x17, 0x76, 0xA2, 0x4B, 0xDA, 0x3E,
+	0x15, 0x24, 0xCF, 0x2D, 0x9F, 0xA7, 0x04, 0x2E,
+	0x1C, 0x9B, 0x15, 0x84, 0xCA, 0xDB, 0xB9, 0xA4,
+	0x17, 0x8D, 0x6C, 0x36, 0x13, 0x2F, 0x0D, 0x76,
+	0xAC, 0x1F, 0xE2, 0xC2, 0x1B, 0x02, 0xEC, 0xA9,
+	0x6A, 0xE1,
+
+	0x42, 0xD9, 0x95, 0xFA, 0xE0, 0x9D, 0xDC, 0x8E,
+	0xB3, 0x50, 0x3D, 0xCA, 0xBB, 0x9E, 0x92, 0xFC,
+	0x81, 0x35, 0x5A, 0x20, 0xF6, 0x46, 0x7C, 0x94,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xF0, 0x58, 0x5E, 0x82, 0x09, 0x54, 0xDB, 0x0E,
+	0xEC, 0xF0, 0x4D, 0x8D, 0xCC, 0xB5, 0xA0, 0xE3,
+	0xA8, 0x80, 0x8E, 0xDF, 0x3F, 0x6E, 0x4C, 0x97,
+	0xDA, 0xBB, 0x5E, 0xCA, 0xF0, 0xC6, 0x06, 0x68,
+	0x2B, 0x72, 0x0E, 0x7B, 0xAE, 0x3B, 0xE7, 0x7B,
+	0x15, 0xCA, 0x4A, 0xBD, 0x80, 0x7F, 0x88, 0x68,
+	0x7A, 0x7B, 0xDF, 0xD0, 0x5F, 0x29, 0x31, 0xB7,
+	0xBC, 0x86, 0x9A, 0xA3, 0xF4, 0x41, 0x41, 0xDE,
+	0xB0, 0xDE, 0x5E, 0x6A, 0x78, 0xEE, 0xE3, 0xA0,
+	0xCB, 0x55, 0xBD, 0x22, 0xEC, 0x51, 0xD2, 0xF8,
+	0xBB, 0xAC, 0xDA, 0x30, 0xDE, 0x49, 0x55, 0x74,
+	0x0E, 0x46, 0x0D, 0x16, 0xD9, 0x4F, 0x17, 0x91,
+	0xF8, 0x17, 0x43, 0x45, 0xBE, 0x80, 0x98, 0x85,
+	0xAF, 0x43, 0x14, 0x44, 0xD6, 0xD0, 0xAD, 0x06,
+	0x6C, 0xD4, 0xE5, 0xAC, 0x98, 0x74, 0xD6, 0xB3,
+	0x13, 0x19, 0xD4, 0xB1, 0xE5, 0x3F, 0x9E, 0x74,
+	0xA8, 0x75, 0x48, 0xA0, 0xC9, 0x38, 0x57, 0x23,
+	0x7B, 0x4E, 0x41, 0x7D, 0x11, 0xED, 0x20, 0xA8,
+	0xBA, 0xAF, 0x16, 0x7A, 0x0D, 0x11, 0x2E, 0xD9,
+	0xF7, 0xC2, 0xB6, 0x47, 0x01, 0x99, 0x6A, 0x1F,
+	0x17, 0xFF, 0x32, 0x0D, 0xDF, 0x9C, 0x18, 0x41,
+	0xE3, 0x40, 0xFE, 0x6C, 0x37, 0xF5, 0x2D, 0xB7,
+	0x04, 0x63, 0xD8, 0xBA, 0xAB, 0x3E, 0xAA, 0xF1,
+	0xC5, 0xDA, 0xE4, 0xE1, 0x3A, 0xF6, 0x01, 0xC6,
+	0xCF, 0xD5, 0x7B, 0x2C, 0x1B, 0x3F, 0x0F, 0x32,
+	0x01, 0x61, 0xBF, 0x4B, 0x1A, 0xDB, 0x9F, 0xB0,
+	0x7A, 0xCC, 0x50,
+
+	0x42, 0xD9, 0x96, 0xFA, 0xA8, 0x9D, 0xDC, 0x8E,
+	0x35, 0x77, 0x3D, 0xCA, 0xBB, 0x9E, 0x92, 0xFC,
+	0x81, 0x35, 0x5A, 0x20, 0xF6, 0x46, 0x7C, 0x94,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xF0, 0x88, 0x5E, 0x82, 0x09, 0x54, 0xDB, 0x0C,
+	0x74, 0x88, 0xCF, 0x8B, 0x4C, 0xB4, 0x3C, 0xE3,
+	0xA9, 0x28, 0x9F, 0xDF, 0x5F, 0x26, 0x89, 0x23,
+	0x8B, 0xBB, 0x5E, 0xB2, 0xC2, 0x36, 0x2F, 0xF3,
+	0x0C, 0xB2, 0x00, 0x1A, 0x7F, 0x5C, 0x86, 0x0D,
+	0xD8, 0xD0, 0x89, 0x95, 0xD6, 0xF9, 0x51, 0x5A,
+	0xF6, 0xB6, 0x9F, 0x8E, 0x6D, 0x43, 0x09, 0x16,
+	0x4B, 0x15, 0x81, 0x67, 0xBB, 0x37, 0x68, 0xA0,
+	0xD1, 0xBC, 0x9D, 0xE2, 0x9C, 0x59, 0x9A, 0x75,
+	0x70, 0xA5, 0xCC, 0x08, 0xBB, 0x18, 0x48, 0xB9,
+	0x7F, 0x0F, 0xF7, 0xE8, 0x80, 0xA8, 0x22, 0x69,
+	0xF8, 0xB9, 0xB6, 0x49, 0xE6, 0x98, 0x51, 0xFA,
+	0x9F, 0xEE, 0x7C, 0xA6, 0x69, 0x43, 0x46, 0xF3,
+	0xDF, 0xBC, 0xDD, 0x31, 0x42, 0x71, 0x26, 0x4E,
+	0x84, 0x69, 0x56, 0x64, 0xA2, 0x2F, 0x77, 0x6C,
+	0xA2, 0x2E, 0xC6, 0x0C, 0x1D, 0x1E, 0x32, 0xED,
+	0x8F, 0x7D, 0x9E, 0x72, 0x36, 0xAF, 0x17, 0x4E,
+	0x1E, 0xFC, 0x27, 0x48, 0xB9, 0xA6, 0xCF, 0xF9,
+	0x28, 0xB5, 0x13, 0xE3, 0x04, 0x2E, 0x53, 0xE3,
+	0x4F, 0xAC, 0xDB, 0xB5, 0x18, 0x59, 0x1E, 0x0C,
+	0x66, 0x42, 0xE3, 0x5F, 0xFF, 0x59, 0xD9, 0xFD,
+	0x7A, 0x09, 0x6E, 0xFC, 0x6A, 0x50, 0xB4, 0x56,
+	0x65, 0x16, 0xD5, 0x80, 0xC4, 0xBD, 0xEB, 0xB4,
+	0x2F, 0xD7, 0xAF, 0x23, 0x07, 0xC2, 0xC0, 0xDC,
+	0x59, 0xBB, 0x39, 0x27, 0x79, 0x8F, 0x00, 0x4D,
+	0x29, 0x51, 0x7E, 0x89, 0x1F, 0xC9, 0x19, 0x9D,
+	0x24, 0x0B, 0x9F, 0xD1,
+
+	0x42, 0xD9, 0x96, 0xF3, 0xA8, 0xB0, 0xDC, 0x8E,
+	0xF5, 0xA5, 0x2A, 0xCA, 0xBB, 0x9E, 0x92, 0xFC,
+	0x81, 0x35, 0x5A, 0x20, 0xF6, 0x46, 0x7C, 0x94,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xF3, 0x88, 0xFE, 0x8C, 0x09, 0x54, 0xDB, 0x0C,
+	0x74, 0xD6, 0xB3, 0x2A, 0x48, 0xB4, 0x2D, 0xFC,
+	0xB1, 0xE8, 0xD7, 0xDF, 0x5F, 0x16, 0x1C, 0x23,
+	0x84, 0xFB, 0x5E, 0xB2, 0xBE, 0x0F, 0xD3, 0xC6,
+	0x31, 0xBB, 0xA8, 0x83, 0x7E, 0x47, 0x24, 0xF3,
+	0xF9, 0x07, 0x19, 0x71, 0x5C, 0x44, 0xC2, 0x50,
+	0x03, 0xF9, 0xC7, 0x0E, 0x06, 0x82, 0x06, 0x10,
+	0xB7, 0xA6, 0x06, 0x24, 0x5B, 0x0B, 0xF9, 0xA8,
+	0x02, 0xE8, 0x0D, 0x60, 0xBB, 0x34, 0x20, 0x01,
+	0x9A, 0x9B, 0x64, 0x06, 0xEE, 0xAB, 0x7D, 0x5F,
+	0x34, 0xE9, 0x35, 0x3E, 0x1D, 0x4A, 0x13, 0xB9,
+	0xD6, 0xD8, 0xC1, 0x86, 0x14, 0x59, 0x7C, 0xC1,
+	0x64, 0x8A, 0xC5, 0xB3, 0x46, 0x1A, 0x14, 0xEA,
+	0x84, 0x6F, 0x5B, 0x71, 0x34, 0xDA, 0x1C, 0xCD,
+	0x66, 0xE4, 0x4C, 0x59, 0x44, 0x2F, 0xEC, 0x39,
+	0x2E, 0xE1, 0xC1, 0x49, 0x98, 0xE0, 0x95, 0x61,
+	0x48, 0x0C, 0x7A, 0x86, 0xFA, 0x8C, 0xCF, 0xDC,
+	0xB5, 0x4F, 0x8E, 0x62, 0x94, 0x5B, 0x6D, 0x7A,
+	0x96, 0x0C, 0xA5, 0x6D, 0x63, 0x67, 0xA2, 0x39,
+	0x57, 0x13, 0x07, 0x9D, 0x4F, 0xD2, 0x6F, 0xBE,
+	0x92, 0xD0, 0x92, 0xD8, 0x1A, 0x56, 0xED, 0xFC,
+	0x4C, 0x5C, 0xE5, 0x88, 0xC9, 0x0E, 0x3C, 0xA8,
+	0x97, 0xFF, 0xC4, 0x70, 0x69, 0x62, 0x5C, 0x8D,
+	0xBC, 0xC2, 0x27, 0x63, 0x8D, 0xEF, 0x96, 0xAC,
+	0x2B, 0x0E, 0xB4, 0x63, 0xA7, 0xB0, 0x72, 0x70,
+	0x0D, 0x99, 0x28, 0x4F, 0x87, 0x42, 0xD6, 0xAE,
+	0x8F, 0x7F, 0x6C, 0x85, 0x72,
+
+	0x42, 0xD9, 0x96, 0xF3, 0xA8, 0xB0, 0xDC, 0x8E,
+	0xF5, 0xA5, 0x2A, 0xC2, 0xBB, 0x9E, 0x92, 0xFC,
+	0x81, 0x35, 0x5A, 0x20, 0xF6, 0x46, 0x7C, 0x94,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xF3, 0x98, 0xFE, 0x8C, 0x49, 0x54, 0xDB, 0x0C,
+	0x54, 0xD6, 0x93, 0xAA, 0xC8, 0xB5, 0x2D, 0xFC,
+	0xB1, 0xE8, 0xD6, 0xDF, 0x5B, 0x16, 0x1C, 0x23,
+	0x86, 0xB8, 0xDE, 0xB2, 0xBE, 0x8F, 0x93, 0xE6,
+	0x19, 0xBA, 0xB9, 0x83, 0x6D, 0x45, 0x1C, 0x83,
+	0x7B, 0x07, 0x15, 0x59, 0x4C, 0x64, 0x06, 0xD8,
+	0x07, 0x98, 0xD5, 0x67, 0x12, 0x4E, 0x43, 0x00,
+	0x96, 0x96, 0x04, 0x0E, 0xD3, 0x89, 0xFD, 0x88,
+	0xE2, 0x6B, 0xD0, 0x9B, 0xA8, 0xDD, 0x11, 0x15,
+	0x85, 0x38, 0x2D, 0x78, 0x2B, 0x0A, 0xD1, 0xEF,
+	0x21, 0x9F, 0x23, 0x1D, 0x41, 0xCB, 0xFB, 0x76,
+	0xC7, 0xB6, 0xE2, 0xB1, 0x46, 0x99, 0x22, 0xDE,
+	0xBF, 0xEC, 0x07, 0xFE, 0x01, 0xD0, 0xCC, 0xE4,
+	0x18, 0x4A, 0x4B, 0x58, 0xE6, 0xCC, 0x69, 0x1A,
+	0x58, 0x5F, 0x53, 0xDB, 0x0F, 0xFF, 0xD8, 0x1C,
+	0x0D, 0xB1, 0x21, 0x5D, 0x57, 0x2F, 0x09, 0x77,
+	0x24, 0x2E, 0x0C, 0x6E, 0x00, 0x67, 0x7A, 0x3B,
+	0xE1, 0xD2, 0x27, 0x61, 0x83, 0xDF, 0x16, 0x98,
+	0xF9, 0xF3, 0x8C, 0x75, 0x64, 0x9D, 0x88, 0xE6,
+	0x4B, 0xB7, 0xCB, 0xB5, 0x94, 0x44, 0x27, 0xBF,
+	0x8D, 0x6E, 0x0B, 0xF2, 0x66, 0x82, 0x51, 0xE6,
+	0x7D, 0xE5, 0x8B, 0x76, 0xF6, 0xB2, 0xDC, 0x18,
+	0x1F, 0xAD, 0x5F, 0x77, 0xB6, 0x7D, 0xC7, 0x0B,
+	0xA1, 0x75, 0xAA, 0x71, 0x63, 0x32, 0xA3, 0xD1,
+	0x09, 0xD9, 0x8B, 0x6A, 0x37, 0xD2, 0x22, 0x14,
+	0xF5, 0x31, 0x8A, 0xD1, 0xC5, 0xE4, 0x84, 0x2A,
+	0xC8, 0xDF, 0x64, 0xA6, 0xB8, 0x67,
+
+	0x42, 0xD9, 0x96, 0xF3, 0xA8, 0xBC, 0xDC, 0x9E,
+	0xF5, 0xA5, 0x2A, 0x82, 0x83, 0x9E, 0x92, 0xFC,
+	0x81, 0x35, 0x5A, 0x20, 0xF6, 0x46, 0x7C, 0x94,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xF3, 0x18, 0xFE, 0x8C, 0x41, 0x51, 0xDB, 0x0C,
+	0x5C, 0x17, 0x93, 0xAB, 0x4C, 0x32, 0x23, 0xBD,
+	0xB8, 0xA8, 0xD6, 0xDF, 0xBB, 0x1A, 0x1C, 0x3B,
+	0xD6, 0xA6, 0xB3, 0x32, 0xBC, 0x8F, 0x17, 0x25,
+	0x10, 0x86, 0xC4, 0x90, 0x96, 0x71, 0xFA, 0x0B,
+	0x77, 0x09, 0x34, 0x3F, 0x33, 0x6A, 0xCC, 0x82,
+	0x77, 0x80, 0x68, 0x76, 0x7C, 0x5A, 0x3B, 0x1D,
+	0xD4, 0xA0, 0xAB, 0x84, 0x7C, 0xA4, 0x44, 0x95,
+	0xFB, 0xDE, 0x80, 0xAC, 0x5A, 0x4F, 0x9F, 0x53,
+	0x9A, 0xE1, 0xC9, 0x6F, 0x00, 0x1E, 0x85, 0xD8,
+	0xDD, 0x3A, 0x2B, 0x2B, 0x79, 0x41, 0x7C, 0xF4,
+	0xFA, 0x78, 0x84, 0xD2, 0xDC, 0x6D, 0xF3, 0xDE,
+	0xD4, 0x49, 0x0B, 0xC6, 0x04, 0xDB, 0x46, 0x0E,
+	0x61, 0x32, 0x7D, 0x5A, 0xD4, 0xEE, 0xE3, 0x24,
+	0x3E, 0xF2, 0x76, 0x4C, 0x98, 0x28, 0x50, 0xCA,
+	0x0D, 0x3F, 0x4A, 0x9C, 0xAC, 0x68, 0x1D, 0xE4,
+	0xDA, 0x65, 0x2B, 0xC7, 0x13, 0xF6, 0x38, 0x1A,
+	0x75, 0x50, 0xB8, 0x0F, 0xA5, 0x9C, 0x8B, 0xE6,
+	0x2E, 0xF0, 0x39, 0x46, 0xC4, 0x29, 0xA6, 0xA0,
+	0x9E, 0x53, 0xF8, 0xC7, 0xE2, 0x14, 0xBC, 0x97,
+	0x55, 0x29, 0x24, 0x1F, 0x38, 0x30, 0x18, 0xD4,
+	0x77, 0xC0, 0x4E, 0x5D, 0x37, 0x78, 0x85, 0xBE,
+	0xF6, 0x6B, 0xC1, 0x22, 0x46, 0x01, 0x2A, 0xA8,
+	0x17, 0x01, 0x24, 0x22, 0x4B, 0x5B, 0x5A, 0x94,
+	0xA6, 0x42, 0x6A, 0x7D, 0x2A, 0xA7, 0x1F, 0x4E,
+	0x0D, 0x46, 0x90, 0x6F, 0x13, 0xBB, 0x59, 0x80,
+	0x34, 0x6C, 0x7A, 0xA7, 0x89, 0x56, 0x50,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xF5, 0xA5, 0x2A, 0x82, 0x2B, 0xB6, 0x92, 0xFC,
+	0x81, 0x35, 0x5A, 0x20, 0xF6, 0x46, 0x7C, 0x94,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xF3, 0x18, 0xFE, 0xDC, 0x41, 0x41, 0x12, 0x0C,
+	0x5C, 0x37, 0x23, 0xAA, 0x4C, 0xBA, 0xB9, 0xBF,
+	0xB8, 0x8A, 0xDC, 0xDF, 0xBE, 0x4A, 0x0C, 0x3B,
+	0x94, 0x07, 0x99, 0x1F, 0x3C, 0x8D, 0x17, 0xAD,
+	0x18, 0x47, 0x28, 0xD9, 0x70, 0x11, 0x25, 0x22,
+	0xEF, 0x03, 0x3A, 0x4F, 0x41, 0xF6, 0x06, 0x1E,
+	0x24, 0xD8, 0x74, 0x68, 0x8D, 0xF8, 0x2A, 0x3D,
+	0x4B, 0xBC, 0x7B, 0x03, 0x56, 0x2F, 0xC8, 0xEC,
+	0x49, 0x80, 0xAC, 0x1F, 0x28, 0xE3, 0x51, 0xB1,
+	0xFD, 0x0E, 0x20, 0xF7, 0x96, 0xBA, 0x30, 0x29,
+	0x0A, 0x04, 0x53, 0x6B, 0x1D, 0x51, 0x29, 0x49,
+	0xAB, 0xA2, 0xDC, 0x0F, 0xA4, 0x0F, 0x49, 0xA8,
+	0x34, 0x41, 0x07, 0x7F, 0xA0, 0xA2, 0x92, 0x8F,
+	0x3A, 0x49, 0x29, 0x8B, 0xE1, 0xFC, 0x6B, 0x63,
+	0x53, 0x48, 0xBA, 0x00, 0x3B, 0x6B, 0xBE, 0xB0,
+	0xB7, 0xF4, 0x8D, 0x27, 0xA6, 0xFC, 0x9E, 0xE2,
+	0x41, 0xEC, 0x00, 0xB0, 0x5F, 0x8E, 0xF9, 0x8E,
+	0xE8, 0x37, 0xA6, 0x0F, 0xA2, 0x13, 0xD2, 0x94,
+	0x91, 0x9B, 0x8A, 0xD5, 0x4C, 0x6D, 0x83, 0x68,
+	0xBE, 0x75, 0x01, 0x8B, 0x10, 0xB7, 0xA1, 0xD0,
+	0x47, 0xE6, 0xFF, 0x4E, 0x03, 0xDA, 0x18, 0x63,
+	0x75, 0xE1, 0x2C, 0xA7, 0x51, 0x54, 0x99, 0x9B,
+	0xA3, 0x34, 0xB5, 0xB6, 0xB6, 0xDC, 0xDB, 0x47,
+	0xA9, 0x0D, 0x79, 0xCA, 0x0F, 0xC7, 0x7C, 0x94,
+	0x2E, 0xD4, 0xC4, 0x58, 0xE6, 0x7B, 0x97, 0xF6,
+	0x4D, 0x78, 0x06, 0xD3, 0x2D, 0x9B, 0x44, 0xB8,
+	0xAF, 0x68, 0x58, 0xBA, 0x79, 0xCA, 0x20, 0x37,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0x75, 0x84, 0x2A, 0x82, 0x2B, 0xB6, 0xB2, 0xFC,
+	0x81, 0x35, 0x5A, 0x20, 0x36, 0x96, 0x64, 0x94,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0x7F, 0x1D, 0xCF, 0xF2, 0x04, 0x41, 0x13, 0x0C,
+	0x5C, 0x37, 0x23, 0xAA, 0x4C, 0xBA, 0xB1, 0xFD,
+	0xB8, 0x0A, 0xD4, 0xBD, 0xBE, 0xE8, 0x80, 0x26,
+	0x94, 0x37, 0x9D, 0x9F, 0x36, 0x8E, 0x3D, 0xA1,
+	0x1E, 0xEB, 0xE9, 0x77, 0x00, 0xE1, 0xDB, 0xBA,
+	0xAF, 0x39, 0x66, 0x4E, 0x2B, 0xF4, 0x58, 0xF2,
+	0x34, 0xDA, 0x69, 0xE6, 0xFC, 0xE8, 0xD8, 0xF1,
+	0xE9, 0xBA, 0xB8, 0x8F, 0xB9, 0x26, 0xA4, 0x2D,
+	0xD0, 0x17, 0xED, 0x42, 0x44, 0x38, 0x4D, 0x96,
+	0xB5, 0xBD, 0xD6, 0x9F, 0x6A, 0xB5, 0xA0, 0x88,
+	0x33, 0x67, 0xCB, 0x49, 0x5D, 0xA8, 0x72, 0xD1,
+	0x86, 0xC8, 0x67, 0x99, 0x0F, 0x56, 0xB9, 0xA2,
+	0x4B, 0xEA, 0x38, 0x15, 0xD7, 0xF4, 0x94, 0xD7,
+	0x90, 0xEC, 0x8C, 0x05, 0x0E, 0x70, 0x7A, 0xA4,
+	0xD3, 0xAF, 0x65, 0x14, 0xF2, 0x88, 0xDD, 0xC2,
+	0xA5, 0x65, 0x2E, 0x7D, 0xEE, 0x13, 0x10, 0x64,
+	0x30, 0x39, 0xE5, 0xE2, 0x8F, 0x16, 0x2C, 0xF9,
+	0xA4, 0x65, 0x05, 0x5A, 0xA0, 0xE7, 0x4D, 0x82,
+	0x8C, 0x82, 0x07, 0xE6, 0x98, 0x6A, 0x95, 0x66,
+	0xB3, 0xFA, 0xDA, 0xC4, 0x23, 0x31, 0xCA, 0x35,
+	0x0D, 0x06, 0xB9, 0xF9, 0x94, 0xE9, 0x94, 0x62,
+	0xE5, 0xF6, 0xDA, 0x23, 0x38, 0xB3, 0x18, 0xCB,
+	0x60, 0xD7, 0x67, 0x29, 0x64, 0x19, 0xB5, 0x2F,
+	0x2D, 0x3F, 0x3F, 0xCE, 0xE6, 0xFF, 0xCF, 0x11,
+	0xA0, 0xA2, 0x60, 0x79, 0xBA, 0x45, 0xB1, 0x5C,
+	0x5D, 0xA6, 0x14, 0x5F, 0x43, 0x43, 0x6A, 0x76,
+	0xAE, 0x3F, 0x83, 0xDA, 0xA5, 0x12, 0xDD, 0x0D,
+	0xFE,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0x75, 0x84, 0x3A, 0x82, 0x2B, 0xB6, 0xB2, 0x7C,
+	0x81, 0x35, 0x5A, 0x20, 0x36, 0x96, 0x6C, 0x9D,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0x7F, 0x8D, 0xC7, 0xB6, 0x66, 0x0F, 0x03, 0x0C,
+	0x1C, 0x37, 0x23, 0xAA, 0x4C, 0xBA, 0xB1, 0xFD,
+	0x08, 0x8B, 0xD4, 0xB4, 0x9A, 0xE9, 0x14, 0xB6,
+	0x94, 0x37, 0xDD, 0xDF, 0x34, 0xAF, 0x3E, 0xA1,
+	0xF2, 0x2B, 0xF8, 0x63, 0x05, 0xD5, 0x33, 0x29,
+	0xF6, 0xA8, 0x80, 0x7E, 0x8E, 0x85, 0x5C, 0x82,
+	0x34, 0xCB, 0xF9, 0x85, 0x2E, 0x2C, 0x9D, 0x96,
+	0xFB, 0xF7, 0x78, 0x95, 0xB8, 0x45, 0xE4, 0xBD,
+	0xAB, 0x13, 0x13, 0x4A, 0x58, 0xA7, 0x7B, 0x6B,
+	0x0E, 0xEE, 0xD9, 0x13, 0xCF, 0x01, 0x44, 0x6E,
+	0x67, 0xF7, 0x0B, 0x66, 0x5D, 0x43, 0xC2, 0x2E,
+	0x4A, 0xCD, 0x5F, 0xD1, 0x1B, 0xB3, 0xAB, 0xA6,
+	0xA0, 0xEB, 0xC5, 0x2A, 0x0B, 0x14, 0x6D, 0x6F,
+	0x5C, 0x29, 0x51, 0x01, 0xD1, 0xCC, 0x5C, 0x76,
+	0xB6, 0xF4, 0xE6, 0x18, 0xBF, 0x3E, 0xCC, 0x93,
+	0xDB, 0x95, 0x90, 0x3F, 0x03, 0x66, 0x8C, 0x07,
+	0xE1, 0xB1, 0x0F, 0x32, 0xB4, 0x59, 0xF0, 0x41,
+	0x1B, 0x84, 0x28, 0x11, 0x33, 0xA2, 0x14, 0xBD,
+	0xD2, 0x29, 0x25, 0xA8, 0xEC, 0xD5, 0x41, 0x2D,
+	0x1F, 0x6C, 0x42, 0x36, 0xD5, 0xAE, 0xEB, 0xF7,
+	0xFD, 0x01, 0x79, 0x6C, 0x1B, 0x9B, 0xB5, 0x69,
+	0x9A, 0x6A, 0x70, 0x7A, 0xB3, 0x07, 0x66, 0xCE,
+	0xC4, 0xED, 0x1D, 0x53, 0xF3, 0x79, 0x2E, 0x0D,
+	0x2E, 0x95, 0xEE, 0xA3, 0xA2, 0x11, 0x0A, 0x95,
+	0xA4, 0x54, 0x70, 0x80, 0x23, 0x6B, 0x2D, 0x26,
+	0xAD, 0x53, 0xC9, 0x3D, 0x97, 0x72, 0x86, 0x86,
+	0x34, 0x4A, 0xC2, 0x5E, 0x96, 0x97, 0xAD, 0xFD,
+	0xE8, 0x1D,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xF5, 0x8C, 0xBA, 0x90, 0x2B, 0xB6, 0xB2, 0x7C,
+	0xB8, 0x35, 0x5A, 0x20, 0x36, 0x96, 0x6C, 0xD4,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0x7D, 0x8D, 0x53, 0xBD, 0xB6, 0x71, 0x0F, 0x9C,
+	0x34, 0xF0, 0x23, 0xAA, 0x4C, 0x3A, 0xE3, 0xFD,
+	0x9E, 0x00, 0xDE, 0xB4, 0x9B, 0x0C, 0x14, 0x82,
+	0x96, 0x36, 0xDD, 0x9F, 0x3B, 0xA3, 0x3F, 0xA0,
+	0xA8, 0x80, 0xCA, 0x1B, 0xCE, 0x66, 0x10, 0x0B,
+	0x8C, 0x41, 0x64, 0x46, 0x8E, 0xBF, 0xD4, 0x62,
+	0x3C, 0xC9, 0x39, 0x95, 0x91, 0x11, 0x3A, 0x7B,
+	0x7B, 0x31, 0x5A, 0x84, 0x8B, 0x75, 0x0C, 0xE5,
+	0xAC, 0xE5, 0xB1, 0xFA, 0xB5, 0xAE, 0x57, 0x8E,
+	0x0D, 0x52, 0x85, 0x9D, 0x87, 0x69, 0x48, 0x06,
+	0x48, 0xE1, 0xBC, 0xF3, 0x39, 0x47, 0x7E, 0x8B,
+	0x5D, 0x43, 0x37, 0x58, 0x5E, 0x57, 0x17, 0x6F,
+	0x9C, 0x8E, 0x97, 0x62, 0x81, 0xAB, 0x97, 0x42,
+	0xFC, 0x58, 0x67, 0xEF, 0x79, 0x1C, 0xC0, 0xC3,
+	0x5F, 0xCA, 0x69, 0x8C, 0x32, 0x39, 0x9C, 0xC9,
+	0x45, 0xA3, 0xA0, 0xAE, 0xBE, 0x69, 0xA7, 0x1A,
+	0x85, 0xB0, 0x1E, 0xDE, 0x1C, 0xEF, 0x80, 0xBB,
+	0xF8, 0xD5, 0x8C, 0xB9, 0x29, 0x61, 0x14, 0x8D,
+	0x37, 0xFD, 0xF8, 0xC2, 0x21, 0x35, 0x1B, 0xC0,
+	0x90, 0xBB, 0xC6, 0x15, 0xDA, 0xD3, 0xC6, 0xAF,
+	0x0E, 0x05, 0xB3, 0x36, 0xDA, 0x6C, 0x9D, 0xF4,
+	0x89, 0x84, 0x37, 0xB8, 0xC6, 0x10, 0x7F, 0x4E,
+	0xBA, 0x6A, 0xEC, 0x13, 0xFD, 0x53, 0x16, 0x4D,
+	0xC9, 0x60, 0xD7, 0x56, 0xC9, 0x60, 0x18, 0x3F,
+	0x93, 0x22, 0x84, 0xB4, 0xCA, 0x7F, 0x69, 0x5C,
+	0xAE, 0xB0, 0xD2, 0xFD, 0xFC, 0xDB, 0xF9, 0xA1,
+	0x63, 0x24, 0x02, 0xEF, 0x63, 0x8B, 0xE6, 0xC0,
+	0x15, 0x58, 0xBC,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xF5, 0x4C, 0xAE, 0x90, 0x79, 0xB6, 0xB2, 0x7C,
+	0x38, 0x1C, 0x5A, 0x20, 0x36, 0x96, 0x6C, 0x54,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0x6D, 0x8D, 0x53, 0x25, 0xF4, 0x31, 0x65, 0x18,
+	0x34, 0xC0, 0x33, 0xAA, 0x4C, 0x3A, 0xE3, 0xBE,
+	0x1C, 0x98, 0x64, 0xB6, 0x1B, 0x0D, 0xA2, 0x86,
+	0xD4, 0xB6, 0xC9, 0x9F, 0x4B, 0x8E, 0x35, 0x80,
+	0x45, 0x8E, 0xAA, 0x01, 0xBC, 0xCB, 0x20, 0xED,
+	0x6E, 0x11, 0xB4, 0x44, 0xEC, 0x80, 0xE8, 0x78,
+	0xE0, 0x54, 0xA2, 0xCC, 0x59, 0x1A, 0x4A, 0xDB,
+	0xC9, 0x30, 0xDA, 0x01, 0x1A, 0xA0, 0x80, 0xD1,
+	0xE3, 0x5B, 0x99, 0xAB, 0x11, 0x9B, 0xDC, 0xD8,
+	0x43, 0xD6, 0x00, 0x3E, 0xBC, 0x01, 0x63, 0x34,
+	0x79, 0xEF, 0x9E, 0x6C, 0xFB, 0xC3, 0xB1, 0x30,
+	0xE5, 0xD8, 0xAE, 0x4F, 0xEF, 0x5B, 0x6F, 0x5B,
+	0x5E, 0x7D, 0x65, 0xB7, 0x46, 0xAD, 0xAE, 0xA4,
+	0xE6, 0xCD, 0xD1, 0xC9, 0x59, 0x69, 0x74, 0x38,
+	0x29, 0xC4, 0xC6, 0x20, 0xA1, 0x1D, 0x17, 0x01,
+	0x9E, 0x3D, 0x85, 0x1A, 0x69, 0x0D, 0xB8, 0x3F,
+	0x74, 0x85, 0x66, 0x04, 0x75, 0xC4, 0x7C, 0x7C,
+	0x10, 0xF0, 0xE5, 0x2D, 0xFB, 0xF3, 0x86, 0xFD,
+	0x1F, 0x76, 0xEA, 0xB1, 0x9D, 0x1D, 0x9C, 0xB1,
+	0xC2, 0xE7, 0x7B, 0x4D, 0xAA, 0x0F, 0x18, 0x5E,
+	0x7E, 0x2B, 0x89, 0xD4, 0x0A, 0xFD, 0xAF, 0xFB,
+	0x43, 0x38, 0x9D, 0xC6, 0x74, 0xE9, 0x8E, 0x90,
+	0x57, 0xE5, 0xB4, 0x81, 0xA1, 0xF2, 0xC6, 0xC6,
+	0xB6, 0x6E, 0x95, 0x0A, 0xB2, 0xE8, 0x33, 0xBB,
+	0xC9, 0x26, 0xC0, 0xFF, 0x4A, 0x60, 0x6F, 0x8A,
+	0xA9, 0xB0, 0x9D, 0x1A, 0x03, 0xC8, 0x07, 0xF0,
+	0x8E, 0xC3, 0x80, 0x0C, 0x76, 0xDD, 0x49, 0x35,
+	0x44, 0x34, 0x53, 0xBC,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xF5, 0x4C, 0x4E, 0x98, 0x79, 0xB5, 0xB2, 0x7C,
+	0xB8, 0xD4, 0x43, 0x20, 0x36, 0x96, 0x6C, 0x54,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xA5, 0x9D, 0x52, 0x25, 0x68, 0xF4, 0x36, 0x6E,
+	0x37, 0xC0, 0x1B, 0xA3, 0x4C, 0x3A, 0xE3, 0x3E,
+	0x1C, 0x98, 0xF9, 0x03, 0x1B, 0xCD, 0xB2, 0xD0,
+	0x6C, 0x94, 0xC9, 0x9F, 0x4B, 0x8E, 0x46, 0xC6,
+	0x4D, 0x59, 0xC8, 0x78, 0x63, 0x2F, 0x16, 0xB3,
+	0xAC, 0x77, 0xFC, 0x76, 0xA6, 0x97, 0x19, 0x13,
+	0xC1, 0x34, 0xB3, 0xE5, 0x76, 0x42, 0x5A, 0xE5,
+	0x61, 0x65, 0xFF, 0xE2, 0x10, 0x90, 0xD6, 0x68,
+	0x1B, 0x87, 0x6A, 0x0F, 0x72, 0x14, 0x22, 0x38,
+	0xC7, 0x01, 0xAE, 0x29, 0x92, 0xA0, 0xFD, 0x8D,
+	0xB6, 0xA8, 0x09, 0x5C, 0x08, 0xF8, 0x70, 0x37,
+	0x58, 0xFF, 0xA8, 0x8D, 0x6C, 0x7E, 0x4F, 0xBF,
+	0x58, 0x59, 0xA0, 0x52, 0x37, 0x10, 0xCE, 0xF2,
+	0xC7, 0x8B, 0x4A, 0xF0, 0xFE, 0x4D, 0x75, 0x29,
+	0xB3, 0x60, 0x8B, 0x52, 0xB6, 0x59, 0x8D, 0x98,
+	0x58, 0x3C, 0x8A, 0x5F, 0x8F, 0xA4, 0x1B, 0x55,
+	0xE1, 0xFB, 0x5F, 0xF4, 0x8D, 0x8E, 0x12, 0x11,
+	0x09, 0xD0, 0x8F, 0xF0, 0xA3, 0x30, 0x51, 0xE9,
+	0x72, 0xE7, 0xB1, 0x68, 0x33, 0xB4, 0x0A, 0x89,
+	0x06, 0xB4, 0x68, 0xB5, 0x13, 0x18, 0x0E, 0x16,
+	0xA9, 0x3D, 0xA6, 0x0F, 0x6D, 0x79, 0xE0, 0x34,
+	0x9C, 0x37, 0xD4, 0x21, 0xC2, 0x08, 0x90, 0xFA,
+	0xEA, 0xB1, 0xB2, 0xBB, 0xB7, 0x46, 0x02, 0x11,
+	0x9F, 0x01, 0xD7, 0xB9, 0xE3, 0xB4, 0x54, 0x1E,
+	0xD9, 0x78, 0x6E, 0xD3, 0x33, 0xBD, 0xC6, 0x59,
+	0x2E, 0x3D, 0x47, 0x39, 0x7F, 0x46, 0x85, 0xD4,
+	0xE1, 0x0B, 0xE2, 0xC1, 0x1A, 0x96, 0xE3, 0x71,
+	0x09, 0xDB, 0x33, 0x32, 0xAF,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xF5, 0x4C, 0x4E, 0x98, 0x7D, 0xB5, 0xA6, 0x7C,
+	0xB8, 0xD4, 0x43, 0x2A, 0x36, 0x96, 0x6C, 0x54,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0x27, 0x87, 0x52, 0x25, 0x68, 0x54, 0x36, 0xEE,
+	0x37, 0xC4, 0x1B, 0xA3, 0x0D, 0x3A, 0xE3, 0x3E,
+	0x34, 0x98, 0xD1, 0xA3, 0x5B, 0xCD, 0xB2, 0xD0,
+	0x2C, 0x95, 0xC8, 0x9F, 0x4F, 0x8E, 0x06, 0xC6,
+	0xD7, 0x73, 0x28, 0xD2, 0x69, 0x4D, 0xA4, 0xF2,
+	0xFC, 0x66, 0xAD, 0xE6, 0x22, 0x9D, 0x05, 0x43,
+	0x7B, 0xB4, 0x3F, 0x47, 0x32, 0xC6, 0xBB, 0xF7,
+	0x40, 0x21, 0xE6, 0xA5, 0x11, 0x9B, 0xD6, 0x34,
+	0x22, 0x09, 0x10, 0x50, 0x0A, 0x29, 0x77, 0xE9,
+	0x66, 0xC0, 0x78, 0x0B, 0xDE, 0x4D, 0x55, 0xAA,
+	0x82, 0x7B, 0x99, 0x23, 0xF0, 0x0D, 0x53, 0xFD,
+	0xEC, 0xF5, 0xBD, 0xDC, 0x09, 0x83, 0x5A, 0xE8,
+	0x0E, 0x2E, 0xBE, 0xF3, 0x34, 0xCD, 0xF0, 0xE1,
+	0xCF, 0x0E, 0x45, 0x42, 0x57, 0x2B, 0xEE, 0x5B,
+	0x94, 0x94, 0xF3, 0xE6, 0x08, 0x11, 0x75, 0x47,
+	0x19, 0x9E, 0xAB, 0x00, 0xAD, 0xD9, 0xEB, 0x24,
+	0xC7, 0x65, 0x9E, 0x89, 0x02, 0x91, 0xF4, 0x27,
+	0xD6, 0x68, 0x31, 0xD2, 0xF3, 0x66, 0xCB, 0x8B,
+	0xE3, 0x1B, 0xAC, 0xC4, 0x03, 0xF2, 0x50, 0x3E,
+	0x0A, 0xEA, 0x86, 0xCD, 0xD2, 0x8A, 0xD3, 0x98,
+	0x27, 0xF6, 0x3C, 0x06, 0xE3, 0x68, 0xDB, 0xF8,
+	0xB6, 0x86, 0xCB, 0x8C, 0xA1, 0xED, 0xA4, 0x4A,
+	0x14, 0xEB, 0x42, 0x72, 0x85, 0x97, 0xEE, 0xE3,
+	0xC4, 0x46, 0x12, 0x4E, 0x63, 0xCE, 0xA2, 0xE8,
+	0xBA, 0x80, 0x55, 0x29, 0x46, 0x49, 0x21, 0x1D,
+	0xA9, 0x0C, 0x9B, 0xE1, 0x90, 0x73, 0xC2, 0xA3,
+	0x16, 0x3A, 0x24, 0x7E, 0xE8, 0x4C, 0xC3, 0xBD,
+	0xEB, 0x00, 0x46, 0x6C, 0xFA, 0xA8,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xF5, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x26, 0x4C,
+	0xB8, 0xD4, 0x03, 0x60, 0x0C, 0x96, 0x6C, 0x54,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xB6, 0x19, 0xB2, 0x21, 0x68, 0x54, 0x92, 0x6D,
+	0x37, 0x44, 0x0B, 0xA3, 0x45, 0xBF, 0xE3, 0x3E,
+	0x1C, 0x79, 0xD0, 0x0F, 0xFF, 0xCE, 0xB6, 0x90,
+	0xBA, 0xDB, 0xC0, 0xAD, 0x47, 0x82, 0x06, 0xCE,
+	0x45, 0x0B, 0xC1, 0x0C, 0xB1, 0x9A, 0xFC, 0x37,
+	0xCE, 0xA7, 0xE2, 0x0A, 0xE3, 0x75, 0x99, 0xE4,
+	0x69, 0x32, 0x96, 0xA3, 0xA9, 0x20, 0x78, 0xAF,
+	0x32, 0x25, 0xE6, 0x96, 0x5B, 0x92, 0xA0, 0x31,
+	0x58, 0x87, 0x41, 0xE5, 0xB6, 0x50, 0x51, 0x69,
+	0x88, 0x67, 0x9A, 0x1E, 0x4A, 0xF4, 0x06, 0x0C,
+	0xAC, 0x29, 0x06, 0xC1, 0xD8, 0xC6, 0x07, 0x72,
+	0xD0, 0x83, 0xD0, 0x13, 0xCE, 0xFC, 0x21, 0x1A,
+	0x99, 0xF2, 0x14, 0x48, 0xB9, 0x7B, 0x22, 0xC3,
+	0x5F, 0x6B, 0x5E, 0x57, 0xF8, 0xB3, 0xCD, 0x95,
+	0x0F, 0x65, 0x1A, 0x56, 0x8F, 0xFD, 0xDD, 0x28,
+	0xC3, 0x1B, 0x4A, 0x90, 0x16, 0x77, 0x19, 0x50,
+	0x8A, 0x47, 0x67, 0x57, 0x2E, 0x2F, 0xA9, 0xE2,
+	0x16, 0xC3, 0xA4, 0x74, 0x47, 0xAB, 0x27, 0xCF,
+	0x8B, 0x6F, 0x7E, 0xF0, 0xB0, 0x8E, 0xBC, 0x3C,
+	0x38, 0x7D, 0xFD, 0xA6, 0xD7, 0xD5, 0xFF, 0x39,
+	0x28, 0xEB, 0x63, 0x84, 0xBF, 0xE5, 0x2B, 0x0E,
+	0x31, 0x42, 0x00, 0xCD, 0xE1, 0xC8, 0xD7, 0xA3,
+	0x8B, 0xC2, 0x49, 0xE6, 0xC2, 0x0A, 0xDB, 0xDC,
+	0x50, 0x1A, 0x30, 0x07, 0x50, 0xD9, 0x2F, 0x84,
+	0xAA, 0x24, 0x6F, 0x35, 0x51, 0x57, 0xC4, 0x55,
+	0x44, 0xD7, 0xEF, 0x29, 0xA9, 0x4D, 0x03, 0x31,
+	0x47, 0xA8, 0x93, 0x8A, 0xB7, 0x7D, 0x3F, 0xE1,
+	0xCD, 0x08, 0x0E, 0x79, 0xDD, 0x23, 0xE6,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xB8, 0xD4, 0x03, 0xE0, 0xAC, 0xBC, 0x6C, 0x54,
+	0xDD, 0xB2, 0x69, 0xA2, 0x04, 0xD5, 0xA0, 0x8F,
+	0xB4, 0xB1, 0x18, 0x4F, 0xE8, 0x54, 0x92, 0xC5,
+	0x37, 0x44, 0x0B, 0xA2, 0x45, 0xBF, 0xB6, 0x3F,
+	0x9C, 0x53, 0x7A, 0x0F, 0x75, 0x66, 0xF4, 0x97,
+	0xBA, 0x53, 0xC2, 0xBD, 0x42, 0x87, 0x12, 0xCE,
+	0x4B, 0x2A, 0x75, 0x22, 0x2B, 0x51, 0xAC, 0x3E,
+	0xCB, 0xA0, 0xBB, 0x0E, 0xE3, 0x99, 0xB4, 0xE6,
+	0x85, 0x12, 0x40, 0xEB, 0x1D, 0xA8, 0x7E, 0xC7,
+	0x22, 0x27, 0xF8, 0xC8, 0x37, 0x94, 0xE4, 0xFF,
+	0x96, 0x18, 0x3C, 0x6E, 0x8B, 0x1E, 0x9D, 0xC9,
+	0xAE, 0xA1, 0x57, 0x42, 0x46, 0xE2, 0x04, 0xCA,
+	0x63, 0x9C, 0x30, 0x6D, 0x95, 0x49, 0xBA, 0xF0,
+	0x3A, 0x0B, 0x37, 0x36, 0x28, 0x57, 0x7D, 0xDF,
+	0x2E, 0xCD, 0xD7, 0x04, 0x82, 0xB9, 0x46, 0xBC,
+	0x40, 0xB0, 0x7F, 0xB7, 0xA3, 0xC3, 0x34, 0x4C,
+	0x3C, 0x67, 0x10, 0x5E, 0x3F, 0x50, 0x84, 0x6D,
+	0xCD, 0x4B, 0x91, 0x6F, 0xEE, 0x8E, 0x48, 0x71,
+	0x6E, 0x92, 0xEA, 0x31, 0x9F, 0x13, 0x53, 0x1B,
+	0x32, 0xA1, 0xDC, 0x41, 0x44, 0x59, 0xC0, 0xD5,
+	0xB3, 0xD5, 0xFD, 0x86, 0x5D, 0x19, 0x7D, 0x5A,
+	0x4F, 0xFB, 0xB3, 0x6F, 0x2C, 0xAB, 0x64, 0x38,
+	0x6B, 0x8E, 0xFF, 0xF9, 0x3B, 0x27, 0xF0, 0x06,
+	0xCD, 0xF9, 0x9B, 0x94, 0x9B, 0xB6, 0x72, 0x0C,
+	0xC5, 0x70, 0x01, 0x81, 0xAE, 0x37, 0xA7, 0xA8,
+	0xE0, 0xED, 0xA2, 0xF6, 0x17, 0x2B, 0x50, 0x59,
+	0x77, 0xC8, 0x1E, 0xE5, 0x20, 0xED, 0x8D, 0xB2,
+	0x25, 0x97, 0xBE, 0x45, 0x40, 0xF1, 0xB8, 0x12,
+	0x06, 0x8E, 0x55, 0x25, 0x71, 0x4F, 0x43, 0x62,
+	0x96, 0x81, 0xC2, 0x28, 0xE7, 0x78, 0x2E, 0x5F,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xB8, 0xF0, 0x03, 0xE0, 0xAC, 0xBC, 0x6C, 0x59,
+	0xDD, 0xB2, 0x69, 0xA2, 0x84, 0x0D, 0xBA, 0x8F,
+	0xB4, 0xE1, 0x2A, 0x0B, 0xF0, 0x56, 0x57, 0xC1,
+	0x9B, 0x03, 0xB9, 0x14, 0x26, 0x3F, 0xB6, 0x3F,
+	0x9C, 0x53, 0x7A, 0x0F, 0x75, 0x66, 0xAC, 0xC5,
+	0xB6, 0x93, 0xD0, 0xC6, 0x40, 0xCE, 0xBF, 0xCB,
+	0xDB, 0xB3, 0x8D, 0x08, 0xDC, 0x5E, 0x97, 0xDE,
+	0x46, 0xED, 0xBA, 0x60, 0x48, 0x68, 0x55, 0xAD,
+	0x03, 0x09, 0x67, 0x97, 0x35, 0xD3, 0x4F, 0x67,
+	0x7B, 0x9B, 0x67, 0x6E, 0x67, 0x1B, 0x14, 0xFB,
+	0xCE, 0x1D, 0x76, 0x58, 0x9E, 0x6A, 0xE0, 0x8C,
+	0x7A, 0x1B, 0xA0, 0x8A, 0x5C, 0x59, 0x71, 0x13,
+	0xC1, 0xDB, 0x2C, 0xD6, 0x46, 0xDC, 0x2F, 0x55,
+	0x30, 0x6C, 0x40, 0xA9, 0x44, 0x18, 0xA4, 0x57,
+	0x95, 0x55, 0xB7, 0xFB, 0x9C, 0xDE, 0x61, 0x54,
+	0x11, 0xE2, 0x1E, 0x22, 0x52, 0xB7, 0x6B, 0x06,
+	0xEF, 0x63, 0xDF, 0xBB, 0x0A, 0x83, 0xCB, 0x45,
+	0x2E, 0xD0, 0xB1, 0xCB, 0x35, 0xE6, 0x2C, 0x74,
+	0x3B, 0x69, 0x63, 0x58, 0x5E, 0xF9, 0x17, 0xF4,
+	0x28, 0xAB, 0x91, 0x8E, 0x95, 0xF0, 0x49, 0xFA,
+	0xE9, 0x81, 0x29, 0x84, 0x0F, 0x6E, 0x9A, 0x77,
+	0xA6, 0x1D, 0x7A, 0xED, 0xD1, 0xF4, 0x64, 0x12,
+	0x9D, 0xC6, 0x46, 0x92, 0x1D, 0x17, 0x80, 0xEC,
+	0xF9, 0x16, 0xE1, 0x5B, 0x83, 0xCF, 0x51, 0xE5,
+	0x68, 0x9A, 0x9D, 0xB2, 0x93, 0x87, 0x0B, 0x53,
+	0x5A, 0x05, 0xF1, 0xB9, 0x51, 0x62, 0x86, 0x77,
+	0xEF, 0x4B, 0x12, 0xFB, 0x70, 0x77, 0xD0, 0x5F,
+	0x47, 0xF3, 0x71, 0x79, 0xAB, 0x4D, 0xBF, 0x50,
+	0x54, 0xC1, 0xEA, 0x83, 0xC9, 0xBB, 0x16, 0x0C,
+	0x36, 0x7D, 0x74, 0x69, 0x6A, 0xF5, 0x6B, 0x8E,
+	0xC9,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xBC, 0xF0, 0x01, 0xE0, 0xAC, 0xBC, 0x6C, 0x59,
+	0xDD, 0xB2, 0x69, 0xA2, 0x84, 0x0D, 0xBA, 0x84,
+	0xB1, 0xE1, 0xEA, 0x0A, 0xF2, 0x76, 0x56, 0xC1,
+	0x9B, 0xB3, 0xA1, 0xD4, 0xE4, 0x74, 0x36, 0x3F,
+	0xB5, 0x53, 0x7A, 0x0F, 0x75, 0x66, 0xAC, 0x85,
+	0x16, 0x02, 0xD0, 0xC6, 0x6C, 0xCF, 0xBF, 0x7B,
+	0xDB, 0x89, 0xCD, 0xB1, 0xD0, 0x4D, 0x25, 0x77,
+	0x2D, 0x96, 0xCF, 0xCC, 0x20, 0x87, 0x8E, 0x8F,
+	0xDE, 0x8E, 0x42, 0xF1, 0x48, 0x9A, 0x72, 0x85,
+	0x69, 0x6F, 0xA6, 0x18, 0xA9, 0x9B, 0x7A, 0x45,
+	0x92, 0xCB, 0x01, 0xDF, 0x44, 0x33, 0x1E, 0xC0,
+	0xAE, 0xF2, 0x64, 0x35, 0x03, 0xB3, 0x8C, 0x97,
+	0x4D, 0x2C, 0xEB, 0x53, 0x3B, 0xA5, 0x6C, 0x96,
+	0xD2, 0xBF, 0x13, 0x52, 0xAB, 0x2D, 0xC8, 0x79,
+	0xE5, 0xDB, 0x48, 0x98, 0x83, 0xA8, 0x27, 0x91,
+	0xF2, 0xC8, 0x39, 0xE6, 0x7E, 0xF2, 0x3F, 0x8A,
+	0xB1, 0xA4, 0x00, 0x98, 0xC3, 0xEA, 0x2F, 0xA3,
+	0xE2, 0x69, 0x85, 0xE8, 0x45, 0xD4, 0xC9, 0xDE,
+	0x83, 0xD2, 0xCC, 0xD5, 0xED, 0x04, 0xD0, 0xDA,
+	0x5B, 0x63, 0x87, 0xBD, 0x79, 0x1C, 0x5F, 0x6E,
+	0x64, 0xFE, 0x97, 0xA3, 0x31, 0xA9, 0x04, 0x44,
+	0x8D, 0x45, 0x1E, 0xB4, 0xDF, 0xBD, 0xF2, 0x14,
+	0x27, 0xBF, 0x8E, 0x2C, 0x67, 0xC0, 0xFC, 0xC2,
+	0x77, 0xB6, 0x16, 0xDF, 0xCF, 0x0F, 0x5E, 0x46,
+	0x88, 0x50, 0x13, 0x77, 0x66, 0x9C, 0x95, 0xDE,
+	0x64, 0x47, 0x6E, 0x1A, 0xED, 0x8A, 0xD5, 0xBF,
+	0xEC, 0xA5, 0x40, 0x86, 0x1D, 0x73, 0x16, 0xAC,
+	0x8A, 0xE6, 0x83, 0x75, 0x98, 0x94, 0xB0, 0xB7,
+	0x02, 0x9A, 0xE0, 0xAD, 0xA5, 0x5E, 0xC4, 0x75,
+	0x32, 0x6D, 0xF5, 0xB5, 0x29, 0xDC, 0xC8, 0x2F,
+	0x4C, 0x33,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0x9C, 0xFC, 0x01, 0xD0, 0xAC, 0xBC, 0x6C, 0x59,
+	0xE6, 0xB2, 0x69, 0xA2, 0x84, 0x0D, 0xFA, 0xCD,
+	0x3B, 0xEC, 0xEA, 0xCA, 0xEE, 0x56, 0x98, 0xC7,
+	0x9B, 0xB3, 0x15, 0xD7, 0x74, 0xAA, 0x58, 0x1A,
+	0x95, 0x5A, 0x7A, 0x0F, 0x75, 0x66, 0xBE, 0x85,
+	0xA0, 0xF1, 0xD6, 0xC6, 0x47, 0x6A, 0xBF, 0x97,
+	0x29, 0x4A, 0x73, 0x4B, 0xE1, 0x5C, 0x47, 0xAC,
+	0x4D, 0x0A, 0xA8, 0x6A, 0x57, 0x2B, 0x85, 0x76,
+	0xDE, 0x04, 0x28, 0x62, 0xD9, 0x6A, 0x07, 0x94,
+	0x0C, 0xAB, 0x38, 0x3A, 0xB5, 0x2D, 0xE8, 0x13,
+	0xF4, 0xF3, 0xAE, 0xF5, 0x67, 0xEB, 0x59, 0xDD,
+	0xCF, 0x0D, 0x34, 0x11, 0x3D, 0x01, 0xED, 0x3F,
+	0x32, 0x42, 0x89, 0x49, 0x92, 0x17, 0xC3, 0x5E,
+	0x26, 0x36, 0x4F, 0xF0, 0xA0, 0x07, 0x4E, 0x67,
+	0x86, 0x07, 0x76, 0xBE, 0xF3, 0xFE, 0xA2, 0xF3,
+	0xB2, 0xF2, 0xFD, 0xA6, 0x4B, 0x88, 0xF5, 0xFC,
+	0x93, 0xF9, 0x2F, 0x4A, 0xCB, 0xDE, 0x17, 0x53,
+	0x9D, 0xE5, 0xC7, 0x8E, 0x74, 0xE8, 0xD3, 0xEF,
+	0xED, 0xE0, 0x26, 0x12, 0x53, 0x22, 0x3A, 0xA3,
+	0x7A, 0xCA, 0xE0, 0xE3, 0xB7, 0xDC, 0x66, 0x5B,
+	0x11, 0xB3, 0x99, 0xC1, 0x28, 0x06, 0x96, 0x2D,
+	0xE9, 0xA9, 0xC4, 0x7A, 0x89, 0x2E, 0x24, 0xA6,
+	0x7A, 0xF4, 0x6C, 0x96, 0x47, 0x13, 0x2F, 0xDE,
+	0x41, 0xAC, 0x28, 0xD5, 0xD1, 0xAA, 0xC4, 0xB5,
+	0xE3, 0xC5, 0x54, 0xCA, 0x36, 0xA4, 0x22, 0x57,
+	0xE9, 0x35, 0x6A, 0x4C, 0x1A, 0xD4, 0xE4, 0xE0,
+	0x86, 0x52, 0x37, 0x29, 0xE7, 0x03, 0x57, 0x73,
+	0x58, 0x3C, 0xBA, 0xEE, 0x22, 0x77, 0x44, 0x66,
+	0x79, 0xA2, 0xEC, 0xC4, 0x30, 0x7F, 0x22, 0xFA,
+	0x3D, 0xE6, 0xE0, 0x29, 0x59, 0x4D, 0xA5, 0x91,
+	0x8F, 0x1A, 0x3E,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0x9C, 0xFC, 0x00, 0xD0, 0xFC, 0xBC, 0x6C, 0x59,
+	0x6F, 0x99, 0x69, 0xA2, 0x84, 0x0D, 0xFA, 0xCD,
+	0x3E, 0xED, 0xFE, 0xCA, 0xCE, 0x9A, 0x90, 0xB7,
+	0x9B, 0xB3, 0x15, 0x6F, 0x36, 0xCB, 0xF2, 0xB4,
+	0x95, 0x3A, 0x63, 0x0F, 0x75, 0x66, 0xBE, 0xD2,
+	0x28, 0x4B, 0x94, 0xD6, 0x47, 0x6A, 0x71, 0x93,
+	0x8D, 0xF2, 0x36, 0x18, 0x47, 0x9F, 0xDD, 0xEA,
+	0x74, 0x80, 0x32, 0x7C, 0x35, 0x2E, 0x59, 0xE0,
+	0xB6, 0x25, 0x58, 0xE2, 0x8A, 0x26, 0x7A, 0x91,
+	0x56, 0xC5, 0xC1, 0x5F, 0x1C, 0x41, 0xF2, 0x50,
+	0x9B, 0x98, 0x79, 0x89, 0x5A, 0x10, 0x12, 0xC2,
+	0x98, 0x00, 0x9C, 0xB0, 0xB7, 0x01, 0x4B, 0x41,
+	0x90, 0xA8, 0xC2, 0xE9, 0xAF, 0xE6, 0xFB, 0xBB,
+	0x65, 0xD4, 0x4D, 0x95, 0x56, 0x59, 0xC0, 0x34,
+	0x0E, 0x6D, 0x3C, 0x61, 0xC5, 0xFA, 0x9B, 0x9A,
+	0x1E, 0x4E, 0x18, 0x72, 0x53, 0x74, 0xBE, 0xC7,
+	0xD7, 0xCA, 0x62, 0x48, 0xCB, 0x29, 0x7F, 0x41,
+	0x4E, 0xA3, 0x4E, 0xFA, 0xFD, 0xCB, 0x90, 0xBA,
+	0x16, 0xBF, 0x4E, 0x82, 0x79, 0xFC, 0x75, 0xAD,
+	0x04, 0xC2, 0x42, 0x39, 0x35, 0xAF, 0xAE, 0xF3,
+	0xBB, 0xEE, 0xC8, 0xF7, 0x44, 0x5A, 0x9B, 0x3C,
+	0x7B, 0xFD, 0x6B, 0x4E, 0x73, 0xD1, 0xA0, 0x48,
+	0xBC, 0x28, 0x7A, 0x20, 0xB9, 0x5D, 0x6C, 0xD9,
+	0x79, 0xA6, 0x91, 0x27, 0xBC, 0x85, 0xDC, 0xD1,
+	0x4F, 0x8C, 0xA4, 0xD0, 0xEB, 0xA6, 0x19, 0x54,
+	0xBF, 0xC6, 0xD5, 0x61, 0x06, 0x1B, 0xAE, 0xF2,
+	0x5D, 0x46, 0x99, 0xC1, 0x84, 0xC6, 0x4F, 0xD8,
+	0xB8, 0x2D, 0x15, 0x61, 0x0D, 0xB8, 0x4C, 0xCC,
+	0x70, 0xEE, 0x65, 0x19, 0xD7, 0x10, 0xF8, 0xAA,
+	0x95, 0xE0, 0x4B, 0xCF, 0xC0, 0xA9, 0x1C, 0xBC,
+	0xF9, 0xD8, 0x46, 0x05,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0x9C, 0xFC, 0x60, 0xD0, 0xFC, 0xBA, 0x6C, 0x59,
+	0xAF, 0x42, 0x72, 0xA2, 0x84, 0x0D, 0xFA, 0xCD,
+	0xDD, 0xAC, 0xDA, 0xC6, 0xCE, 0xEA, 0xB6, 0x8F,
+	0xDA, 0xB3, 0x15, 0x6F, 0x8A, 0xCC, 0x0D, 0x42,
+	0xB1, 0x3A, 0x73, 0x5E, 0x75, 0x66, 0xBE, 0xD2,
+	0x28, 0x41, 0x28, 0xBB, 0x46, 0x6A, 0x62, 0xEC,
+	0x48, 0xBE, 0x1D, 0x58, 0x96, 0xFC, 0x7F, 0xC2,
+	0xA0, 0x5A, 0xF4, 0xCA, 0xFF, 0xE0, 0x29, 0xD0,
+	0xDD, 0x15, 0x0C, 0x4E, 0x83, 0x00, 0x3B, 0xEB,
+	0xC7, 0x46, 0xCA, 0x1B, 0xA1, 0x79, 0x69, 0xB7,
+	0x6E, 0x28, 0xA6, 0xBD, 0x7C, 0xC7, 0x15, 0x3E,
+	0x90, 0x77, 0x83, 0x5E, 0x8F, 0x2F, 0x9C, 0x6A,
+	0x2E, 0xAD, 0x76, 0x5F, 0x87, 0x3F, 0xD5, 0xE2,
+	0xB2, 0x03, 0xE2, 0x7A, 0x4E, 0x98, 0xEE, 0x29,
+	0xFE, 0x5E, 0x12, 0xE4, 0xCE, 0x75, 0x14, 0xE8,
+	0x5B, 0x56, 0x33, 0xE3, 0x1A, 0x49, 0x5E, 0xFC,
+	0xCD, 0xFC, 0xF1, 0x21, 0x5C, 0x4D, 0x0F, 0x9D,
+	0xBF, 0x2B, 0x19, 0x78, 0x4D, 0x7B, 0x66, 0x3F,
+	0xDC, 0xE2, 0x79, 0xB6, 0xDC, 0x88, 0xBA, 0x51,
+	0x0D, 0xEC, 0xA2, 0x90, 0x78, 0x78, 0x47, 0x2F,
+	0x0B, 0xF3, 0x65, 0xC1, 0x24, 0x76, 0x49, 0x24,
+	0x91, 0x28, 0x73, 0x4E, 0xF2, 0x9B, 0x79, 0x13,
+	0x16, 0x75, 0x89, 0x39, 0x2C, 0xD2, 0x41, 0x4D,
+	0x28, 0x69, 0x68, 0x3C, 0x7C, 0xDC, 0xD4, 0x8B,
+	0x09, 0x7B, 0x70, 0x55, 0xF3, 0x98, 0xB5, 0x2D,
+	0x18, 0x36, 0x4B, 0x48, 0x7D, 0x7F, 0x83, 0xB9,
+	0xAC, 0x4C, 0xE5, 0x89, 0xE3, 0xF2, 0xAF, 0x73,
+	0x94, 0x1B, 0xAD, 0xBB, 0x51, 0xE5, 0xAF, 0x2E,
+	0xED, 0x6C, 0x50, 0xC5, 0xD5, 0x3A, 0xCB, 0xFA,
+	0x23, 0x29, 0xBB, 0x93, 0x5E, 0x29, 0x40, 0xD1,
+	0xC0, 0xE2, 0x23, 0x7F, 0x6A,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0x9C, 0xFC, 0x60, 0xD0, 0xFC, 0xBA, 0x74, 0x59,
+	0xAF, 0x42, 0x7E, 0xAE, 0x84, 0x0D, 0xFA, 0xCD,
+	0x3D, 0x2D, 0xDA, 0xC6, 0xCC, 0xEA, 0x36, 0x8D,
+	0xD9, 0xC0, 0x15, 0x6F, 0x8A, 0x0C, 0x0D, 0x62,
+	0xB1, 0x22, 0x73, 0xDE, 0x34, 0x66, 0xBE, 0xD2,
+	0x18, 0x41, 0x18, 0x7B, 0x06, 0x6B, 0x62, 0xE0,
+	0xC2, 0xDD, 0x0C, 0x08, 0x9C, 0x92, 0xFF, 0xD5,
+	0x38, 0x6A, 0x83, 0xBF, 0xF3, 0x20, 0xA7, 0x51,
+	0xB3, 0xB4, 0x04, 0x6C, 0x85, 0x0C, 0x6B, 0x8B,
+	0x34, 0x46, 0xEF, 0x30, 0x62, 0x69, 0x21, 0xEE,
+	0x6E, 0x6C, 0x00, 0xA4, 0xD4, 0xB4, 0x74, 0x38,
+	0x0C, 0xDA, 0xB5, 0x04, 0x7C, 0x58, 0xC4, 0x6F,
+	0xC5, 0xA5, 0x60, 0xC3, 0xCA, 0xAD, 0xAF, 0x83,
+	0x41, 0x6F, 0xDC, 0x48, 0xD1, 0x76, 0x4A, 0xD5,
+	0x05, 0x2F, 0x42, 0xFC, 0x25, 0xC8, 0x84, 0x6E,
+	0xC2, 0x70, 0xF7, 0x5A, 0x1A, 0x63, 0xE1, 0xE6,
+	0xE8, 0x1F, 0xF4, 0x42, 0xB0, 0x79, 0x02, 0x6E,
+	0x5C, 0x7F, 0x1F, 0xD7, 0xA6, 0x71, 0xC7, 0x6E,
+	0x48, 0x01, 0x40, 0x4A, 0xF0, 0x3F, 0xF3, 0xB5,
+	0x0D, 0x51, 0x72, 0x8A, 0x66, 0xAC, 0x50, 0xA6,
+	0x44, 0x27, 0x33, 0xDA, 0x9D, 0x47, 0xA7, 0xC0,
+	0x1E, 0x30, 0x3F, 0xA3, 0x68, 0x41, 0xE1, 0x62,
+	0x09, 0xE4, 0x03, 0xA5, 0x8F, 0xA0, 0xD8, 0xB3,
+	0x4D, 0x36, 0x7D, 0x14, 0xD6, 0x0C, 0x08, 0x79,
+	0x2F, 0xE3, 0x64, 0xA8, 0xC5, 0x50, 0xE4, 0xB6,
+	0xBB, 0x61, 0x75, 0x85, 0xE8, 0x63, 0x0D, 0x11,
+	0xFA, 0x5A, 0x30, 0xD9, 0x9B, 0x54, 0x0C, 0xF4,
+	0x56, 0x76, 0xC8, 0x60, 0xE0, 0x45, 0x74, 0x35,
+	0x89, 0x3D, 0xEB, 0xE8, 0x25, 0x96, 0x8B, 0x73,
+	0x6B, 0x85, 0x8A, 0x85, 0x46, 0x41, 0x87, 0x1D,
+	0x40, 0x74, 0x66, 0x58, 0x73, 0x00,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0x9C, 0xFC, 0x60, 0xD0, 0xFC, 0xB4, 0x74, 0x61,
+	0xAF, 0x42, 0x3E, 0xCE, 0xB8, 0x0D, 0xFA, 0xCD,
+	0x34, 0x6B, 0xD6, 0xE7, 0x40, 0xFE, 0x36, 0x9D,
+	0xC9, 0xDF, 0x1B, 0x6F, 0x8A, 0x0C, 0xC9, 0x61,
+	0xB1, 0x22, 0x4B, 0xDE, 0x3C, 0x05, 0xBF, 0xD2,
+	0x18, 0xB0, 0x18, 0x7A, 0xC0, 0x24, 0x64, 0xA0,
+	0xFA, 0xCF, 0x0D, 0x8B, 0x1D, 0xDB, 0xE3, 0x75,
+	0xD0, 0xFB, 0x11, 0xFC, 0xB9, 0x44, 0x86, 0x52,
+	0xE3, 0xFD, 0x0B, 0x81, 0x21, 0xA2, 0xC7, 0x61,
+	0x09, 0x41, 0xA9, 0x95, 0x8B, 0xE9, 0x75, 0x27,
+	0x0F, 0x3D, 0x81, 0x08, 0x2F, 0x63, 0xCA, 0x73,
+	0x02, 0x5A, 0xB0, 0xB9, 0xFD, 0x8A, 0x13, 0xE8,
+	0xDC, 0xE1, 0x98, 0xA2, 0xF6, 0x3B, 0x58, 0x4F,
+	0xC0, 0xC3, 0xA0, 0x00, 0x36, 0xC6, 0x77, 0x9A,
+	0xEC, 0xFE, 0x3B, 0xE1, 0xB8, 0xFC, 0x92, 0x0B,
+	0xF0, 0xD3, 0x14, 0x97, 0xF1, 0x3D, 0x95, 0xF5,
+	0xEA, 0xDC, 0x3C, 0xFF, 0x7B, 0x2E, 0x0C, 0x8B,
+	0x58, 0x9C, 0xA5, 0x28, 0x09, 0x14, 0x3A, 0x53,
+	0x80, 0xDA, 0x9C, 0x46, 0x72, 0xE6, 0xB9, 0x6A,
+	0x96, 0xE5, 0xB7, 0xB3, 0xDE, 0x24, 0x28, 0x01,
+	0x3D, 0xB0, 0x3A, 0x2C, 0xFB, 0x3D, 0x22, 0xD9,
+	0x1E, 0xA5, 0xA3, 0xE3, 0x5E, 0x8D, 0x51, 0xDA,
+	0xBA, 0xE1, 0x9F, 0xA2, 0x7F, 0x56, 0xDF, 0x8F,
+	0xA5, 0xE9, 0x86, 0x11, 0xED, 0xDA, 0x6B, 0xD6,
+	0xDF, 0x22, 0x91, 0x5B, 0xD3, 0x12, 0xF8, 0x63,
+	0xDE, 0x77, 0x5E, 0x23, 0x12, 0x99, 0x2C, 0xC9,
+	0x3A, 0x79, 0xFE, 0xD2, 0xE3, 0xFB, 0x1E, 0x5E,
+	0x1D, 0x57, 0x07, 0xE5, 0xBF, 0x54, 0x50, 0xB8,
+	0x30, 0x66, 0xB5, 0xAB, 0xF9, 0x9B, 0x0D, 0xE3,
+	0x36, 0x8E, 0x7E, 0x76, 0xEB, 0xA3, 0x8B, 0xDD,
+	0x16, 0x5F, 0x41, 0x0D, 0xBD, 0xF2, 0x55,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xA8, 0xFC, 0xDC, 0x9E,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xCC, 0xFC, 0x60, 0xD0, 0xFC, 0xB4, 0x64, 0x61,
+	0xAF, 0x42, 0x3E, 0xCE, 0x38, 0x21, 0xFA, 0xCD,
+	0x14, 0x40, 0x96, 0xF3, 0x00, 0xAE, 0x26, 0x9D,
+	0x8B, 0xFF, 0xA0, 0xE6, 0x8A, 0x0E, 0xC9, 0xA9,
+	0xB1, 0x22, 0x4B, 0x86, 0x3C, 0x05, 0x3E, 0xD2,
+	0x18, 0xB0, 0x3A, 0x7A, 0x00, 0xEC, 0x6E, 0x66,
+	0x8D, 0xE6, 0x99, 0xAC, 0x2E, 0x45, 0xAF, 0xF3,
+	0x8E, 0xA3, 0x16, 0x69, 0x1A, 0x04, 0x2A, 0x73,
+	0xB6, 0xCB, 0x88, 0xB9, 0x67, 0xC6, 0xD6, 0x61,
+	0x55, 0xCF, 0x33, 0x66, 0x2F, 0x39, 0x74, 0x3E,
+	0xFD, 0xF0, 0x11, 0x12, 0x34, 0xE2, 0x12, 0xEC,
+	0x45, 0x4D, 0x33, 0x2C, 0xA5, 0x73, 0xA1, 0xF5,
+	0x6A, 0x8D, 0xAB, 0x3E, 0xF0, 0x1F, 0x1F, 0x49,
+	0x21, 0x6D, 0xD3, 0xE0, 0x48, 0x73, 0x33, 0xD0,
+	0xE8, 0x0E, 0xA5, 0x85, 0xC1, 0xB7, 0x09, 0x62,
+	0xAC, 0x3D, 0x6F, 0xF5, 0xC2, 0x8A, 0x87, 0x8E,
+	0xEE, 0x62, 0x3D, 0x03, 0xFC, 0x3F, 0xFE, 0x13,
+	0x66, 0x0C, 0xFB, 0x18, 0xC1, 0x96, 0xBA, 0xB5,
+	0x8E, 0xC5, 0xB2, 0xE7, 0x49, 0x37, 0xE9, 0x74,
+	0x1A, 0xEB, 0xAB, 0x8C, 0xEB, 0x74, 0xE6, 0x88,
+	0xA2, 0x47, 0x87, 0xB0, 0x82, 0xAD, 0x18, 0xB2,
+	0xF3, 0x8C, 0xCE, 0x13, 0x4F, 0xFE, 0x0F, 0x37,
+	0xA9, 0xC3, 0xD5, 0xB4, 0x3E, 0xED, 0xDC, 0x8C,
+	0x6F, 0xBA, 0x47, 0x22, 0x0E, 0x11, 0x89, 0xB2,
+	0x6D, 0x89, 0x27, 0xC4, 0xFF, 0xCC, 0x81, 0x72,
+	0x90, 0xAD, 0x42, 0x58, 0xC9, 0x50, 0x62, 0xC2,
+	0x96, 0xC7, 0xC9, 0x10, 0x0A, 0x33, 0x17, 0x5E,
+	0x64, 0xF7, 0x96, 0xD3, 0x37, 0x39, 0xB6, 0x90,
+	0x0B, 0xF4, 0xE3, 0x0B, 0x8F, 0x85, 0x96, 0x62,
+	0x91, 0x82, 0x26, 0xE0, 0x2F, 0x68, 0x2F, 0x02,
+	0x0C, 0x04, 0x15, 0x70, 0xCF, 0x5A, 0xFF, 0x05,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xE8, 0x30, 0xC0, 0x9E,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xCC, 0xFC, 0x60, 0xD0, 0xFC, 0xB4, 0x64, 0x61,
+	0x2F, 0x4A, 0x3E, 0xCE, 0x38, 0x21, 0xFA, 0xCB,
+	0x54, 0x80, 0x96, 0x30, 0x00, 0x56, 0xEB, 0x54,
+	0x8B, 0x7F, 0x85, 0x6D, 0x12, 0x0C, 0xA9, 0xA9,
+	0x7D, 0x23, 0x68, 0xB9, 0xAB, 0x05, 0x3E, 0xD2,
+	0x18, 0xB0, 0x3A, 0x7A, 0x18, 0xEC, 0x7E, 0x4A,
+	0x93, 0xE8, 0xF0, 0xC6, 0xD9, 0x66, 0xC6, 0xF4,
+	0xA4, 0x33, 0xEE, 0xCB, 0x67, 0x65, 0x91, 0xA2,
+	0xD0, 0xA1, 0x11, 0x5A, 0x77, 0x33, 0x17, 0x49,
+	0x11, 0xD4, 0x88, 0xEF, 0x9A, 0x49, 0xF4, 0x43,
+	0x5D, 0xCC, 0xD1, 0x43, 0xA3, 0xCF, 0x71, 0x5C,
+	0xF3, 0x02, 0x2B, 0x97, 0xF6, 0x1C, 0xD6, 0x62,
+	0x5B, 0xF4, 0x81, 0xD9, 0xBF, 0x2A, 0xE6, 0xF9,
+	0x31, 0x96, 0x38, 0xD7, 0x44, 0x45, 0x6A, 0x4D,
+	0x55, 0x6A, 0x0A, 0x43, 0x16, 0x07, 0xDF, 0x02,
+	0x61, 0x83, 0x00, 0xD1, 0x9E, 0x5B, 0xE4, 0x8E,
+	0x35, 0x60, 0x17, 0x7E, 0xAE, 0x9B, 0x9E, 0x15,
+	0xBF, 0xC5, 0x9C, 0x6E, 0x49, 0xA6, 0x01, 0x0E,
+	0xEA, 0xCA, 0xE4, 0x4B, 0xF1, 0xBE, 0xD4, 0x05,
+	0x66, 0x75, 0x6B, 0xD6, 0x1F, 0x1E, 0x6E, 0xB2,
+	0xDE, 0x80, 0xCF, 0x2F, 0x83, 0x10, 0xA5, 0x1B,
+	0xE9, 0x9F, 0x4F, 0xF6, 0x80, 0xDC, 0x7A, 0x7F,
+	0xD7, 0x18, 0x21, 0x1D, 0x3A, 0x3D, 0x93, 0x00,
+	0xC0, 0x83, 0xC5, 0xAE, 0x58, 0xE5, 0x7E, 0x88,
+	0xF5, 0x6B, 0xED, 0xE6, 0xAD, 0xE8, 0xC5, 0xAB,
+	0x92, 0x57, 0x33, 0x74, 0xDE, 0x1E, 0x66, 0xD1,
+	0x72, 0x3A, 0x69, 0xEC, 0xEF, 0xA1, 0xFF, 0x69,
+	0x9C, 0x98, 0xAA, 0x90, 0xFB, 0x5E, 0xEE, 0x41,
+	0xC5, 0x0A, 0x04, 0x50, 0x09, 0x91, 0x93, 0xA9,
+	0xD9, 0x26, 0xCA, 0xDE, 0x53, 0x94, 0xFF, 0x7B,
+	0xC1, 0x96, 0x9C, 0x75, 0xD3, 0xB0, 0xB7, 0x0E,
+	0xC7,
+
+	0x43, 0xD9, 0x96, 0xF3, 0xE8, 0x30, 0xC4, 0x93,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xCC, 0xFC, 0x60, 0xD0, 0xFC, 0xB4, 0x64, 0x61,
+	0x29, 0x4A, 0x2E, 0xCE, 0x38, 0x21, 0xFA, 0x4B,
+	0x74, 0x80, 0x96, 0x38, 0xB4, 0x56, 0xEF, 0x84,
+	0x8B, 0x7F, 0x85, 0x6E, 0x1B, 0x2C, 0xA9, 0x61,
+	0x7D, 0xF3, 0x68, 0xF9, 0xC8, 0x1A, 0xBE, 0xD2,
+	0x19, 0xB0, 0x3A, 0x7A, 0x18, 0xEC, 0x7E, 0xEA,
+	0x88, 0xA3, 0x32, 0x1A, 0xAD, 0x26, 0x6F, 0x93,
+	0xB5, 0xF6, 0x46, 0x5C, 0x79, 0x2F, 0x9D, 0xB4,
+	0x5B, 0xD8, 0x27, 0x53, 0x8A, 0x03, 0x99, 0x4E,
+	0x15, 0x94, 0xFE, 0xF6, 0x37, 0x34, 0x84, 0x01,
+	0xA4, 0x40, 0xE6, 0x51, 0xA0, 0xF9, 0x39, 0x7E,
+	0x78, 0x9F, 0x41, 0x10, 0x57, 0xAE, 0x81, 0x84,
+	0xE4, 0xFE, 0x43, 0xED, 0xC2, 0x19, 0x5B, 0x08,
+	0x1D, 0x44, 0x78, 0xDD, 0x4F, 0x91, 0x62, 0x27,
+	0xBB, 0x94, 0x66, 0x6B, 0x2F, 0x7F, 0x77, 0x1B,
+	0x57, 0xF5, 0x29, 0x0C, 0xB6, 0xE0, 0xE8, 0x94,
+	0x73, 0xEF, 0xF5, 0x35, 0x40, 0xBB, 0xD4, 0x8F,
+	0x49, 0x70, 0x13, 0xAA, 0x7E, 0xC2, 0xAD, 0x72,
+	0x17, 0x97, 0x45, 0x24, 0xFE, 0x23, 0xAE, 0xDF,
+	0xE0, 0x6D, 0x54, 0x05, 0xD8, 0x13, 0xD0, 0x90,
+	0x56, 0x36, 0x09, 0x7C, 0x2F, 0x34, 0xC6, 0x2C,
+	0x8C, 0x7E, 0x58, 0x4B, 0xDE, 0x4E, 0xA2, 0x04,
+	0x4B, 0x58, 0x58, 0x19, 0x07, 0xBA, 0x72, 0xBB,
+	0xFD, 0x60, 0xAE, 0x9E, 0xD2, 0x8D, 0x40, 0xE8,
+	0xBB, 0xEC, 0x10, 0x91, 0x71, 0x6B, 0x37, 0xCD,
+	0xC8, 0x0E, 0xBD, 0xAE, 0x20, 0xC4, 0xF9, 0x2D,
+	0xB5, 0xC8, 0xA1, 0x1B, 0x20, 0x1A, 0x87, 0x98,
+	0x55, 0xF2, 0x99, 0x1F, 0x41, 0x3F, 0x46, 0x55,
+	0x48, 0x5E, 0x16, 0x8B, 0x80, 0xFA, 0x05, 0xCE,
+	0x11, 0xEB, 0x54, 0xE9, 0x8A, 0x0B, 0x09, 0x27,
+	0xF7, 0x3E, 0xE7, 0xEF, 0x81, 0x2C, 0x0D, 0x2B,
+	0x44, 0xF9,
+
+	0x7E, 0xD9, 0x96, 0xF3, 0xE8, 0x30, 0xC4, 0xFF,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xCC, 0xFC, 0x60, 0xD0, 0xFC, 0xB4, 0x64, 0x61,
+	0x89, 0x40, 0x2E, 0xC4, 0x38, 0x21, 0xFA, 0x4B,
+	0xA0, 0xB3, 0x96, 0x38, 0x88, 0xB3, 0xEF, 0x64,
+	0xC3, 0x65, 0x85, 0xCE, 0x50, 0x64, 0x81, 0x68,
+	0x7D, 0xF3, 0xBC, 0xF0, 0x88, 0x4D, 0xA5, 0x92,
+	0x91, 0xB1, 0x3A, 0x7A, 0x18, 0x6C, 0x46, 0xEA,
+	0xBE, 0xE2, 0xE0, 0x99, 0x28, 0xC4, 0x99, 0xC1,
+	0xD7, 0xFF, 0x5F, 0xD1, 0x0D, 0x2B, 0x2F, 0x65,
+	0xB7, 0x03, 0x38, 0xFA, 0x67, 0x63, 0xFC, 0x86,
+	0x0F, 0x5C, 0xAC, 0x3A, 0x7D, 0x18, 0x29, 0xC3,
+	0x67, 0x6C, 0x31, 0xC1, 0xB9, 0x4B, 0x29, 0xE5,
+	0x32, 0xB4, 0x02, 0xC4, 0x2E, 0x8E, 0x65, 0x2D,
+	0x34, 0x14, 0xDF, 0x14, 0xCD, 0x95, 0xB1, 0x7C,
+	0x3E, 0x68, 0xA1, 0x81, 0x9C, 0xB1, 0x93, 0x62,
+	0x9D, 0xA5, 0xB8, 0x61, 0x70, 0x43, 0x97, 0x77,
+	0xC3, 0xE3, 0xF0, 0x62, 0x7B, 0x9D, 0x9D, 0x3D,
+	0x0E, 0x97, 0x47, 0x88, 0x15, 0x6E, 0x78, 0xF1,
+	0xDC, 0x38, 0x25, 0x80, 0x63, 0xDD, 0x8D, 0x7C,
+	0xEA, 0x43, 0x27, 0x1C, 0x32, 0xF5, 0xA4, 0x1C,
+	0x2F, 0xA6, 0xA8, 0xA1, 0x93, 0xDC, 0x61, 0xCB,
+	0x48, 0xDE, 0x18, 0x75, 0xAD, 0x88, 0xB3, 0x12,
+	0x8A, 0xEA, 0x48, 0xE0, 0x83, 0x2A, 0x30, 0x6E,
+	0x16, 0x9A, 0x63, 0x5A, 0x2B, 0xF1, 0x25, 0xD0,
+	0xB5, 0x20, 0xFD, 0xD2, 0xD6, 0xA5, 0xCA, 0xEA,
+	0xA1, 0xD5, 0xBC, 0xD0, 0xB7, 0x91, 0x09, 0x06,
+	0x67, 0xE1, 0x7B, 0x58, 0xC0, 0x32, 0x69, 0xB0,
+	0x0B, 0xCD, 0x2D, 0x9F, 0xB9, 0x20, 0x45, 0xEC,
+	0x4B, 0x22, 0xF6, 0xE7, 0x12, 0xB0, 0x43, 0x64,
+	0x92, 0xE4, 0x54, 0xA5, 0xA1, 0x69, 0x83, 0x8B,
+	0xC5, 0x7E, 0xF1, 0x01, 0x0E, 0x9E, 0xB3, 0xB9,
+	0x95, 0x8D, 0x43, 0xD6, 0x96, 0x54, 0xE2, 0x33,
+	0x54, 0x1B, 0xB2,
+
+	0xFA, 0xF4, 0x96, 0xF3, 0xE8, 0x30, 0xC4, 0xFF,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xCC, 0xFC, 0x60, 0xD0, 0xFC, 0xB4, 0x64, 0x61,
+	0x89, 0x00, 0x3E, 0xC4, 0x61, 0x21, 0xFA, 0x4B,
+	0x86, 0x6B, 0xBC, 0xB7, 0x08, 0xBA, 0xC9, 0x64,
+	0x8A, 0x67, 0x82, 0xCE, 0xF0, 0x0D, 0x4A, 0xF8,
+	0x7D, 0xF3, 0xBC, 0x28, 0xAA, 0x2D, 0xCE, 0x46,
+	0x91, 0x31, 0xB3, 0x7B, 0x18, 0x6C, 0x46, 0xB1,
+	0x22, 0x56, 0xF9, 0x12, 0x8A, 0x67, 0xB9, 0x60,
+	0x02, 0x03, 0x59, 0x3E, 0xBF, 0x11, 0xAC, 0x2F,
+	0xE8, 0x4B, 0x61, 0x72, 0xDD, 0x01, 0x6B, 0x3E,
+	0xA2, 0x2C, 0xFB, 0x47, 0x9D, 0x45, 0xA6, 0x54,
+	0x41, 0x04, 0x62, 0x64, 0xE6, 0x15, 0xBC, 0x16,
+	0x23, 0xEC, 0x5D, 0x2A, 0x0F, 0xAD, 0x18, 0x5C,
+	0x06, 0xDA, 0xFC, 0x93, 0xE6, 0x24, 0x20, 0x2B,
+	0xD3, 0x0F, 0x43, 0x7C, 0xA3, 0x95, 0x99, 0x8B,
+	0xED, 0xD1, 0xD2, 0x25, 0x63, 0x4C, 0xD3, 0x1A,
+	0xA9, 0x49, 0xA4, 0x22, 0x47, 0xE9, 0x40, 0x5D,
+	0xD1, 0x58, 0x59, 0xAF, 0x44, 0xE6, 0xB2, 0x33,
+	0xBA, 0x64, 0x2D, 0x4F, 0x92, 0x74, 0x98, 0x89,
+	0x3B, 0xFD, 0xB9, 0x97, 0x80, 0xF2, 0x86, 0x95,
+	0x27, 0x03, 0x7B, 0x0F, 0x6C, 0x6A, 0x0A, 0x35,
+	0xBE, 0x4B, 0x13, 0x9D, 0xD5, 0xF4, 0xDA, 0xC8,
+	0x8A, 0xEE, 0x41, 0x7E, 0xE6, 0xD8, 0x14, 0xEF,
+	0xDF, 0x8E, 0x70, 0x15, 0x85, 0x4B, 0xC2, 0x09,
+	0x77, 0x58, 0xBE, 0x59, 0xF0, 0xA5, 0x08, 0xCB,
+	0xB3, 0xAF, 0x98, 0x0B, 0x4E, 0x4B, 0x33, 0x10,
+	0x1B, 0x99, 0x6A, 0x0E, 0x0F, 0x4B, 0x96, 0xC1,
+	0xBA, 0xC1, 0x4F, 0x96, 0x83, 0x29, 0xB3, 0x35,
+	0x42, 0xD9, 0x07, 0xED, 0xB7, 0x92, 0x1F, 0x6E,
+	0xAF, 0x87, 0xF4, 0x20, 0x52, 0xF6, 0xBD, 0x0F,
+	0xE8, 0x8F, 0x03, 0xBD, 0xB9, 0xC0, 0x31, 0xB7,
+	0xE4, 0x46, 0x62, 0xD5, 0x2D, 0x35, 0x3C, 0x76,
+	0xFB, 0x66, 0xE3, 0x5D,
+
+	0xFA, 0x34, 0x8B, 0xF3, 0xE8, 0x30, 0xC4, 0xFF,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xCC, 0xFC, 0x60, 0xD0, 0xFC, 0xB4, 0x64, 0x61,
+	0x89, 0x00, 0x7E, 0xCE, 0xE1, 0x11, 0xFA, 0x4B,
+	0x87, 0x1B, 0x61, 0x76, 0x4B, 0x3A, 0xDC, 0x0B,
+	0x82, 0x64, 0xE6, 0xC2, 0xF0, 0x4D, 0x7E, 0x7E,
+	0x6F, 0x63, 0xBD, 0x28, 0x76, 0x64, 0xBE, 0x31,
+	0x90, 0x31, 0x13, 0x23, 0x18, 0x6C, 0x46, 0x31,
+	0xB3, 0x40, 0x01, 0x8F, 0x9D, 0xFA, 0xE2, 0xF7,
+	0x67, 0xD8, 0x51, 0x6F, 0x6E, 0x30, 0x6E, 0xBF,
+	0xBF, 0x70, 0x08, 0x56, 0x5C, 0x59, 0xB1, 0x61,
+	0x3F, 0x4E, 0xBD, 0x11, 0x93, 0xB5, 0xF3, 0xAC,
+	0x71, 0xA8, 0xC3, 0xEA, 0x6C, 0x28, 0x82, 0x2F,
+	0x88, 0x91, 0xE4, 0xEC, 0xB2, 0x2C, 0x0A, 0x70,
+	0x35, 0x2D, 0x31, 0x00, 0x45, 0xD7, 0x34, 0x64,
+	0x14, 0x82, 0x1F, 0x2E, 0x52, 0x1E, 0x21, 0x80,
+	0x5D, 0xC4, 0x75, 0xB2, 0xED, 0x10, 0x2D, 0x32,
+	0xCA, 0xE4, 0x1F, 0x5D, 0x25, 0xFD, 0x4E, 0x4F,
+	0xB8, 0x1F, 0x4E, 0xD3, 0x1D, 0xB5, 0xE1, 0x01,
+	0x01, 0xC2, 0x4D, 0x40, 0x53, 0xB4, 0xC3, 0x4E,
+	0xA4, 0x78, 0x7C, 0xE5, 0xD1, 0xA4, 0x32, 0xEB,
+	0x23, 0xD1, 0xA7, 0x8A, 0x94, 0xE1, 0x6D, 0xF6,
+	0x2D, 0x79, 0xAC, 0x47, 0x5D, 0xBA, 0xBD, 0x0F,
+	0xDC, 0xA0, 0x26, 0xB0, 0x26, 0x27, 0x63, 0x2A,
+	0x0E, 0x6E, 0x58, 0x89, 0x62, 0x27, 0x32, 0x64,
+	0x85, 0x5E, 0x7B, 0x29, 0x29, 0x93, 0x3A, 0x88,
+	0x60, 0x98, 0x04, 0xD2, 0x81, 0xE6, 0xFF, 0x42,
+	0x59, 0x91, 0xD6, 0x30, 0xA1, 0x64, 0x1E, 0xBF,
+	0x64, 0x31, 0x4A, 0x24, 0x86, 0xDE, 0xF3, 0xC9,
+	0xC6, 0xE6, 0xC2, 0x46, 0x32, 0xEF, 0x50, 0xAF,
+	0x93, 0x32, 0xA0, 0x2F, 0x28, 0x6C, 0xFF, 0x82,
+	0x71, 0xD6, 0xB0, 0x4F, 0x4E, 0x4A, 0xD4, 0x61,
+	0xA1, 0xB9, 0x0F, 0x20, 0x30, 0x50, 0xEA, 0xF6,
+	0x45, 0x6D, 0x3C, 0x57, 0xDD,
+
+	0xFA, 0x34, 0x8D, 0xFD, 0xE8, 0x30, 0xC4, 0xFF,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xCC, 0xFC, 0x60, 0xD0, 0xFC, 0xB4, 0x64, 0x61,
+	0x89, 0x00, 0x7E, 0xCE, 0xE4, 0x11, 0xF2, 0x4B,
+	0x97, 0x1B, 0x79, 0x96, 0xAB, 0xBA, 0xDC, 0x03,
+	0x92, 0xA4, 0xFF, 0xA2, 0xF1, 0x4D, 0x7E, 0xFF,
+	0xEC, 0x48, 0xBD, 0x28, 0x76, 0x84, 0x9E, 0xB1,
+	0x90, 0x31, 0x13, 0xE3, 0x48, 0x6C, 0x46, 0x31,
+	0x22, 0xF0, 0x2C, 0x32, 0x94, 0xE3, 0xA2, 0x1C,
+	0xCB, 0x90, 0xE5, 0x5A, 0x7E, 0x5B, 0xEF, 0xD4,
+	0x70, 0xCB, 0x47, 0x39, 0xB3, 0x73, 0x4D, 0xA5,
+	0xF9, 0x27, 0x35, 0xF3, 0x90, 0x95, 0x83, 0xDA,
+	0x12, 0x35, 0x43, 0x92, 0xE8, 0xE9, 0xAD, 0xC5,
+	0xB5, 0x4E, 0x15, 0x3D, 0xA2, 0x24, 0xC5, 0x9E,
+	0x79, 0x90, 0x13, 0x80, 0x55, 0x48, 0x1D, 0xC3,
+	0xC9, 0x04, 0xF9, 0x25, 0xF6, 0xBB, 0x58, 0x81,
+	0xB9, 0x95, 0x1D, 0x3C, 0x7B, 0x91, 0x23, 0x4C,
+	0x8B, 0xAE, 0x95, 0xCA, 0xDC, 0x9D, 0xCE, 0x54,
+	0xE6, 0x6D, 0x1C, 0xD4, 0x43, 0xFF, 0xE0, 0x03,
+	0x24, 0x69, 0x47, 0x93, 0xDF, 0x84, 0xF5, 0x98,
+	0x8B, 0x1F, 0x26, 0x04, 0x37, 0x97, 0x93, 0xDC,
+	0x25, 0xA6, 0x15, 0xF1, 0x85, 0xE4, 0x24, 0xB1,
+	0x7B, 0x94, 0x5B, 0x69, 0x75, 0x36, 0xE0, 0x8D,
+	0x32, 0xE2, 0x1C, 0x08, 0x77, 0xCC, 0xE4, 0x45,
+	0xBA, 0x8A, 0x05, 0xE7, 0xAD, 0x3A, 0x32, 0xA6,
+	0x30, 0x81, 0x25, 0xE4, 0x88, 0x88, 0x60, 0xF7,
+	0x62, 0xA1, 0x78, 0x7E, 0xFE, 0x19, 0x55, 0xDB,
+	0x19, 0x6C, 0x98, 0xC5, 0x35, 0x94, 0xCA, 0x42,
+	0xDE, 0x0A, 0x23, 0xEE, 0x30, 0x26, 0xEF, 0x72,
+	0xB2, 0xFA, 0x7B, 0x75, 0x11, 0x60, 0x55, 0x97,
+	0xC2, 0x0C, 0x55, 0xC1, 0x29, 0x1E, 0x69, 0x18,
+	0x88, 0xE6, 0xE6, 0x2E, 0xC0, 0xF0, 0x74, 0x25,
+	0xCD, 0x5E, 0x12, 0xC9, 0xDE, 0x4A, 0x92, 0x16,
+	0x36, 0xFA, 0x8E, 0x55, 0x6F, 0x83,
+
+	0xFA, 0x34, 0x8D, 0xB3, 0xD6, 0x30, 0xC4, 0xFF,
+	0xB4, 0x4C, 0x4E, 0x98, 0x7D, 0xB4, 0x32, 0x4C,
+	0xCC, 0xFC, 0x60, 0xD0, 0xFC, 0xB4, 0x64, 0x61,
+	0x89, 0x00, 0x7E, 0xCE, 0xE4, 0x01, 0x72, 0x1F,
+	0xB3, 0xA2, 0x7B, 0xCF, 0x4F, 0xFD, 0xDF, 0x03,
+	0x1C, 0x21, 0xB6, 0xE0, 0x7A, 0x44, 0x7E, 0x27,
+	0x1C, 0xD7, 0x90, 0x28, 0x74, 0x84, 0x7A, 0xF0,
+	0x90, 0x31, 0x0B, 0xE3, 0x40, 0x2F, 0x46, 0x31,
+	0xBF, 0xC9, 0x30, 0xE5, 0xF6, 0x7C, 0xAD, 0xF5,
+	0x59, 0x6C, 0x59, 0x82, 0xA6, 0x4C, 0xB3, 0x2C,
+	0xA2, 0xD4, 0xAE, 0xC0, 0xAF, 0x61, 0xD6, 0xDC,
+	0x4E, 0xBB, 0xF6, 0xEB, 0x20, 0x10, 0x3D, 0xFF,
+	0x88, 0xAF, 0x36, 0x62, 0xDC, 0xFB, 0xFF, 0xD6,
+	0xB0, 0x73, 0x39, 0xCC, 0x56, 0x0A, 0x2C, 0x84,
+	0x8B, 0x78, 0x40, 0xA8, 0xDA, 0xCA, 0x82, 0x25,
+	0x17, 0xD7, 0x7C, 0xD9, 0x56, 0xB7, 0xC3, 0x9E,
+	0x60, 0xD6, 0xCF, 0x81, 0xC8, 0x8B, 0x08, 0xA6,
+	0xCF, 0x50, 0x64, 0x09, 0xA4, 0x50, 0x94, 0x5B,
+	0x5B, 0x6C, 0x89, 0x20, 0xD4, 0xD6, 0xD6, 0x3D,
+	0x8D, 0x83, 0xB7, 0xEE, 0x5E, 0x83, 0x5C, 0x31,
+	0x64, 0x7B, 0x17, 0x11, 0x13, 0x87, 0x13, 0xB8,
+	0x2D, 0x29, 0x35, 0x78, 0xCC, 0x97, 0x8E, 0xBA,
+	0xFB, 0x74, 0x6F, 0xCD, 0x73, 0x0E, 0x85, 0xBB,
+	0x7C, 0xF8, 0x16, 0x1A, 0xAB, 0x68, 0x02, 0x03,
+	0x9A, 0x1C, 0x72, 0xF5, 0x2A, 0x72, 0x8D, 0x82,
+	0x35, 0x7E, 0xA7, 0x97, 0x57, 0x40, 0x01, 0xB9,
+	0xE0, 0xCE, 0xD0, 0x38, 0x40, 0x39, 0xA5, 0xED,
+	0xCE, 0x13, 0xC0, 0x21, 0x1B, 0x19, 0x69, 0xA9,
+	0x7A, 0xFD, 0x1B, 0xCD, 0x67, 0x2F, 0xDB, 0x3A,
+	0x49, 0x99, 0x6E, 0x94, 0x8B, 0x43, 0xC8, 0xCC,
+	0x3D, 0xDF, 0x18, 0xF9, 0xD5, 0xF8, 0x75, 0x79,
+	0x88, 0x29, 0xF1, 0x81, 0xA4, 0x86, 0x4B, 0xB1,
+	0x37, 0x40, 0xB7, 0x13, 0x46, 0x68, 0x1A, 0x56,
+	0xD9, 0x48, 0x34, 0x64, 0xE2, 0x91, 0x21,
+}
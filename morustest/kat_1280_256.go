@@ -1,11 +1,11 @@
-// morus_1280_256_kat_test.go - MORUS-1280-256 Known Answer Test results
+// kat_1280_256.go - MORUS-1280-256 Known Answer Test results
 //
 // To the extent possible under law, Yawning Angel has waived all copyright
 // and related or neighboring rights to the software, using the Creative
 // Commons "CC0" public domain dedication. See LICENSE or
 // <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
 
-package morus
+package morustest
 
 var kat1280256 = []byte{
 	0xE4, 0x21, 0xF5, 0xB9, 0xC5, 0x0B, 0x14, 0x91,
@@ -0,0 +1,4973 @@
+// kat_640_128.go - MORUS-640-128 regression test vectors
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morustest
+
+// kat640128 was generated by this package, and has not been checked against
+// any reference code, so it is a self-generated regression vector rather
+// than a known answer test.
+var kat640128 = []byte{
+	0x9C, 0x49, 0xE4, 0x3E, 0xC2, 0x24, 0x05, 0xB9,
+	0x72, 0x57, 0xF9, 0x4B, 0xDE, 0xFF, 0xB5, 0xA7,
+
+	0x82, 0xA7, 0xB9, 0x21, 0x26, 0x28, 0x4E, 0xE0,
+	0xFA, 0x5F, 0x18, 0x28, 0xD6, 0x1A, 0x16, 0xE7,
+	0x66,
+
+	0x8A, 0x97, 0xC5, 0xFE, 0x4B, 0x89, 0xF5, 0xF0,
+	0xDF, 0x63, 0x2A, 0x8E, 0x24, 0xA0, 0xCB, 0x2F,
+	0xCC, 0x24,
+
+	0x2A, 0xB7, 0x80, 0xFA, 0x18, 0x6B, 0x68, 0x52,
+	0x13, 0xE0, 0xC1, 0x10, 0x31, 0xA8, 0x2D, 0x1C,
+	0xC5, 0xEA, 0x81,
+
+	0x2A, 0xB7, 0x90, 0x32, 0x1B, 0x1A, 0x35, 0xA3,
+	0x75, 0x55, 0x54, 0x10, 0xDC, 0x4D, 0x96, 0xFD,
+	0xFC, 0xE9, 0x53, 0x72,
+
+	0x2A, 0xB7, 0x90, 0x32, 0xE4, 0xA2, 0xBA, 0xBB,
+	0x52, 0x1E, 0xEC, 0x1F, 0xFE, 0xBF, 0xA5, 0x0E,
+	0xE8, 0xE6, 0x78, 0x2E, 0x2E,
+
+	0x2A, 0xB7, 0x90, 0x32, 0xFC, 0x07, 0x13, 0x80,
+	0x25, 0x42, 0xA7, 0xA5, 0x4B, 0x08, 0x24, 0x48,
+	0xBE, 0x13, 0x89, 0xD4, 0xC9, 0x7F,
+
+	0x2A, 0xB7, 0x90, 0x32, 0xFC, 0x07, 0x94, 0x77,
+	0xDA, 0x3A, 0xBA, 0xCF, 0x83, 0xE3, 0x19, 0x0D,
+	0x26, 0x79, 0x80, 0x6F, 0xE1, 0xEC, 0x10,
+
+	0x2A, 0xB7, 0x90, 0x32, 0xFC, 0xC7, 0x94, 0x1C,
+	0x66, 0xD7, 0xFD, 0xAC, 0x1A, 0x5D, 0x30, 0x22,
+	0x9B, 0x1F, 0x3C, 0xFB, 0xF5, 0xE6, 0x50, 0x01,
+
+	0x2A, 0xB7, 0x90, 0x32, 0xFC, 0xC7, 0x94, 0x1C,
+	0x9C, 0x34, 0xCA, 0x5F, 0x04, 0x3F, 0x34, 0xE8,
+	0x9B, 0x23, 0x0E, 0x4D, 0x6F, 0x83, 0x52, 0xE6,
+	0xAF,
+
+	0x2A, 0xB7, 0x90, 0x32, 0xFC, 0xC7, 0x94, 0x1C,
+	0x84, 0x4D, 0x1D, 0xB5, 0x01, 0x97, 0x9A, 0x3D,
+	0xC5, 0xD8, 0xF0, 0x8D, 0x62, 0xBC, 0x57, 0x2E,
+	0x37, 0xF5,
+
+	0x2A, 0xB7, 0x90, 0x32, 0xFC, 0xC7, 0x94, 0x1C,
+	0x64, 0x4C, 0xA9, 0xA6, 0xE3, 0xB6, 0x36, 0x66,
+	0x21, 0x1E, 0x1D, 0xE4, 0x81, 0xB2, 0x33, 0x55,
+	0x53, 0xD0, 0x14,
+
+	0x2A, 0xB7, 0x90, 0x32, 0xFC, 0xC7, 0x94, 0x1C,
+	0x64, 0x4C, 0x89, 0xF4, 0xCE, 0x4C, 0xBE, 0x9D,
+	0xBB, 0x7E, 0x48, 0xFF, 0xA9, 0x39, 0x28, 0x31,
+	0x27, 0xEE, 0x2E, 0xB1,
+
+	0xE9, 0xB5, 0x90, 0xB2, 0xFC, 0xC7, 0x94, 0x1C,
+	0x64, 0x4C, 0x89, 0xF4, 0x69, 0xBC, 0x33, 0x38,
+	0x5B, 0xE8, 0x83, 0x47, 0x1B, 0xC0, 0x93, 0xC7,
+	0x66, 0xCE, 0x6F, 0x17, 0xD9,
+
+	0xE9, 0x91, 0xB4, 0xB2, 0xFC, 0xC7, 0x94, 0x1C,
+	0x64, 0x4C, 0x89, 0xF4, 0x69, 0xFB, 0xE4, 0x76,
+	0x18, 0xA3, 0xE8, 0x61, 0x93, 0x80, 0xBE, 0xCD,
+	0xE6, 0xC4, 0x8D, 0x21, 0x26, 0xB0,
+
+	0xE9, 0x11, 0xB0, 0xB2, 0xFC, 0xC7, 0x94, 0x1C,
+	0x64, 0x4C, 0x89, 0xF4, 0x09, 0xFB, 0x2A, 0x5E,
+	0x8C, 0x0F, 0x08, 0x20, 0x4F, 0xED, 0x58, 0xFD,
+	0x1D, 0x10, 0xA0, 0x59, 0xF3, 0x1B, 0x79,
+
+	0xEC, 0x11, 0xB0, 0xD7, 0xFC, 0xC7, 0x94, 0x1C,
+	0x64, 0x4C, 0x89, 0xF4, 0x09, 0x3B, 0x0A, 0xD0,
+	0x60, 0xA9, 0x94, 0x23, 0x11, 0xFA, 0x25, 0x9F,
+	0x5B, 0xC0, 0x03, 0x0B, 0x33, 0x87, 0x70, 0x54,
+
+	0x38, 0x83, 0xA4, 0x3C, 0x84, 0xD7, 0x36, 0x62,
+	0xD1, 0x7A, 0x28, 0xDE, 0x91, 0x24, 0xF9, 0xEE,
+	0x1F, 0xC0, 0x2E, 0x59, 0xB1, 0x79, 0x81, 0x75,
+	0xE0, 0x02, 0x04, 0x10, 0x81, 0xFE, 0xBF, 0x13,
+	0xEB,
+
+	0x30, 0x83, 0xA4, 0x3C, 0x84, 0xF1, 0x30, 0x62,
+	0xD1, 0x7A, 0x28, 0xDE, 0x91, 0x24, 0xF9, 0xEE,
+	0x1F, 0x6F, 0xA5, 0xB0, 0x84, 0x59, 0xF5, 0x4C,
+	0x81, 0x5D, 0xF4, 0x18, 0x23, 0x20, 0xD4, 0xDD,
+	0x16, 0xB5,
+
+	0x10, 0x81, 0xA4, 0x3C, 0x84, 0x71, 0x36, 0x60,
+	0xD1, 0x7A, 0x28, 0xDE, 0x91, 0x24, 0xF9, 0xEE,
+	0x1F, 0x6F, 0x71, 0xBA, 0xCF, 0xC8, 0x80, 0xE8,
+	0x2A, 0x8D, 0xC2, 0x37, 0x9E, 0xCF, 0xBA, 0xAD,
+	0x28, 0x8C, 0x1A,
+
+	0x10, 0x81, 0x84, 0x3C, 0xA7, 0x71, 0x36, 0x07,
+	0xD1, 0x7A, 0x28, 0xDE, 0x91, 0x24, 0xF9, 0xEE,
+	0x3A, 0x66, 0x71, 0x99, 0x36, 0x3E, 0x67, 0x91,
+	0xE0, 0xDE, 0x06, 0x6D, 0x76, 0x6B, 0x65, 0xDE,
+	0x03, 0xE8, 0xB3, 0x23,
+
+	0x10, 0x81, 0x84, 0x3C, 0xA7, 0x71, 0x36, 0x15,
+	0x16, 0x79, 0x28, 0x5E, 0x91, 0x24, 0xF9, 0xEE,
+	0x1F, 0x46, 0x21, 0x3D, 0x74, 0xD8, 0xB2, 0xE8,
+	0xF5, 0x81, 0xAD, 0x55, 0x2A, 0xCA, 0xE5, 0xEE,
+	0xC8, 0xD2, 0x00, 0x3B, 0x31,
+
+	0x10, 0x81, 0x84, 0x3C, 0xAF, 0x71, 0x36, 0x15,
+	0x16, 0x51, 0x28, 0x5E, 0x91, 0x24, 0xF9, 0xEE,
+	0xDF, 0x51, 0xA1, 0x7D, 0x74, 0x4A, 0x90, 0xDB,
+	0x58, 0xD0, 0xC8, 0x62, 0x50, 0x37, 0x43, 0xA4,
+	0x5B, 0xB3, 0x97, 0xF4, 0x84, 0xAC,
+
+	0x10, 0x81, 0x84, 0x3C, 0xAF, 0x75, 0x36, 0x15,
+	0x16, 0xD1, 0x20, 0x5E, 0x91, 0x24, 0xF9, 0xEE,
+	0xCD, 0xDD, 0xA5, 0x7D, 0x74, 0x4A, 0x83, 0xBA,
+	0xBE, 0xC1, 0xAB, 0x1F, 0xDD, 0xFB, 0x7C, 0xAE,
+	0x30, 0x90, 0x8D, 0xB6, 0xE7, 0x23, 0x6C,
+
+	0x10, 0x81, 0x84, 0x3C, 0xAF, 0x35, 0x32, 0x15,
+	0x5E, 0xD1, 0x20, 0x37, 0x91, 0x24, 0xF9, 0xEE,
+	0x4D, 0xB9, 0xED, 0x4C, 0xF6, 0x4F, 0x83, 0xF8,
+	0x26, 0xDE, 0xF0, 0x22, 0x20, 0x37, 0x5C, 0x05,
+	0x86, 0xCE, 0xE3, 0xFC, 0xE3, 0xC7, 0x3C, 0x58,
+
+	0x10, 0x81, 0x84, 0x3C, 0xAF, 0x35, 0x32, 0x15,
+	0x5E, 0xD1, 0x20, 0x35, 0xD8, 0x65, 0xF9, 0x6E,
+	0xB5, 0xBD, 0x5D, 0x4D, 0xD4, 0x47, 0x22, 0xED,
+	0x23, 0x2C, 0xC1, 0xAD, 0x3E, 0x2B, 0x53, 0x3A,
+	0x43, 0x23, 0x85, 0xF9, 0x3E, 0x04, 0x74, 0xD8,
+	0x41,
+
+	0x10, 0x81, 0x84, 0x3C, 0xAF, 0x35, 0x32, 0x15,
+	0x5E, 0xD1, 0x20, 0xB5, 0xD8, 0x4F, 0xDB, 0x6E,
+	0x72, 0xB9, 0x4C, 0xFC, 0x84, 0x53, 0xA2, 0xA7,
+	0x23, 0xB7, 0x80, 0xE6, 0x11, 0xB6, 0xBE, 0xFE,
+	0x48, 0xC9, 0x50, 0xDC, 0x70, 0x80, 0x15, 0x63,
+	0x4A, 0x11,
+
+	0x10, 0x81, 0x84, 0x3C, 0xAF, 0x35, 0x32, 0x15,
+	0xBE, 0xD6, 0x20, 0xB5, 0xD8, 0xCF, 0x51, 0x64,
+	0x7B, 0xB8, 0x0C, 0xED, 0x8C, 0x6E, 0xA7, 0xAF,
+	0x22, 0xB7, 0xC0, 0xA2, 0x27, 0x72, 0x2F, 0x98,
+	0x81, 0x95, 0x34, 0x6C, 0x2F, 0xB7, 0xCD, 0x2E,
+	0xF9, 0xF4, 0xFA,
+
+	0x10, 0x81, 0x84, 0x3C, 0xAF, 0x35, 0x32, 0x15,
+	0xBE, 0x96, 0x20, 0xB5, 0xB3, 0xCF, 0x51, 0x0F,
+	0xFA, 0x4D, 0xA3, 0x6C, 0x0C, 0x62, 0xEE, 0x9F,
+	0x6C, 0xBF, 0xC0, 0x2B, 0x68, 0xB9, 0x99, 0x72,
+	0x62, 0x3A, 0xE2, 0x1D, 0xE4, 0x6B, 0x40, 0x90,
+	0x4F, 0xE9, 0x6C, 0xBF,
+
+	0x5B, 0x89, 0x84, 0xBC, 0xAF, 0x35, 0x32, 0x15,
+	0xBE, 0x96, 0x20, 0xB5, 0xB3, 0xCF, 0x51, 0x2D,
+	0xCA, 0x78, 0x3B, 0x48, 0x14, 0x82, 0x7D, 0x9A,
+	0x4F, 0x9F, 0x90, 0x1E, 0x04, 0xB9, 0x99, 0xF4,
+	0xBA, 0xA2, 0x99, 0x1C, 0xAD, 0x81, 0x99, 0xC9,
+	0xC4, 0x04, 0x4C, 0x7E, 0x6C,
+
+	0x5B, 0xA5, 0xA0, 0xBC, 0xAF, 0x35, 0x32, 0x15,
+	0xBE, 0x96, 0x20, 0xB5, 0xBE, 0xCF, 0x51, 0x2D,
+	0x48, 0x68, 0x3E, 0x09, 0x12, 0x44, 0x5D, 0x1E,
+	0xEE, 0x8A, 0x50, 0x1C, 0x04, 0xBE, 0x3F, 0x6A,
+	0x86, 0x67, 0x24, 0x9E, 0x27, 0xC6, 0x71, 0xAF,
+	0x33, 0x53, 0x08, 0xD2, 0x2F, 0x13,
+
+	0x5B, 0x25, 0xAC, 0xB8, 0xAF, 0x35, 0x32, 0x15,
+	0xBE, 0x96, 0x20, 0xB5, 0xFE, 0xCE, 0x51, 0x2D,
+	0x81, 0x78, 0xEC, 0xDD, 0x90, 0x45, 0x1F, 0x5A,
+	0xE6, 0x66, 0x56, 0x54, 0x04, 0xBE, 0xBF, 0x13,
+	0xDD, 0x24, 0x7C, 0x84, 0x6C, 0xDA, 0x2B, 0x86,
+	0x46, 0x6B, 0xCA, 0xAD, 0x6F, 0x48, 0xDE,
+
+	0x1B, 0x25, 0xAC, 0xD5, 0xAF, 0x35, 0x32, 0x15,
+	0xBE, 0x96, 0x20, 0xB5, 0xFE, 0x8E, 0x5C, 0x2D,
+	0x20, 0xA7, 0xCA, 0x9D, 0x50, 0x42, 0x1B, 0xCA,
+	0x36, 0x22, 0x2A, 0x65, 0x01, 0xB6, 0xBF, 0x07,
+	0xFD, 0xCA, 0xDF, 0x90, 0xDE, 0x4B, 0x1B, 0x35,
+	0x79, 0x0A, 0xD0, 0xE7, 0xC1, 0xC9, 0x19, 0xFE,
+
+	0xCB, 0x03, 0xA0, 0xCE, 0x08, 0x73, 0xC9, 0xCD,
+	0x56, 0x57, 0x5E, 0x3A, 0xE9, 0xB2, 0xDC, 0x83,
+	0xC5, 0x75, 0xB2, 0xCA, 0x57, 0xB4, 0x62, 0x85,
+	0xFE, 0x94, 0x06, 0xC5, 0x9A, 0xA6, 0xED, 0x9B,
+	0xB6, 0x98, 0x08, 0x9B, 0x74, 0x3B, 0x79, 0xF8,
+	0xF9, 0x05, 0x73, 0x48, 0x98, 0x78, 0x52, 0xC0,
+	0xEB,
+
+	0xCB, 0x03, 0xA0, 0x4E, 0x08, 0x5D, 0xE3, 0xCD,
+	0x56, 0x57, 0x5E, 0x3A, 0xE9, 0xB2, 0xDC, 0x83,
+	0xC5, 0x75, 0x6A, 0xCF, 0x15, 0x38, 0x93, 0xE0,
+	0xF7, 0x9E, 0x04, 0x01, 0x2B, 0xB2, 0x6D, 0x9B,
+	0x03, 0xF4, 0x5D, 0xA6, 0x9B, 0xED, 0x1F, 0xE8,
+	0x40, 0xF8, 0xC2, 0x71, 0x90, 0x3F, 0x1C, 0x6A,
+	0x40, 0xBB,
+
+	0xCB, 0x07, 0xA0, 0x4E, 0x08, 0xDD, 0x6D, 0xCF,
+	0x56, 0x57, 0x5E, 0x3A, 0xE9, 0xB2, 0xDC, 0x83,
+	0xC4, 0x75, 0x7A, 0x35, 0x94, 0x38, 0x15, 0x29,
+	0x86, 0x9E, 0x96, 0x03, 0x2E, 0x2E, 0x6A, 0x13,
+	0x8C, 0xF0, 0x1E, 0xAC, 0xC5, 0xDC, 0x43, 0xA9,
+	0x20, 0x94, 0x32, 0x45, 0x48, 0xBE, 0xDA, 0xBF,
+	0x7F, 0x32, 0x23,
+
+	0xCB, 0x47, 0xA0, 0x4E, 0x06, 0xDD, 0x6D, 0xA0,
+	0x56, 0x57, 0x5E, 0x3A, 0xE9, 0xB2, 0xDC, 0x83,
+	0xCD, 0x7C, 0x7A, 0x3D, 0x74, 0xC3, 0x1B, 0x45,
+	0xB2, 0x1D, 0x81, 0x07, 0x2E, 0x6C, 0x63, 0x25,
+	0x96, 0x12, 0x23, 0x29, 0x63, 0x64, 0x00, 0x10,
+	0x9A, 0x6B, 0xD1, 0xA6, 0xDA, 0x24, 0x55, 0x5D,
+	0x5A, 0x1F, 0x5A, 0xA7,
+
+	0xCB, 0x47, 0xA0, 0x4E, 0x06, 0xDD, 0x4D, 0xB4,
+	0x99, 0x1E, 0x5E, 0xBA, 0xE9, 0xB2, 0xDC, 0x83,
+	0xE8, 0x94, 0xBE, 0x29, 0x7C, 0x77, 0x1B, 0x45,
+	0x72, 0xE6, 0x75, 0x07, 0x24, 0x08, 0x82, 0x3E,
+	0xBB, 0x4E, 0x3D, 0x9B, 0xBE, 0xAB, 0x21, 0xCF,
+	0x87, 0xBD, 0xD2, 0x55, 0xD9, 0x74, 0xEE, 0xA1,
+	0x22, 0xB4, 0x4E, 0xF3, 0xBF,
+
+	0xCB, 0x47, 0xA0, 0x4E, 0x12, 0xDD, 0x4D, 0xB4,
+	0x99, 0x2E, 0x7E, 0xBA, 0xE9, 0xB2, 0xDC, 0x83,
+	0x6C, 0x8E, 0xBE, 0x29, 0x7C, 0x77, 0x1A, 0x45,
+	0x71, 0xE6, 0x77, 0x06, 0x2A, 0x40, 0x02, 0x2F,
+	0x1A, 0x0F, 0x1E, 0x66, 0xB9, 0x61, 0x5D, 0xBF,
+	0xDD, 0x3E, 0x3C, 0xF9, 0x4C, 0x67, 0xFC, 0xBF,
+	0xCC, 0x18, 0x76, 0x4B, 0x41, 0x27,
+
+	0xCB, 0x47, 0xA0, 0x4E, 0x72, 0xD5, 0x4D, 0xB4,
+	0x99, 0xAE, 0xEE, 0xBA, 0xE9, 0xB2, 0xDC, 0x83,
+	0x6C, 0x4A, 0xB7, 0x29, 0x7E, 0x77, 0x1A, 0x57,
+	0x7B, 0xE7, 0xA1, 0x92, 0x1A, 0x41, 0x42, 0xE2,
+	0xD4, 0xE1, 0xA5, 0x86, 0x13, 0x55, 0x82, 0xC0,
+	0xB5, 0xFA, 0xC8, 0x50, 0xEA, 0x10, 0x92, 0x9B,
+	0xAE, 0xC5, 0x94, 0x6C, 0x6B, 0xC2, 0x52,
+
+	0xCB, 0x47, 0xA0, 0x4E, 0x72, 0x15, 0x69, 0xB4,
+	0xE9, 0xAE, 0xEE, 0xCB, 0xE9, 0xB2, 0xDC, 0x83,
+	0x7C, 0x6C, 0xAB, 0x13, 0x78, 0x77, 0x1A, 0x5F,
+	0x45, 0xF1, 0xA0, 0xFD, 0x2B, 0x6B, 0x74, 0x72,
+	0x4B, 0x46, 0x2A, 0x3E, 0xAC, 0xE0, 0x4B, 0x86,
+	0x12, 0x97, 0x6F, 0xF8, 0x4E, 0xF5, 0x21, 0x8F,
+	0x3E, 0xF6, 0xEC, 0xAA, 0x92, 0xA9, 0xF3, 0x86,
+
+	0xCB, 0x47, 0xA0, 0x4E, 0x72, 0x15, 0x69, 0xB4,
+	0xE9, 0xAE, 0x4E, 0xDF, 0xB8, 0xB2, 0xDC, 0x03,
+	0xA4, 0xC4, 0xEB, 0x43, 0x57, 0x67, 0x1B, 0x09,
+	0x45, 0x81, 0xA8, 0xFD, 0x1B, 0x5A, 0x66, 0x77,
+	0xC2, 0xBB, 0x9B, 0xC2, 0xB8, 0xE8, 0x35, 0xC1,
+	0x8D, 0x22, 0x83, 0x7D, 0xDC, 0x5C, 0x3D, 0xD6,
+	0xF2, 0x7D, 0xDF, 0xB0, 0x5B, 0x27, 0xB2, 0x3A,
+	0xFF,
+
+	0xCB, 0x47, 0xA0, 0x4E, 0x72, 0x15, 0x69, 0xB4,
+	0xE8, 0xAE, 0x4E, 0xDF, 0xB8, 0x80, 0xDE, 0x03,
+	0xAE, 0xC4, 0xEB, 0x1A, 0xC5, 0x7D, 0x1B, 0x0C,
+	0x45, 0x81, 0xE8, 0xFF, 0x19, 0x4A, 0xE5, 0x50,
+	0x21, 0x89, 0x1E, 0x62, 0x1C, 0x98, 0x72, 0x67,
+	0xCE, 0x38, 0xBE, 0xA5, 0xF9, 0x52, 0xB3, 0xA0,
+	0xA6, 0xA4, 0x58, 0x11, 0xE3, 0x31, 0x0E, 0xD8,
+	0x50, 0x8A,
+
+	0xCB, 0x47, 0xA0, 0x4E, 0x72, 0x15, 0x69, 0xB4,
+	0x68, 0xAB, 0x4E, 0xDF, 0xB8, 0x00, 0x4C, 0x13,
+	0xFE, 0x47, 0xE7, 0x17, 0xC1, 0xA1, 0x12, 0x24,
+	0x47, 0x81, 0xEC, 0xBD, 0x13, 0x4B, 0x6B, 0x80,
+	0x21, 0xDB, 0x37, 0x85, 0x22, 0x3A, 0xAC, 0xE9,
+	0xF4, 0x68, 0x0F, 0x4A, 0xE1, 0xD4, 0xA4, 0xD1,
+	0x08, 0x1D, 0x7B, 0x87, 0xD8, 0xDD, 0x54, 0x2E,
+	0x15, 0x6A, 0x40,
+
+	0xCB, 0x47, 0xA0, 0x4E, 0x72, 0x15, 0x69, 0xB4,
+	0x68, 0xEB, 0x6A, 0xDF, 0xDB, 0x00, 0x4C, 0x60,
+	0xEE, 0xC6, 0x59, 0x9F, 0xE1, 0x8D, 0x3A, 0x1B,
+	0x45, 0x83, 0xEC, 0xBD, 0x53, 0x7C, 0x6D, 0xA0,
+	0x0C, 0xE9, 0x95, 0x4F, 0x98, 0x1A, 0x02, 0x26,
+	0x9E, 0xA2, 0x25, 0x07, 0xA1, 0x55, 0x47, 0x1B,
+	0xA2, 0x81, 0x0A, 0x8F, 0x83, 0xE8, 0x76, 0x9D,
+	0x53, 0x6E, 0x86, 0x67,
+
+	0x98, 0x44, 0xA0, 0xCE, 0x72, 0x15, 0x69, 0xB4,
+	0x68, 0xEB, 0x6A, 0xDF, 0xDB, 0x00, 0x6C, 0x59,
+	0xFE, 0x94, 0x67, 0x1A, 0xE1, 0x0C, 0x88, 0x95,
+	0x6B, 0x93, 0xEC, 0xDA, 0x53, 0x04, 0x6D, 0xA0,
+	0x5D, 0xC1, 0x6A, 0x6C, 0x0C, 0x14, 0x32, 0x70,
+	0xB9, 0x42, 0x13, 0x3B, 0x34, 0xE3, 0xE5, 0x6F,
+	0x9A, 0x23, 0x60, 0x75, 0xC4, 0x4E, 0xD3, 0xFF,
+	0x6F, 0x0A, 0xBB, 0x54, 0xBB,
+
+	0x98, 0x70, 0xB4, 0xCE, 0x72, 0x15, 0x69, 0xB4,
+	0x68, 0xEB, 0x6A, 0xDF, 0xDC, 0x00, 0x6C, 0x59,
+	0xFD, 0xF0, 0xC5, 0x58, 0x62, 0x0C, 0xB8, 0x85,
+	0x8B, 0x8B, 0xAC, 0xDB, 0x53, 0x84, 0xFD, 0xA4,
+	0xC9, 0xCC, 0x88, 0xF3, 0xB7, 0xE5, 0x19, 0xC0,
+	0x45, 0x04, 0xF8, 0x90, 0xA4, 0xB1, 0x8F, 0xEF,
+	0x2C, 0xF2, 0x95, 0xF6, 0xAC, 0x22, 0xF5, 0x9D,
+	0x29, 0xF3, 0x5D, 0xA9, 0xEA, 0xC0,
+
+	0x98, 0xF0, 0xA0, 0xCA, 0x72, 0x15, 0x69, 0xB4,
+	0x68, 0xEB, 0x6A, 0xDF, 0xBC, 0x06, 0x6C, 0x59,
+	0xB5, 0xF5, 0x03, 0xC0, 0x68, 0xA8, 0xF2, 0x05,
+	0x8A, 0x2F, 0xA7, 0x93, 0x51, 0x84, 0xFD, 0xF4,
+	0x8E, 0x62, 0xB7, 0xEC, 0xBB, 0xE0, 0x4E, 0xF6,
+	0x03, 0x2D, 0x05, 0x19, 0x6F, 0x3D, 0x16, 0x9A,
+	0xCB, 0x91, 0xEE, 0x0C, 0xE9, 0x40, 0xFE, 0x58,
+	0xD2, 0xF1, 0x8E, 0x39, 0x69, 0xC6, 0x09,
+
+	0xE8, 0xF0, 0xA0, 0xBF, 0x72, 0x15, 0x69, 0xB4,
+	0x68, 0xEB, 0x6A, 0xDF, 0xBC, 0x86, 0x4B, 0x59,
+	0x55, 0xA6, 0x86, 0x5E, 0x62, 0xC8, 0xE8, 0x81,
+	0x8A, 0x24, 0xDD, 0xAF, 0x75, 0x89, 0xFD, 0x74,
+	0x95, 0x7A, 0xD1, 0x55, 0x28, 0x0A, 0xBF, 0x69,
+	0x44, 0x12, 0x5F, 0x1C, 0x31, 0x31, 0x40, 0xCF,
+	0x46, 0x8D, 0xB6, 0x55, 0xFC, 0xD6, 0x30, 0xDA,
+	0x7E, 0xC2, 0xFD, 0x2A, 0xDC, 0x4B, 0x55, 0xFD,
+
+	0x7C, 0x99, 0x42, 0x2F, 0x7F, 0x9D, 0x92, 0x96,
+	0xAB, 0x93, 0xAB, 0xD8, 0x5C, 0xD4, 0x3B, 0x4E,
+	0x55, 0x04, 0xBB, 0x9D, 0x41, 0x05, 0x7C, 0x1C,
+	0xF7, 0x9A, 0x38, 0x45, 0xD4, 0x51, 0x83, 0xFA,
+	0x56, 0xD4, 0x09, 0x26, 0x0B, 0x0D, 0x10, 0x58,
+	0x64, 0x81, 0xAE, 0x50, 0x54, 0xB3, 0xDB, 0x5A,
+	0x4C, 0xB1, 0xE5, 0xC8, 0x57, 0x87, 0xC8, 0xE0,
+	0x18, 0x7B, 0xE8, 0x0A, 0x0E, 0xC2, 0xC8, 0x53,
+	0xD2,
+
+	0x7E, 0x99, 0x42, 0xAF, 0x7F, 0xAB, 0x82, 0x96,
+	0xAB, 0x93, 0xAB, 0xD8, 0x5C, 0xD4, 0x3B, 0x4E,
+	0x55, 0x04, 0x2B, 0x9F, 0x42, 0xCA, 0x99, 0x5B,
+	0xF5, 0x98, 0x02, 0x0F, 0x44, 0x49, 0x83, 0xFC,
+	0x11, 0x9C, 0x16, 0x60, 0x77, 0x81, 0x10, 0xFA,
+	0x8D, 0x28, 0xCA, 0xB8, 0x41, 0x33, 0xAA, 0x82,
+	0x93, 0xE6, 0x07, 0xAA, 0x34, 0xDE, 0xAC, 0x2A,
+	0x35, 0xF0, 0xB3, 0x16, 0x7A, 0x1A, 0xD2, 0x0D,
+	0xA1, 0xAA,
+
+	0xBE, 0x9D, 0x42, 0xAF, 0x7F, 0x2B, 0x94, 0x80,
+	0xAB, 0x93, 0xAB, 0xD8, 0x5C, 0xD4, 0x3B, 0x4E,
+	0x57, 0x04, 0x3B, 0x57, 0x28, 0x4F, 0x6B, 0x99,
+	0xFF, 0xEC, 0x6C, 0x47, 0x51, 0xF5, 0x89, 0x94,
+	0xD1, 0xBA, 0x6C, 0x81, 0x1A, 0x7A, 0x47, 0x13,
+	0xF3, 0xA4, 0xA2, 0x7F, 0x31, 0xE3, 0xF0, 0xCF,
+	0x51, 0x27, 0x11, 0x8F, 0x69, 0x38, 0x71, 0xFB,
+	0xB7, 0x12, 0xED, 0x1B, 0xAA, 0x18, 0xC4, 0x5B,
+	0xAF, 0x0F, 0x7A,
+
+	0xBE, 0x9D, 0x64, 0xAF, 0x18, 0x2B, 0x94, 0xF7,
+	0xAB, 0x93, 0xAB, 0xD8, 0x5C, 0xD4, 0x3B, 0x4E,
+	0x97, 0x00, 0x3B, 0x7F, 0xCE, 0x31, 0xEC, 0x98,
+	0xF5, 0x95, 0x64, 0x5D, 0x31, 0xD2, 0xE1, 0xAB,
+	0x92, 0xD8, 0xC4, 0xF5, 0xE3, 0x9E, 0x6F, 0x28,
+	0x54, 0xA7, 0xCB, 0xA7, 0x46, 0xB7, 0x47, 0x51,
+	0x93, 0xDD, 0x75, 0xA3, 0xAA, 0x23, 0xC2, 0xE1,
+	0xC4, 0xDB, 0x50, 0x8C, 0xC7, 0x60, 0xE2, 0xD5,
+	0xE0, 0x28, 0x9C, 0x2F,
+
+	0xBE, 0x9D, 0x64, 0xAF, 0x18, 0x2B, 0x94, 0xEF,
+	0xFC, 0x86, 0xAB, 0x58, 0x5C, 0xD4, 0x3B, 0x4E,
+	0xBB, 0x68, 0x4F, 0x7B, 0xCE, 0x87, 0xE6, 0x98,
+	0x0F, 0x64, 0x1F, 0x08, 0x23, 0x93, 0x53, 0xB7,
+	0x10, 0x16, 0x2C, 0xBF, 0xA9, 0xD4, 0x16, 0x8D,
+	0x80, 0xA3, 0x6D, 0x03, 0xBE, 0x78, 0xB4, 0x95,
+	0x32, 0xEC, 0x19, 0x89, 0x14, 0xDB, 0x40, 0x5E,
+	0xBD, 0x56, 0x43, 0x40, 0x3E, 0x26, 0x5F, 0x01,
+	0x1B, 0xFF, 0x25, 0xC8, 0x7E,
+
+	0xBE, 0x9D, 0x64, 0xAF, 0x0A, 0x2B, 0x94, 0xEF,
+	0xFC, 0xBE, 0x83, 0x58, 0x5C, 0xD4, 0x3B, 0x4E,
+	0xF9, 0x74, 0x4F, 0x78, 0xCE, 0x07, 0x26, 0x9A,
+	0x0C, 0x62, 0x9B, 0x8D, 0x29, 0xB2, 0x8B, 0xBB,
+	0x38, 0x86, 0x66, 0x02, 0xDF, 0xF5, 0xFF, 0x9F,
+	0x2B, 0x92, 0xD1, 0x70, 0x44, 0xC1, 0x10, 0xC1,
+	0x52, 0x9F, 0xD0, 0x98, 0xD4, 0x2D, 0xC1, 0xB5,
+	0x14, 0x4B, 0x42, 0x3F, 0x35, 0x4B, 0x7F, 0xD8,
+	0x0B, 0xD9, 0xE0, 0xF7, 0x64, 0x71,
+
+	0xBE, 0x9D, 0x64, 0xAF, 0x4A, 0x23, 0x94, 0xEF,
+	0xFC, 0x3E, 0x9B, 0x48, 0x5C, 0xD4, 0x3B, 0x4E,
+	0xF9, 0xFA, 0x42, 0xF8, 0xCE, 0x07, 0xA6, 0xFA,
+	0x8E, 0x63, 0x09, 0x84, 0x21, 0x96, 0x0A, 0xBB,
+	0x54, 0x59, 0xF6, 0x21, 0x4A, 0xFE, 0x0F, 0x8F,
+	0xC2, 0x40, 0xE3, 0x68, 0xEE, 0x77, 0x7C, 0x56,
+	0x9D, 0x54, 0xBC, 0x1A, 0x7D, 0x72, 0x74, 0xD1,
+	0x69, 0x1E, 0xB8, 0xD6, 0x59, 0xC0, 0xDB, 0x34,
+	0x6D, 0x19, 0xF1, 0x8C, 0x61, 0xBE, 0x22,
+
+	0xBE, 0x9D, 0x64, 0xAF, 0x4A, 0x23, 0x94, 0xEF,
+	0x9C, 0x3E, 0x9B, 0x31, 0x5C, 0xD4, 0x3B, 0x4E,
+	0x79, 0xFC, 0x94, 0xC4, 0xE8, 0x01, 0xA6, 0xFA,
+	0x86, 0xF0, 0x2E, 0x89, 0x2E, 0xF6, 0x39, 0x3A,
+	0x66, 0xEE, 0x15, 0xCA, 0x26, 0x82, 0x56, 0xDF,
+	0x66, 0x40, 0xEC, 0x7F, 0x5C, 0x90, 0xD8, 0x70,
+	0x1D, 0xFF, 0x55, 0xFE, 0xDA, 0x5E, 0x95, 0x93,
+	0x5C, 0x19, 0x3B, 0xE6, 0x10, 0x5D, 0x07, 0x6B,
+	0xAE, 0x80, 0xDB, 0x36, 0xF3, 0x15, 0x6B, 0x74,
+
+	0xBE, 0x9D, 0x64, 0xAF, 0x4A, 0x23, 0x94, 0xEF,
+	0x9C, 0x3E, 0xBB, 0x0B, 0x85, 0x9D, 0x3B, 0xCE,
+	0xEB, 0x78, 0xD0, 0x87, 0xC6, 0x91, 0xE2, 0x6E,
+	0x86, 0xC6, 0x2E, 0x89, 0x35, 0xF6, 0xA8, 0x3F,
+	0x95, 0xFA, 0x11, 0x83, 0x2D, 0x00, 0xF1, 0x7F,
+	0x57, 0x8E, 0xC4, 0xBE, 0xB1, 0x39, 0x95, 0x4F,
+	0x31, 0xD9, 0x42, 0x4B, 0x49, 0xC2, 0x58, 0x11,
+	0x61, 0x6C, 0x1C, 0xFF, 0x5B, 0xAA, 0xD7, 0x60,
+	0xC5, 0xE1, 0xD0, 0x18, 0x82, 0xD5, 0x83, 0x3F,
+	0xCE,
+
+	0xBE, 0x9D, 0x64, 0xAF, 0x4A, 0x23, 0x94, 0xEF,
+	0x9E, 0x3E, 0xBB, 0x0B, 0x85, 0xA7, 0x31, 0xCE,
+	0xE0, 0x70, 0xD4, 0x02, 0xB3, 0x8C, 0x42, 0xFD,
+	0x86, 0xC6, 0x0E, 0x89, 0x36, 0xDF, 0x6A, 0x9C,
+	0xCF, 0xD9, 0x29, 0xE4, 0x89, 0x07, 0xB8, 0x06,
+	0x42, 0x3E, 0xA4, 0x0D, 0x25, 0x3F, 0xCE, 0xC6,
+	0xF5, 0xA0, 0x80, 0x40, 0x46, 0xC4, 0x68, 0x43,
+	0x93, 0x6C, 0xF4, 0xBC, 0x1C, 0xCA, 0xB8, 0x22,
+	0xED, 0x58, 0xC2, 0x06, 0xAE, 0xDF, 0x6B, 0xB3,
+	0xEA, 0x8E,
+
+	0xBE, 0x9D, 0x64, 0xAF, 0x4A, 0x23, 0x94, 0xEF,
+	0xDE, 0x37, 0xBB, 0x0B, 0x85, 0x27, 0x2B, 0xC4,
+	0x7D, 0x62, 0x98, 0x97, 0xB6, 0xB2, 0x4E, 0xDD,
+	0x87, 0xC6, 0x0E, 0xAB, 0x9D, 0xDE, 0x93, 0x1C,
+	0x46, 0x66, 0x3B, 0x00, 0x9E, 0xDA, 0x6D, 0x96,
+	0x60, 0x7D, 0xF8, 0x49, 0xAA, 0x0E, 0xC0, 0x43,
+	0x1C, 0x47, 0x08, 0x41, 0x42, 0xF8, 0x2A, 0x63,
+	0xB4, 0x93, 0xCA, 0xDF, 0xD5, 0xB7, 0xCC, 0x71,
+	0xD2, 0x13, 0xFC, 0x78, 0x28, 0xC6, 0x47, 0x07,
+	0x66, 0x1F, 0x6A,
+
+	0xBE, 0x9D, 0x64, 0xAF, 0x4A, 0x23, 0x94, 0xEF,
+	0xDE, 0x77, 0x9B, 0x0B, 0xEF, 0x27, 0x2B, 0xBF,
+	0x6E, 0x62, 0x10, 0x0F, 0x16, 0xFE, 0xA2, 0xE5,
+	0xC5, 0xC0, 0x0E, 0xAB, 0x27, 0x61, 0x94, 0x48,
+	0x10, 0x29, 0x6C, 0x79, 0xD6, 0x76, 0x27, 0x7A,
+	0x19, 0xFD, 0xBC, 0xC3, 0x91, 0xBA, 0x38, 0x04,
+	0xE6, 0xB9, 0xED, 0xA0, 0xB0, 0x17, 0x0A, 0x4A,
+	0x12, 0xB0, 0x61, 0x5F, 0x75, 0x44, 0x4B, 0x98,
+	0x4C, 0x33, 0x2B, 0xC5, 0x2B, 0xB5, 0x18, 0x8B,
+	0xF0, 0x08, 0xA5, 0x16,
+
+	0xE5, 0xC7, 0x64, 0x2F, 0x4A, 0x23, 0x94, 0xEF,
+	0xDE, 0x77, 0x9B, 0x0B, 0xEF, 0x27, 0x2B, 0xA6,
+	0x07, 0x1A, 0xAB, 0xAE, 0xD4, 0x1B, 0xD7, 0xEC,
+	0xEF, 0xE0, 0x0E, 0x0E, 0xF3, 0x63, 0x9D, 0x48,
+	0x8B, 0x42, 0x98, 0xA3, 0xBC, 0x76, 0x14, 0x52,
+	0x71, 0x3D, 0x7C, 0xC6, 0x46, 0xDC, 0x8B, 0xD1,
+	0x3D, 0x4C, 0x8A, 0x34, 0x8C, 0xC6, 0x8C, 0x37,
+	0x3A, 0x46, 0xE7, 0xFF, 0x15, 0x0B, 0x45, 0x46,
+	0xD2, 0x5D, 0xDC, 0xB9, 0xFF, 0x2F, 0x88, 0xBE,
+	0x79, 0x08, 0x97, 0x84, 0x76,
+
+	0xE5, 0xFB, 0x7C, 0x2F, 0x4A, 0x23, 0x94, 0xEF,
+	0xDE, 0x77, 0x9B, 0x0B, 0xEF, 0x27, 0x2B, 0xA6,
+	0x06, 0x8A, 0x2F, 0x69, 0xD6, 0x1B, 0x42, 0x76,
+	0x8F, 0xFE, 0x0E, 0x0E, 0xF3, 0x63, 0xCD, 0x48,
+	0x56, 0x65, 0xF7, 0x05, 0x4C, 0x3B, 0x28, 0x4A,
+	0x70, 0x00, 0xA0, 0x97, 0x47, 0xC3, 0xB6, 0x78,
+	0xDD, 0xC7, 0x58, 0x51, 0x8D, 0xAF, 0x07, 0x76,
+	0x7E, 0x92, 0xD2, 0x4C, 0xD1, 0xF5, 0x15, 0x9B,
+	0xAE, 0x66, 0x09, 0x69, 0x28, 0x16, 0xB2, 0x41,
+	0x21, 0x45, 0x90, 0x73, 0xC0, 0x93,
+
+	0xE5, 0x7B, 0xE0, 0x3F, 0x4A, 0x23, 0x94, 0xEF,
+	0xDE, 0x77, 0x9B, 0x0B, 0xCF, 0x26, 0x2B, 0xA6,
+	0xCE, 0x8B, 0x3B, 0xDA, 0xF2, 0x9C, 0xE6, 0xFE,
+	0x8E, 0x90, 0x01, 0x4E, 0xF0, 0x63, 0xC9, 0xA8,
+	0x3B, 0x96, 0x6A, 0x37, 0x7C, 0xF5, 0x71, 0x10,
+	0xDE, 0x2F, 0xAB, 0xFA, 0xB1, 0x8C, 0x16, 0xD6,
+	0x9F, 0x99, 0x47, 0x3F, 0x20, 0xD8, 0xBA, 0x60,
+	0x69, 0xE8, 0x72, 0x43, 0xC7, 0xFD, 0x74, 0xD8,
+	0xA4, 0xAA, 0x91, 0x03, 0x92, 0x0D, 0x22, 0x7C,
+	0x7B, 0x01, 0x89, 0x24, 0x4C, 0xC1, 0x08,
+
+	0xC1, 0x7B, 0xE0, 0x42, 0x4A, 0x23, 0x94, 0xEF,
+	0xDE, 0x77, 0x9B, 0x0B, 0xCF, 0xA6, 0x2B, 0xA6,
+	0x6D, 0x54, 0x3F, 0xF7, 0x3C, 0xBA, 0xE0, 0x7E,
+	0x8E, 0x95, 0x24, 0x70, 0x26, 0x6D, 0xC9, 0xC8,
+	0x8C, 0x7A, 0x53, 0x1C, 0x32, 0x3E, 0xAB, 0xCA,
+	0xB7, 0x09, 0x99, 0xC1, 0xBA, 0x6B, 0x16, 0xAD,
+	0x34, 0x42, 0xFD, 0xED, 0xF2, 0xCD, 0x20, 0xE0,
+	0x44, 0x60, 0x96, 0x25, 0x2A, 0xAB, 0xAF, 0xC2,
+	0x6E, 0xAA, 0x9C, 0xEF, 0xF2, 0xEA, 0x71, 0xCB,
+	0xE7, 0x4D, 0xF8, 0xCD, 0x03, 0x36, 0x5C, 0x62,
+
+	0x8E, 0x69, 0xF9, 0xA4, 0x68, 0xEE, 0x12, 0x69,
+	0x57, 0xAB, 0x72, 0x3F, 0x26, 0x39, 0xAA, 0xD4,
+	0xBE, 0xF5, 0x8B, 0xD4, 0x79, 0x17, 0x11, 0xAF,
+	0xF5, 0x5A, 0x57, 0x6C, 0x8F, 0x7A, 0xBA, 0xCE,
+	0x57, 0x38, 0x3D, 0x0E, 0x03, 0x9A, 0xD0, 0xAD,
+	0x21, 0xDA, 0xC4, 0xDC, 0xF7, 0x26, 0xC2, 0x10,
+	0x74, 0xCD, 0x89, 0x8A, 0xB4, 0xB9, 0xC9, 0xB0,
+	0xFF, 0x7D, 0xC1, 0xE8, 0x1D, 0x7D, 0x2A, 0xC6,
+	0xF0, 0x30, 0x1B, 0x09, 0xD0, 0x52, 0xB9, 0xB4,
+	0x9C, 0xFC, 0xF1, 0x3D, 0x68, 0xEF, 0x10, 0x1F,
+	0x9E,
+
+	0x8E, 0x69, 0xF9, 0xA4, 0x68, 0xD0, 0x1C, 0x69,
+	0x57, 0xAB, 0x72, 0x3F, 0x26, 0x39, 0xAA, 0xD4,
+	0xBE, 0x75, 0x3B, 0xD1, 0xFA, 0x9B, 0xC4, 0x4C,
+	0xF6, 0x5A, 0x4C, 0xA8, 0x9F, 0x67, 0x9A, 0xCF,
+	0x75, 0xB1, 0xD6, 0xCA, 0x44, 0x30, 0x0F, 0x9D,
+	0x5B, 0xA5, 0xED, 0x9E, 0x06, 0xD1, 0x7B, 0x96,
+	0x07, 0x46, 0x3C, 0x34, 0xE4, 0x21, 0xF7, 0xD7,
+	0x9A, 0x58, 0xEC, 0x04, 0x0A, 0xF8, 0xBB, 0xE0,
+	0x96, 0x3B, 0xBE, 0xB9, 0xA6, 0x65, 0x2B, 0x32,
+	0x0C, 0xE0, 0x9F, 0x92, 0x90, 0x84, 0x6C, 0x0D,
+	0xE0, 0xAC,
+
+	0x8E, 0x61, 0xF9, 0xA4, 0x68, 0x50, 0x82, 0x71,
+	0x57, 0xAB, 0x72, 0x3F, 0x26, 0x39, 0xAA, 0xD4,
+	0xBD, 0x75, 0x9F, 0xF1, 0x33, 0x0B, 0xCC, 0x82,
+	0xA4, 0x1E, 0x0C, 0xCA, 0x9D, 0xBB, 0x94, 0xEF,
+	0xB4, 0x93, 0x68, 0x3A, 0xF1, 0xE4, 0xDA, 0xD9,
+	0x2B, 0x70, 0xF0, 0xB0, 0x34, 0x0D, 0x18, 0x9A,
+	0x22, 0xAE, 0x90, 0x60, 0x85, 0xD7, 0x95, 0x62,
+	0x06, 0x47, 0xBE, 0x33, 0xAF, 0xF8, 0x2A, 0xE1,
+	0x97, 0x80, 0xB1, 0x39, 0xBE, 0x6F, 0x6F, 0xC4,
+	0xA2, 0xC6, 0x1F, 0xC5, 0x42, 0xF9, 0x34, 0x06,
+	0x2A, 0xF6, 0x69,
+
+	0x8E, 0x21, 0xD9, 0xA4, 0x48, 0x50, 0x82, 0x0E,
+	0x57, 0xAB, 0x72, 0x3F, 0x26, 0x39, 0xAA, 0xD4,
+	0x4F, 0x7D, 0x9F, 0xC1, 0xC2, 0xB4, 0x0B, 0x22,
+	0x72, 0x1E, 0x09, 0xAA, 0xCD, 0x3E, 0x9C, 0xD3,
+	0xE0, 0xB8, 0x6E, 0xB3, 0xBE, 0x79, 0x2F, 0x00,
+	0x19, 0xFF, 0xA8, 0xC3, 0xFC, 0xB6, 0x05, 0x0E,
+	0xC4, 0x36, 0xE8, 0x80, 0x59, 0xEF, 0xFD, 0x94,
+	0x2E, 0x5A, 0x29, 0x97, 0x37, 0xA3, 0xCD, 0x97,
+	0x0D, 0x19, 0xC1, 0xF1, 0xEC, 0x8F, 0xCB, 0xAE,
+	0xDE, 0xD2, 0x5F, 0x25, 0x75, 0x54, 0xC2, 0xC6,
+	0xF3, 0x9C, 0xDE, 0x73,
+
+	0x8E, 0x21, 0xD9, 0xA4, 0x48, 0x50, 0x82, 0x1E,
+	0x08, 0xED, 0x72, 0xBF, 0x26, 0x39, 0xAA, 0xD4,
+	0x67, 0x55, 0x8F, 0x05, 0x42, 0x40, 0x0A, 0x22,
+	0x20, 0xE2, 0xFF, 0xAF, 0x1F, 0xB6, 0x5B, 0xDC,
+	0x8F, 0x63, 0xA3, 0xFC, 0x6B, 0xF5, 0xAC, 0xA7,
+	0xEE, 0xBD, 0xAB, 0x65, 0x3C, 0xCD, 0x99, 0x4A,
+	0xA7, 0x76, 0x96, 0xD1, 0xC9, 0x20, 0xE1, 0xEE,
+	0x94, 0x33, 0x30, 0xDA, 0xB7, 0x6A, 0xB6, 0x37,
+	0xF9, 0x2C, 0x80, 0xCA, 0x99, 0xE0, 0x0E, 0x4A,
+	0x72, 0x2E, 0x33, 0x3A, 0x4D, 0x2F, 0x84, 0x9A,
+	0x51, 0x33, 0xCC, 0x34, 0x57,
+
+	0x8E, 0x21, 0xD9, 0xA4, 0x58, 0x50, 0x82, 0x1E,
+	0x08, 0xAD, 0x72, 0xBF, 0x26, 0x39, 0xAA, 0xD4,
+	0x66, 0x73, 0x8F, 0x01, 0x42, 0x40, 0x0A, 0x22,
+	0x24, 0xE2, 0xF4, 0xAF, 0x1F, 0xBE, 0x5B, 0xDE,
+	0xEC, 0xB6, 0xA3, 0x88, 0x0E, 0xF7, 0xEA, 0xA7,
+	0xEE, 0xEC, 0x2B, 0xB5, 0xEC, 0x8F, 0x1C, 0x1B,
+	0x50, 0x8C, 0x89, 0xEC, 0xA0, 0x9D, 0xE8, 0x17,
+	0x90, 0xB0, 0x9B, 0x60, 0xB8, 0xF1, 0x27, 0x07,
+	0x4C, 0x35, 0xCF, 0x46, 0x6A, 0x9B, 0x5B, 0x8F,
+	0x07, 0x82, 0x9B, 0x54, 0x37, 0x16, 0xFD, 0x4C,
+	0x52, 0xFE, 0xEF, 0x28, 0x21, 0xBF,
+
+	0x8E, 0x21, 0xD9, 0xA4, 0x18, 0x58, 0x82, 0x1E,
+	0x08, 0x2D, 0x52, 0x9F, 0x26, 0x39, 0xAA, 0xD4,
+	0x77, 0x3F, 0x9E, 0x01, 0x46, 0x40, 0x0A, 0x30,
+	0x2C, 0xF0, 0xF6, 0xB3, 0x0F, 0xB4, 0x1B, 0xCC,
+	0x75, 0xFF, 0x55, 0xA2, 0x10, 0xB6, 0x28, 0xAC,
+	0x6E, 0x14, 0xA7, 0x13, 0xF6, 0xF8, 0xB1, 0xD2,
+	0xE3, 0x61, 0xF8, 0x28, 0x8C, 0x81, 0x49, 0x43,
+	0x98, 0x17, 0xF9, 0xA0, 0xBC, 0xEB, 0x48, 0xEB,
+	0x5E, 0x09, 0xA6, 0x36, 0x26, 0xF3, 0x72, 0x10,
+	0xDE, 0xC2, 0x5F, 0x09, 0xC2, 0x86, 0x55, 0x25,
+	0xA7, 0x9B, 0xDC, 0x78, 0x72, 0xB7, 0x54,
+
+	0x8E, 0x21, 0xD9, 0xA4, 0x18, 0x98, 0x82, 0x1E,
+	0x08, 0x2D, 0x52, 0x9E, 0x26, 0x39, 0xAA, 0xD4,
+	0x77, 0x3B, 0x16, 0x01, 0x62, 0x40, 0x0A, 0x30,
+	0x0C, 0xF0, 0xFE, 0x93, 0x07, 0xA4, 0x3B, 0xCC,
+	0xAF, 0xF5, 0x00, 0xA0, 0x5D, 0xF8, 0x4A, 0xAF,
+	0xA4, 0xC6, 0xE3, 0x97, 0x70, 0x79, 0xB3, 0xC4,
+	0xD7, 0xB6, 0xCB, 0x14, 0x31, 0xE8, 0xB6, 0x09,
+	0xAA, 0x14, 0x0D, 0xDE, 0x24, 0xF2, 0xFA, 0x7C,
+	0x93, 0x5F, 0x26, 0x7B, 0x1A, 0xC0, 0xE2, 0x87,
+	0x86, 0x8F, 0xCC, 0xC7, 0x40, 0xCD, 0xF6, 0x49,
+	0x52, 0xCD, 0x9B, 0x5D, 0x46, 0x09, 0xC1, 0x2B,
+
+	0x8E, 0x21, 0xD9, 0xA4, 0x18, 0x98, 0x82, 0x1E,
+	0x08, 0x2D, 0x72, 0xB6, 0xC7, 0x59, 0xAA, 0x54,
+	0x67, 0x4B, 0x76, 0x15, 0x55, 0x40, 0x6C, 0xE0,
+	0x00, 0xF4, 0xFE, 0x93, 0x09, 0x82, 0x29, 0xCA,
+	0x00, 0x15, 0xD3, 0xEF, 0x6C, 0x62, 0xA6, 0x2A,
+	0xB8, 0x37, 0x15, 0x91, 0xFB, 0x88, 0x38, 0x3E,
+	0xA2, 0x13, 0x32, 0x4B, 0xB5, 0x60, 0xAC, 0x75,
+	0xF2, 0xC5, 0x35, 0xED, 0x54, 0xD2, 0xB8, 0xFC,
+	0x89, 0xC4, 0x03, 0x7F, 0x8C, 0xC0, 0xAC, 0x90,
+	0xC1, 0x96, 0xAE, 0xDA, 0x2F, 0x49, 0x43, 0x37,
+	0x36, 0xF6, 0xF5, 0x85, 0x0D, 0xA2, 0xB9, 0xA9,
+	0x12,
+
+	0x8E, 0x21, 0xD9, 0xA4, 0x18, 0x98, 0x82, 0x1E,
+	0x08, 0x2D, 0x72, 0x36, 0xC7, 0x1B, 0xAA, 0x54,
+	0xE7, 0x5B, 0x72, 0x16, 0x45, 0x67, 0x4C, 0xE0,
+	0x00, 0xF4, 0xF6, 0x9A, 0x0D, 0xC2, 0x40, 0xE2,
+	0x4C, 0x89, 0x58, 0x09, 0x23, 0x43, 0x7A, 0xDE,
+	0xB9, 0x57, 0x7A, 0x33, 0xA2, 0xC9, 0x0D, 0xC7,
+	0x07, 0x39, 0x2C, 0x56, 0xFF, 0xD1, 0xEB, 0x8E,
+	0x6B, 0x21, 0x41, 0x18, 0xDD, 0x64, 0xC9, 0xC9,
+	0x66, 0x20, 0x06, 0x09, 0x7E, 0x00, 0x96, 0xA1,
+	0x39, 0x48, 0x5E, 0xF4, 0x67, 0x07, 0x57, 0x8F,
+	0x99, 0xC6, 0x7B, 0x16, 0x25, 0xD2, 0x85, 0xA0,
+	0x86, 0xF1,
+
+	0x8E, 0x21, 0xD9, 0xA4, 0x18, 0x98, 0x82, 0x1E,
+	0x88, 0x34, 0x72, 0x36, 0xC7, 0x9B, 0x08, 0x74,
+	0x7F, 0x67, 0x12, 0x55, 0x15, 0x13, 0x5E, 0xE8,
+	0x00, 0xF4, 0xF6, 0x88, 0x25, 0xC0, 0x5C, 0xB6,
+	0x00, 0xB9, 0xB5, 0x62, 0x64, 0x2C, 0x28, 0x51,
+	0x09, 0x51, 0xC7, 0x26, 0xDF, 0x98, 0xF1, 0x4E,
+	0xB6, 0x62, 0xE5, 0x0D, 0x87, 0x96, 0xE2, 0x78,
+	0xC7, 0xC0, 0x67, 0xC3, 0x22, 0x19, 0x39, 0x90,
+	0x7E, 0x17, 0x27, 0xCB, 0x48, 0x3B, 0xDC, 0x85,
+	0xF2, 0x09, 0x91, 0xFC, 0xA2, 0xE5, 0xE4, 0xFB,
+	0x62, 0xCF, 0xF0, 0xA6, 0x9D, 0xB5, 0x6C, 0xB2,
+	0xCE, 0x66, 0x09,
+
+	0x8E, 0x21, 0xD9, 0xA4, 0x18, 0x98, 0x82, 0x1E,
+	0x88, 0x34, 0x73, 0x36, 0xC4, 0x9B, 0x08, 0x77,
+	0xEF, 0xD6, 0x12, 0xD5, 0x05, 0x13, 0xD6, 0xE9,
+	0x2C, 0xF4, 0xF6, 0x88, 0x45, 0xF0, 0x64, 0xC6,
+	0x4F, 0xED, 0x6F, 0x68, 0xFD, 0x71, 0x04, 0x31,
+	0x80, 0x1E, 0xC9, 0x35, 0x16, 0x67, 0x7D, 0x5D,
+	0x90, 0xB9, 0xDD, 0x87, 0xC9, 0xD1, 0x31, 0x22,
+	0x70, 0xC1, 0x75, 0x75, 0xB7, 0xA6, 0x3E, 0x7D,
+	0x05, 0x9C, 0x28, 0xBE, 0x15, 0x1D, 0x48, 0xFC,
+	0xE0, 0xE2, 0x45, 0x9D, 0xBA, 0x8C, 0x9D, 0xF8,
+	0x32, 0xDD, 0xEF, 0x61, 0x2F, 0xE7, 0xBF, 0xB6,
+	0x1A, 0x8E, 0xA2, 0xB0,
+
+	0xED, 0x02, 0xD9, 0x24, 0x18, 0x98, 0x82, 0x1E,
+	0x88, 0x34, 0x73, 0x36, 0xC4, 0x9B, 0x28, 0x76,
+	0x33, 0x82, 0x26, 0xD3, 0xD5, 0xBB, 0x37, 0xE1,
+	0x1A, 0xFC, 0xE8, 0x4C, 0x41, 0x1D, 0x6C, 0xC6,
+	0xDD, 0xE7, 0xE1, 0x46, 0xC3, 0x5F, 0x0E, 0x0B,
+	0xDD, 0x27, 0x20, 0xD3, 0x3B, 0x61, 0x07, 0xF3,
+	0x6D, 0x76, 0x69, 0x92, 0x99, 0x61, 0x27, 0xCF,
+	0x69, 0x98, 0x04, 0x8F, 0xC1, 0xDA, 0x20, 0x3A,
+	0xB4, 0xE9, 0xC9, 0x8F, 0xB1, 0x85, 0xC1, 0xE0,
+	0xCD, 0x8D, 0xA5, 0x1E, 0xFF, 0xEE, 0xED, 0x1D,
+	0x43, 0xC5, 0x00, 0x90, 0x87, 0xBC, 0x39, 0x27,
+	0x88, 0xE5, 0x51, 0xBB, 0x6E,
+
+	0xED, 0x46, 0x9D, 0x24, 0x18, 0x98, 0x82, 0x1E,
+	0x88, 0x34, 0x73, 0x36, 0xC5, 0x9B, 0x28, 0x76,
+	0xB7, 0x30, 0x2C, 0x9B, 0xDC, 0xAB, 0x35, 0xC3,
+	0x7A, 0xD8, 0xA8, 0xCC, 0x41, 0x3D, 0x7E, 0xC6,
+	0xD2, 0xB3, 0x8B, 0x25, 0x43, 0x08, 0x15, 0x89,
+	0x97, 0x2C, 0xA4, 0x8F, 0x0C, 0x1D, 0x12, 0x8A,
+	0xD9, 0x55, 0xCF, 0x26, 0x86, 0xF0, 0xF3, 0xF1,
+	0xB1, 0x42, 0x8D, 0x73, 0x18, 0x30, 0xFE, 0x09,
+	0xA8, 0x5E, 0xE9, 0xBB, 0xE2, 0xE7, 0x03, 0xFC,
+	0xD5, 0xF1, 0x1D, 0x69, 0xB3, 0x13, 0x40, 0xDD,
+	0x6B, 0x51, 0xD2, 0xCE, 0x92, 0xDC, 0x17, 0xEE,
+	0xC0, 0xBC, 0x02, 0x8C, 0xE4, 0x95,
+
+	0xED, 0xC6, 0x39, 0x00, 0x18, 0x98, 0x82, 0x1E,
+	0x88, 0x34, 0x73, 0x36, 0xC5, 0x88, 0x28, 0x76,
+	0xFF, 0xA6, 0x2E, 0x0A, 0x56, 0x33, 0x71, 0xE1,
+	0x62, 0xF4, 0xB9, 0xCC, 0x45, 0x3D, 0x5E, 0xC6,
+	0xBD, 0x49, 0x40, 0x40, 0xFA, 0xF7, 0xC8, 0x89,
+	0xA1, 0xF3, 0x6E, 0x47, 0xAB, 0x99, 0xB1, 0xAC,
+	0x33, 0x98, 0xEA, 0x84, 0xAA, 0xEA, 0x1D, 0x96,
+	0x5E, 0x04, 0x14, 0xB5, 0xF0, 0x73, 0x4C, 0xC0,
+	0x77, 0xA7, 0x2C, 0xDD, 0xC5, 0xBD, 0x7D, 0x06,
+	0x1C, 0xD1, 0xDB, 0x0B, 0xC8, 0x22, 0x9C, 0x88,
+	0x03, 0x5E, 0xE2, 0x9F, 0x46, 0xC7, 0xAC, 0xBC,
+	0xA5, 0xFB, 0xD9, 0xB8, 0x78, 0x6B, 0xBA,
+
+	0xE9, 0xC6, 0x39, 0x05, 0x18, 0x98, 0x82, 0x1E,
+	0x88, 0x34, 0x73, 0x36, 0xC5, 0x88, 0x2B, 0x76,
+	0x9F, 0xB6, 0x2E, 0x82, 0xD6, 0xF3, 0x71, 0xE5,
+	0x72, 0x74, 0x51, 0xCE, 0xC1, 0x3D, 0x5E, 0xE6,
+	0xCC, 0x4E, 0x10, 0xC5, 0xEA, 0x7F, 0xE0, 0x10,
+	0x21, 0xE2, 0xF2, 0x5F, 0x19, 0x83, 0x29, 0xC3,
+	0x86, 0x17, 0xFC, 0xE7, 0x7C, 0xBE, 0x09, 0x09,
+	0x3B, 0x06, 0x4F, 0xF0, 0x51, 0x9C, 0x00, 0x4D,
+	0xB4, 0xD6, 0xF9, 0xFC, 0x8B, 0xFB, 0xA9, 0x60,
+	0x21, 0x16, 0x22, 0xC9, 0xD4, 0x6C, 0xFE, 0x27,
+	0xEC, 0x7E, 0xF5, 0xDC, 0x29, 0x7C, 0x22, 0x58,
+	0x34, 0x0D, 0x4B, 0x70, 0x5E, 0x06, 0x0F, 0xCF,
+
+	0xBD, 0x13, 0xCF, 0xC9, 0xB9, 0xF7, 0x83, 0x50,
+	0x32, 0x8D, 0xA7, 0x95, 0x19, 0x71, 0x88, 0x6F,
+	0xB8, 0x6A, 0xCA, 0xCC, 0x0F, 0xDF, 0x05, 0xEE,
+	0xDA, 0x88, 0xC7, 0x7F, 0x93, 0xEC, 0x98, 0x4E,
+	0x0B, 0x96, 0x64, 0x4A, 0x85, 0x25, 0x75, 0x00,
+	0xAB, 0x04, 0x91, 0x72, 0x63, 0xE5, 0xAF, 0xE5,
+	0xE5, 0x1A, 0xB5, 0x7B, 0x3F, 0x4D, 0x31, 0xF7,
+	0x73, 0x54, 0x02, 0xFE, 0xEC, 0xFC, 0x6E, 0xB1,
+	0xCE, 0xFC, 0xD4, 0x31, 0x52, 0x0D, 0x62, 0x1A,
+	0x47, 0x81, 0x3E, 0xE6, 0xF6, 0x2E, 0x13, 0xBE,
+	0x0F, 0x03, 0x08, 0x90, 0x0E, 0x0A, 0x32, 0xD4,
+	0xE5, 0x66, 0x3C, 0x91, 0x12, 0x7C, 0xC4, 0xD1,
+	0x8E,
+
+	0xBD, 0x13, 0xCF, 0xC9, 0xB9, 0xB1, 0x81, 0x50,
+	0x32, 0x8D, 0xA7, 0x95, 0x19, 0x71, 0x88, 0x6F,
+	0xB8, 0x7A, 0x5A, 0xCC, 0x0B, 0xBF, 0xE7, 0x86,
+	0xDB, 0xA9, 0xC6, 0x7D, 0x8B, 0xC9, 0x98, 0x0A,
+	0x9E, 0x04, 0x04, 0x06, 0x19, 0x77, 0xC1, 0x57,
+	0xF9, 0x90, 0x85, 0xFA, 0xC7, 0x03, 0x8D, 0x9D,
+	0xED, 0x7F, 0x94, 0xFC, 0x05, 0xBF, 0x75, 0xA8,
+	0x53, 0x8D, 0x5E, 0xE8, 0xE1, 0xEC, 0x27, 0xA6,
+	0x24, 0x37, 0x95, 0x27, 0x5E, 0x44, 0xF7, 0x5B,
+	0x78, 0x38, 0x1C, 0x8B, 0xF9, 0x14, 0x10, 0xCE,
+	0xD0, 0x5E, 0x53, 0xAD, 0xE4, 0x97, 0x6D, 0x4A,
+	0x74, 0xC5, 0x61, 0xDB, 0x36, 0x33, 0x4B, 0xF9,
+	0xCA, 0x60,
+
+	0xFD, 0x03, 0xCF, 0xC9, 0xB9, 0x31, 0x27, 0x74,
+	0x32, 0x8D, 0xA7, 0x95, 0x19, 0x71, 0x88, 0x6F,
+	0xBC, 0x7A, 0x5A, 0x14, 0x2E, 0xF9, 0x99, 0x76,
+	0xEB, 0xEB, 0x94, 0x2C, 0xDB, 0xD5, 0x88, 0x22,
+	0xA4, 0x34, 0xFF, 0x2D, 0x28, 0xB0, 0x0A, 0xFD,
+	0x99, 0xE5, 0x92, 0x0C, 0xFC, 0x48, 0xF3, 0x9F,
+	0xA8, 0xA6, 0x7B, 0x24, 0x0C, 0x88, 0x10, 0xD7,
+	0x70, 0xEB, 0x6D, 0x82, 0x53, 0x54, 0x1E, 0xC5,
+	0x6C, 0xEB, 0xC7, 0xF5, 0x9A, 0x2A, 0x39, 0x5F,
+	0xC5, 0xB4, 0xAE, 0x14, 0x06, 0xD9, 0xDB, 0x94,
+	0x58, 0x59, 0xC6, 0xB4, 0x51, 0x0D, 0xB9, 0x51,
+	0x9D, 0x89, 0x5B, 0xCF, 0xAE, 0xF8, 0x37, 0x71,
+	0xDE, 0x37, 0x5C,
+
+	0xFD, 0x03, 0xCD, 0xC9, 0xBC, 0x31, 0x27, 0x73,
+	0x32, 0x8D, 0xA7, 0x95, 0x19, 0x71, 0x88, 0x6F,
+	0x38, 0x7A, 0x5A, 0x04, 0x0E, 0x99, 0x99, 0x96,
+	0xEA, 0x28, 0x95, 0xEC, 0xFB, 0xD7, 0x50, 0x21,
+	0x1A, 0x64, 0x75, 0x54, 0x78, 0xB5, 0xDB, 0x74,
+	0x8C, 0xA3, 0xB7, 0x11, 0x8B, 0x6B, 0xE6, 0xBD,
+	0x51, 0x2E, 0x11, 0x33, 0xC1, 0xFD, 0x08, 0xA4,
+	0x82, 0x17, 0x51, 0xDA, 0x3D, 0x1C, 0xBC, 0x5F,
+	0x4B, 0xCD, 0x8B, 0x6B, 0xE7, 0x1F, 0x00, 0xBD,
+	0x00, 0x84, 0xEA, 0x8F, 0x05, 0xBA, 0xE9, 0x1A,
+	0xE3, 0xE2, 0xC3, 0x1E, 0x2A, 0x4B, 0xC9, 0xAC,
+	0x94, 0x46, 0xBA, 0x4A, 0xAB, 0xC5, 0x42, 0xF5,
+	0xD6, 0xA3, 0xBD, 0x01,
+
+	0xFD, 0x03, 0xCD, 0xC9, 0xBC, 0x31, 0x07, 0x7B,
+	0x55, 0xEA, 0xA7, 0x15, 0x19, 0x71, 0x88, 0x6F,
+	0x0A, 0x72, 0x6C, 0xC0, 0x2E, 0x75, 0x99, 0x96,
+	0x4E, 0x5E, 0xE3, 0xCA, 0x11, 0xCF, 0x71, 0x24,
+	0x4E, 0x1C, 0xFE, 0x9B, 0x42, 0xA9, 0xB1, 0x7D,
+	0x70, 0xA2, 0x79, 0xEC, 0x39, 0x6D, 0x99, 0xA5,
+	0xE6, 0xB5, 0x9B, 0xEF, 0xD9, 0x08, 0x71, 0xA7,
+	0x4C, 0x4A, 0x5F, 0x79, 0xBB, 0x54, 0xC4, 0x3C,
+	0x8A, 0x89, 0x6F, 0x7A, 0xB2, 0x2C, 0xCD, 0xA4,
+	0xAC, 0x22, 0x47, 0x43, 0xD1, 0xC6, 0x8D, 0x4D,
+	0xA3, 0x9F, 0x83, 0xCF, 0x1A, 0x59, 0xFE, 0x71,
+	0x61, 0x2C, 0x1A, 0x35, 0x8B, 0x12, 0x4B, 0xEA,
+	0x6B, 0xF0, 0x38, 0x07, 0x1D,
+
+	0xFD, 0x03, 0xCD, 0xC9, 0xBC, 0x31, 0x07, 0x7B,
+	0x55, 0xA2, 0xEF, 0x15, 0x19, 0x71, 0x88, 0x6F,
+	0x4B, 0x50, 0xEC, 0xC4, 0x2E, 0x35, 0x9B, 0x9F,
+	0x4A, 0x3E, 0xEA, 0x49, 0x00, 0xC4, 0x70, 0x24,
+	0x90, 0x10, 0xBE, 0x7A, 0x0F, 0x31, 0xDD, 0x00,
+	0xE0, 0xF1, 0x5B, 0xF4, 0xF1, 0x57, 0x22, 0xAC,
+	0x49, 0xC9, 0x04, 0x44, 0x37, 0x71, 0x69, 0xE0,
+	0x09, 0x89, 0xD4, 0xEC, 0x37, 0xF5, 0xE3, 0x64,
+	0x57, 0x4D, 0xBB, 0x10, 0x8C, 0xE3, 0xCB, 0x48,
+	0x07, 0xD7, 0x42, 0xC3, 0xBE, 0x81, 0x7D, 0x16,
+	0x3F, 0xB4, 0x8B, 0x38, 0x61, 0xA5, 0x08, 0x9E,
+	0x5D, 0x45, 0x93, 0x93, 0xE5, 0x3A, 0x66, 0xF4,
+	0x49, 0x23, 0x68, 0x88, 0x3C, 0xF5,
+
+	0xFD, 0x03, 0xCD, 0xC9, 0xBC, 0x3D, 0x07, 0x7B,
+	0x55, 0x22, 0x47, 0x1D, 0x19, 0x71, 0x88, 0x6F,
+	0x09, 0xDD, 0xF9, 0x44, 0x2F, 0x35, 0x9B, 0x9E,
+	0xC8, 0x68, 0xDB, 0x51, 0x10, 0xC3, 0x10, 0x20,
+	0x49, 0x9B, 0x77, 0x28, 0xBC, 0xB6, 0xAF, 0xA4,
+	0xB4, 0xBB, 0x2B, 0x62, 0x70, 0x8B, 0x09, 0x2D,
+	0xBD, 0x20, 0xCA, 0x2C, 0x38, 0x13, 0xF5, 0x32,
+	0x00, 0x7F, 0xAE, 0x69, 0xC1, 0x9C, 0xE8, 0x09,
+	0xC8, 0x6B, 0xE9, 0x4A, 0x9B, 0x82, 0x01, 0xE2,
+	0x4F, 0xD3, 0x58, 0x3F, 0x22, 0x6F, 0x70, 0x64,
+	0x41, 0x8C, 0x7B, 0x36, 0xDC, 0x4C, 0x8D, 0x38,
+	0x64, 0x4C, 0xE5, 0xA3, 0xF2, 0x1C, 0x0F, 0xC2,
+	0xC5, 0x2C, 0xF9, 0xFF, 0x01, 0xC9, 0x15,
+
+	0xFD, 0x03, 0xCD, 0xC9, 0xBC, 0xBD, 0x01, 0x7B,
+	0x54, 0x22, 0x47, 0x14, 0x19, 0x71, 0x88, 0x6F,
+	0x19, 0xD9, 0xA1, 0x40, 0x2F, 0x35, 0x9B, 0x9E,
+	0xC0, 0xF8, 0xFB, 0x74, 0x1C, 0xA7, 0x53, 0xA0,
+	0xC6, 0x83, 0xD4, 0x39, 0xB8, 0x6E, 0xA6, 0xEA,
+	0x34, 0x44, 0x5D, 0xA6, 0x44, 0xAB, 0x21, 0x4A,
+	0xBD, 0x54, 0xF9, 0x0E, 0x2C, 0xB6, 0xCC, 0x25,
+	0x4F, 0xFB, 0x0B, 0x04, 0x06, 0x01, 0x62, 0x04,
+	0x4B, 0x1E, 0x1A, 0x89, 0x6C, 0x1D, 0x15, 0x21,
+	0x94, 0x2D, 0x86, 0x25, 0x67, 0xDC, 0x27, 0xA0,
+	0xB2, 0x56, 0x20, 0x8C, 0x20, 0x78, 0x71, 0x25,
+	0xDF, 0xF7, 0x97, 0x98, 0x19, 0x89, 0xC8, 0x0D,
+	0xA2, 0xBC, 0x7F, 0xAC, 0x93, 0xB0, 0x87, 0x20,
+
+	0xFD, 0x03, 0xCD, 0xC9, 0xBC, 0xBD, 0x01, 0x7B,
+	0x54, 0x22, 0x47, 0x3A, 0x70, 0x11, 0x88, 0xEF,
+	0x98, 0xA9, 0x9B, 0x15, 0x1A, 0x25, 0xAB, 0x9A,
+	0xC0, 0x6D, 0xF2, 0x74, 0x78, 0x5C, 0xC6, 0xE6,
+	0x5F, 0x05, 0x0F, 0xA5, 0x25, 0x7B, 0x2A, 0x2D,
+	0xAB, 0x14, 0x28, 0xED, 0xD6, 0x46, 0xE3, 0x88,
+	0xA3, 0x47, 0xC0, 0x4A, 0x63, 0x26, 0x1A, 0x93,
+	0x29, 0x10, 0x08, 0x2F, 0x3D, 0x73, 0x82, 0x3C,
+	0x82, 0x6B, 0x1C, 0x53, 0x93, 0x87, 0xC1, 0xAA,
+	0x08, 0x0E, 0x72, 0x9B, 0xF9, 0x2C, 0xBF, 0x25,
+	0x3A, 0xB4, 0x83, 0xDA, 0x00, 0xE0, 0x4D, 0xA9,
+	0x29, 0x84, 0x16, 0xA6, 0xD3, 0x33, 0x5E, 0x59,
+	0x89, 0x2A, 0xC1, 0x52, 0xD3, 0x57, 0x65, 0xCE,
+	0xE9,
+
+	0xFD, 0x03, 0xCD, 0xC9, 0xBC, 0xBD, 0x01, 0x7B,
+	0x66, 0x22, 0x47, 0xBA, 0x70, 0x5B, 0xCA, 0xEF,
+	0x42, 0x29, 0xBE, 0xD0, 0x6A, 0x06, 0x8B, 0x8E,
+	0xC0, 0x3D, 0x92, 0x7D, 0x38, 0x15, 0xC6, 0x4D,
+	0x0C, 0xC8, 0x33, 0xD2, 0x9C, 0xF1, 0x02, 0x73,
+	0x45, 0xAD, 0x17, 0x39, 0x7F, 0x71, 0x15, 0x1F,
+	0x62, 0x62, 0x2C, 0x22, 0x07, 0xF1, 0xF4, 0x8C,
+	0xA4, 0x6C, 0xC0, 0xCE, 0xC6, 0x02, 0xA2, 0x22,
+	0x85, 0xAA, 0x09, 0xA7, 0xE0, 0xBC, 0x81, 0xA0,
+	0x29, 0x36, 0xCC, 0xE3, 0x93, 0x1D, 0x6D, 0xFE,
+	0x1F, 0x17, 0xAE, 0xAC, 0xA0, 0x0A, 0xBD, 0x68,
+	0x69, 0xCC, 0xEA, 0xAC, 0x41, 0x4C, 0xC0, 0x4D,
+	0xDF, 0xD9, 0x14, 0x57, 0x0D, 0x49, 0x0E, 0x87,
+	0x34, 0x8D,
+
+	0xFD, 0x03, 0xCD, 0xC9, 0xBC, 0xBD, 0x01, 0x7B,
+	0x46, 0x27, 0x47, 0xBA, 0x70, 0xDB, 0xE0, 0xE7,
+	0xA3, 0xC7, 0x37, 0xD0, 0x6E, 0xB2, 0x9D, 0x2E,
+	0xC4, 0x3D, 0xC6, 0x6F, 0x95, 0x17, 0x9B, 0x59,
+	0x0B, 0x2A, 0xE6, 0x78, 0x91, 0x9B, 0xA3, 0xF1,
+	0x64, 0xA3, 0x5C, 0xBF, 0xAD, 0x58, 0x2A, 0x90,
+	0x01, 0xD5, 0x21, 0xDB, 0xBE, 0xCD, 0x5E, 0x1F,
+	0x75, 0x3B, 0x01, 0x64, 0x55, 0xDA, 0x14, 0x10,
+	0x98, 0x6C, 0x68, 0x40, 0xF7, 0xBF, 0x90, 0xEC,
+	0x4A, 0x65, 0x75, 0x65, 0x86, 0xB3, 0xF3, 0x2C,
+	0x52, 0x37, 0xC1, 0x24, 0x1C, 0xB0, 0xD5, 0x4A,
+	0x82, 0x7E, 0x99, 0x89, 0x8B, 0xAC, 0xFC, 0x13,
+	0x42, 0xE9, 0xFE, 0xDD, 0x8C, 0x15, 0x3C, 0x9F,
+	0xA5, 0xF2, 0xB5,
+
+	0xFD, 0x03, 0xCD, 0xC9, 0xBC, 0xBD, 0x01, 0x7B,
+	0x46, 0x67, 0x41, 0xBA, 0x7B, 0xDB, 0xE0, 0xEC,
+	0x2B, 0x62, 0x34, 0x40, 0xFE, 0xB2, 0x45, 0x2B,
+	0xC8, 0x3C, 0xC6, 0x6F, 0xB4, 0xA7, 0x9B, 0x6D,
+	0x2B, 0x6F, 0xBA, 0x0A, 0xE1, 0xA8, 0xBD, 0x05,
+	0x0F, 0x2D, 0x55, 0x9C, 0xBF, 0xE3, 0x54, 0x70,
+	0xF8, 0xD5, 0xA6, 0x17, 0x3C, 0xB6, 0x56, 0x4C,
+	0x70, 0x42, 0xC9, 0x33, 0x57, 0x0B, 0x25, 0xA6,
+	0x41, 0x09, 0x67, 0x20, 0xF0, 0x66, 0x5F, 0x6A,
+	0xB2, 0xE3, 0xB2, 0xA4, 0x90, 0x7E, 0x12, 0x20,
+	0x31, 0x8D, 0x00, 0xC3, 0x13, 0xA8, 0x7B, 0xB4,
+	0xD1, 0xB2, 0x81, 0x29, 0x9B, 0x42, 0xE3, 0x45,
+	0xD6, 0x82, 0x50, 0x9F, 0x63, 0x7A, 0xE1, 0xB4,
+	0x84, 0x03, 0xF8, 0x22,
+
+	0x16, 0x63, 0xCD, 0x49, 0xBC, 0xBD, 0x01, 0x7B,
+	0x46, 0x67, 0x41, 0xBA, 0x7B, 0xDB, 0xE0, 0xE4,
+	0xB7, 0x1A, 0x8B, 0x42, 0x76, 0x41, 0x5B, 0x25,
+	0xF8, 0x3C, 0xE0, 0x4A, 0xF4, 0x3D, 0x96, 0x6D,
+	0x10, 0x32, 0xF4, 0x1A, 0x1F, 0x1D, 0xBB, 0xBB,
+	0x85, 0xB7, 0x41, 0x58, 0xC2, 0xAE, 0xA0, 0xD9,
+	0xF4, 0xCD, 0x19, 0xDA, 0xD9, 0xAB, 0x95, 0xBF,
+	0x79, 0xA9, 0xE2, 0x5E, 0xDE, 0x1C, 0x9D, 0x5F,
+	0x6D, 0x1B, 0x1E, 0x5D, 0xCB, 0x96, 0x1C, 0x33,
+	0x36, 0x1F, 0x76, 0x04, 0xDB, 0x88, 0x1B, 0xAA,
+	0x70, 0xD9, 0xB2, 0xE8, 0x3F, 0xF5, 0xC7, 0x21,
+	0x48, 0x78, 0x0D, 0xD2, 0xAC, 0x9F, 0xEF, 0x00,
+	0xAC, 0xD6, 0xAC, 0x93, 0xE5, 0xFC, 0x13, 0x73,
+	0x74, 0x0B, 0x9D, 0xDC, 0xA5,
+
+	0x16, 0x2F, 0xCD, 0x49, 0xBC, 0xBD, 0x01, 0x7B,
+	0x46, 0x67, 0x41, 0xBA, 0x4F, 0xDB, 0xE0, 0xE4,
+	0x93, 0xD2, 0x88, 0x0E, 0x7D, 0x51, 0x5A, 0x21,
+	0x11, 0x18, 0x60, 0x4E, 0xF4, 0x3D, 0xB6, 0x65,
+	0x33, 0xA8, 0x3E, 0xA9, 0x29, 0x08, 0x7C, 0xF3,
+	0xE0, 0xF8, 0x99, 0xE2, 0xD0, 0x08, 0x83, 0xD5,
+	0x37, 0xA8, 0xE8, 0x2D, 0xA2, 0x83, 0xAE, 0x97,
+	0xB8, 0x67, 0xB2, 0x74, 0x2E, 0x08, 0x43, 0x30,
+	0xEF, 0xDE, 0x99, 0xB6, 0x4D, 0xBF, 0x10, 0xAA,
+	0x34, 0xE5, 0xFC, 0xC4, 0xF9, 0x79, 0x27, 0x07,
+	0x48, 0x8D, 0x8E, 0x91, 0xB8, 0x13, 0x01, 0x59,
+	0xFA, 0x6E, 0x5A, 0xF8, 0x0C, 0x16, 0xEF, 0x35,
+	0xDE, 0xD8, 0xFD, 0xB7, 0x3F, 0xFC, 0xA2, 0x2A,
+	0x8C, 0xBF, 0x0C, 0xA8, 0x55, 0x7E,
+
+	0x16, 0xAF, 0x61, 0x69, 0xBC, 0xBD, 0x01, 0x7B,
+	0x46, 0x67, 0x41, 0xBA, 0x2F, 0xCE, 0xE0, 0xE4,
+	0x5A, 0x50, 0xCA, 0x8A, 0xCC, 0x4A, 0x70, 0x77,
+	0xE1, 0xB9, 0x75, 0x8E, 0xF4, 0x3D, 0xB2, 0xD6,
+	0x93, 0xA9, 0x11, 0x36, 0x6D, 0xEC, 0x5C, 0x5B,
+	0x20, 0x81, 0x60, 0x02, 0x40, 0x9D, 0xFA, 0x4C,
+	0xB7, 0x0F, 0xD4, 0x4F, 0xCC, 0x1B, 0x9F, 0x6B,
+	0xE0, 0x5D, 0x5F, 0x37, 0xAE, 0x14, 0x99, 0xF7,
+	0xB5, 0x67, 0x9E, 0x41, 0x98, 0xFF, 0x92, 0x9D,
+	0xCF, 0x05, 0xA7, 0x98, 0x5C, 0x90, 0x94, 0x4E,
+	0x86, 0xE4, 0xF0, 0x1E, 0x7E, 0xE2, 0xF5, 0x2A,
+	0x46, 0x5E, 0x34, 0xEA, 0x48, 0xAC, 0xB7, 0x67,
+	0x23, 0xA3, 0x62, 0x71, 0x38, 0xAE, 0x23, 0x6F,
+	0x47, 0x10, 0x13, 0x72, 0x72, 0xC5, 0x34,
+
+	0x1F, 0xAF, 0x61, 0x64, 0xBC, 0xBD, 0x01, 0x7B,
+	0x46, 0x67, 0x41, 0xBA, 0x2F, 0x4E, 0xE4, 0xE4,
+	0x33, 0xC0, 0x8A, 0x92, 0x4A, 0x6C, 0x73, 0x33,
+	0x31, 0xBD, 0x5D, 0x88, 0x74, 0x3D, 0xB2, 0xD6,
+	0x98, 0x0A, 0xF6, 0xFC, 0x99, 0xDC, 0x52, 0xAA,
+	0xD0, 0xD9, 0x56, 0xBC, 0x60, 0x12, 0xBB, 0xDA,
+	0x81, 0x61, 0x33, 0xF4, 0xFD, 0x57, 0x73, 0xE2,
+	0x3A, 0x64, 0x3D, 0x3B, 0xE4, 0xD6, 0x60, 0x5E,
+	0xF8, 0x14, 0x5C, 0xF3, 0xD4, 0x9F, 0xA3, 0x50,
+	0xC9, 0x54, 0x5C, 0x02, 0x17, 0x50, 0x8A, 0x59,
+	0xEF, 0x2C, 0x52, 0x3E, 0xE7, 0xB7, 0x81, 0xD4,
+	0x0A, 0x84, 0xAA, 0xEA, 0x72, 0x8B, 0xCD, 0x76,
+	0xA6, 0x3C, 0x10, 0x98, 0xCD, 0xCA, 0xA5, 0x17,
+	0xA8, 0x79, 0xDB, 0x2E, 0x33, 0x83, 0xD5, 0x37,
+
+	0xB1, 0x2E, 0xE4, 0xE9, 0x7C, 0x6D, 0x01, 0xA6,
+	0x16, 0x44, 0x29, 0xD3, 0x8D, 0x71, 0x45, 0xCA,
+	0x11, 0x05, 0xDE, 0x9E, 0xF6, 0xD2, 0x82, 0xD5,
+	0x9C, 0xDA, 0x3F, 0x07, 0xAA, 0x70, 0xDC, 0xE9,
+	0xEE, 0xD7, 0x7F, 0x3C, 0x79, 0x1A, 0xDA, 0x87,
+	0x80, 0xE5, 0x84, 0xEF, 0x9A, 0xBF, 0x88, 0xD0,
+	0x82, 0x03, 0x97, 0x52, 0xE0, 0x88, 0x88, 0x7B,
+	0x67, 0xC3, 0x08, 0xE0, 0xFB, 0x7B, 0x83, 0xAF,
+	0xFC, 0x4F, 0x50, 0x27, 0xF8, 0x69, 0x43, 0x5E,
+	0xB2, 0xE4, 0x35, 0x71, 0x7A, 0x6E, 0x9A, 0xD8,
+	0xF3, 0x8E, 0x38, 0x54, 0xE2, 0xFA, 0x21, 0x6E,
+	0x9A, 0xAB, 0xBC, 0x94, 0x01, 0x9A, 0xBF, 0xC0,
+	0x4F, 0xC0, 0x95, 0x20, 0xFF, 0x3A, 0x4A, 0x8A,
+	0xB0, 0x65, 0x83, 0xD3, 0x61, 0x4B, 0x3B, 0x54,
+	0x78,
+
+	0x95, 0x2E, 0xE4, 0xE9, 0x7C, 0x23, 0x07, 0xA6,
+	0x16, 0x44, 0x29, 0xD3, 0x8D, 0x71, 0x45, 0xCA,
+	0x11, 0x55, 0x7E, 0x96, 0xE2, 0x9A, 0xC8, 0x3C,
+	0xC7, 0xDA, 0x29, 0x85, 0xFA, 0x51, 0x9C, 0x6D,
+	0xC6, 0x01, 0x11, 0x03, 0x12, 0x08, 0x82, 0x39,
+	0x43, 0x79, 0x28, 0x6A, 0xA1, 0x40, 0x46, 0x97,
+	0x8F, 0x47, 0x1B, 0x43, 0xB1, 0xF0, 0x4B, 0xF0,
+	0xA2, 0x81, 0x5A, 0xC0, 0x91, 0x2D, 0x12, 0xB4,
+	0x74, 0x93, 0x3C, 0x64, 0x75, 0x27, 0x42, 0xCB,
+	0x89, 0x4A, 0x73, 0xF2, 0x49, 0xF2, 0xAE, 0x47,
+	0x5E, 0xBB, 0xBB, 0xFE, 0xF3, 0xEC, 0x32, 0xE5,
+	0x24, 0xDE, 0x25, 0x8E, 0x85, 0x6A, 0x73, 0xCD,
+	0x34, 0xD0, 0x6F, 0xAF, 0xE8, 0x03, 0x42, 0xC1,
+	0x59, 0x1A, 0x98, 0xC2, 0x95, 0x5F, 0x25, 0xED,
+	0xBA, 0xCC,
+
+	0xF5, 0x3E, 0xE4, 0xE9, 0x7C, 0xA3, 0x29, 0xA8,
+	0x16, 0x44, 0x29, 0xD3, 0x8D, 0x71, 0x45, 0xCA,
+	0x10, 0x55, 0x7A, 0xB4, 0x0B, 0x98, 0x16, 0xC9,
+	0xFF, 0xD2, 0x31, 0x97, 0x5B, 0xCD, 0x88, 0x2D,
+	0x9B, 0x58, 0x3F, 0xF2, 0x2C, 0x0E, 0x57, 0x2A,
+	0x39, 0x34, 0xF6, 0x31, 0x15, 0xF8, 0x52, 0x1D,
+	0xA7, 0xCA, 0x7B, 0xCA, 0x0D, 0xDE, 0x9A, 0x3B,
+	0x15, 0xF1, 0x2E, 0x08, 0x1C, 0x45, 0x10, 0x3A,
+	0x2B, 0xCF, 0xB0, 0x7E, 0xF0, 0x8C, 0x2B, 0x65,
+	0x71, 0xB9, 0xFF, 0x2E, 0x63, 0x37, 0x2D, 0x8A,
+	0xCE, 0xA3, 0x1A, 0xA9, 0x21, 0x40, 0x8F, 0x58,
+	0xEB, 0xFA, 0x52, 0xD9, 0xC3, 0x1A, 0x15, 0x6E,
+	0xC2, 0xDA, 0xAA, 0xCD, 0x8B, 0xBE, 0x32, 0x0F,
+	0x44, 0xD0, 0xEE, 0x5F, 0x91, 0x28, 0xEB, 0x14,
+	0xD1, 0x7D, 0xE6,
+
+	0xF5, 0x7E, 0xE0, 0xE9, 0x7B, 0xA3, 0x29, 0xA7,
+	0x16, 0x44, 0x29, 0xD3, 0x8D, 0x71, 0x45, 0xCA,
+	0xB8, 0x54, 0x7A, 0x84, 0xFB, 0x69, 0x16, 0x8D,
+	0x6D, 0xF6, 0x13, 0x95, 0x2B, 0xAD, 0x81, 0x2A,
+	0xE3, 0xEF, 0x62, 0xA4, 0x25, 0x6D, 0xA8, 0xE9,
+	0x5D, 0x51, 0xA3, 0xA4, 0x92, 0xB7, 0x63, 0x48,
+	0x34, 0xAD, 0x2F, 0x68, 0xA7, 0xF6, 0x7C, 0xFB,
+	0x19, 0x91, 0x6B, 0x7B, 0xB5, 0xB3, 0x07, 0x33,
+	0xB8, 0xC0, 0xB4, 0x9F, 0x7B, 0x08, 0x99, 0xEE,
+	0x34, 0x51, 0x26, 0xFC, 0xBE, 0x96, 0x05, 0xF8,
+	0x1F, 0x03, 0xB4, 0xC1, 0x81, 0xA6, 0x05, 0xEE,
+	0xAC, 0x59, 0x69, 0x83, 0xDA, 0x15, 0x14, 0x50,
+	0x53, 0x1A, 0x94, 0xC0, 0x7E, 0x55, 0xA9, 0xED,
+	0x82, 0x4C, 0x3E, 0x48, 0x86, 0x80, 0x59, 0xA8,
+	0x91, 0x44, 0xE2, 0x14,
+
+	0xF5, 0x7E, 0xE0, 0xE9, 0x7B, 0xA3, 0x29, 0x87,
+	0x79, 0x43, 0x29, 0x53, 0x8D, 0x71, 0x45, 0xCA,
+	0x8E, 0xDC, 0x38, 0xB1, 0xB3, 0x05, 0x1F, 0x8D,
+	0x9D, 0x07, 0xEC, 0xB3, 0xA9, 0xD6, 0xC2, 0x2F,
+	0xF2, 0x56, 0x2D, 0xFF, 0x31, 0x5E, 0x0D, 0xFB,
+	0x2F, 0x12, 0x05, 0xA0, 0x54, 0xE3, 0xA0, 0xFE,
+	0xFD, 0x7B, 0xDB, 0x22, 0x67, 0x78, 0x37, 0xD1,
+	0x3E, 0xC5, 0x2B, 0x7C, 0x0E, 0xC6, 0xFB, 0xEB,
+	0x52, 0xFD, 0xAA, 0x46, 0x1A, 0xB3, 0x89, 0xDE,
+	0x4A, 0xD6, 0x9E, 0x0C, 0x4C, 0xE9, 0x47, 0xE5,
+	0xBB, 0xC7, 0x1B, 0x97, 0x7B, 0x2F, 0xB0, 0x5C,
+	0x6D, 0x43, 0x4D, 0x3C, 0x71, 0x99, 0xF7, 0x0D,
+	0x39, 0x84, 0xC4, 0xEE, 0xD0, 0x5E, 0x09, 0xEF,
+	0xBA, 0x25, 0xC2, 0xC6, 0x65, 0x6D, 0x23, 0x39,
+	0xA5, 0x5E, 0x8B, 0x10, 0x11,
+
+	0xF5, 0x7E, 0xE0, 0xE9, 0x43, 0xA3, 0x29, 0x87,
+	0x79, 0x13, 0x79, 0x53, 0x8D, 0x71, 0x45, 0xCA,
+	0x0E, 0xF3, 0x38, 0x34, 0xB3, 0x05, 0x1F, 0x84,
+	0x98, 0x45, 0xE5, 0xB1, 0xA3, 0xD6, 0xCB, 0x07,
+	0xFE, 0xB6, 0x2D, 0x97, 0xB5, 0x8E, 0x93, 0x08,
+	0x6E, 0x8F, 0xB5, 0x4C, 0x10, 0x90, 0x08, 0x8E,
+	0x33, 0x77, 0x59, 0x1F, 0xB4, 0x70, 0xD8, 0x26,
+	0x71, 0x68, 0xAA, 0x58, 0x39, 0x5D, 0x7E, 0x53,
+	0x1B, 0xF5, 0x3E, 0xC6, 0x4D, 0x63, 0x9B, 0x67,
+	0xDA, 0xCE, 0x9E, 0x50, 0x4B, 0x92, 0x0D, 0xD0,
+	0x5D, 0x9F, 0x09, 0x35, 0xD3, 0x3D, 0x70, 0x73,
+	0xF1, 0x09, 0xFD, 0xB6, 0xF7, 0xAF, 0xD2, 0xE6,
+	0x0F, 0x57, 0xA3, 0x86, 0x3B, 0xDE, 0x88, 0xC1,
+	0x8D, 0x11, 0xE9, 0x08, 0x26, 0xCF, 0x5C, 0xD0,
+	0xFE, 0xB7, 0x58, 0xC6, 0x79, 0x95,
+
+	0xF5, 0x7E, 0xE0, 0xE9, 0x23, 0xB3, 0x29, 0x87,
+	0x79, 0x93, 0xC9, 0x63, 0x8D, 0x71, 0x45, 0xCA,
+	0x2C, 0x3F, 0x21, 0x34, 0xB7, 0x05, 0x1F, 0x84,
+	0x94, 0x40, 0xF1, 0x2B, 0xAB, 0xDA, 0xC9, 0x1B,
+	0xAE, 0xB6, 0x45, 0x41, 0x69, 0x08, 0x23, 0xDB,
+	0xA1, 0xA4, 0xE9, 0x34, 0xE8, 0x82, 0x5A, 0xFF,
+	0x89, 0x22, 0x44, 0xAE, 0x33, 0x64, 0x42, 0xF0,
+	0xF7, 0x32, 0xDB, 0x73, 0x8B, 0x2C, 0x63, 0x78,
+	0x2F, 0xF9, 0x0A, 0xDB, 0xB1, 0x31, 0x1A, 0x90,
+	0x9E, 0x02, 0xF9, 0x86, 0x74, 0x55, 0x22, 0x8E,
+	0xE7, 0x4B, 0x7F, 0x14, 0xBE, 0x93, 0x29, 0x10,
+	0x1D, 0x7C, 0xC6, 0xEF, 0x14, 0x08, 0xF3, 0x3B,
+	0x19, 0xB0, 0x6C, 0xF3, 0x16, 0x9F, 0x70, 0x34,
+	0xDE, 0x00, 0x4A, 0x1D, 0x8C, 0x25, 0xA5, 0xA8,
+	0x6F, 0xA6, 0x0E, 0x79, 0x1D, 0xFA, 0xC8,
+
+	0xF5, 0x7E, 0xE0, 0xE9, 0x23, 0x33, 0x25, 0x87,
+	0x79, 0x93, 0xC9, 0x72, 0x8D, 0x71, 0x45, 0xCA,
+	0x2C, 0x5F, 0x3B, 0x3C, 0xD3, 0x05, 0x1F, 0x84,
+	0x96, 0x52, 0xD0, 0xC9, 0xA3, 0xA2, 0xCF, 0x99,
+	0x3E, 0x07, 0x16, 0x51, 0x29, 0x34, 0xA0, 0xB3,
+	0x24, 0xD8, 0xCE, 0x74, 0x39, 0xE4, 0x14, 0x77,
+	0x91, 0x24, 0x51, 0x6D, 0x70, 0xFC, 0xDD, 0x37,
+	0x35, 0x99, 0x9C, 0xDA, 0x7E, 0x5E, 0xC8, 0x99,
+	0x7A, 0x7B, 0xC2, 0x6F, 0xDF, 0x3B, 0x20, 0xA1,
+	0xF2, 0x30, 0x11, 0x48, 0x82, 0xF9, 0x55, 0x30,
+	0x06, 0x07, 0x5D, 0x45, 0xBF, 0xE4, 0xCD, 0xBE,
+	0xC4, 0x5C, 0xA9, 0xA5, 0x76, 0x4E, 0x80, 0xDE,
+	0x74, 0x45, 0xEA, 0x41, 0xA6, 0x02, 0xEA, 0x04,
+	0x13, 0x42, 0x5C, 0xAB, 0x85, 0xAC, 0x8D, 0x22,
+	0x00, 0xCF, 0xB1, 0xF4, 0x15, 0x07, 0xB9, 0x37,
+
+	0xF5, 0x7E, 0xE0, 0xE9, 0x23, 0x33, 0x25, 0x87,
+	0x79, 0x93, 0x69, 0x52, 0xFC, 0x11, 0x45, 0x4A,
+	0xEE, 0xDE, 0x5B, 0x26, 0xED, 0x05, 0x0E, 0xD4,
+	0x96, 0x27, 0xD5, 0xC9, 0xEA, 0xAE, 0xD0, 0x9F,
+	0xA4, 0xF5, 0x73, 0x04, 0xA9, 0x7F, 0x85, 0xDC,
+	0x6E, 0x34, 0x44, 0xCD, 0x35, 0xC3, 0xF6, 0xD9,
+	0x4E, 0x51, 0x13, 0x62, 0xEE, 0x61, 0x7C, 0x4D,
+	0xF2, 0x4D, 0x67, 0xC9, 0x5F, 0xB7, 0x02, 0x99,
+	0x02, 0x2D, 0x1D, 0x1A, 0xB7, 0xA1, 0xFD, 0x03,
+	0xF1, 0x6B, 0xC8, 0xC7, 0x2A, 0xB0, 0x44, 0xED,
+	0x08, 0xE7, 0x25, 0xAF, 0xBE, 0x3F, 0xA3, 0x26,
+	0x3A, 0x0B, 0x3D, 0xD6, 0x06, 0x38, 0x4F, 0xD4,
+	0xB2, 0x39, 0xC3, 0x87, 0x2B, 0x69, 0x3B, 0xAF,
+	0x50, 0x14, 0x45, 0xF7, 0x46, 0x3A, 0xE2, 0x55,
+	0xF5, 0x54, 0x02, 0x69, 0x8B, 0xDD, 0xCF, 0x72,
+	0x99,
+
+	0xF5, 0x7E, 0xE0, 0xE9, 0x23, 0x33, 0x25, 0x87,
+	0x71, 0x93, 0x69, 0x52, 0xFC, 0x43, 0x57, 0x4A,
+	0xF4, 0xFC, 0x72, 0xA7, 0x5E, 0x2B, 0x2E, 0xF5,
+	0x96, 0x27, 0xD7, 0xC3, 0x6F, 0xA6, 0xC9, 0xBD,
+	0xD3, 0xB0, 0x1B, 0x37, 0x9A, 0x89, 0xB1, 0x2B,
+	0xE7, 0x6E, 0xC2, 0x28, 0xDC, 0x3E, 0xB9, 0xAD,
+	0xF2, 0xC9, 0x99, 0x9F, 0xC5, 0x97, 0x5E, 0x71,
+	0x6A, 0x51, 0xF8, 0xC3, 0xB8, 0x46, 0xA2, 0xBC,
+	0x2B, 0x81, 0x73, 0x8D, 0xD9, 0xE5, 0xB4, 0x79,
+	0x4A, 0x61, 0x49, 0x48, 0xDC, 0x38, 0x8B, 0x73,
+	0xD5, 0x96, 0xE9, 0xD2, 0xB5, 0x7A, 0xE6, 0xAC,
+	0xE1, 0xBA, 0xB8, 0xD2, 0x2A, 0xCD, 0xEF, 0xF6,
+	0x3E, 0x6F, 0x4E, 0x68, 0xB1, 0xDA, 0xDF, 0x12,
+	0x73, 0xEA, 0x7C, 0xD9, 0xEC, 0x9C, 0x88, 0xD8,
+	0xB8, 0x71, 0xF3, 0x93, 0x95, 0xEE, 0x16, 0x1A,
+	0xEE, 0x7E,
+
+	0xF5, 0x7E, 0xE0, 0xE9, 0x23, 0x33, 0x25, 0x87,
+	0x71, 0x87, 0x69, 0x52, 0xFC, 0xC3, 0x65, 0x78,
+	0x04, 0xFE, 0xF0, 0x2E, 0x7D, 0xF7, 0x35, 0xFD,
+	0x92, 0x27, 0xC7, 0xC1, 0x4C, 0xA1, 0xD3, 0xE2,
+	0x1E, 0x8A, 0x56, 0xCB, 0x24, 0xD7, 0x62, 0x80,
+	0x92, 0xA0, 0x52, 0x5F, 0xA3, 0x1A, 0xA7, 0xF8,
+	0x56, 0xC2, 0x9F, 0x60, 0xDA, 0x03, 0xD2, 0x69,
+	0xD3, 0x12, 0x9D, 0xBD, 0x94, 0xD1, 0x0B, 0x70,
+	0xDF, 0xD6, 0x3A, 0xD6, 0x6E, 0xEE, 0xA9, 0x9A,
+	0x3C, 0x8E, 0x0C, 0xD3, 0x79, 0x93, 0x91, 0x50,
+	0xA0, 0x48, 0x43, 0xA9, 0xAB, 0x47, 0x4B, 0x35,
+	0xF5, 0xFD, 0x1E, 0xC7, 0xD7, 0x99, 0xC2, 0x1B,
+	0x54, 0xB0, 0x07, 0x29, 0x4B, 0xC2, 0x5B, 0x9E,
+	0x1E, 0x6A, 0x3A, 0x7B, 0x60, 0x94, 0x96, 0xB5,
+	0xD5, 0xCA, 0x81, 0x8B, 0x0F, 0x1A, 0xC3, 0x0B,
+	0x3F, 0x4C, 0xB4,
+
+	0xF5, 0x7E, 0xE0, 0xE9, 0x23, 0x33, 0x25, 0x87,
+	0x71, 0x87, 0x64, 0x52, 0xEE, 0xC3, 0x65, 0x6B,
+	0x14, 0x56, 0xF7, 0x8E, 0x4D, 0xD7, 0x0D, 0xF4,
+	0xDA, 0x25, 0xC7, 0xC1, 0x2C, 0xB1, 0xD2, 0x66,
+	0xD8, 0x08, 0x44, 0xEE, 0x8C, 0x0C, 0x78, 0xC5,
+	0x53, 0x7E, 0xD9, 0x2F, 0xFC, 0x34, 0x2E, 0x7C,
+	0x41, 0xAB, 0xB7, 0xA3, 0xB4, 0x28, 0x7D, 0xAE,
+	0xF2, 0x4E, 0xFE, 0x08, 0xE3, 0x4D, 0x99, 0x42,
+	0x00, 0xCC, 0x5A, 0xC0, 0x06, 0xD5, 0xD1, 0x33,
+	0x75, 0xD1, 0x7F, 0xCB, 0xF9, 0xCC, 0xF6, 0x45,
+	0xAF, 0x3F, 0x00, 0xBD, 0x47, 0x7A, 0x53, 0x74,
+	0x2B, 0x73, 0x90, 0xA7, 0xAA, 0xDA, 0x74, 0x94,
+	0x57, 0xFC, 0x21, 0x05, 0x0C, 0x48, 0xCC, 0x12,
+	0x42, 0xB6, 0xC6, 0xF5, 0xEB, 0xE0, 0xA0, 0xB2,
+	0x29, 0xFA, 0x60, 0xB0, 0xE0, 0xBD, 0x28, 0x44,
+	0x91, 0x46, 0xD9, 0xE1,
+
+	0x86, 0x2F, 0xE0, 0x69, 0x23, 0x33, 0x25, 0x87,
+	0x71, 0x87, 0x64, 0x52, 0xEE, 0xC3, 0x65, 0x6A,
+	0x6C, 0x20, 0xC4, 0x89, 0x3F, 0xF6, 0x36, 0xEF,
+	0xE5, 0x2D, 0x84, 0xA5, 0xA4, 0x5D, 0xDC, 0x66,
+	0x35, 0x86, 0xC5, 0x6B, 0x7A, 0xC4, 0xF6, 0xAA,
+	0xD2, 0x0C, 0x76, 0x87, 0xE5, 0xCB, 0x27, 0x56,
+	0xA7, 0x19, 0xAB, 0xD4, 0xC4, 0x07, 0xCF, 0xE5,
+	0x25, 0xB3, 0xDA, 0x9A, 0xD4, 0x8B, 0x38, 0x37,
+	0x2E, 0x40, 0xD6, 0xFC, 0x5D, 0x6D, 0xB3, 0x7A,
+	0x51, 0xA1, 0x82, 0x15, 0x99, 0x7D, 0x4E, 0x84,
+	0x2A, 0x60, 0xEE, 0xD7, 0x6E, 0xE1, 0x57, 0x4E,
+	0x0D, 0x32, 0xCC, 0xFE, 0x99, 0xB0, 0x84, 0x46,
+	0xBC, 0xC0, 0xE9, 0x2C, 0xEA, 0x7C, 0x24, 0xC9,
+	0xC2, 0x08, 0xEA, 0xDD, 0x6B, 0x5C, 0xAF, 0x3D,
+	0xE1, 0x97, 0x22, 0x95, 0x1A, 0x13, 0xFC, 0x7F,
+	0x97, 0xAA, 0x33, 0x8C, 0x95,
+
+	0x86, 0x7B, 0xF0, 0x69, 0x23, 0x33, 0x25, 0x87,
+	0x71, 0x87, 0x64, 0x52, 0xC4, 0xC3, 0x65, 0x6A,
+	0xE9, 0x82, 0x46, 0xC1, 0x2A, 0xA7, 0x3E, 0x45,
+	0x07, 0x00, 0xC4, 0x04, 0xA4, 0x5D, 0x5C, 0x67,
+	0x6D, 0x06, 0xE7, 0x17, 0xD9, 0x44, 0x2C, 0x21,
+	0x54, 0xB9, 0x37, 0x20, 0x5B, 0xF3, 0xF4, 0x77,
+	0xBC, 0x17, 0xA2, 0x54, 0xD6, 0xC4, 0x81, 0xB2,
+	0x29, 0x8F, 0xE0, 0x03, 0xC6, 0x0B, 0x80, 0xD6,
+	0x5E, 0x95, 0x5B, 0x60, 0x44, 0x61, 0xD0, 0xD0,
+	0x1B, 0x2A, 0x01, 0x6A, 0xAB, 0xF3, 0x7B, 0x4D,
+	0x9A, 0xFE, 0xBB, 0x61, 0x4B, 0xB8, 0xB6, 0xB9,
+	0xC5, 0xC2, 0x42, 0x5C, 0x52, 0x3D, 0xDA, 0x21,
+	0x8A, 0x22, 0x85, 0x65, 0x9B, 0xE4, 0xBE, 0xB7,
+	0x58, 0xD5, 0x00, 0xB6, 0x56, 0x6C, 0x51, 0xDE,
+	0x11, 0x7C, 0x15, 0x9B, 0x13, 0x1B, 0x9C, 0x31,
+	0x86, 0x8D, 0xAB, 0xC4, 0x34, 0x44,
+
+	0x86, 0xFB, 0xC4, 0x4D, 0x23, 0x33, 0x25, 0x87,
+	0x71, 0x87, 0x64, 0x52, 0xE4, 0xC0, 0x65, 0x6A,
+	0xA7, 0x81, 0xA0, 0xF5, 0x4A, 0xA4, 0x36, 0xCD,
+	0x1E, 0xA4, 0xDC, 0x0C, 0xA2, 0x5D, 0x58, 0x36,
+	0x0A, 0x99, 0xE6, 0x04, 0xBE, 0xE8, 0xE2, 0x40,
+	0xB2, 0x0F, 0x48, 0x13, 0xB1, 0x14, 0xAB, 0x88,
+	0xFF, 0xB6, 0x92, 0x11, 0x7B, 0x02, 0x3B, 0x9B,
+	0xE0, 0xC1, 0xC7, 0xC7, 0x5F, 0x01, 0xAA, 0x8E,
+	0x2F, 0xED, 0xED, 0xB3, 0xA1, 0xB7, 0x72, 0xBA,
+	0x37, 0x18, 0x9E, 0xEE, 0x5D, 0xE8, 0xAE, 0x11,
+	0x91, 0xC1, 0x6C, 0xC8, 0x45, 0x05, 0x58, 0x20,
+	0x0A, 0x35, 0x33, 0x17, 0x19, 0x00, 0x6C, 0xC9,
+	0xA9, 0x96, 0xB7, 0xA4, 0xAD, 0x74, 0x70, 0x39,
+	0xC5, 0x8D, 0x76, 0x9E, 0x99, 0x21, 0x93, 0x14,
+	0x78, 0x1F, 0x49, 0xA8, 0x62, 0x54, 0x06, 0x98,
+	0x9D, 0x62, 0x4D, 0x75, 0xEF, 0xDD, 0xBD,
+
+	0x93, 0xFB, 0xC4, 0x58, 0x23, 0x33, 0x25, 0x87,
+	0x71, 0x87, 0x64, 0x52, 0xE4, 0x40, 0x6E, 0x6A,
+	0xC7, 0xD1, 0x01, 0x31, 0xE8, 0x7E, 0x54, 0x4B,
+	0x4E, 0xD3, 0xE4, 0x07, 0x86, 0x5D, 0x58, 0xB6,
+	0x5A, 0xED, 0xDB, 0xD3, 0x92, 0x9B, 0xC2, 0x51,
+	0x94, 0xAD, 0x0A, 0x13, 0xBB, 0x88, 0xE3, 0xC6,
+	0x14, 0xF3, 0x20, 0x50, 0xC1, 0xF4, 0xC3, 0x34,
+	0xCA, 0xFC, 0x2A, 0xE5, 0xFC, 0xDF, 0x85, 0x81,
+	0x32, 0x91, 0x72, 0xBD, 0xB2, 0x40, 0x35, 0x91,
+	0x14, 0x4F, 0x27, 0xC9, 0x74, 0xF3, 0x2A, 0xE2,
+	0x79, 0x2B, 0x78, 0x4B, 0xB3, 0xEF, 0x1E, 0xFA,
+	0x2F, 0xBA, 0xB5, 0xB3, 0xDA, 0x44, 0xA1, 0x00,
+	0x16, 0x82, 0x26, 0x97, 0xC9, 0xA1, 0x6B, 0x40,
+	0xC5, 0xC2, 0xB9, 0x6B, 0x44, 0x34, 0xF9, 0xFB,
+	0x39, 0xAE, 0x10, 0x09, 0x3C, 0xA2, 0x54, 0x5B,
+	0xA0, 0xA9, 0x58, 0x5E, 0xAD, 0xDC, 0x32, 0xB8,
+
+	0x67, 0xFF, 0x66, 0x50, 0xD5, 0x67, 0x8E, 0x5C,
+	0x15, 0x66, 0x90, 0x70, 0x36, 0x51, 0xAA, 0x92,
+	0xEC, 0x57, 0xBD, 0x50, 0xBB, 0xF9, 0x74, 0xD2,
+	0x67, 0x09, 0xA4, 0x58, 0x64, 0x3A, 0xFB, 0x59,
+	0x52, 0xAF, 0xCD, 0xFC, 0xF8, 0x20, 0xB3, 0x81,
+	0x05, 0x59, 0xA4, 0xF1, 0xF1, 0x93, 0x52, 0x6F,
+	0xC3, 0xB2, 0x77, 0x2B, 0xF2, 0x6D, 0xF0, 0xAD,
+	0xB0, 0xD7, 0x37, 0x89, 0x4E, 0xD8, 0xF4, 0x02,
+	0x14, 0x3A, 0xE1, 0xA1, 0x85, 0x1E, 0xF1, 0x39,
+	0xEF, 0xBA, 0x06, 0xE6, 0x6A, 0x38, 0x55, 0x88,
+	0xB1, 0xF4, 0x9A, 0x0D, 0x06, 0x11, 0x39, 0x24,
+	0x70, 0xA5, 0x75, 0x10, 0x6F, 0x4A, 0xC7, 0xDE,
+	0x51, 0x20, 0x7D, 0x22, 0xDF, 0xA8, 0xB7, 0x0B,
+	0x91, 0xC7, 0xD3, 0x68, 0x3B, 0x7A, 0xC0, 0xCC,
+	0xBF, 0x32, 0x6F, 0xED, 0x9B, 0x32, 0x25, 0xEA,
+	0xCE, 0xDA, 0xCF, 0xAF, 0xCC, 0x6A, 0xAB, 0xF4,
+	0x0B,
+
+	0x6D, 0xFF, 0x66, 0x50, 0xD5, 0x31, 0xC8, 0x5C,
+	0x15, 0x66, 0x90, 0x70, 0x36, 0x51, 0xAA, 0x92,
+	0xEC, 0xF7, 0x7D, 0x50, 0xBE, 0x33, 0xA6, 0xB5,
+	0x65, 0x03, 0x0E, 0xD8, 0xB7, 0x16, 0xDB, 0x5D,
+	0x74, 0xFF, 0x58, 0x1A, 0x16, 0x36, 0x6B, 0x8F,
+	0xFE, 0x91, 0x04, 0x2D, 0xFF, 0x9C, 0xBB, 0xC9,
+	0x37, 0x98, 0x78, 0x32, 0xBD, 0xD3, 0x1C, 0xF0,
+	0xAA, 0x3E, 0x60, 0x2C, 0x7A, 0x3E, 0xEE, 0xFF,
+	0x32, 0x92, 0x72, 0x34, 0x9F, 0xCA, 0x2C, 0x60,
+	0xA2, 0xE3, 0x85, 0x04, 0xBE, 0x9F, 0x78, 0x3E,
+	0x3C, 0x10, 0x2A, 0xD7, 0x94, 0x06, 0x20, 0x7A,
+	0x83, 0x2B, 0x51, 0x40, 0xD0, 0x26, 0xE9, 0x49,
+	0xFD, 0x09, 0x53, 0x41, 0xB4, 0x2A, 0x3F, 0x82,
+	0x1F, 0xA3, 0x5E, 0xB6, 0xC7, 0xB2, 0xD8, 0x2A,
+	0x04, 0xAB, 0x5A, 0x14, 0x06, 0xA4, 0x57, 0x4D,
+	0xC0, 0x78, 0x22, 0xC5, 0xF5, 0x2E, 0x73, 0x4C,
+	0xAF, 0xBE,
+
+	0x2D, 0xFF, 0x66, 0x50, 0xD5, 0xB1, 0x7E, 0x58,
+	0x15, 0x66, 0x90, 0x70, 0x36, 0x51, 0xAA, 0x92,
+	0xE8, 0xF7, 0x7D, 0xC8, 0xD1, 0x20, 0xD2, 0x43,
+	0x8D, 0x65, 0xC7, 0x90, 0xB2, 0x8A, 0xC2, 0x3D,
+	0xDA, 0x4B, 0x69, 0xEB, 0x80, 0xA8, 0x66, 0x1E,
+	0x57, 0xA7, 0xD3, 0xCD, 0x06, 0x37, 0xD7, 0x17,
+	0x16, 0x20, 0x4F, 0xF6, 0x0A, 0xE7, 0x02, 0x73,
+	0xF7, 0xD0, 0x90, 0x27, 0x2E, 0x45, 0x88, 0xB3,
+	0x79, 0x45, 0xFD, 0x03, 0x41, 0x67, 0x43, 0x1E,
+	0x1F, 0xF3, 0x3B, 0xC3, 0x48, 0xE9, 0x3F, 0xEE,
+	0x32, 0xAB, 0xC5, 0xFC, 0x5D, 0xE6, 0xD5, 0x62,
+	0xA9, 0xE0, 0x85, 0xD3, 0x1C, 0x29, 0x65, 0x61,
+	0x44, 0xAA, 0x48, 0xCC, 0xA3, 0x3C, 0x8B, 0xA2,
+	0x5A, 0x9E, 0x84, 0x7C, 0x70, 0xCE, 0x58, 0x03,
+	0x68, 0x27, 0x45, 0xA3, 0xB6, 0x5C, 0xEE, 0x80,
+	0xE9, 0x4D, 0x7F, 0x33, 0x8E, 0xFF, 0xA2, 0xAE,
+	0x7C, 0x38, 0x4E,
+
+	0x2D, 0xBF, 0x62, 0x50, 0xC2, 0xB1, 0x7E, 0x4F,
+	0x15, 0x66, 0x90, 0x70, 0x36, 0x51, 0xAA, 0x92,
+	0xE0, 0xF5, 0x7D, 0xE0, 0x21, 0x52, 0xEB, 0x43,
+	0x2D, 0x25, 0xC2, 0x04, 0x82, 0x89, 0x8A, 0x36,
+	0xC6, 0x09, 0x02, 0x9C, 0x52, 0xE4, 0xC5, 0xD7,
+	0xAC, 0xE5, 0x5E, 0xC5, 0xF5, 0x30, 0x8A, 0x8C,
+	0x4B, 0x08, 0x5D, 0xED, 0x47, 0x4A, 0x28, 0xF5,
+	0x2C, 0x57, 0xB6, 0xFB, 0x5E, 0x1D, 0xE2, 0x96,
+	0x31, 0xBA, 0x15, 0x18, 0xC6, 0xD4, 0xCB, 0x7C,
+	0x49, 0xDC, 0x9C, 0xD5, 0x80, 0x3E, 0x82, 0x6F,
+	0x20, 0x94, 0x64, 0xBD, 0xE8, 0xCD, 0xBC, 0x08,
+	0x39, 0x81, 0xC8, 0xC9, 0x97, 0xFD, 0x6A, 0x70,
+	0x97, 0x61, 0xA9, 0x08, 0x07, 0x51, 0x47, 0xB2,
+	0x52, 0x0C, 0xD8, 0x30, 0x05, 0xCB, 0x4A, 0xA9,
+	0x30, 0x0A, 0x93, 0x05, 0xC3, 0x96, 0x24, 0x6A,
+	0xE3, 0xD2, 0xF9, 0x4D, 0x94, 0xE4, 0x98, 0x17,
+	0xE9, 0xB6, 0xF3, 0x66,
+
+	0x2D, 0xBF, 0x62, 0x50, 0xC2, 0xB1, 0x5E, 0x6D,
+	0xE2, 0x77, 0x90, 0xF0, 0x36, 0x51, 0xAA, 0x92,
+	0xDC, 0x85, 0x3A, 0xE2, 0x29, 0xC2, 0xED, 0x43,
+	0x9D, 0x97, 0xB0, 0x27, 0x86, 0x11, 0xD8, 0x22,
+	0x86, 0xB4, 0xF3, 0x12, 0x67, 0xEE, 0xF4, 0xC8,
+	0x67, 0xF1, 0x2D, 0xC5, 0x12, 0x8C, 0x2A, 0x62,
+	0x85, 0x7E, 0x4C, 0xEA, 0xD9, 0x9A, 0x45, 0xE3,
+	0xDD, 0x83, 0x22, 0x53, 0xFA, 0xA2, 0x28, 0xD0,
+	0xFA, 0x26, 0x9A, 0xE2, 0x6A, 0xCA, 0x34, 0xA5,
+	0x71, 0xFC, 0x04, 0x8D, 0xAB, 0x9A, 0x02, 0x1D,
+	0xD4, 0xBD, 0x11, 0x23, 0x42, 0xEA, 0x39, 0xCC,
+	0x91, 0xCB, 0xF3, 0x3D, 0x2B, 0xF0, 0x3A, 0x0A,
+	0x5C, 0xDA, 0xD6, 0x49, 0x49, 0xAC, 0x86, 0x94,
+	0x42, 0xB7, 0x14, 0x12, 0x54, 0x63, 0x65, 0xD2,
+	0x1E, 0xCF, 0x0C, 0xE5, 0x82, 0x5F, 0x7D, 0x79,
+	0x46, 0x74, 0xE7, 0x8A, 0x29, 0xE1, 0x86, 0x91,
+	0xDB, 0x93, 0x31, 0x6B, 0xEE,
+
+	0x2D, 0xBF, 0x62, 0x50, 0xD0, 0xB1, 0x5E, 0x6D,
+	0xE2, 0x2F, 0xD8, 0xF0, 0x36, 0x51, 0xAA, 0x92,
+	0x9F, 0xAF, 0x3A, 0x63, 0x29, 0xC2, 0xCD, 0x42,
+	0x98, 0x1F, 0x3B, 0xAA, 0x86, 0x58, 0xBC, 0xA2,
+	0x9C, 0x15, 0xBF, 0xC0, 0x71, 0x94, 0xB1, 0x11,
+	0x37, 0x87, 0x40, 0x75, 0x38, 0xFE, 0xCB, 0x73,
+	0x8E, 0x91, 0x73, 0x09, 0x02, 0xD9, 0xE0, 0x91,
+	0x6C, 0x7D, 0xDB, 0xD4, 0x6A, 0x5E, 0x26, 0xEA,
+	0xD2, 0xAB, 0x5C, 0xEB, 0x7B, 0x7A, 0x8C, 0xC3,
+	0x5A, 0xDE, 0xD4, 0x25, 0x9B, 0x31, 0xF1, 0xCE,
+	0x6E, 0x58, 0xF2, 0x01, 0x94, 0xFF, 0xD0, 0x20,
+	0x2E, 0x63, 0x56, 0x85, 0x7C, 0x81, 0x5B, 0x64,
+	0x88, 0xAC, 0x26, 0x06, 0x08, 0xFE, 0xE4, 0xC5,
+	0x21, 0xE9, 0x9F, 0x2D, 0x5E, 0x31, 0xD9, 0x5B,
+	0xB2, 0xD5, 0x4C, 0x3D, 0x64, 0x42, 0xAF, 0xE3,
+	0x73, 0x05, 0x5B, 0x4E, 0x9A, 0xFD, 0xEA, 0xB8,
+	0xDE, 0xF9, 0xAE, 0x90, 0xDF, 0x83,
+
+	0x2D, 0xBF, 0x62, 0x50, 0x90, 0xB1, 0x5E, 0x6D,
+	0xE2, 0xAF, 0xE0, 0xD0, 0x36, 0x51, 0xAA, 0x92,
+	0x9C, 0x22, 0x24, 0xEB, 0x2E, 0xC2, 0xCD, 0x82,
+	0x93, 0x0C, 0x28, 0x2C, 0x2E, 0x5B, 0xFE, 0x36,
+	0xA5, 0xDF, 0xAD, 0x69, 0x89, 0xFD, 0x26, 0xB9,
+	0x67, 0x08, 0x06, 0x21, 0x38, 0xAC, 0xBC, 0xE0,
+	0x1E, 0x1A, 0x76, 0xAC, 0x59, 0xB1, 0x43, 0x84,
+	0xA9, 0x1A, 0x95, 0x08, 0xBE, 0x75, 0xFA, 0xE5,
+	0x9E, 0x69, 0xAA, 0x31, 0x74, 0x7B, 0x0A, 0x4F,
+	0x21, 0xAA, 0xE0, 0x86, 0x66, 0xC7, 0x6F, 0xFB,
+	0x21, 0x0E, 0x25, 0x7C, 0x82, 0x0B, 0x84, 0x05,
+	0x33, 0x98, 0x6D, 0x4F, 0xA1, 0x3C, 0xF5, 0x4E,
+	0x15, 0x3F, 0x6D, 0x5C, 0xF9, 0xF7, 0xA0, 0x88,
+	0x5B, 0x85, 0xAC, 0xF7, 0xED, 0xD6, 0x4D, 0xB9,
+	0xC4, 0x54, 0x10, 0x14, 0x4E, 0x7C, 0x6B, 0xD4,
+	0x60, 0x9B, 0xB2, 0x7D, 0x4E, 0xCF, 0x4B, 0x7B,
+	0x07, 0xE6, 0xF7, 0x3C, 0xCF, 0x0D, 0xF7,
+
+	0x2D, 0xBF, 0x62, 0x50, 0x90, 0x31, 0x56, 0x6D,
+	0xEA, 0xAF, 0xE0, 0xC9, 0x36, 0x51, 0xAA, 0x92,
+	0x9C, 0x07, 0xFC, 0xE6, 0x4E, 0xC2, 0xCD, 0x4A,
+	0x30, 0x9F, 0xE1, 0x09, 0xA2, 0x33, 0xDD, 0x22,
+	0xC9, 0x77, 0x74, 0x24, 0xC0, 0xC0, 0xA7, 0x8C,
+	0xB5, 0x58, 0x0D, 0xE6, 0x49, 0x1C, 0x72, 0xC4,
+	0x47, 0x65, 0xF0, 0x45, 0xD2, 0x3E, 0xA4, 0x0C,
+	0xC9, 0xC1, 0xB9, 0xFE, 0x37, 0x2C, 0x6A, 0x2E,
+	0x2E, 0x79, 0x07, 0x5C, 0x18, 0x75, 0x34, 0x78,
+	0x2C, 0x1A, 0xAC, 0x8C, 0xD6, 0x3E, 0xBC, 0xBA,
+	0xA6, 0x5E, 0xE3, 0xD5, 0xF7, 0x86, 0xA0, 0x6E,
+	0x84, 0x82, 0xB1, 0xE0, 0xEE, 0x48, 0x61, 0xD3,
+	0x31, 0x10, 0x09, 0x12, 0x8E, 0xB4, 0x2E, 0x3C,
+	0xF6, 0xE5, 0x8D, 0x3F, 0x79, 0x63, 0x98, 0xD0,
+	0x63, 0xA2, 0x21, 0xD9, 0xEF, 0xC2, 0xFB, 0x35,
+	0x58, 0x19, 0x71, 0x03, 0x9E, 0x45, 0xA7, 0x90,
+	0xCE, 0xD0, 0xD5, 0x72, 0x53, 0x94, 0xA9, 0xF5,
+
+	0x2D, 0xBF, 0x62, 0x50, 0x90, 0x31, 0x56, 0x6D,
+	0xEA, 0xAF, 0xC0, 0xCB, 0x4F, 0x49, 0xAA, 0x12,
+	0xFE, 0xCF, 0xA0, 0xE5, 0x74, 0x5A, 0xDD, 0xCC,
+	0x34, 0x7B, 0xEA, 0x09, 0x82, 0x5E, 0xCA, 0x35,
+	0x85, 0xE9, 0xF2, 0x7A, 0xC8, 0x7B, 0x03, 0x23,
+	0x8B, 0x6E, 0xA1, 0xEA, 0x8B, 0xC4, 0xBC, 0x2E,
+	0xB5, 0xDD, 0x1C, 0xB8, 0x24, 0xF6, 0xFC, 0x64,
+	0x89, 0xB9, 0xDD, 0xB0, 0x22, 0xB7, 0x89, 0x13,
+	0x6B, 0x5C, 0x19, 0x05, 0xD3, 0xF6, 0xD6, 0x0F,
+	0x5D, 0xC0, 0x08, 0x5C, 0x80, 0x2A, 0x02, 0x2C,
+	0x5C, 0x6A, 0x16, 0xC5, 0x70, 0x6E, 0xEE, 0x1C,
+	0xB0, 0x74, 0xFF, 0xC9, 0x42, 0xD0, 0x85, 0x55,
+	0x90, 0xC0, 0x6E, 0x57, 0xBC, 0xD5, 0xD7, 0xBA,
+	0xBF, 0xCC, 0x40, 0xFB, 0x76, 0xFB, 0x38, 0x36,
+	0xE6, 0x7F, 0x98, 0xB7, 0xB5, 0x3D, 0x77, 0x0A,
+	0x59, 0x84, 0x39, 0x24, 0x14, 0x76, 0xEE, 0x67,
+	0x2C, 0x2A, 0x62, 0x7F, 0xA8, 0xAE, 0x56, 0x3A,
+	0x82,
+
+	0x2D, 0xBF, 0x62, 0x50, 0x90, 0x31, 0x56, 0x6D,
+	0xF2, 0xAF, 0xC0, 0x4B, 0x4F, 0x13, 0xF0, 0x12,
+	0x6D, 0xCB, 0x84, 0xED, 0x27, 0x70, 0x5D, 0x50,
+	0x34, 0xAB, 0xE2, 0x08, 0x87, 0x77, 0x20, 0x96,
+	0x8C, 0x82, 0xFA, 0x9E, 0xC3, 0x04, 0xF2, 0x4F,
+	0x45, 0xDA, 0x97, 0xFC, 0xC6, 0x93, 0x2D, 0x37,
+	0x7F, 0x30, 0x08, 0x6C, 0xA9, 0x3A, 0x0E, 0x27,
+	0xC8, 0xE7, 0x5A, 0xC3, 0x15, 0xCD, 0xFB, 0x1A,
+	0xBC, 0xF9, 0x66, 0x3E, 0xFA, 0xE2, 0xBD, 0xF3,
+	0xF2, 0x10, 0x34, 0x13, 0xC1, 0x2A, 0x83, 0xB8,
+	0x49, 0x41, 0xBE, 0x10, 0xBD, 0xD0, 0x36, 0x98,
+	0xC6, 0xAF, 0x0B, 0x17, 0x6B, 0xE6, 0xC0, 0xB4,
+	0xA4, 0x62, 0xC6, 0x54, 0xD7, 0x0D, 0x33, 0x05,
+	0x5D, 0x89, 0x19, 0x66, 0xBB, 0xA5, 0x11, 0x06,
+	0xFC, 0xD5, 0x67, 0xC4, 0x42, 0x19, 0x14, 0xF5,
+	0xD7, 0x42, 0xBC, 0x2E, 0x37, 0x05, 0x2B, 0x9D,
+	0xC0, 0x8A, 0x5C, 0xB7, 0x74, 0x42, 0x52, 0xE1,
+	0x2F, 0x65,
+
+	0x2D, 0xBF, 0x62, 0x50, 0x90, 0x31, 0x56, 0x6D,
+	0x32, 0xAC, 0xC0, 0x4B, 0x4F, 0x93, 0xCA, 0x12,
+	0x7D, 0x41, 0xCC, 0xED, 0x26, 0x46, 0x41, 0xD0,
+	0x30, 0xAB, 0x22, 0x43, 0x2E, 0x74, 0x2A, 0x42,
+	0x34, 0x1B, 0x54, 0x7F, 0x68, 0x82, 0xBB, 0x64,
+	0x7D, 0xDC, 0x06, 0xF1, 0x02, 0xCB, 0xB9, 0xF6,
+	0xB8, 0xD0, 0xA1, 0x50, 0x24, 0xA5, 0xD9, 0xEE,
+	0x08, 0xF6, 0xB5, 0x46, 0x4C, 0x78, 0x42, 0xB9,
+	0x0A, 0xAC, 0x98, 0x54, 0x59, 0x6A, 0xC5, 0xF9,
+	0xE9, 0xF1, 0xC1, 0x01, 0x3F, 0x95, 0x68, 0x47,
+	0x4E, 0x89, 0xE4, 0x13, 0xDB, 0xD4, 0x00, 0x52,
+	0x45, 0xDD, 0xFC, 0xDC, 0xDF, 0x92, 0x48, 0x53,
+	0x1A, 0xDD, 0xAC, 0x2C, 0x30, 0xE1, 0x4A, 0x80,
+	0xA4, 0xCF, 0xC8, 0xEE, 0x81, 0x52, 0x91, 0xA6,
+	0x3B, 0x08, 0xAA, 0x0E, 0x5A, 0x40, 0x81, 0x33,
+	0x6B, 0xB6, 0xB0, 0x6B, 0x7D, 0x1A, 0x98, 0x2F,
+	0x37, 0x79, 0x13, 0x39, 0x84, 0xD8, 0xDA, 0x95,
+	0x89, 0x45, 0x46,
+
+	0x2D, 0xBF, 0x62, 0x50, 0x90, 0x31, 0x56, 0x6D,
+	0x32, 0xEC, 0xC2, 0x4B, 0x5E, 0x93, 0xCA, 0x09,
+	0x65, 0x10, 0x4A, 0x6D, 0x86, 0x4C, 0xAA, 0xDC,
+	0x14, 0xA9, 0x22, 0x5B, 0xCD, 0xC4, 0xBB, 0x00,
+	0x65, 0xF8, 0x97, 0x95, 0xB8, 0x44, 0x6B, 0x52,
+	0x93, 0x00, 0x8F, 0xBF, 0x36, 0x4F, 0xAE, 0xF0,
+	0xDB, 0x2C, 0x6C, 0x69, 0x0B, 0xAD, 0x18, 0xC7,
+	0x6F, 0xC0, 0xA3, 0x09, 0x09, 0xBE, 0xFA, 0xBE,
+	0x1B, 0x07, 0xFD, 0x11, 0x85, 0x05, 0x01, 0xE1,
+	0xBF, 0x56, 0x0C, 0xBF, 0x48, 0x78, 0x73, 0xB9,
+	0x7D, 0x00, 0x32, 0x41, 0x30, 0x8B, 0x9D, 0x1C,
+	0x12, 0x04, 0x5E, 0x6F, 0x55, 0x8F, 0x8E, 0x55,
+	0x28, 0xB6, 0xCC, 0xA5, 0xB9, 0xF2, 0x7A, 0xA4,
+	0x82, 0x0E, 0x6B, 0x7A, 0xDD, 0xB7, 0xA0, 0x4B,
+	0x6D, 0x57, 0xD8, 0x5E, 0x9C, 0x21, 0x10, 0xAC,
+	0xE8, 0xD9, 0xF0, 0xEF, 0xAC, 0x43, 0x04, 0xA5,
+	0x0C, 0x4B, 0xFC, 0xB3, 0xBB, 0x5E, 0xA5, 0x9D,
+	0x0A, 0x4B, 0xF8, 0xB2,
+
+	0x56, 0xAD, 0x62, 0xD0, 0x90, 0x31, 0x56, 0x6D,
+	0x32, 0xEC, 0xC2, 0x4B, 0x5E, 0x93, 0xEA, 0x28,
+	0x05, 0x60, 0x7D, 0xEB, 0xDE, 0x43, 0x9A, 0x47,
+	0x2D, 0x19, 0x2D, 0xCE, 0xCD, 0x1A, 0xBA, 0x00,
+	0x9B, 0x1C, 0xFD, 0xCF, 0x70, 0x16, 0xA0, 0x64,
+	0x44, 0x49, 0x10, 0x0B, 0x05, 0xAA, 0x13, 0x14,
+	0x65, 0x5D, 0xA7, 0xC6, 0xA6, 0x7A, 0x3D, 0xFA,
+	0x0D, 0x46, 0x97, 0xC4, 0x1C, 0xBE, 0x4A, 0xED,
+	0x22, 0xE7, 0x33, 0xDC, 0x01, 0x21, 0x79, 0xE5,
+	0x5D, 0xA3, 0x13, 0xF0, 0xDA, 0x80, 0x84, 0xB5,
+	0x0A, 0xE0, 0x72, 0xF2, 0x98, 0x12, 0x8E, 0xE3,
+	0xEE, 0x97, 0xC5, 0x1D, 0xC8, 0x84, 0xD3, 0x95,
+	0x90, 0xFF, 0x4D, 0x5E, 0x79, 0xBB, 0xA5, 0x65,
+	0x58, 0xBE, 0x3B, 0x72, 0x6C, 0xD9, 0x92, 0x30,
+	0x84, 0x92, 0x72, 0xA0, 0x75, 0x14, 0xC7, 0x47,
+	0x0E, 0x49, 0xCB, 0x77, 0xF7, 0x48, 0x62, 0xA3,
+	0xFA, 0x71, 0xD3, 0x46, 0x7D, 0x35, 0xE5, 0x26,
+	0x51, 0x9D, 0xBC, 0xEA, 0xFE,
+
+	0x56, 0xF1, 0x32, 0xD0, 0x90, 0x31, 0x56, 0x6D,
+	0x32, 0xEC, 0xC2, 0x4B, 0x77, 0x93, 0xEA, 0x28,
+	0x80, 0x78, 0x9C, 0x29, 0xD2, 0x77, 0xB4, 0x8F,
+	0x05, 0x30, 0xAD, 0xCB, 0xCD, 0x7A, 0x28, 0x09,
+	0x54, 0xBA, 0x78, 0x24, 0x56, 0x4D, 0x92, 0xA4,
+	0xB3, 0x1C, 0x5B, 0x46, 0x8B, 0x16, 0x62, 0x40,
+	0xF6, 0xE2, 0x82, 0x6F, 0xCF, 0x2B, 0xC0, 0xF0,
+	0x58, 0x72, 0x8A, 0x44, 0x52, 0xDB, 0x87, 0x81,
+	0x5D, 0x45, 0x27, 0xB7, 0x72, 0xD8, 0x4D, 0x87,
+	0x11, 0x2C, 0xEE, 0x54, 0x2A, 0x46, 0xF1, 0x0B,
+	0x97, 0xE6, 0x26, 0xDB, 0x68, 0xDA, 0x69, 0x97,
+	0xAA, 0x6C, 0xB7, 0x0A, 0x69, 0x53, 0x9C, 0x08,
+	0xB4, 0xAF, 0x98, 0x0E, 0x42, 0xF8, 0xE0, 0x3B,
+	0x4D, 0x0B, 0x8C, 0x4C, 0xFF, 0xD6, 0x95, 0xDC,
+	0x0D, 0x7A, 0x03, 0xB7, 0x67, 0xAC, 0xBA, 0x25,
+	0x8C, 0x9B, 0x87, 0x63, 0x09, 0xD3, 0x26, 0xBF,
+	0x3D, 0x82, 0x2C, 0xDB, 0x3D, 0xC6, 0x3F, 0xDC,
+	0x9D, 0x36, 0x09, 0xAA, 0x6A, 0xD0,
+
+	0x56, 0x71, 0x0E, 0xE0, 0x90, 0x31, 0x56, 0x6D,
+	0x32, 0xEC, 0xC2, 0x4B, 0x17, 0x83, 0xEA, 0x28,
+	0x4B, 0x3F, 0x1C, 0xBE, 0x62, 0x68, 0xB4, 0xFD,
+	0x8E, 0x5C, 0xB3, 0xC3, 0xCC, 0x7A, 0xA8, 0x3B,
+	0x59, 0xA6, 0x47, 0xE0, 0x9B, 0xA5, 0xD7, 0x95,
+	0xA0, 0xD1, 0xE2, 0xC1, 0xF3, 0xCD, 0x1F, 0x1D,
+	0xFC, 0x24, 0xA8, 0x00, 0x9C, 0xCB, 0x2A, 0x93,
+	0xD0, 0x7D, 0x02, 0xEE, 0xE4, 0x32, 0xA9, 0xFD,
+	0x32, 0x63, 0x0F, 0x12, 0x14, 0x30, 0xA3, 0x32,
+	0x8C, 0xEB, 0x5B, 0xB5, 0xDA, 0x18, 0xDB, 0xC9,
+	0x01, 0xDE, 0x53, 0x0D, 0x1F, 0xBB, 0xAD, 0xB1,
+	0xCB, 0x54, 0xD9, 0xFC, 0x1A, 0x5B, 0xE5, 0x5C,
+	0xC9, 0x6F, 0x70, 0xE7, 0x76, 0x26, 0x44, 0xC8,
+	0x17, 0x82, 0xA6, 0x3E, 0xFF, 0xAD, 0x86, 0x47,
+	0xFC, 0x8F, 0x2A, 0xDB, 0x89, 0x47, 0xCF, 0xCE,
+	0xC3, 0x7A, 0xFE, 0xBE, 0xBD, 0x63, 0xC7, 0x94,
+	0x2F, 0x9A, 0x5E, 0xE2, 0x3D, 0x88, 0x4A, 0x1A,
+	0x86, 0x31, 0x90, 0xEB, 0x06, 0xE0, 0xD4,
+
+	0x47, 0x71, 0x0E, 0xFD, 0x90, 0x31, 0x56, 0x6D,
+	0x32, 0xEC, 0xC2, 0x4B, 0x17, 0x03, 0xE2, 0x28,
+	0xA9, 0xEC, 0x9D, 0x8A, 0x8C, 0xD8, 0xB0, 0xDD,
+	0x0E, 0x54, 0x09, 0xCD, 0xE8, 0x79, 0xA8, 0x13,
+	0xBF, 0x30, 0x63, 0x22, 0xBD, 0xA9, 0xF7, 0xF3,
+	0x26, 0x80, 0x79, 0x07, 0x60, 0xE7, 0xFD, 0xE4,
+	0xFD, 0x13, 0x46, 0x35, 0xA4, 0x97, 0x76, 0xBC,
+	0xE0, 0xE2, 0x87, 0x10, 0xBD, 0xD1, 0x21, 0x90,
+	0x92, 0xDC, 0xA0, 0x73, 0xBB, 0xE6, 0xD5, 0x32,
+	0xF6, 0xAB, 0xBE, 0xDC, 0x02, 0xC3, 0x13, 0x28,
+	0x26, 0x9B, 0x44, 0xCF, 0xF3, 0x01, 0x3F, 0xCA,
+	0xFA, 0xEC, 0xF5, 0x5B, 0x24, 0x0E, 0x02, 0x85,
+	0x96, 0x9B, 0xB2, 0xDA, 0x25, 0xD5, 0xD5, 0x8F,
+	0x62, 0xC5, 0xE2, 0x7D, 0xDA, 0xA8, 0x21, 0x50,
+	0x19, 0x8E, 0x3B, 0x59, 0xF9, 0x13, 0xED, 0x3A,
+	0x05, 0x02, 0x1C, 0x00, 0x9F, 0xF4, 0x8C, 0x25,
+	0x75, 0xBA, 0x2C, 0xA8, 0x6A, 0x27, 0xE2, 0x21,
+	0xDC, 0x78, 0xEB, 0x59, 0x7C, 0x84, 0x62, 0x7C,
+
+	0xA1, 0x50, 0xDD, 0xC9, 0xC2, 0x89, 0xEE, 0xFA,
+	0x9E, 0xE0, 0xDF, 0xBA, 0xD8, 0x75, 0x6F, 0x92,
+	0xD1, 0x3D, 0x04, 0xAA, 0xC9, 0xB6, 0xF5, 0x59,
+	0x9A, 0x33, 0x44, 0x94, 0x34, 0x74, 0xA2, 0x46,
+	0xC4, 0x9F, 0x88, 0x8A, 0xA0, 0xDA, 0x2A, 0x62,
+	0x12, 0x1E, 0xBC, 0x3C, 0x26, 0xC7, 0x44, 0x6F,
+	0x25, 0xB9, 0xEF, 0xB9, 0xC7, 0x2B, 0x7C, 0x7B,
+	0x4F, 0xF8, 0x66, 0x63, 0xA6, 0x0C, 0xCA, 0xDE,
+	0xAA, 0xA1, 0x38, 0xBF, 0x1A, 0x80, 0x89, 0x06,
+	0xE5, 0x8E, 0x15, 0x1B, 0x4F, 0x65, 0xB7, 0xD6,
+	0xEC, 0x27, 0x02, 0xD1, 0x55, 0xC3, 0x95, 0xBF,
+	0x7D, 0xCE, 0x51, 0xC6, 0x3A, 0x06, 0xA0, 0x44,
+	0xF0, 0xE3, 0x11, 0x95, 0x37, 0x51, 0xB5, 0x30,
+	0x98, 0x38, 0x67, 0x45, 0x4B, 0xDB, 0x21, 0x13,
+	0x8E, 0x9E, 0x2B, 0xE8, 0x1D, 0xA5, 0xF9, 0xEF,
+	0x27, 0x03, 0xF1, 0xE5, 0xAF, 0x05, 0xF9, 0xF2,
+	0x16, 0x3E, 0x49, 0x5D, 0x11, 0x01, 0x8E, 0x75,
+	0x27, 0x83, 0x88, 0x3A, 0xB5, 0x54, 0x98, 0x91,
+	0xA2,
+
+	0x81, 0x50, 0xDD, 0xC9, 0xC2, 0xD7, 0xFA, 0xFA,
+	0x9E, 0xE0, 0xDF, 0xBA, 0xD8, 0x75, 0x6F, 0x92,
+	0xD1, 0x9D, 0x36, 0xAA, 0x4C, 0x74, 0x07, 0x93,
+	0x94, 0x3B, 0x8C, 0x59, 0x45, 0x5D, 0x42, 0x42,
+	0x82, 0x27, 0xBD, 0x7F, 0x44, 0xB7, 0xAB, 0x28,
+	0xDA, 0x7C, 0x32, 0x55, 0x0E, 0x76, 0xE7, 0xF7,
+	0x81, 0x20, 0x28, 0x15, 0xCF, 0x92, 0xBE, 0x7B,
+	0x05, 0x7F, 0xE4, 0x6E, 0x6B, 0xF2, 0x8D, 0xBD,
+	0x7F, 0x80, 0x1E, 0x59, 0x2F, 0x00, 0x50, 0xA1,
+	0x9C, 0xBD, 0xCD, 0x26, 0x9E, 0xEB, 0x68, 0xC3,
+	0x0A, 0x24, 0xE5, 0xF7, 0xB7, 0xE0, 0x9F, 0x90,
+	0x0F, 0x81, 0x8B, 0x24, 0x34, 0xFA, 0x52, 0xBA,
+	0x2B, 0x7F, 0x89, 0x76, 0xD7, 0x1A, 0x62, 0x53,
+	0x92, 0x94, 0xEB, 0xDA, 0x88, 0xD1, 0x8E, 0xB1,
+	0x8F, 0xBA, 0x2F, 0x30, 0xB2, 0x66, 0x46, 0x6B,
+	0x90, 0xE7, 0xCA, 0xC1, 0x33, 0xDD, 0xAC, 0xD5,
+	0x31, 0xA6, 0x93, 0xFF, 0x64, 0x93, 0x98, 0xC9,
+	0xD9, 0x78, 0x64, 0x75, 0xF1, 0xB1, 0x0B, 0xDE,
+	0x18, 0x2F,
+
+	0xE1, 0x40, 0xDD, 0xC9, 0xC2, 0x57, 0xC4, 0xC0,
+	0x9E, 0xE0, 0xDF, 0xBA, 0xD8, 0x75, 0x6F, 0x92,
+	0xD7, 0x9D, 0xE2, 0xC3, 0xA7, 0xB3, 0x93, 0x77,
+	0xC7, 0x22, 0x54, 0x5E, 0x15, 0x00, 0x5D, 0x4A,
+	0x8A, 0xA6, 0xDD, 0xA0, 0x9A, 0x1F, 0xE4, 0xA3,
+	0x02, 0x44, 0xAF, 0x8D, 0x95, 0xF7, 0xD5, 0xE5,
+	0xCD, 0x24, 0x33, 0x64, 0x98, 0xFF, 0x80, 0x4D,
+	0xF8, 0x75, 0x3A, 0x4A, 0xD7, 0xDD, 0x5D, 0x0F,
+	0x95, 0xBF, 0x0C, 0x5E, 0x27, 0x92, 0x98, 0xB6,
+	0x16, 0x9B, 0x28, 0xCD, 0x7B, 0xA0, 0xC4, 0x6E,
+	0xF6, 0x23, 0xB0, 0x7F, 0x38, 0x3E, 0xB1, 0x67,
+	0x9F, 0x15, 0x1B, 0x81, 0x05, 0xBB, 0x76, 0x95,
+	0x33, 0xC4, 0xA9, 0x65, 0x56, 0xEC, 0x88, 0xA2,
+	0x73, 0x5C, 0x66, 0xF9, 0xDC, 0xD0, 0xC3, 0x64,
+	0x19, 0x27, 0xA1, 0x66, 0x22, 0xA3, 0x52, 0xD1,
+	0x48, 0xA9, 0x25, 0x83, 0x84, 0x8F, 0x60, 0x29,
+	0x14, 0x7F, 0x40, 0xFE, 0xA4, 0xFD, 0xC4, 0x99,
+	0xA4, 0x26, 0x91, 0x95, 0x4F, 0x68, 0x47, 0xF5,
+	0xFD, 0x28, 0xD8,
+
+	0xE1, 0x40, 0xD5, 0xC9, 0xC4, 0x57, 0xC4, 0xDF,
+	0x9E, 0xE0, 0xDF, 0xBA, 0xD8, 0x75, 0x6F, 0x92,
+	0xBB, 0x9E, 0xE2, 0x43, 0x46, 0x62, 0xF2, 0x17,
+	0x4D, 0x1D, 0x54, 0xAA, 0x95, 0x42, 0x57, 0x44,
+	0x67, 0x9D, 0xED, 0xAA, 0xB5, 0x67, 0x3E, 0xB3,
+	0x10, 0x9C, 0x58, 0xB5, 0x98, 0xC4, 0x75, 0xCF,
+	0x9E, 0x7B, 0xF1, 0x7E, 0xA3, 0x5F, 0xD7, 0xD0,
+	0x8B, 0x2B, 0x49, 0xBB, 0xE5, 0x1B, 0x0C, 0x70,
+	0x3B, 0xAD, 0xAD, 0x6E, 0x6F, 0x0E, 0x03, 0xC8,
+	0x7C, 0xB0, 0x2C, 0x95, 0x5E, 0x86, 0xFC, 0x1E,
+	0x5E, 0x60, 0x5E, 0xDE, 0x97, 0x49, 0xC1, 0xD9,
+	0x71, 0x2E, 0x1A, 0x5F, 0xBA, 0x50, 0x91, 0x8D,
+	0x0F, 0x53, 0x71, 0xA2, 0x88, 0x9F, 0xCD, 0xB6,
+	0xD0, 0xA1, 0xD7, 0xDB, 0xD4, 0x06, 0x0B, 0xF4,
+	0xE5, 0x07, 0x2D, 0x83, 0xFF, 0x37, 0x03, 0xE2,
+	0x84, 0x0A, 0x35, 0x16, 0xB0, 0xAC, 0x09, 0x06,
+	0xDD, 0x84, 0x9E, 0xA3, 0xBF, 0xF0, 0x21, 0x6A,
+	0xAA, 0x43, 0x33, 0xDE, 0x08, 0x1C, 0x6E, 0xBD,
+	0x2E, 0xC3, 0x1E, 0x6B,
+
+	0xE1, 0x40, 0xD5, 0xC9, 0xC4, 0x57, 0xE4, 0xDF,
+	0xE1, 0xC2, 0xDF, 0x3A, 0xD8, 0x75, 0x6F, 0x92,
+	0x80, 0x6E, 0xE1, 0x83, 0xC2, 0xB6, 0xFF, 0x17,
+	0xAC, 0xE4, 0xCF, 0x98, 0xA1, 0x89, 0xD4, 0x52,
+	0xAA, 0x86, 0x84, 0x26, 0xF7, 0x90, 0x23, 0xC5,
+	0xEA, 0x76, 0x2D, 0x16, 0xAD, 0xB5, 0x46, 0xB3,
+	0xB8, 0x4B, 0x18, 0x00, 0x33, 0x22, 0x40, 0x46,
+	0x83, 0x10, 0xA1, 0x47, 0xF2, 0x52, 0xA7, 0x01,
+	0xAE, 0x9F, 0x6E, 0x15, 0x60, 0xA4, 0xFB, 0xA8,
+	0x79, 0x2F, 0xF1, 0x32, 0x20, 0x1B, 0x81, 0xCF,
+	0x87, 0x24, 0xC8, 0xD0, 0x58, 0xC3, 0x11, 0xCE,
+	0x83, 0xAD, 0x6E, 0xFF, 0x24, 0x55, 0xF4, 0x92,
+	0x23, 0xFC, 0x2A, 0x58, 0xD8, 0x7E, 0x3F, 0x81,
+	0x86, 0xF6, 0xBA, 0xF6, 0xEB, 0x0E, 0xA1, 0xA2,
+	0x5D, 0x4C, 0xB8, 0x44, 0x8A, 0xDD, 0x69, 0x2B,
+	0x05, 0x52, 0x11, 0x1A, 0x37, 0x83, 0xA2, 0xAD,
+	0x19, 0xE9, 0x5B, 0x44, 0x4D, 0x06, 0x27, 0x44,
+	0x65, 0x6D, 0x07, 0xA2, 0x4B, 0x4C, 0x08, 0xA5,
+	0xDF, 0x40, 0x2A, 0xD4, 0xA3,
+
+	0xE1, 0x40, 0xD5, 0xC9, 0xC4, 0x57, 0xE4, 0xDF,
+	0xE1, 0xA2, 0xDF, 0x3A, 0xD8, 0x75, 0x6F, 0x92,
+	0x80, 0x5B, 0xE1, 0x87, 0xC2, 0xB6, 0xFE, 0x1B,
+	0xAA, 0xE4, 0xC9, 0x96, 0xB5, 0x89, 0xD1, 0x62,
+	0x39, 0x06, 0xAC, 0xCA, 0xFD, 0x16, 0xC2, 0x05,
+	0x32, 0xF7, 0x9F, 0xAC, 0x27, 0x36, 0x06, 0x73,
+	0x02, 0xB3, 0x7B, 0xA2, 0xBE, 0x79, 0xB0, 0x40,
+	0x95, 0x99, 0x30, 0x0C, 0x9A, 0xDF, 0xBD, 0x20,
+	0x6A, 0x66, 0x4B, 0x45, 0xCA, 0xA8, 0x91, 0x7C,
+	0x77, 0x6F, 0xDA, 0xE2, 0x22, 0x0E, 0x2A, 0xEB,
+	0xC2, 0x4C, 0x8C, 0x75, 0x52, 0xA9, 0x92, 0x8D,
+	0xCA, 0xB5, 0xCE, 0xED, 0x47, 0x2C, 0x72, 0x45,
+	0x1B, 0xDB, 0x74, 0xA8, 0xA9, 0x9A, 0x7F, 0x6B,
+	0x20, 0xF3, 0x5F, 0x88, 0x26, 0x10, 0x09, 0x6A,
+	0xF5, 0x73, 0x8F, 0x24, 0x24, 0x23, 0x06, 0x71,
+	0x64, 0xC1, 0x30, 0xE3, 0xF1, 0x30, 0xC5, 0xF1,
+	0x66, 0x1F, 0xAE, 0x06, 0xC3, 0x24, 0x3A, 0xB0,
+	0xBA, 0x37, 0x2B, 0x06, 0xA9, 0x44, 0x21, 0xD8,
+	0xB4, 0x18, 0x76, 0x9C, 0x51, 0x86,
+
+	0xE1, 0x40, 0xD5, 0xC9, 0xC4, 0x67, 0xE4, 0xDF,
+	0xE1, 0x22, 0x1F, 0x3A, 0xD8, 0x75, 0x6F, 0x92,
+	0x84, 0x16, 0xC7, 0x8F, 0xC3, 0xB6, 0xFE, 0x0B,
+	0xA2, 0xE0, 0xD7, 0x95, 0x87, 0x89, 0xD1, 0x02,
+	0x5B, 0x2E, 0xE0, 0xEE, 0x41, 0x10, 0x36, 0x72,
+	0xE2, 0x91, 0x08, 0xAD, 0x41, 0xD0, 0x4E, 0x36,
+	0xEB, 0xB1, 0x0F, 0x85, 0xA9, 0xF3, 0xAD, 0x89,
+	0x84, 0x11, 0x9F, 0xE4, 0x55, 0xD8, 0x57, 0xBA,
+	0x2F, 0x29, 0xEF, 0x83, 0xB1, 0x63, 0x55, 0x3C,
+	0x35, 0x17, 0x27, 0xA2, 0x61, 0xC2, 0x09, 0x35,
+	0x25, 0x04, 0x2B, 0xC4, 0x2A, 0x08, 0x0E, 0x4C,
+	0x28, 0x77, 0x10, 0xE8, 0x9F, 0xBE, 0xA3, 0x60,
+	0x95, 0x71, 0xA3, 0x5E, 0x81, 0xDF, 0x5C, 0xBE,
+	0x75, 0x6A, 0xF1, 0x59, 0xA6, 0x03, 0xE4, 0x49,
+	0x67, 0x03, 0x65, 0x28, 0xF1, 0x20, 0x3E, 0xC3,
+	0x8D, 0xC7, 0x82, 0xC0, 0x61, 0x86, 0x67, 0xD6,
+	0xA1, 0x34, 0x56, 0xEB, 0x2E, 0xF3, 0xF2, 0x89,
+	0x32, 0xB3, 0xC9, 0xD9, 0x9B, 0x69, 0x74, 0xBF,
+	0x66, 0xF9, 0x7A, 0xF6, 0xB0, 0x4F, 0x5D,
+
+	0xE1, 0x40, 0xD5, 0xC9, 0xC4, 0x27, 0xE4, 0xDF,
+	0xE1, 0x22, 0x1F, 0x1B, 0xD8, 0x75, 0x6F, 0x92,
+	0x84, 0x14, 0x5F, 0x9C, 0x67, 0xB2, 0xFE, 0x0B,
+	0xAF, 0xF0, 0xD5, 0x15, 0x95, 0xE9, 0xD7, 0x16,
+	0x89, 0x20, 0x01, 0xCD, 0xF9, 0xD7, 0x3F, 0xF0,
+	0x68, 0x8A, 0x3F, 0x25, 0x46, 0x11, 0x5F, 0xB6,
+	0xEB, 0x13, 0xF5, 0x58, 0x66, 0x17, 0xA9, 0x8E,
+	0x61, 0x95, 0x43, 0xBD, 0x9B, 0xE1, 0x0F, 0x3E,
+	0x61, 0x30, 0xD0, 0x48, 0xAC, 0xD8, 0x18, 0xAD,
+	0xE3, 0x60, 0x78, 0xC7, 0x09, 0x05, 0x90, 0x01,
+	0xD9, 0xAB, 0x5A, 0x6A, 0xA1, 0x41, 0xAD, 0x77,
+	0x20, 0x51, 0xC4, 0x2A, 0xD1, 0x2D, 0x34, 0x77,
+	0x2D, 0xD7, 0xA3, 0x6A, 0xF4, 0x4A, 0x87, 0x8C,
+	0x67, 0xC5, 0x3B, 0x39, 0x23, 0x08, 0x08, 0x78,
+	0x32, 0xD6, 0xD3, 0x7C, 0xC5, 0x66, 0x86, 0xFB,
+	0x0A, 0x3B, 0xF7, 0x3B, 0xAA, 0xF3, 0x50, 0xCA,
+	0x8C, 0xEA, 0x83, 0xB8, 0x40, 0x41, 0xEB, 0x2E,
+	0xED, 0xF9, 0xA7, 0xF1, 0xC7, 0x84, 0xB6, 0x5B,
+	0xB7, 0xB5, 0xC1, 0x4E, 0x22, 0x74, 0x41, 0x5D,
+
+	0xE1, 0x40, 0xD5, 0xC9, 0xC4, 0x27, 0xE4, 0xDF,
+	0xE1, 0x22, 0x1F, 0x1B, 0x59, 0x75, 0x6F, 0x12,
+	0x84, 0xD0, 0x6F, 0x9C, 0x67, 0xB2, 0xCE, 0xDF,
+	0xA7, 0xC4, 0xD5, 0x15, 0xB5, 0xD9, 0xCF, 0x16,
+	0x4B, 0x60, 0x83, 0xC7, 0x70, 0x6F, 0x7B, 0xD4,
+	0xE0, 0x0C, 0x73, 0x25, 0xE1, 0xF4, 0xDD, 0x52,
+	0xD2, 0x74, 0x4B, 0x68, 0x3C, 0x3F, 0xAA, 0x5B,
+	0x9A, 0x0C, 0xD7, 0x79, 0xE5, 0x8A, 0xC3, 0xD8,
+	0xA0, 0x96, 0x56, 0x96, 0xAC, 0xE1, 0x42, 0x76,
+	0x4B, 0x10, 0xA2, 0x92, 0x46, 0x14, 0x55, 0xA9,
+	0xE9, 0x7B, 0x64, 0x5A, 0xDE, 0xC5, 0x35, 0xBF,
+	0xD7, 0x0F, 0x17, 0x66, 0xAC, 0xE9, 0x15, 0x53,
+	0xA1, 0x48, 0xB0, 0xC8, 0xB2, 0xC1, 0xA1, 0x26,
+	0x28, 0x38, 0x1E, 0x16, 0xE7, 0x01, 0xD7, 0x93,
+	0x54, 0x79, 0xF0, 0x16, 0x48, 0xB6, 0x75, 0x81,
+	0x8A, 0x86, 0x0E, 0xA9, 0xCA, 0x0F, 0x4F, 0x2D,
+	0xB5, 0xC3, 0x7C, 0x00, 0x38, 0xFD, 0x24, 0x36,
+	0x03, 0x6A, 0x28, 0x60, 0xA0, 0xCE, 0x36, 0xED,
+	0xB6, 0xDB, 0xF3, 0x11, 0xAF, 0xA1, 0xF1, 0xA0,
+	0x46,
+
+	0xE1, 0x40, 0xD5, 0xC9, 0xC4, 0x27, 0xE4, 0xDF,
+	0xE0, 0x22, 0x1F, 0x9B, 0x59, 0x17, 0x2F, 0x12,
+	0x18, 0xD0, 0x7E, 0x1C, 0x52, 0x86, 0xCE, 0xDB,
+	0xA7, 0xC4, 0x1D, 0x19, 0xB3, 0x11, 0x8D, 0x3A,
+	0x8B, 0xBF, 0x0F, 0x46, 0xD8, 0x7A, 0x33, 0x5C,
+	0x29, 0x12, 0x05, 0xBD, 0x27, 0x7D, 0x69, 0xF3,
+	0xFD, 0x51, 0x32, 0x65, 0xDD, 0x70, 0xB4, 0x68,
+	0x62, 0xAE, 0x38, 0x20, 0x72, 0x24, 0x6B, 0xF6,
+	0x4F, 0xE3, 0x6F, 0x0F, 0xA3, 0x6D, 0x8C, 0x26,
+	0x24, 0x08, 0x7C, 0x86, 0x7F, 0xDD, 0xDB, 0x94,
+	0x28, 0x96, 0xCA, 0xE5, 0xDA, 0xE0, 0xDF, 0xFC,
+	0xE3, 0x74, 0xC2, 0x0C, 0x07, 0x93, 0x33, 0x25,
+	0x0F, 0xC1, 0x6F, 0x08, 0x10, 0xF6, 0x5C, 0x2F,
+	0x0A, 0x75, 0x2D, 0x04, 0x17, 0xCA, 0x07, 0xA7,
+	0x0E, 0xA5, 0x09, 0x3D, 0xAA, 0xA1, 0x27, 0xA1,
+	0xA6, 0xE7, 0x4B, 0x26, 0x1A, 0xD4, 0x4B, 0x18,
+	0x8D, 0x84, 0x4E, 0xCE, 0xC2, 0xCB, 0xDD, 0x86,
+	0x7E, 0x6F, 0xCC, 0x34, 0x27, 0x03, 0x26, 0x63,
+	0x5C, 0x3F, 0x6F, 0x9C, 0x67, 0xFC, 0xC6, 0xE3,
+	0x22, 0xBC,
+
+	0xE1, 0x40, 0xD5, 0xC9, 0xC4, 0x27, 0xE4, 0xDF,
+	0x20, 0x23, 0x1F, 0x9B, 0x59, 0x97, 0x6D, 0x10,
+	0xEB, 0xCC, 0x76, 0x5C, 0x03, 0xFB, 0xE9, 0xFB,
+	0xAF, 0xC4, 0x1D, 0x51, 0x93, 0x15, 0x87, 0x21,
+	0xC9, 0x96, 0x88, 0x82, 0xEB, 0x26, 0x77, 0xF2,
+	0x36, 0x49, 0x40, 0x31, 0x7A, 0x45, 0x3C, 0x72,
+	0x4D, 0x9C, 0x0A, 0x5D, 0xDE, 0x6C, 0xB8, 0x89,
+	0xBB, 0xD5, 0x5B, 0x1B, 0xEC, 0x17, 0x0E, 0x86,
+	0xC8, 0xA2, 0xBC, 0xC9, 0x0B, 0xA9, 0x0C, 0x26,
+	0xE9, 0xF8, 0xE0, 0x09, 0x78, 0x11, 0x61, 0x34,
+	0x86, 0x23, 0xA6, 0xCA, 0xB7, 0xD5, 0xC1, 0xD2,
+	0x7E, 0xBA, 0x8A, 0x7F, 0xB2, 0x96, 0x47, 0x45,
+	0x86, 0x38, 0x14, 0x23, 0xEF, 0x2D, 0xC7, 0x4A,
+	0xC3, 0x37, 0x0B, 0x7A, 0x51, 0x1E, 0x38, 0xA3,
+	0xCA, 0xA6, 0xAF, 0x66, 0x5C, 0x29, 0x60, 0xB1,
+	0xB1, 0xD1, 0x27, 0xA6, 0x19, 0x1D, 0x96, 0x91,
+	0xF7, 0x69, 0xF6, 0x73, 0x60, 0x70, 0xBC, 0xB1,
+	0x4B, 0x02, 0x8C, 0x24, 0xD7, 0x3A, 0x9A, 0x14,
+	0x8F, 0x6D, 0xAA, 0x44, 0xA9, 0xE8, 0x66, 0xB1,
+	0x38, 0xA3, 0x9D,
+
+	0xE1, 0x40, 0xD5, 0xC9, 0xC4, 0x27, 0xE4, 0xDF,
+	0x20, 0x63, 0x1F, 0x9B, 0x5A, 0x97, 0x6D, 0x33,
+	0x69, 0x2C, 0x72, 0xFC, 0x23, 0xF9, 0x71, 0xE9,
+	0x67, 0xC4, 0x1D, 0x59, 0x96, 0x26, 0x87, 0x25,
+	0x59, 0xCB, 0xC8, 0x21, 0x70, 0xCB, 0x83, 0xC3,
+	0x90, 0xB7, 0x6C, 0x65, 0x15, 0xFD, 0x07, 0x78,
+	0xAD, 0xDF, 0x8A, 0x21, 0xF6, 0x18, 0x57, 0x50,
+	0xFC, 0x39, 0xA7, 0x9F, 0x93, 0x09, 0xF6, 0x2A,
+	0x56, 0xAE, 0x0C, 0x60, 0x39, 0xC3, 0x63, 0x37,
+	0x0B, 0x47, 0xE9, 0x91, 0xB6, 0xBC, 0x89, 0x15,
+	0xA7, 0x9C, 0x26, 0x7D, 0xEB, 0xFA, 0x97, 0x4C,
+	0x3B, 0x67, 0xAE, 0x55, 0x5E, 0x7B, 0xC2, 0x21,
+	0x76, 0x43, 0x58, 0x14, 0x79, 0x00, 0x38, 0x8F,
+	0xB8, 0xA6, 0x0F, 0x5B, 0x05, 0xB4, 0xFA, 0xB1,
+	0xFE, 0x67, 0x87, 0x33, 0xC1, 0x5B, 0xEA, 0x05,
+	0x96, 0xF5, 0x8F, 0x57, 0x58, 0xC4, 0x3E, 0xCD,
+	0x11, 0xA0, 0xC8, 0x4D, 0x3D, 0x0D, 0x11, 0xA0,
+	0x3B, 0xC4, 0x4F, 0x1A, 0xFA, 0x68, 0x64, 0x91,
+	0xBF, 0x8A, 0x87, 0xDA, 0xB7, 0x1C, 0xBC, 0x92,
+	0x59, 0x22, 0x4F, 0xD2,
+
+	0x62, 0x42, 0xD5, 0x49, 0xC4, 0x27, 0xE4, 0xDF,
+	0x20, 0x63, 0x1F, 0x9B, 0x5A, 0x97, 0x6D, 0x33,
+	0x69, 0x1C, 0x42, 0xFC, 0x43, 0x79, 0x20, 0x69,
+	0x66, 0xCC, 0x75, 0xBD, 0x92, 0x78, 0x87, 0x25,
+	0x89, 0x74, 0x3E, 0x0C, 0xE2, 0xEE, 0xE7, 0xD3,
+	0x6B, 0x8C, 0x7E, 0x6F, 0x28, 0xEF, 0x06, 0x59,
+	0x86, 0xB1, 0x69, 0xE7, 0x2C, 0x7F, 0xF7, 0xCB,
+	0x9D, 0x2F, 0xDD, 0x83, 0xBD, 0x2B, 0xB1, 0xCF,
+	0x91, 0x8A, 0x0A, 0xD0, 0x1C, 0x1C, 0xE2, 0xE7,
+	0xA8, 0x80, 0xA3, 0xF6, 0x33, 0x90, 0x80, 0xFE,
+	0x11, 0xC1, 0xCB, 0xD2, 0x68, 0x4A, 0xEB, 0xE2,
+	0x0F, 0x35, 0x5C, 0xC7, 0xEF, 0x16, 0x82, 0x59,
+	0x02, 0x29, 0x95, 0x18, 0xC9, 0xAF, 0x0C, 0x04,
+	0xDC, 0x63, 0xF4, 0x10, 0xF9, 0xE1, 0x07, 0xD3,
+	0xF1, 0xA4, 0xF2, 0xBF, 0x68, 0xA6, 0x5E, 0x83,
+	0x36, 0x76, 0xD5, 0x4C, 0x08, 0x65, 0xE7, 0x30,
+	0x5A, 0xFC, 0xDF, 0x45, 0x55, 0x50, 0x69, 0x81,
+	0x04, 0x81, 0xC5, 0xD9, 0xF9, 0x87, 0xD6, 0x83,
+	0x95, 0x2C, 0x2D, 0x25, 0xF6, 0xD0, 0x53, 0x0A,
+	0x5E, 0xAC, 0x7C, 0xB9, 0x83,
+
+	0x62, 0x26, 0xD5, 0x49, 0xC4, 0x27, 0xE4, 0xDF,
+	0x20, 0x63, 0x1F, 0x9B, 0x52, 0x97, 0x6D, 0x33,
+	0x6B, 0xBC, 0xEC, 0xBE, 0xD6, 0x79, 0x10, 0xEB,
+	0x46, 0xF9, 0x75, 0x3B, 0x92, 0x58, 0x07, 0x24,
+	0x3C, 0x16, 0xAE, 0xEC, 0x58, 0x0D, 0xAF, 0xDE,
+	0xD4, 0x0A, 0xA2, 0x9D, 0x91, 0x7C, 0xBA, 0x09,
+	0x79, 0xB4, 0xA3, 0x17, 0x2D, 0x04, 0xE1, 0x0E,
+	0x86, 0xDB, 0xCB, 0x4C, 0x7E, 0x5F, 0xC8, 0xB6,
+	0x03, 0x99, 0xEB, 0x65, 0x5B, 0xF9, 0xE4, 0x28,
+	0xBF, 0xBC, 0x10, 0x56, 0x10, 0x29, 0xB4, 0xB5,
+	0xA9, 0xA9, 0x63, 0xAB, 0x11, 0x1D, 0x95, 0xED,
+	0xBC, 0xEF, 0xAA, 0xBF, 0x68, 0x80, 0x5A, 0x81,
+	0x2B, 0x6A, 0xA2, 0xA6, 0x7D, 0xDE, 0x4E, 0x33,
+	0x22, 0xFE, 0x5D, 0x47, 0x89, 0x82, 0x0A, 0xAB,
+	0x98, 0xA5, 0x12, 0x1F, 0xC8, 0x31, 0x05, 0x0D,
+	0x90, 0x3E, 0x85, 0xB8, 0xD3, 0xD7, 0xB7, 0x60,
+	0x22, 0x8D, 0x72, 0xF0, 0x08, 0x81, 0xA5, 0x49,
+	0xC4, 0x23, 0xBE, 0x45, 0x73, 0x18, 0xCA, 0x23,
+	0x6B, 0xA9, 0x8E, 0x45, 0x15, 0xC7, 0xFC, 0xC3,
+	0xAE, 0x34, 0xD9, 0x25, 0x2E, 0xD8,
+
+	0x62, 0xA6, 0x91, 0x4D, 0xC4, 0x27, 0xE4, 0xDF,
+	0x20, 0x63, 0x1F, 0x9B, 0x12, 0xA4, 0x6D, 0x33,
+	0x67, 0xB8, 0xCE, 0x61, 0xD6, 0x71, 0x10, 0xAB,
+	0x42, 0x95, 0x51, 0x73, 0x93, 0x58, 0x07, 0x24,
+	0x46, 0x90, 0xFE, 0x8B, 0x48, 0x64, 0x38, 0x95,
+	0xBD, 0x5A, 0x3B, 0xFA, 0x13, 0xF7, 0xD1, 0x89,
+	0xA7, 0x0A, 0x5A, 0x1A, 0x6D, 0x20, 0xE7, 0x9E,
+	0xDA, 0xFF, 0x88, 0xAC, 0xF9, 0x11, 0x6B, 0x6C,
+	0xF6, 0xCC, 0x28, 0x2B, 0x69, 0xC7, 0x25, 0xFE,
+	0x25, 0xBB, 0x88, 0x55, 0x6B, 0x75, 0x4F, 0x47,
+	0x64, 0xA8, 0x42, 0xDB, 0x0C, 0x87, 0x84, 0x2C,
+	0xC4, 0x92, 0x04, 0xF9, 0x0A, 0xED, 0x72, 0xF1,
+	0x19, 0x6C, 0x1D, 0xAC, 0xCF, 0x82, 0xF0, 0xA9,
+	0x60, 0xBC, 0x6F, 0x49, 0xDF, 0xAD, 0xC5, 0x02,
+	0xF9, 0xC5, 0x66, 0xD6, 0x45, 0x59, 0x51, 0xB6,
+	0xAF, 0x0C, 0x1E, 0xCD, 0xC4, 0xC9, 0xEF, 0xB9,
+	0xBD, 0xC3, 0x3F, 0x40, 0xEE, 0xB2, 0x14, 0xC7,
+	0x6E, 0xFB, 0x80, 0xE5, 0x76, 0xAE, 0x68, 0xE1,
+	0x30, 0x14, 0x8B, 0x15, 0xD3, 0xD6, 0xA3, 0xCA,
+	0x8E, 0x56, 0x4B, 0x74, 0x21, 0xC1, 0x9C,
+
+	0x67, 0xA6, 0x91, 0x68, 0xC4, 0x27, 0xE4, 0xDF,
+	0x20, 0x63, 0x1F, 0x9B, 0x12, 0x24, 0x64, 0x33,
+	0x06, 0xAE, 0xCC, 0xED, 0xD4, 0x12, 0x94, 0xEF,
+	0x52, 0x97, 0xE9, 0x62, 0x97, 0x5C, 0x07, 0x24,
+	0x3F, 0xD7, 0x27, 0x12, 0xDC, 0x95, 0x48, 0xED,
+	0xE9, 0x6C, 0x93, 0xCD, 0x86, 0xAE, 0xF3, 0xAF,
+	0x74, 0x80, 0x91, 0x44, 0x5E, 0xE8, 0x26, 0xF0,
+	0xAA, 0x27, 0x55, 0x72, 0x7E, 0xF4, 0x44, 0x8F,
+	0x62, 0x2F, 0x89, 0x7F, 0x18, 0x2C, 0x3B, 0x7A,
+	0x4B, 0x25, 0x59, 0xA4, 0x4F, 0xF2, 0xF1, 0x18,
+	0x3B, 0xF3, 0xA1, 0x96, 0xAD, 0x04, 0x1D, 0xF5,
+	0x0B, 0xC8, 0xC3, 0xB7, 0x31, 0x53, 0xBD, 0x62,
+	0x35, 0x8C, 0x2A, 0x80, 0x47, 0x42, 0x52, 0xC9,
+	0x14, 0xFF, 0xAE, 0xFC, 0x8B, 0xEA, 0x98, 0x09,
+	0x32, 0xD2, 0x22, 0x9C, 0x0C, 0x39, 0x77, 0x21,
+	0x63, 0xE5, 0xF0, 0x30, 0x0A, 0x9D, 0x39, 0x1F,
+	0xAA, 0xD0, 0x73, 0x52, 0xBD, 0x33, 0x10, 0x0D,
+	0x89, 0x09, 0xB7, 0x5E, 0x4D, 0x06, 0x82, 0x49,
+	0xDA, 0xCE, 0x6B, 0x18, 0x1F, 0xE1, 0x27, 0x13,
+	0xE8, 0x61, 0x6C, 0x63, 0x8D, 0x51, 0xC0, 0x5B,
+
+	0xB6, 0xC8, 0x1F, 0xAE, 0xCF, 0x6F, 0x66, 0x7A,
+	0xC9, 0xA4, 0x1D, 0x09, 0xC6, 0x41, 0x60, 0x0A,
+	0xEA, 0xC9, 0x22, 0x1F, 0xC2, 0x41, 0x76, 0x2C,
+	0xC2, 0x23, 0xC7, 0x1B, 0xA0, 0x1D, 0x55, 0xF6,
+	0x96, 0xF9, 0x5F, 0xC5, 0x16, 0x8E, 0x5B, 0xC5,
+	0x20, 0xC1, 0x88, 0xB9, 0xA1, 0x01, 0x9E, 0xFD,
+	0x18, 0x10, 0x6E, 0x5F, 0xDB, 0x60, 0xC6, 0x24,
+	0x7D, 0x4B, 0xF1, 0x92, 0xED, 0x4F, 0xE1, 0x50,
+	0xD0, 0x49, 0xB8, 0x7B, 0xB2, 0x56, 0x85, 0xB0,
+	0x5C, 0x64, 0x31, 0x3A, 0xCF, 0xA2, 0xA2, 0x28,
+	0x8D, 0xCB, 0x7B, 0xF4, 0x5F, 0x8E, 0xB9, 0xB5,
+	0xCF, 0x56, 0x65, 0x99, 0xBF, 0x3C, 0xD7, 0xF6,
+	0x75, 0x49, 0xF5, 0x42, 0xDA, 0x11, 0x47, 0xF0,
+	0xA5, 0x54, 0x0B, 0x45, 0x11, 0x5B, 0x46, 0xAD,
+	0x53, 0xFD, 0x9B, 0x32, 0x5F, 0x47, 0x01, 0x6A,
+	0x65, 0xEE, 0x99, 0x68, 0x6F, 0xE3, 0x2B, 0x6A,
+	0xD5, 0xB8, 0xE5, 0x02, 0x16, 0x22, 0x0B, 0xF9,
+	0x26, 0xC8, 0xD5, 0xFB, 0xB7, 0xB6, 0xDF, 0x37,
+	0x48, 0xB4, 0xB4, 0x41, 0x19, 0x01, 0x89, 0x67,
+	0x3B, 0xB6, 0x44, 0xDE, 0x8B, 0xB3, 0x0C, 0xF0,
+	0x95,
+
+	0xBC, 0xC8, 0x1F, 0x2E, 0xCF, 0x09, 0x66, 0x7A,
+	0xC9, 0xA4, 0x1D, 0x09, 0xC6, 0x41, 0x60, 0x0A,
+	0xEA, 0xC9, 0x78, 0x12, 0x80, 0x45, 0x72, 0x48,
+	0xD3, 0x22, 0xF2, 0x58, 0xB0, 0x2B, 0x55, 0xF2,
+	0x58, 0x9F, 0x48, 0xF8, 0xB6, 0xDC, 0x47, 0x8F,
+	0xC0, 0xD4, 0x5E, 0x93, 0xF4, 0xCA, 0x78, 0xFF,
+	0xD6, 0x0B, 0xBF, 0xE5, 0xB3, 0x70, 0x4F, 0xEF,
+	0x23, 0xFD, 0x4E, 0xD4, 0xF4, 0x44, 0x5D, 0x04,
+	0x12, 0x42, 0xCD, 0x0B, 0x4C, 0x96, 0x88, 0x8B,
+	0x64, 0xFD, 0x2E, 0x30, 0xCE, 0xEC, 0xB4, 0x76,
+	0x6B, 0xA0, 0x6D, 0xFE, 0xB9, 0x2A, 0x7B, 0xD9,
+	0x85, 0xE2, 0xA2, 0xD5, 0x36, 0xEE, 0x8C, 0x05,
+	0x7F, 0xA5, 0xA3, 0xD4, 0x26, 0x41, 0xDF, 0x4A,
+	0xCE, 0x75, 0x74, 0x61, 0x47, 0x33, 0xF9, 0x5A,
+	0xA1, 0xB2, 0x51, 0x5C, 0x4D, 0xFF, 0x9C, 0xBF,
+	0xFA, 0xB9, 0x96, 0x53, 0x76, 0xB1, 0xE3, 0x7B,
+	0x9A, 0xE3, 0x2B, 0x0F, 0x80, 0xB9, 0x1D, 0x33,
+	0x13, 0x65, 0xFF, 0x9A, 0xAD, 0x3B, 0x71, 0xA6,
+	0x3B, 0xB8, 0x3F, 0x7D, 0x6F, 0xF3, 0xA3, 0x20,
+	0xA8, 0x23, 0x4B, 0x4A, 0x4C, 0xFA, 0x49, 0xC2,
+	0x98, 0x29,
+
+	0xFC, 0xD8, 0x1F, 0x2E, 0xCF, 0x89, 0xA0, 0x7E,
+	0xC9, 0xA4, 0x1D, 0x09, 0xC6, 0x41, 0x60, 0x0A,
+	0xEA, 0xC9, 0x4C, 0x4A, 0xE0, 0x41, 0x32, 0xD3,
+	0x43, 0x2B, 0xAA, 0x1A, 0xF2, 0x37, 0x70, 0x92,
+	0x03, 0xD9, 0x59, 0x24, 0xC1, 0x23, 0x4E, 0xD5,
+	0x4B, 0xFA, 0x13, 0x4B, 0xE2, 0x7B, 0x7C, 0x49,
+	0x31, 0xE7, 0xBF, 0x17, 0xC5, 0x3F, 0xA0, 0x08,
+	0x7D, 0x90, 0x2C, 0x20, 0x04, 0x87, 0x4B, 0xA8,
+	0x08, 0xDA, 0xFA, 0x18, 0xD3, 0x88, 0x34, 0x56,
+	0xF6, 0x63, 0x09, 0x98, 0xC9, 0xC6, 0xD7, 0xAF,
+	0x4C, 0xB8, 0x01, 0xFD, 0x22, 0x83, 0xAD, 0xD1,
+	0xB8, 0xE9, 0xCD, 0xEA, 0xEA, 0x54, 0x7A, 0x3F,
+	0x97, 0x59, 0x22, 0x96, 0x2A, 0x20, 0x13, 0xD2,
+	0x1D, 0x58, 0x30, 0x6E, 0x67, 0xCF, 0x5E, 0x7C,
+	0x0A, 0xF4, 0x10, 0xDC, 0x2D, 0x8B, 0x8E, 0xFF,
+	0x4B, 0x3D, 0x17, 0x1C, 0x5B, 0x24, 0xF8, 0xB4,
+	0x61, 0x4E, 0xD3, 0xE9, 0x21, 0x72, 0x2D, 0x27,
+	0x79, 0x21, 0xE4, 0x52, 0x2D, 0x85, 0x98, 0xFA,
+	0xFE, 0x5D, 0x1C, 0x8F, 0xB0, 0xE5, 0x51, 0xB8,
+	0xFC, 0x08, 0x8D, 0x07, 0x43, 0xB8, 0x78, 0x73,
+	0x7C, 0x0E, 0x8B,
+
+	0xFC, 0x98, 0x15, 0x2E, 0xEB, 0x89, 0xA0, 0x59,
+	0xC9, 0xA4, 0x1D, 0x09, 0xC6, 0x41, 0x60, 0x0A,
+	0xD2, 0xC9, 0x4C, 0x42, 0x95, 0x31, 0x10, 0x97,
+	0x4B, 0x2A, 0xAE, 0x9B, 0xA2, 0xF7, 0xE8, 0x82,
+	0xB5, 0x37, 0x64, 0xC0, 0x07, 0x48, 0x5B, 0xFE,
+	0xD7, 0x36, 0x7B, 0xCA, 0xA6, 0x43, 0x83, 0x5B,
+	0xDC, 0x52, 0x74, 0xEC, 0xB1, 0x25, 0xCB, 0x21,
+	0xE1, 0x1F, 0x61, 0xC8, 0x84, 0x31, 0x0C, 0x6F,
+	0x5C, 0x8C, 0x61, 0x57, 0x4D, 0x06, 0xAB, 0xDC,
+	0x63, 0x0F, 0x8D, 0x6C, 0xAB, 0xEF, 0xA8, 0xF8,
+	0xCC, 0xB8, 0xD8, 0x05, 0xAA, 0xEE, 0x9C, 0x0D,
+	0xA4, 0xFE, 0x01, 0xEF, 0x59, 0xDD, 0xC2, 0x5C,
+	0xA3, 0xCA, 0x59, 0x6C, 0x57, 0xB1, 0x2B, 0xDB,
+	0xA6, 0xCD, 0x46, 0xD6, 0x3C, 0x5F, 0x14, 0xCE,
+	0xBC, 0x9C, 0x1A, 0x41, 0x32, 0x53, 0xAB, 0xD8,
+	0xE0, 0x05, 0xA3, 0x9C, 0xAC, 0xBB, 0x70, 0x2D,
+	0x8C, 0xBD, 0xD6, 0xD3, 0x04, 0xDF, 0x6F, 0x92,
+	0x1B, 0x3E, 0x05, 0x2A, 0x38, 0x32, 0xF8, 0x5F,
+	0xC4, 0x08, 0x50, 0x07, 0x66, 0x4C, 0x76, 0xF2,
+	0xE8, 0x25, 0xE2, 0x05, 0xCB, 0xCF, 0x55, 0xD1,
+	0x09, 0x9A, 0xE7, 0x25,
+
+	0xFC, 0x98, 0x15, 0x2E, 0xEB, 0x89, 0x80, 0x59,
+	0x4E, 0xA3, 0x1D, 0x89, 0xC6, 0x41, 0x60, 0x0A,
+	0xD1, 0xA1, 0x4C, 0xD6, 0x95, 0x1D, 0x10, 0x97,
+	0xFB, 0x9A, 0xFE, 0x9F, 0x00, 0x14, 0xB9, 0x83,
+	0xAF, 0x8B, 0xC5, 0x73, 0xC0, 0xDF, 0x9B, 0x7E,
+	0x90, 0xCB, 0x3D, 0x36, 0xCB, 0x1F, 0x03, 0x45,
+	0x81, 0x79, 0x68, 0xCE, 0x1B, 0x52, 0x3A, 0x24,
+	0xDE, 0x4C, 0xF0, 0xCE, 0xAE, 0x8E, 0x98, 0xD5,
+	0x8C, 0x05, 0x41, 0xFC, 0xD4, 0xA6, 0x78, 0xBC,
+	0x2D, 0x28, 0x1E, 0x33, 0x7E, 0x02, 0x2E, 0xDD,
+	0xF0, 0x37, 0x5E, 0x07, 0x3B, 0xF9, 0x9F, 0x4A,
+	0xE2, 0x89, 0xB4, 0xCC, 0xA3, 0x61, 0x90, 0x17,
+	0xDF, 0xD7, 0xA3, 0x6D, 0xC2, 0x3A, 0x83, 0x4E,
+	0x1A, 0x86, 0x5B, 0x36, 0x98, 0xDD, 0x2A, 0x01,
+	0x4F, 0x4D, 0x5C, 0xB8, 0x9E, 0xA6, 0xC5, 0xA5,
+	0xD6, 0xFE, 0x27, 0xC0, 0x71, 0x37, 0x06, 0x74,
+	0x52, 0xE4, 0x8B, 0x9D, 0x7B, 0x86, 0x38, 0x3C,
+	0x3E, 0xB7, 0x28, 0xCB, 0xBD, 0x31, 0x74, 0x79,
+	0xC9, 0xEF, 0x73, 0x83, 0x58, 0xE4, 0xCA, 0xB5,
+	0x91, 0x8E, 0xB9, 0x4F, 0x3E, 0x8B, 0x8C, 0xF1,
+	0x87, 0x25, 0x3B, 0x94, 0xBC,
+
+	0xFC, 0x98, 0x15, 0x2E, 0xEF, 0x89, 0x80, 0x59,
+	0x4E, 0xCB, 0x75, 0x89, 0xC6, 0x41, 0x60, 0x0A,
+	0x11, 0x90, 0xCC, 0xD0, 0x95, 0x1D, 0xB0, 0x97,
+	0xBD, 0x5B, 0xF1, 0x90, 0x00, 0x20, 0xAD, 0x81,
+	0x24, 0x5D, 0x24, 0xB4, 0xB8, 0x74, 0x95, 0x0F,
+	0xED, 0x68, 0xA3, 0xD8, 0x52, 0x4D, 0xB3, 0x16,
+	0xFD, 0x05, 0x02, 0x42, 0x09, 0xBC, 0x43, 0x13,
+	0x86, 0x8F, 0x39, 0x96, 0xC6, 0x43, 0xC4, 0x97,
+	0xD1, 0x05, 0x25, 0x47, 0x6B, 0x34, 0x2B, 0x46,
+	0xE4, 0x7D, 0xAD, 0x20, 0x99, 0x93, 0x35, 0xD1,
+	0x2A, 0x43, 0x0C, 0x0F, 0x9E, 0x25, 0xD3, 0x1E,
+	0x18, 0x2B, 0xDA, 0xC3, 0x4F, 0x6F, 0x4F, 0x17,
+	0x08, 0xF8, 0x6D, 0x50, 0x0A, 0x5E, 0xF0, 0x70,
+	0x75, 0xB3, 0x30, 0xEB, 0x8D, 0x45, 0x66, 0x52,
+	0xAC, 0x9D, 0x18, 0x55, 0xCB, 0xBC, 0x6E, 0x41,
+	0x99, 0x5F, 0xD3, 0x4F, 0xDF, 0xC1, 0xD5, 0x8B,
+	0x31, 0xDF, 0xC2, 0x34, 0x92, 0x2B, 0x63, 0x0E,
+	0x49, 0x73, 0x65, 0x9C, 0x07, 0x00, 0x96, 0x01,
+	0x4E, 0x7E, 0x2C, 0xB9, 0x83, 0xE1, 0x38, 0x86,
+	0x40, 0xF9, 0xAD, 0xC3, 0x9F, 0x56, 0xD8, 0xF6,
+	0xD0, 0x6D, 0x28, 0x00, 0xF1, 0x10,
+
+	0xFC, 0x98, 0x15, 0x2E, 0xEF, 0x9F, 0x80, 0x59,
+	0x4E, 0x4B, 0x3D, 0xC9, 0xC6, 0x41, 0x60, 0x0A,
+	0x91, 0x9D, 0xEA, 0x58, 0x9D, 0x1D, 0xB4, 0xA7,
+	0x34, 0x4F, 0xD3, 0x81, 0x22, 0x07, 0xED, 0x81,
+	0x83, 0x5D, 0xF9, 0x7F, 0x6E, 0x80, 0x9A, 0x7C,
+	0x99, 0xEE, 0xF2, 0x3E, 0x41, 0x1C, 0xE7, 0xB8,
+	0x43, 0x41, 0xE7, 0xD1, 0x7B, 0x7A, 0x8D, 0xCE,
+	0x4B, 0xFF, 0x9F, 0x3B, 0x54, 0x6F, 0x15, 0xED,
+	0x3F, 0xC6, 0x61, 0x35, 0x44, 0x39, 0x7E, 0xD6,
+	0x14, 0x9D, 0xD9, 0xDA, 0xF9, 0x55, 0x96, 0xED,
+	0x69, 0x4E, 0x49, 0xB0, 0x52, 0xC5, 0xCB, 0x84,
+	0xBE, 0xBF, 0xEA, 0x18, 0xDB, 0xF6, 0x5B, 0x09,
+	0x94, 0xAB, 0x39, 0xA2, 0xE2, 0x23, 0x6B, 0x59,
+	0xD3, 0x15, 0xA1, 0xF6, 0x1E, 0x64, 0x72, 0x1A,
+	0x71, 0x8F, 0xB4, 0x37, 0x81, 0x4E, 0x38, 0x02,
+	0x4E, 0x09, 0xA3, 0xAE, 0x8C, 0x45, 0x3D, 0xD0,
+	0x69, 0x3C, 0x46, 0xB9, 0xE4, 0x61, 0xF8, 0x39,
+	0x7B, 0x5F, 0x36, 0xE2, 0x3F, 0x0D, 0xD3, 0x7F,
+	0xC0, 0x11, 0xEE, 0xD1, 0x24, 0xE0, 0xFD, 0x4B,
+	0x75, 0x13, 0x6E, 0x89, 0x8A, 0xA9, 0xE1, 0x59,
+	0x5F, 0x37, 0xF0, 0xB1, 0x45, 0xFF, 0x67,
+
+	0xFC, 0x98, 0x15, 0x2E, 0xEF, 0x9F, 0x84, 0x59,
+	0x66, 0x4B, 0x3D, 0xE0, 0xC6, 0x41, 0x60, 0x0A,
+	0x11, 0x0F, 0xB7, 0x4F, 0x3D, 0x19, 0xB4, 0xEF,
+	0x75, 0xDA, 0xD3, 0x65, 0x30, 0x13, 0xE1, 0xA1,
+	0x3B, 0xB9, 0x4E, 0xAF, 0xAA, 0xA0, 0x50, 0xC7,
+	0xC5, 0x2E, 0x62, 0x0B, 0x9B, 0x38, 0x8C, 0xF1,
+	0x7C, 0x20, 0x8F, 0x53, 0x10, 0x6B, 0x9C, 0x07,
+	0x38, 0xF6, 0x69, 0x36, 0xD1, 0x14, 0x7E, 0x25,
+	0xAE, 0xDD, 0x18, 0x33, 0xAA, 0x2E, 0x2C, 0x77,
+	0xA6, 0x01, 0x1A, 0xD3, 0xC0, 0x84, 0x24, 0xDF,
+	0xFE, 0x6C, 0x93, 0xB8, 0xD9, 0x04, 0x64, 0x39,
+	0x27, 0x22, 0x1B, 0x0B, 0x4F, 0xBB, 0xC1, 0xB4,
+	0x36, 0xA9, 0xA5, 0xEC, 0xE9, 0x3B, 0x45, 0x96,
+	0x45, 0xDD, 0xD2, 0x73, 0x72, 0x0A, 0xCF, 0xAB,
+	0x5E, 0x8C, 0x4D, 0x36, 0x4E, 0x65, 0x18, 0xF8,
+	0xBE, 0xAB, 0xB8, 0xDD, 0x55, 0x67, 0x91, 0x25,
+	0x5B, 0x8F, 0x7D, 0xEF, 0xE7, 0xF6, 0x3F, 0xD7,
+	0x62, 0x94, 0x27, 0x00, 0x2D, 0xDB, 0x9A, 0x5A,
+	0x7E, 0x2E, 0x72, 0x19, 0xA7, 0xB0, 0xB4, 0x22,
+	0xF6, 0x71, 0x97, 0x9E, 0x22, 0x83, 0x68, 0x45,
+	0xF7, 0xA9, 0xC6, 0x9E, 0x83, 0x56, 0xFB, 0xBE,
+
+	0xFC, 0x98, 0x15, 0x2E, 0xEF, 0x9F, 0x84, 0x59,
+	0x66, 0x4B, 0xBD, 0xE6, 0x4F, 0x48, 0x60, 0x8A,
+	0x53, 0x4F, 0x93, 0x5E, 0x39, 0x99, 0xB4, 0x7B,
+	0x35, 0xF8, 0xD3, 0x65, 0x62, 0x26, 0x79, 0x81,
+	0x00, 0x2F, 0x59, 0x81, 0x22, 0xE2, 0x4C, 0xEF,
+	0xD7, 0x81, 0x3F, 0x5A, 0xCB, 0x04, 0xB2, 0x75,
+	0x69, 0xD3, 0x90, 0x9E, 0xE1, 0x64, 0x27, 0x21,
+	0x15, 0x96, 0xE4, 0xAA, 0xF2, 0x01, 0x44, 0xED,
+	0x78, 0x05, 0x3F, 0x99, 0x37, 0xBA, 0x1E, 0xBB,
+	0x9C, 0x90, 0x5D, 0x4E, 0x28, 0xF6, 0x7E, 0x64,
+	0x74, 0x41, 0x07, 0xEC, 0xA2, 0xEE, 0x47, 0x17,
+	0x68, 0x19, 0x9D, 0x56, 0xC3, 0x81, 0x40, 0xB2,
+	0x4D, 0xFA, 0x81, 0xBB, 0x86, 0x5C, 0x52, 0xCF,
+	0xB6, 0x82, 0x07, 0x3C, 0x62, 0x45, 0x48, 0xF3,
+	0xEF, 0x9D, 0x81, 0x33, 0xED, 0xF6, 0x8D, 0x29,
+	0xE5, 0x0C, 0x47, 0x54, 0xAE, 0x25, 0x50, 0xEB,
+	0x21, 0xCA, 0x3F, 0x0D, 0xA8, 0x21, 0x3F, 0x96,
+	0x96, 0xAB, 0x61, 0x59, 0x79, 0x62, 0x7E, 0x30,
+	0x8B, 0xA5, 0xF5, 0xAF, 0x3C, 0x96, 0x4E, 0x4D,
+	0xE9, 0x9B, 0x64, 0x67, 0x80, 0x60, 0xAE, 0x13,
+	0x28, 0x56, 0x0A, 0x8D, 0x4A, 0x8E, 0xDB, 0xD1,
+	0x2D,
+
+	0xFC, 0x98, 0x15, 0x2E, 0xEF, 0x9F, 0x84, 0x59,
+	0x4E, 0x4B, 0xBD, 0xE6, 0x4F, 0x22, 0x4A, 0x8A,
+	0x51, 0x4B, 0xF5, 0x4A, 0xC8, 0xA9, 0x34, 0x77,
+	0x35, 0xF8, 0xB3, 0x6C, 0x64, 0x5E, 0x70, 0x2E,
+	0x69, 0x4E, 0x1B, 0x85, 0x16, 0x01, 0xAA, 0x89,
+	0xD0, 0xB3, 0x9B, 0xB1, 0x0C, 0x2F, 0x62, 0xDA,
+	0x7A, 0x3B, 0xC7, 0x79, 0x49, 0xC0, 0x32, 0x78,
+	0x78, 0x32, 0xC3, 0x6E, 0xA4, 0x52, 0x99, 0xE5,
+	0xE2, 0x68, 0x7B, 0x6F, 0x6A, 0x02, 0x11, 0x3F,
+	0xBC, 0xC5, 0xBA, 0x57, 0xD1, 0xE4, 0xE1, 0x1B,
+	0x95, 0xD5, 0x32, 0x7F, 0x2C, 0x65, 0x5F, 0x75,
+	0x86, 0xA4, 0x15, 0xB7, 0x50, 0xC0, 0xE2, 0x68,
+	0x54, 0x15, 0x3D, 0x08, 0x68, 0x34, 0x55, 0x97,
+	0x95, 0x95, 0xAB, 0xC5, 0x7E, 0xC1, 0x1F, 0x09,
+	0x61, 0x5A, 0x69, 0xE3, 0xD8, 0xEF, 0x95, 0xE2,
+	0x59, 0xAD, 0x7E, 0xF5, 0x4F, 0xEB, 0xCA, 0x4D,
+	0x0E, 0x4B, 0xE2, 0x67, 0x9E, 0x42, 0x4E, 0x54,
+	0xF5, 0x43, 0x45, 0x97, 0x1A, 0xC6, 0xDC, 0xA9,
+	0xC3, 0x0F, 0xA8, 0xDF, 0x40, 0x3E, 0x08, 0x7D,
+	0xE5, 0x3C, 0x28, 0xB4, 0x10, 0xF3, 0x23, 0xBB,
+	0x75, 0x40, 0x94, 0x3C, 0xDE, 0x07, 0x5C, 0x7B,
+	0xF6, 0x80,
+
+	0xFC, 0x98, 0x15, 0x2E, 0xEF, 0x9F, 0x84, 0x59,
+	0x2E, 0x6D, 0xBD, 0xE6, 0x4F, 0xA2, 0x80, 0x82,
+	0xB7, 0x14, 0xBE, 0x3B, 0x79, 0x94, 0x13, 0xFF,
+	0x3D, 0xF8, 0xA3, 0x44, 0xC5, 0x5A, 0x68, 0x75,
+	0xF3, 0xA7, 0x9A, 0x27, 0xE3, 0x61, 0x24, 0x2E,
+	0x4A, 0xB2, 0xCE, 0x9D, 0xF8, 0x32, 0x3B, 0x79,
+	0xDA, 0xDB, 0x0D, 0x85, 0xEB, 0x1C, 0x06, 0x5D,
+	0x8C, 0x9C, 0x46, 0xA1, 0xEF, 0xB2, 0xB2, 0xDA,
+	0xF0, 0x02, 0x8E, 0xE6, 0x65, 0xE0, 0xBA, 0x90,
+	0xEA, 0xCF, 0xDB, 0xD2, 0xA1, 0x2A, 0x2B, 0xE1,
+	0x27, 0x17, 0x58, 0xA1, 0x9B, 0x30, 0x27, 0x7A,
+	0xD8, 0xDA, 0x5D, 0x28, 0xB8, 0x4B, 0x3B, 0x2D,
+	0x79, 0x2E, 0x8B, 0xE7, 0x83, 0xA9, 0x52, 0x62,
+	0xC6, 0xBF, 0xBB, 0x6F, 0xCA, 0x76, 0x9F, 0x08,
+	0xA8, 0xE7, 0x0F, 0xF2, 0xE6, 0xCC, 0xA6, 0xB9,
+	0x3D, 0xBD, 0x0A, 0x84, 0x8E, 0x6F, 0xFF, 0xB2,
+	0x17, 0x24, 0x24, 0x24, 0x78, 0x1B, 0x85, 0xA1,
+	0xEE, 0x7D, 0xA5, 0x8F, 0x17, 0x73, 0x3C, 0x67,
+	0x37, 0x5F, 0x58, 0xC4, 0xDA, 0xCB, 0x29, 0xCD,
+	0x2B, 0xCA, 0x27, 0x6B, 0xCD, 0xD1, 0x2A, 0xE3,
+	0x7E, 0x19, 0x98, 0x36, 0x3A, 0x6F, 0x7E, 0x75,
+	0x46, 0x9D, 0x66,
+
+	0xFC, 0x98, 0x15, 0x2E, 0xEF, 0x9F, 0x84, 0x59,
+	0x2E, 0x6D, 0xA7, 0xE6, 0x4D, 0xA2, 0x80, 0xA9,
+	0xE5, 0xA1, 0xB4, 0xAA, 0xD9, 0x94, 0x7E, 0xE9,
+	0x58, 0xF8, 0xA3, 0x0C, 0xC1, 0xCB, 0x4A, 0xD5,
+	0xFA, 0xA5, 0x00, 0x27, 0x5D, 0xF8, 0xEE, 0xB3,
+	0x46, 0xFF, 0x49, 0x1F, 0xA7, 0x98, 0x17, 0x69,
+	0xDF, 0xEE, 0x06, 0x41, 0xDF, 0xC0, 0xCE, 0xC0,
+	0x6E, 0xEE, 0xE0, 0x38, 0x88, 0x6D, 0x58, 0x03,
+	0x3C, 0x27, 0x28, 0x42, 0x60, 0x53, 0xB0, 0x0B,
+	0x12, 0x9D, 0xCC, 0x6F, 0xE1, 0x7C, 0xD8, 0x2D,
+	0x2C, 0xA7, 0xFC, 0xF3, 0xEE, 0x4E, 0x9E, 0x53,
+	0xFF, 0xAE, 0x1F, 0x13, 0x71, 0x83, 0x3E, 0x84,
+	0xA8, 0x63, 0x8E, 0x73, 0xFE, 0x3D, 0xA5, 0x82,
+	0xFC, 0x83, 0xE9, 0xB7, 0x6F, 0xA4, 0x60, 0x7B,
+	0x02, 0x3A, 0x75, 0xAE, 0x14, 0xF9, 0xED, 0xDB,
+	0xCE, 0x47, 0xE8, 0xA8, 0xF8, 0x29, 0xFA, 0x1F,
+	0x00, 0xB6, 0x5D, 0x94, 0x71, 0x8B, 0x2E, 0x8D,
+	0xE9, 0xD1, 0x56, 0x11, 0xFE, 0x66, 0x1C, 0x3F,
+	0xF9, 0xA1, 0x78, 0x15, 0x5F, 0x5E, 0x24, 0x10,
+	0x77, 0xEE, 0xF0, 0xF4, 0x7B, 0xCC, 0x8E, 0x24,
+	0x4C, 0x42, 0x11, 0x7C, 0xBB, 0x13, 0xB2, 0x53,
+	0x4A, 0x08, 0xD9, 0x7C,
+
+	0xF7, 0x92, 0x15, 0xAE, 0xEF, 0x9F, 0x84, 0x59,
+	0x2E, 0x6D, 0xA7, 0xE6, 0x4D, 0xA2, 0x80, 0xAA,
+	0xF6, 0x51, 0x0D, 0xBA, 0x19, 0x15, 0x9B, 0x68,
+	0x5D, 0xE8, 0xA3, 0x88, 0x81, 0xF7, 0x4A, 0xD5,
+	0xF9, 0x82, 0xCB, 0x78, 0x15, 0xC9, 0xAB, 0xEB,
+	0x6C, 0x54, 0x90, 0xE2, 0x60, 0x15, 0x24, 0x01,
+	0xC9, 0x7B, 0x47, 0x52, 0x11, 0xD1, 0xDC, 0xAC,
+	0xC1, 0x4B, 0x12, 0x85, 0x02, 0xE1, 0x55, 0x5A,
+	0x41, 0x7B, 0xC5, 0xA0, 0x7A, 0xAE, 0xBD, 0xC3,
+	0x3F, 0xAF, 0xB3, 0xEF, 0xAB, 0xAA, 0x7F, 0xCB,
+	0xA8, 0xB7, 0xAC, 0x6E, 0xFF, 0x06, 0xBF, 0x83,
+	0xA5, 0xAC, 0x81, 0x31, 0x76, 0xBA, 0x08, 0x84,
+	0x36, 0x75, 0x8F, 0x00, 0xF8, 0xAF, 0xFB, 0xF9,
+	0x43, 0x15, 0x6E, 0x4A, 0x77, 0xB3, 0xB3, 0x96,
+	0x77, 0xDA, 0xD7, 0x36, 0xE0, 0x85, 0x8C, 0xFD,
+	0xD4, 0x98, 0xA3, 0x26, 0xDF, 0xDE, 0xD0, 0xBD,
+	0x80, 0xA1, 0xEC, 0xB8, 0xD7, 0x86, 0x65, 0x7F,
+	0x98, 0xCA, 0xE7, 0x66, 0x12, 0x94, 0x9B, 0x89,
+	0x5C, 0x42, 0x42, 0x0D, 0x10, 0x88, 0x96, 0x20,
+	0x1B, 0x35, 0x5F, 0xF4, 0xBC, 0x7C, 0x58, 0x69,
+	0x54, 0x94, 0xAD, 0xDC, 0x5B, 0x6D, 0x5A, 0x24,
+	0x98, 0xE0, 0x3A, 0xEB, 0xCB,
+
+	0xF7, 0xFE, 0x7D, 0xAE, 0xEF, 0x9F, 0x84, 0x59,
+	0x2E, 0x6D, 0xA7, 0xE6, 0x68, 0xA2, 0x80, 0xAA,
+	0xF0, 0x00, 0xCC, 0x77, 0x98, 0x11, 0xDF, 0x4A,
+	0xB8, 0xDF, 0xA3, 0x4A, 0x81, 0xF7, 0x7B, 0xD8,
+	0x48, 0x1F, 0xC6, 0x80, 0x5C, 0x35, 0x2D, 0xCB,
+	0xA1, 0xB5, 0x5A, 0x66, 0x64, 0x27, 0x10, 0xC7,
+	0xE3, 0x57, 0x58, 0xF0, 0xD0, 0x99, 0x72, 0x0F,
+	0xFD, 0xC3, 0xAD, 0xCC, 0xA1, 0xB1, 0x31, 0xD2,
+	0xF1, 0x46, 0x21, 0x28, 0xAE, 0x36, 0xE5, 0x03,
+	0x8C, 0xA6, 0xFB, 0x33, 0xA8, 0x61, 0xD4, 0xE1,
+	0xC1, 0xE1, 0x05, 0xC4, 0xF6, 0x89, 0x3E, 0xD3,
+	0x99, 0x04, 0xFC, 0x78, 0xF5, 0x21, 0x8E, 0xBC,
+	0xCD, 0xF3, 0xC2, 0x7C, 0xD4, 0xBB, 0x9F, 0x1A,
+	0xC4, 0xA1, 0xC0, 0xBD, 0x96, 0xA3, 0xDB, 0xB2,
+	0x0D, 0x4B, 0x53, 0x0B, 0x82, 0xA1, 0x25, 0xD1,
+	0xA1, 0x67, 0x5B, 0x40, 0xEC, 0x56, 0x62, 0x63,
+	0xA5, 0x28, 0xF9, 0x02, 0x46, 0x5C, 0x04, 0x03,
+	0xE7, 0x60, 0xBA, 0xB6, 0x65, 0xC7, 0x4E, 0xFC,
+	0x99, 0x06, 0x83, 0x0E, 0x78, 0x25, 0x9E, 0x30,
+	0x30, 0xC1, 0xE9, 0xCA, 0xF9, 0x9D, 0xFC, 0xDA,
+	0xBB, 0x23, 0x6F, 0x92, 0x7D, 0x3A, 0x9A, 0x43,
+	0x90, 0x82, 0x1F, 0xD0, 0x26, 0x18,
+
+	0xF7, 0x7E, 0xB1, 0xA2, 0xEF, 0x9F, 0x84, 0x59,
+	0x2E, 0x6D, 0xA7, 0xE6, 0x48, 0x97, 0x80, 0xAA,
+	0x30, 0x14, 0x4A, 0xFC, 0xAE, 0x98, 0x65, 0x0E,
+	0xB5, 0x33, 0x83, 0xC2, 0x81, 0xF7, 0x5B, 0xC8,
+	0x81, 0xA7, 0xFE, 0xBE, 0xEC, 0xE4, 0x61, 0xDE,
+	0x65, 0xAD, 0x81, 0x47, 0x7B, 0x26, 0x7C, 0xB9,
+	0xC4, 0x16, 0x62, 0xD1, 0x3E, 0x6F, 0x20, 0xFB,
+	0x8F, 0x00, 0xBF, 0x8A, 0x64, 0x8A, 0x05, 0xEB,
+	0x65, 0x6B, 0xD3, 0x82, 0xBD, 0xF7, 0x98, 0x4B,
+	0x8C, 0x09, 0xB2, 0x41, 0xDB, 0xAE, 0xF7, 0x91,
+	0x14, 0xD4, 0xB7, 0xC8, 0x5A, 0xA6, 0x80, 0x58,
+	0xF4, 0x04, 0x5C, 0x83, 0x42, 0x76, 0x73, 0xD8,
+	0x06, 0x0B, 0x32, 0x12, 0x4E, 0x73, 0xEB, 0xF0,
+	0x2C, 0xCA, 0x5F, 0xF9, 0x99, 0xF6, 0xD4, 0xC2,
+	0x98, 0xC6, 0xC0, 0x33, 0xEF, 0x03, 0xBD, 0x22,
+	0x23, 0xB5, 0xE8, 0x83, 0xEC, 0xDD, 0x77, 0x4E,
+	0x3B, 0x7E, 0xA0, 0xE6, 0x22, 0x58, 0x90, 0x35,
+	0x3C, 0x21, 0x4D, 0x5F, 0xA9, 0xA1, 0x75, 0xF6,
+	0xCD, 0xBB, 0xFF, 0xC8, 0xDE, 0x62, 0x65, 0xD1,
+	0x51, 0xFF, 0x4E, 0x89, 0x40, 0xF7, 0x49, 0xB8,
+	0xA5, 0x17, 0x00, 0x2E, 0xA9, 0x88, 0x9E, 0x2F,
+	0xF2, 0x2F, 0xF3, 0x2B, 0x6F, 0xEA, 0x79,
+
+	0xFE, 0x7E, 0xB1, 0x8F, 0xEF, 0x9F, 0x84, 0x59,
+	0x2E, 0x6D, 0xA7, 0xE6, 0x48, 0xD7, 0x95, 0xAA,
+	0x08, 0xC0, 0x48, 0xA8, 0xE8, 0x3D, 0xE7, 0xEC,
+	0x75, 0x37, 0xBB, 0xD7, 0x24, 0xF6, 0x5B, 0x88,
+	0x24, 0x1C, 0x6F, 0xF6, 0x8A, 0x06, 0x63, 0x42,
+	0x5F, 0xA7, 0x32, 0x2D, 0xEB, 0xB8, 0x77, 0x07,
+	0x7A, 0x4F, 0xBE, 0xD3, 0x7D, 0x69, 0xB9, 0x9D,
+	0x36, 0x67, 0x26, 0x8A, 0xB4, 0x49, 0x34, 0x73,
+	0x74, 0xDB, 0xDD, 0x68, 0xDA, 0x49, 0x4D, 0x11,
+	0x4C, 0x55, 0x6C, 0xFA, 0x67, 0x70, 0x48, 0xA1,
+	0x98, 0x86, 0x7C, 0xB5, 0x07, 0xE5, 0x0A, 0x42,
+	0x7B, 0x9E, 0x6D, 0xB7, 0xE7, 0xD2, 0xED, 0xAE,
+	0x23, 0x1E, 0xD5, 0x68, 0xB3, 0x83, 0x71, 0x72,
+	0x8C, 0xE5, 0xA9, 0xA4, 0xF9, 0xF3, 0xEC, 0xE9,
+	0x64, 0x72, 0xAE, 0x8A, 0x7D, 0xF6, 0x42, 0x61,
+	0x46, 0xED, 0x72, 0x2B, 0xC4, 0x8D, 0x51, 0x6B,
+	0xBA, 0x21, 0x7B, 0xA3, 0x4F, 0xDD, 0xAF, 0xB5,
+	0x28, 0x57, 0x26, 0x9C, 0x08, 0xAE, 0xFA, 0x05,
+	0xB1, 0x6E, 0xD6, 0xD0, 0x4A, 0xBF, 0x76, 0x60,
+	0x9B, 0x5E, 0xC1, 0x44, 0x37, 0x58, 0xBD, 0x9A,
+	0xFF, 0x3C, 0xCE, 0x3C, 0xA9, 0x48, 0xF3, 0x70,
+	0xBF, 0xF5, 0x9E, 0x85, 0xCF, 0x8C, 0x10, 0x37,
+
+	0x5B, 0xEF, 0x36, 0xDB, 0xF9, 0x08, 0x1D, 0x5B,
+	0xCD, 0x8F, 0x67, 0x9C, 0x1D, 0xAA, 0xBC, 0xB4,
+	0x50, 0x28, 0xBC, 0x8B, 0xE4, 0xFC, 0x3A, 0x7A,
+	0xF6, 0x39, 0x6F, 0xD2, 0xE6, 0x6E, 0xAA, 0x07,
+	0xA8, 0x10, 0x4D, 0x4D, 0x69, 0x86, 0x99, 0x6C,
+	0xE5, 0x21, 0x34, 0xC5, 0xDE, 0x8F, 0x22, 0xF6,
+	0xE8, 0x86, 0x43, 0x11, 0x66, 0x09, 0xEE, 0xA5,
+	0xB2, 0xA4, 0xD0, 0xF2, 0xE1, 0x5B, 0x84, 0xF7,
+	0xF8, 0x33, 0xC1, 0x6E, 0x82, 0x4A, 0x68, 0x4A,
+	0x93, 0x61, 0xBB, 0x1D, 0xE6, 0xD8, 0xE6, 0x4D,
+	0x67, 0x04, 0x68, 0xFB, 0x32, 0xC6, 0x63, 0x27,
+	0xC8, 0x9E, 0xA6, 0x60, 0x18, 0x77, 0x0F, 0xF9,
+	0x4E, 0xC2, 0xC1, 0x50, 0x3B, 0x88, 0xF8, 0x4A,
+	0xD9, 0xDB, 0xCF, 0xE8, 0xFA, 0x48, 0x6C, 0xED,
+	0x6A, 0x1D, 0x14, 0x71, 0x90, 0xAC, 0xC0, 0x6D,
+	0xE3, 0x27, 0xF5, 0xF5, 0x99, 0x19, 0xEA, 0x2B,
+	0x47, 0x6B, 0xC0, 0xE0, 0x0F, 0x00, 0x1D, 0xA7,
+	0x88, 0xC4, 0x20, 0x11, 0xA5, 0x12, 0x28, 0xEE,
+	0xB0, 0x19, 0xBE, 0x67, 0x65, 0x48, 0xB6, 0x20,
+	0x64, 0x3A, 0x3D, 0xB9, 0x59, 0x51, 0xB3, 0x4F,
+	0x35, 0xF0, 0xF0, 0x4E, 0x63, 0xBB, 0x14, 0xBD,
+	0x73, 0x91, 0x1C, 0x5D, 0xEE, 0xD8, 0xEF, 0x05,
+	0xA5,
+
+	0x73, 0xEF, 0x36, 0x5B, 0xF9, 0x66, 0x5B, 0x5B,
+	0xCD, 0x8F, 0x67, 0x9C, 0x1D, 0xAA, 0xBC, 0xB4,
+	0x50, 0x28, 0x9E, 0x8E, 0x62, 0xF6, 0xD6, 0x97,
+	0xE1, 0x21, 0x7F, 0x90, 0x3F, 0x5C, 0x4A, 0xE5,
+	0xC0, 0x68, 0x73, 0xE9, 0x93, 0x52, 0xCD, 0x60,
+	0x95, 0xC8, 0x0D, 0x60, 0x8C, 0xE4, 0x44, 0x63,
+	0x45, 0x02, 0xFD, 0x67, 0x7E, 0xF1, 0xCD, 0xE4,
+	0x66, 0x0D, 0xFD, 0x7F, 0x0E, 0x46, 0x79, 0x91,
+	0xFF, 0xD9, 0x11, 0x19, 0xF5, 0x41, 0xBE, 0x36,
+	0x65, 0x1F, 0x60, 0x95, 0xF5, 0x97, 0xEB, 0x45,
+	0x10, 0x29, 0x1F, 0x1A, 0xA5, 0x29, 0xDF, 0xDB,
+	0x9C, 0x51, 0x65, 0xA5, 0x3F, 0x4A, 0x34, 0xC0,
+	0xBE, 0x52, 0x2B, 0x1B, 0xDD, 0xBA, 0x67, 0xB1,
+	0xFE, 0x5B, 0x5C, 0xA1, 0xF5, 0x17, 0x57, 0x00,
+	0x46, 0x06, 0x1B, 0xAA, 0x38, 0xF0, 0x58, 0x5D,
+	0x40, 0x1D, 0x69, 0xFB, 0x36, 0x1C, 0xB3, 0xE5,
+	0x47, 0x95, 0x28, 0x75, 0x9F, 0x81, 0xCC, 0x68,
+	0x5B, 0x97, 0x36, 0x1E, 0xC2, 0x4D, 0x3F, 0x07,
+	0xFE, 0x98, 0x13, 0xB8, 0x2C, 0x53, 0x71, 0x7B,
+	0x18, 0x98, 0x7F, 0x16, 0x49, 0xE9, 0x03, 0x5A,
+	0x6B, 0xC1, 0x45, 0x8C, 0xC4, 0x7A, 0xC4, 0x0F,
+	0x2D, 0x3D, 0xC8, 0x10, 0xA6, 0x5C, 0x61, 0x50,
+	0x69, 0x55,
+
+	0x53, 0xEF, 0x36, 0x5B, 0xF9, 0xE6, 0x95, 0x5B,
+	0xCD, 0x8F, 0x67, 0x9C, 0x1D, 0xAA, 0xBC, 0xB4,
+	0x59, 0x28, 0xBE, 0x56, 0xA2, 0xF6, 0xC2, 0xEE,
+	0xE4, 0x4B, 0x7F, 0xF0, 0xEA, 0x80, 0x6F, 0x45,
+	0x9E, 0xF6, 0xC9, 0x00, 0xBD, 0x23, 0xF9, 0x19,
+	0xDD, 0xD8, 0xBA, 0xD4, 0x40, 0xBB, 0x55, 0xF3,
+	0x81, 0x7D, 0x4F, 0x69, 0xD8, 0x50, 0x83, 0xB3,
+	0x16, 0xCC, 0x55, 0x07, 0xA2, 0xE5, 0x67, 0x72,
+	0x0E, 0x6B, 0x87, 0x52, 0x0F, 0xDC, 0xCB, 0x73,
+	0x07, 0x98, 0xC5, 0x39, 0x49, 0x01, 0x37, 0xA4,
+	0xEC, 0xD4, 0x01, 0x59, 0x8C, 0xEE, 0x6F, 0xCD,
+	0x4B, 0xF7, 0xBC, 0x2F, 0xF3, 0x9C, 0xD1, 0xC1,
+	0x33, 0x14, 0x37, 0xEF, 0x8E, 0x61, 0xE4, 0x6D,
+	0x1B, 0xD9, 0x43, 0x2E, 0xCE, 0x63, 0x4B, 0x42,
+	0x4F, 0xB5, 0xCB, 0xF1, 0x37, 0xBD, 0x57, 0x90,
+	0x99, 0x85, 0xD3, 0x15, 0xB9, 0x1F, 0x6C, 0x0F,
+	0xCD, 0x06, 0x3E, 0xA6, 0x63, 0x8C, 0xC8, 0x75,
+	0x83, 0x33, 0xC9, 0xB9, 0x2B, 0xA5, 0x54, 0xC7,
+	0x96, 0x55, 0x3A, 0x05, 0x51, 0x88, 0x44, 0x4F,
+	0x5C, 0x20, 0x26, 0x4E, 0x47, 0xFB, 0x37, 0x5D,
+	0xFA, 0x90, 0xB0, 0x6E, 0xE5, 0xAF, 0xA6, 0x1D,
+	0x9F, 0x3F, 0x33, 0x8F, 0xF1, 0x55, 0x1F, 0x5A,
+	0x61, 0x94, 0x35,
+
+	0x53, 0xEF, 0x2E, 0x5B, 0xD1, 0xE6, 0x95, 0x74,
+	0xCD, 0x8F, 0x67, 0x9C, 0x1D, 0xAA, 0xBC, 0xB4,
+	0xD4, 0x2D, 0xBE, 0x0E, 0xC3, 0x06, 0x80, 0x4F,
+	0xF2, 0x89, 0x51, 0x12, 0x7A, 0xA0, 0x53, 0x53,
+	0x02, 0x47, 0xD2, 0x72, 0x71, 0x99, 0x26, 0x96,
+	0x7A, 0x6C, 0x89, 0x38, 0xBE, 0x2C, 0x6F, 0xBF,
+	0x0E, 0x4C, 0x34, 0x29, 0x22, 0x7C, 0x58, 0x2D,
+	0x5B, 0x2A, 0x50, 0x94, 0x54, 0xEE, 0xFA, 0x1B,
+	0xF4, 0x35, 0xC8, 0xAE, 0xCE, 0xF0, 0x54, 0x64,
+	0x21, 0xD5, 0x54, 0x6A, 0x51, 0x80, 0x43, 0xDC,
+	0x4D, 0x08, 0x28, 0xD1, 0x6B, 0x83, 0xCA, 0x4E,
+	0xC5, 0x31, 0x8C, 0xBF, 0xCE, 0xF1, 0x87, 0x9D,
+	0xE7, 0x9C, 0xF9, 0xBE, 0x0A, 0x9D, 0x63, 0xC4,
+	0x10, 0x3E, 0xDE, 0xB1, 0x08, 0xF7, 0x22, 0x14,
+	0xE4, 0x28, 0x3B, 0x72, 0x87, 0x81, 0x8D, 0x3F,
+	0x3A, 0xEA, 0xD0, 0xAA, 0x2C, 0x2D, 0x83, 0x94,
+	0x42, 0x2E, 0x65, 0x25, 0xF7, 0x0C, 0x14, 0x02,
+	0xE3, 0x1E, 0x15, 0x96, 0x57, 0x10, 0xF5, 0xE5,
+	0xF7, 0x0F, 0xF1, 0x56, 0x72, 0xE6, 0xC5, 0x1A,
+	0x0C, 0xFC, 0xAA, 0x0E, 0x72, 0x54, 0xF0, 0x3E,
+	0x49, 0x8A, 0xCD, 0xC3, 0x3F, 0x16, 0x01, 0x69,
+	0xEC, 0xC2, 0xB3, 0xA5, 0x4A, 0x6E, 0xD2, 0x31,
+	0x05, 0xF5, 0xBB, 0x33,
+
+	0x53, 0xEF, 0x2E, 0x5B, 0xD1, 0xE6, 0xB5, 0x70,
+	0x42, 0x8B, 0x67, 0x1C, 0x1D, 0xAA, 0xBC, 0xB4,
+	0xD3, 0x75, 0x9E, 0x4A, 0xC3, 0xE8, 0x81, 0x4F,
+	0x62, 0xF4, 0x38, 0x56, 0x3E, 0x50, 0xD5, 0x51,
+	0x01, 0x50, 0x75, 0x68, 0x33, 0x0F, 0xB7, 0xD4,
+	0xF4, 0xDE, 0xDC, 0xF7, 0xB4, 0xBC, 0xB0, 0x85,
+	0xC9, 0xC3, 0x1C, 0x4E, 0xB7, 0x59, 0x0D, 0x8F,
+	0x49, 0xD2, 0x33, 0x12, 0x75, 0xEC, 0x2D, 0xEE,
+	0x8E, 0x28, 0xAC, 0xFB, 0x84, 0x96, 0x1C, 0x1D,
+	0xF0, 0xA5, 0x45, 0x95, 0x6F, 0x02, 0xC9, 0xF9,
+	0x02, 0x07, 0x4A, 0xF3, 0xD7, 0x87, 0x63, 0x6D,
+	0x4A, 0x29, 0x76, 0x12, 0xB9, 0xCA, 0xBA, 0xAA,
+	0x5E, 0x1D, 0xC8, 0x1C, 0x37, 0x78, 0x9B, 0x50,
+	0x55, 0x57, 0x13, 0xE9, 0xAB, 0x7E, 0xCD, 0xFB,
+	0xD6, 0xC9, 0xFA, 0xC3, 0xFC, 0xDE, 0xFC, 0x04,
+	0x51, 0xA2, 0x3C, 0x3F, 0x45, 0xC6, 0x78, 0x70,
+	0xB9, 0xAC, 0xDF, 0x42, 0x62, 0x9A, 0x0B, 0xD9,
+	0x43, 0x26, 0x76, 0xCE, 0x84, 0x97, 0xA3, 0x26,
+	0x82, 0x53, 0x6E, 0xE3, 0x23, 0xB6, 0xED, 0x2F,
+	0x49, 0x50, 0xD1, 0x26, 0x78, 0x4D, 0x1C, 0x79,
+	0xE9, 0x19, 0x7A, 0xE4, 0x4C, 0x55, 0x9D, 0x8E,
+	0x6E, 0xBF, 0xDD, 0x3B, 0xF6, 0xBC, 0xF9, 0xFB,
+	0x61, 0x57, 0x08, 0x7B, 0xE8,
+
+	0x53, 0xEF, 0x2E, 0x5B, 0xD1, 0xE6, 0xB5, 0x70,
+	0x42, 0xFB, 0x67, 0x1C, 0x1D, 0xAA, 0xBC, 0xB4,
+	0x5B, 0x4D, 0x9E, 0x4C, 0xC3, 0xE8, 0xC0, 0x44,
+	0x65, 0xF8, 0x38, 0x59, 0x39, 0x42, 0xE5, 0x53,
+	0xF8, 0x08, 0x07, 0x41, 0x27, 0xC9, 0xAE, 0x64,
+	0xBC, 0xCF, 0x48, 0x00, 0xF0, 0x5D, 0x70, 0x57,
+	0x4F, 0xA8, 0x60, 0xBC, 0x1A, 0x9F, 0x35, 0xF6,
+	0x3E, 0x28, 0xE3, 0x18, 0x3B, 0x5E, 0xAE, 0x2E,
+	0x39, 0x5C, 0xF9, 0xC2, 0xE0, 0x80, 0xA1, 0xEF,
+	0x02, 0x2E, 0xC7, 0x6B, 0x5F, 0x5E, 0xCB, 0x74,
+	0x3E, 0xBF, 0x08, 0xCB, 0xF9, 0x7D, 0x59, 0x15,
+	0x4E, 0x82, 0xC5, 0x46, 0x1B, 0xA9, 0xD2, 0xDC,
+	0x5C, 0xF0, 0xE0, 0xBC, 0x55, 0xC2, 0x2B, 0xF9,
+	0x5D, 0xED, 0xDE, 0xFC, 0x87, 0x4A, 0x59, 0xF8,
+	0x63, 0xB7, 0xC6, 0x6A, 0x2A, 0x4D, 0xE2, 0x25,
+	0x9D, 0x3C, 0x99, 0x6B, 0x3D, 0x00, 0x5D, 0x3F,
+	0x1A, 0x8F, 0x8A, 0xF8, 0xEE, 0x12, 0x58, 0x85,
+	0x98, 0xE8, 0x5A, 0xD5, 0xD5, 0x80, 0x5B, 0xA0,
+	0x7A, 0x45, 0x95, 0x27, 0x58, 0xAB, 0x4D, 0x26,
+	0x8C, 0x2D, 0xDB, 0x04, 0xFE, 0xB1, 0x33, 0xDD,
+	0x2F, 0xF5, 0x8C, 0x30, 0x2E, 0xB6, 0xF6, 0x2B,
+	0x50, 0xFF, 0x1A, 0xEF, 0xE4, 0x02, 0x9B, 0xEA,
+	0x2E, 0x60, 0xC5, 0xEC, 0xD6, 0x99,
+
+	0x53, 0xEF, 0x2E, 0x5B, 0xD1, 0xC2, 0xB5, 0x70,
+	0x42, 0x7B, 0x37, 0x4C, 0x1D, 0xAA, 0xBC, 0xB4,
+	0x5C, 0x81, 0xB1, 0x44, 0xC3, 0xE8, 0xC4, 0x54,
+	0x67, 0xFD, 0xBA, 0xCB, 0x3B, 0x44, 0xA5, 0x5B,
+	0x92, 0x18, 0x10, 0x5C, 0x41, 0x47, 0x7F, 0x63,
+	0xCA, 0x05, 0x1D, 0x1C, 0x3C, 0x37, 0x63, 0x3F,
+	0xAD, 0x21, 0x18, 0x5F, 0x0B, 0x67, 0x60, 0xFB,
+	0x93, 0x0E, 0x4F, 0xAA, 0x45, 0x7D, 0x41, 0xA5,
+	0x65, 0x3A, 0x43, 0xAE, 0xA3, 0xCE, 0x60, 0x25,
+	0xB6, 0x87, 0x70, 0x77, 0x90, 0x0E, 0xA8, 0x11,
+	0x92, 0x12, 0x4A, 0xA3, 0x0A, 0xE2, 0x91, 0x59,
+	0xB8, 0xC6, 0x52, 0xE3, 0x96, 0x9E, 0xF3, 0xF7,
+	0x7C, 0x98, 0x35, 0x6E, 0x99, 0x2C, 0xB1, 0xC1,
+	0x62, 0x42, 0x28, 0x26, 0x8A, 0xB5, 0x57, 0xB6,
+	0x11, 0x82, 0x96, 0xF6, 0xE4, 0xEE, 0x81, 0x96,
+	0xE8, 0xD4, 0x72, 0xD4, 0x6E, 0xEB, 0x77, 0x49,
+	0x3A, 0x71, 0x2B, 0xD1, 0x1C, 0x83, 0x4E, 0x96,
+	0x4E, 0xD3, 0xEA, 0xC3, 0xA6, 0xC9, 0x46, 0x5F,
+	0x39, 0xCC, 0xB9, 0x39, 0xED, 0x91, 0x43, 0xC1,
+	0x6F, 0xD9, 0x41, 0x0F, 0x13, 0x61, 0xC5, 0x57,
+	0xDD, 0x0D, 0x12, 0xE3, 0x13, 0xDB, 0x13, 0x6F,
+	0x90, 0xEF, 0xBE, 0x58, 0xB4, 0xBC, 0xC8, 0xCF,
+	0xB7, 0xB9, 0xBD, 0xCE, 0xC2, 0x09, 0x41,
+
+	0x53, 0xEF, 0x2E, 0x5B, 0xD1, 0x02, 0xA1, 0x70,
+	0x42, 0x7B, 0x37, 0x7D, 0x1D, 0xAA, 0xBC, 0xB4,
+	0x4C, 0x82, 0xAB, 0x5E, 0x23, 0xEA, 0xC4, 0x54,
+	0x47, 0xEA, 0xB9, 0xA7, 0x29, 0x04, 0xA1, 0x1F,
+	0x83, 0xFD, 0xCC, 0x2C, 0x45, 0xD7, 0x3C, 0x09,
+	0x49, 0xDE, 0xCF, 0xD0, 0x53, 0x24, 0xBE, 0x94,
+	0x72, 0x0D, 0x54, 0xF5, 0x5F, 0xDB, 0x6D, 0x3D,
+	0x46, 0xB0, 0xF2, 0x7B, 0xAD, 0xDD, 0xF4, 0xA3,
+	0x9F, 0xB3, 0x1F, 0xFD, 0x6D, 0x5A, 0x98, 0x79,
+	0xC7, 0x4D, 0xB6, 0x03, 0xA4, 0x9A, 0x9D, 0x6A,
+	0x67, 0xB7, 0xE0, 0xC9, 0xFF, 0x18, 0x0E, 0xC3,
+	0xD0, 0xC9, 0xC1, 0xCC, 0x02, 0x78, 0x0D, 0x0F,
+	0x2B, 0xB2, 0x0A, 0x59, 0xB4, 0xE2, 0xEA, 0x14,
+	0x81, 0x79, 0x10, 0xCF, 0x92, 0xA5, 0x25, 0x73,
+	0xDD, 0xF4, 0x31, 0x78, 0x83, 0x04, 0xC3, 0x02,
+	0x93, 0xB7, 0x45, 0xC5, 0xAF, 0x09, 0x37, 0xC5,
+	0x72, 0xAE, 0x76, 0xBB, 0x12, 0xDC, 0x4D, 0x62,
+	0x91, 0x8A, 0x95, 0x77, 0x26, 0x12, 0x9B, 0x74,
+	0xA0, 0x3E, 0x1B, 0xD8, 0x6D, 0x97, 0x90, 0x8D,
+	0xA2, 0x62, 0xCA, 0x1B, 0xEE, 0xAA, 0xCA, 0x19,
+	0xBE, 0xBD, 0x21, 0xFD, 0x6A, 0xEF, 0xEC, 0xF1,
+	0x92, 0x8C, 0x7D, 0xFB, 0xAF, 0xBF, 0x71, 0x14,
+	0xAF, 0x88, 0x01, 0x24, 0x23, 0xC0, 0xBD, 0x0C,
+
+	0x53, 0xEF, 0x2E, 0x5B, 0xD1, 0x02, 0xA1, 0x70,
+	0x42, 0x7B, 0xB7, 0x71, 0x8C, 0xAA, 0xBC, 0x34,
+	0x4A, 0x4A, 0xEB, 0x5C, 0x2A, 0xFA, 0x95, 0x00,
+	0xC7, 0xBA, 0xB9, 0xA7, 0x1B, 0x0E, 0xBB, 0x1B,
+	0x90, 0x77, 0xE7, 0xD9, 0x67, 0xAF, 0xE5, 0x52,
+	0xFA, 0xA3, 0xA1, 0x60, 0x96, 0xA1, 0xB0, 0x80,
+	0x75, 0x1C, 0xE6, 0xD3, 0x97, 0x7E, 0x0E, 0x22,
+	0x26, 0x3C, 0x1F, 0x2C, 0x3B, 0xA4, 0xC8, 0x7F,
+	0x27, 0xA0, 0xB5, 0xA2, 0x07, 0x96, 0xD2, 0x74,
+	0x7E, 0xCD, 0x30, 0x7B, 0x66, 0x00, 0x74, 0x0F,
+	0x6B, 0x66, 0xC5, 0xC6, 0x99, 0xF0, 0x13, 0x62,
+	0x74, 0x8B, 0x5A, 0xE4, 0x9E, 0xE3, 0x9A, 0xEE,
+	0x1C, 0xD3, 0x14, 0xD7, 0x5A, 0x25, 0x37, 0x9B,
+	0x5D, 0xA2, 0x5F, 0x81, 0x32, 0x1D, 0x02, 0x04,
+	0xF8, 0x4C, 0x00, 0x9A, 0xF6, 0x92, 0xAD, 0xEC,
+	0xAD, 0xFF, 0x1F, 0xD5, 0xEB, 0x7C, 0x98, 0x0E,
+	0x8C, 0x00, 0xEC, 0x3C, 0x64, 0xF1, 0xC0, 0x0F,
+	0x37, 0x48, 0x07, 0xA3, 0x6C, 0x08, 0x03, 0xAC,
+	0x0F, 0x84, 0x54, 0x85, 0x66, 0xD1, 0xA3, 0xAF,
+	0x46, 0x5A, 0x3B, 0x39, 0x8A, 0xB1, 0xB0, 0xA3,
+	0x06, 0x4F, 0xA9, 0x84, 0xE0, 0xF5, 0xB3, 0x86,
+	0x48, 0xA5, 0x4C, 0xDE, 0xFB, 0xA4, 0xF4, 0x53,
+	0x4D, 0x59, 0x20, 0xBA, 0xC5, 0x47, 0xB9, 0xE7,
+	0x6C,
+
+	0x53, 0xEF, 0x2E, 0x5B, 0xD1, 0x02, 0xA1, 0x70,
+	0x63, 0x7B, 0xB7, 0x71, 0x8C, 0xD8, 0xBE, 0x34,
+	0x48, 0x48, 0x7B, 0x74, 0x98, 0xC1, 0xB5, 0x22,
+	0xC7, 0x3A, 0xFB, 0xAF, 0x1C, 0x02, 0xBB, 0x3C,
+	0x21, 0x86, 0x43, 0xE9, 0x18, 0xAC, 0x1F, 0x7D,
+	0xFB, 0xE0, 0xA3, 0x36, 0x29, 0x06, 0x99, 0x1D,
+	0x89, 0x31, 0xF4, 0x80, 0x79, 0xDD, 0x09, 0x24,
+	0x9E, 0x36, 0xD3, 0x0E, 0x13, 0xAC, 0xEB, 0x9D,
+	0x13, 0x91, 0x6C, 0xA5, 0x7A, 0x96, 0x18, 0x33,
+	0x29, 0x3B, 0xC8, 0xBC, 0x51, 0x21, 0xF5, 0x58,
+	0x4B, 0xA5, 0xC8, 0x81, 0x0E, 0x6D, 0xE6, 0xEE,
+	0x04, 0x8E, 0x51, 0x33, 0x2C, 0x7A, 0x1E, 0xE9,
+	0xD6, 0x20, 0x01, 0x12, 0x83, 0x03, 0xB4, 0xB7,
+	0x53, 0x5B, 0x1C, 0x6D, 0x18, 0x36, 0x47, 0x67,
+	0x99, 0x3D, 0x0D, 0xD8, 0x0E, 0x81, 0x12, 0xCB,
+	0x18, 0x7A, 0x30, 0x59, 0x2C, 0x6C, 0x8C, 0x98,
+	0xBE, 0xAF, 0x26, 0x45, 0x0A, 0x16, 0x47, 0xF1,
+	0xA1, 0xEE, 0x50, 0xA8, 0xEC, 0xD0, 0x85, 0xA3,
+	0x50, 0x56, 0x4D, 0x39, 0x0C, 0x32, 0x49, 0x4D,
+	0x48, 0x6D, 0xF1, 0xE6, 0xB8, 0x4D, 0xDA, 0xAB,
+	0x10, 0xB2, 0x44, 0x0D, 0xE0, 0x22, 0x1A, 0x67,
+	0x62, 0xBF, 0x76, 0xB4, 0x74, 0x58, 0xA8, 0xA7,
+	0x7F, 0x6D, 0xD5, 0x02, 0xF8, 0x88, 0x3D, 0xD2,
+	0x1E, 0x35,
+
+	0x53, 0xEF, 0x2E, 0x5B, 0xD1, 0x02, 0xA1, 0x70,
+	0x03, 0x56, 0xB7, 0x71, 0x8C, 0x58, 0xEC, 0x74,
+	0x59, 0x26, 0x33, 0x1C, 0x1A, 0x3E, 0x9A, 0x0A,
+	0xC4, 0x3A, 0xFB, 0xE7, 0x3E, 0x07, 0xEB, 0x26,
+	0x45, 0xFE, 0x44, 0xC8, 0x52, 0xAE, 0x98, 0xD0,
+	0xDC, 0xD0, 0xA7, 0xE5, 0xE3, 0xDB, 0xF2, 0x53,
+	0xC8, 0x55, 0xF6, 0x41, 0x4D, 0xE9, 0x82, 0x0D,
+	0x3E, 0xBB, 0x64, 0xB2, 0x4B, 0x9F, 0x2C, 0x2E,
+	0x8E, 0x3B, 0x7A, 0x69, 0x1D, 0x8A, 0x31, 0x26,
+	0x41, 0xA5, 0x89, 0x56, 0xF4, 0x90, 0xFE, 0xAB,
+	0x96, 0x1F, 0x72, 0x89, 0x2A, 0x7A, 0xA5, 0x34,
+	0x06, 0x54, 0x12, 0x43, 0x71, 0xC8, 0x40, 0x98,
+	0x55, 0xCC, 0xE2, 0x12, 0x37, 0xAA, 0x52, 0x61,
+	0xEC, 0x69, 0x99, 0xAF, 0xC8, 0x90, 0xF1, 0x67,
+	0x1C, 0x1C, 0x8B, 0xBF, 0x2E, 0xE7, 0x21, 0x2E,
+	0xDE, 0xFA, 0xDD, 0x5E, 0x40, 0x49, 0x35, 0x96,
+	0xD3, 0x28, 0x7A, 0xDB, 0x2F, 0x9D, 0x58, 0xCA,
+	0x0F, 0x41, 0x96, 0x3E, 0xB4, 0x36, 0x23, 0x9E,
+	0xED, 0x40, 0x67, 0x5A, 0x2E, 0xED, 0xE0, 0x92,
+	0x65, 0x4E, 0x5B, 0x6D, 0xEC, 0xA7, 0x28, 0x78,
+	0xDD, 0x75, 0x78, 0xE9, 0xFF, 0xA0, 0x2D, 0x07,
+	0x27, 0xDF, 0x51, 0xAF, 0xBE, 0xCB, 0x9B, 0xAD,
+	0x8B, 0x2B, 0x63, 0xC4, 0x6C, 0xC7, 0x0E, 0x4C,
+	0x1E, 0xEA, 0xF6,
+
+	0x53, 0xEF, 0x2E, 0x5B, 0xD1, 0x02, 0xA1, 0x70,
+	0x03, 0x16, 0xB6, 0x71, 0xBC, 0x58, 0xEC, 0x47,
+	0xD8, 0x96, 0x3B, 0xB4, 0x0A, 0xBD, 0xA2, 0x11,
+	0x05, 0x3A, 0xFB, 0x67, 0x7B, 0x25, 0xF8, 0x72,
+	0x39, 0x2F, 0x94, 0xA7, 0x10, 0x2F, 0xFB, 0x56,
+	0x48, 0x6E, 0x08, 0x9B, 0x17, 0xE9, 0xC8, 0xD6,
+	0x7A, 0xCE, 0xDF, 0x56, 0x4A, 0x09, 0xA5, 0x34,
+	0x7A, 0x87, 0x48, 0x3F, 0x95, 0x77, 0x1C, 0x9D,
+	0x71, 0x21, 0xDD, 0x6D, 0x73, 0x06, 0xB8, 0xAF,
+	0x8C, 0x51, 0x77, 0xB9, 0x3F, 0x52, 0x8F, 0xD3,
+	0x71, 0xA0, 0x75, 0xA0, 0x55, 0x9D, 0x9A, 0x6E,
+	0x33, 0xA5, 0x94, 0x79, 0x8C, 0x12, 0x1D, 0x9F,
+	0xD7, 0xF2, 0x38, 0x2F, 0x47, 0xEB, 0xA3, 0x0F,
+	0x9E, 0xCD, 0x8E, 0x67, 0xA9, 0x7A, 0xD5, 0x3D,
+	0x81, 0x18, 0x95, 0x76, 0x0B, 0x80, 0xFA, 0xC2,
+	0x1B, 0x20, 0xFD, 0x3A, 0x5F, 0x51, 0x3E, 0xF8,
+	0x94, 0x29, 0x56, 0x89, 0xF4, 0x81, 0xA2, 0xEC,
+	0xFC, 0xB4, 0xAB, 0x12, 0xE7, 0xBA, 0xBF, 0xAF,
+	0x32, 0x2C, 0x26, 0x0B, 0x01, 0x18, 0x24, 0xFA,
+	0xE1, 0xA0, 0x93, 0xF2, 0x16, 0xB1, 0xB3, 0xEC,
+	0xE3, 0x5F, 0x1D, 0xB7, 0xC8, 0x52, 0x1E, 0x34,
+	0x5E, 0x7A, 0xD2, 0x8A, 0xE6, 0x58, 0xDB, 0xD3,
+	0x5A, 0x2D, 0xF2, 0x1C, 0xAD, 0xC0, 0x03, 0x72,
+	0xF3, 0x55, 0x5D, 0x7D,
+
+	0xC0, 0xFF, 0x2E, 0xDB, 0xD1, 0x02, 0xA1, 0x70,
+	0x03, 0x16, 0xB6, 0x71, 0xBC, 0x58, 0xCC, 0x42,
+	0x68, 0xEC, 0x01, 0xB5, 0x4A, 0x2D, 0xF3, 0x17,
+	0x0D, 0x0A, 0xDB, 0x03, 0x7B, 0x01, 0xF8, 0x72,
+	0xE5, 0xCA, 0xD7, 0x8B, 0x82, 0x26, 0xD5, 0x46,
+	0x5D, 0x82, 0x9A, 0x05, 0x6A, 0xF4, 0xA9, 0x5F,
+	0xFE, 0x5A, 0x6D, 0x4F, 0x47, 0xB1, 0x90, 0xBB,
+	0xAD, 0xEB, 0x81, 0x43, 0x6F, 0x48, 0x46, 0x7D,
+	0x2B, 0x44, 0xD4, 0xA4, 0x10, 0xF1, 0xF8, 0x96,
+	0x13, 0x0B, 0xE1, 0x13, 0x90, 0x0E, 0x9D, 0x22,
+	0xD2, 0xB0, 0xD2, 0x0C, 0xD9, 0x9B, 0x58, 0xF1,
+	0xBB, 0x63, 0xEA, 0x0B, 0x3E, 0x64, 0xB8, 0x43,
+	0xCF, 0x6D, 0xD1, 0x3B, 0x72, 0xCC, 0x80, 0xDB,
+	0xFE, 0xCD, 0x7C, 0x77, 0xCC, 0x94, 0xED, 0xC0,
+	0x0F, 0x37, 0x47, 0xA1, 0x10, 0xE2, 0x51, 0x5F,
+	0x5E, 0x8D, 0x3A, 0x52, 0x3D, 0x70, 0x78, 0x5F,
+	0x94, 0xAC, 0x21, 0xE4, 0x96, 0x2E, 0x0F, 0x4D,
+	0x4C, 0xF8, 0x6C, 0x38, 0x4D, 0x7D, 0x24, 0x26,
+	0xFA, 0xEE, 0x8F, 0x53, 0x71, 0x0A, 0x04, 0x87,
+	0xFA, 0xE1, 0x80, 0x44, 0xF5, 0x87, 0x68, 0x1F,
+	0xAE, 0x10, 0xC7, 0x34, 0x87, 0xE1, 0x1A, 0x5E,
+	0x5F, 0x87, 0x1A, 0x06, 0x53, 0x0A, 0x65, 0x75,
+	0x19, 0x4E, 0x38, 0x56, 0x39, 0xCF, 0xA8, 0x78,
+	0xEC, 0x70, 0x2C, 0x8C, 0x8B,
+
+	0xC0, 0x8B, 0x3E, 0xDB, 0xD1, 0x02, 0xA1, 0x70,
+	0x03, 0x16, 0xB6, 0x71, 0x9B, 0x58, 0xCC, 0x42,
+	0x6F, 0x3C, 0x0D, 0xB2, 0x5E, 0x3C, 0xE3, 0xF5,
+	0xAD, 0x34, 0xDB, 0x02, 0x7B, 0x21, 0xE8, 0x7C,
+	0x5A, 0xEC, 0xC5, 0xDE, 0x49, 0x5E, 0x05, 0xEE,
+	0xA1, 0x13, 0x7C, 0x4B, 0x78, 0x5C, 0x16, 0x6C,
+	0x01, 0x66, 0x88, 0x05, 0x98, 0x5F, 0x5C, 0x53,
+	0xA8, 0xBA, 0xF8, 0x5B, 0xC2, 0x91, 0x4F, 0xAC,
+	0x7E, 0x2B, 0x49, 0xFE, 0x4D, 0x01, 0x76, 0xAC,
+	0x58, 0xC2, 0xA7, 0x64, 0x08, 0xF6, 0xC7, 0x6E,
+	0x0A, 0x11, 0x20, 0xC7, 0x86, 0x25, 0x81, 0x7E,
+	0xA3, 0xF6, 0x09, 0x96, 0xB5, 0x2D, 0xAB, 0xD7,
+	0xA7, 0xFE, 0x7C, 0xF6, 0xF9, 0xF9, 0xB8, 0x48,
+	0x63, 0x7E, 0xD2, 0xF8, 0x3D, 0x28, 0x74, 0xCA,
+	0xFD, 0x1D, 0x25, 0x5F, 0xE5, 0x5B, 0x18, 0x4A,
+	0x47, 0xC0, 0xAB, 0x05, 0x70, 0x0B, 0xFE, 0x8A,
+	0xC3, 0x07, 0xA0, 0x7F, 0x37, 0xF2, 0x4B, 0xA7,
+	0x62, 0x43, 0x20, 0xAD, 0x07, 0x4E, 0x4A, 0x38,
+	0xC2, 0xCB, 0xA5, 0x79, 0x40, 0x3D, 0x03, 0x9D,
+	0xD0, 0xC3, 0x39, 0x0C, 0x7E, 0xFE, 0x02, 0x3D,
+	0xFB, 0xCB, 0x27, 0x3A, 0x3F, 0x04, 0x5B, 0x83,
+	0x31, 0x7B, 0x7C, 0x5C, 0x2D, 0x4F, 0xEE, 0x06,
+	0x70, 0xF2, 0xA5, 0x28, 0x9F, 0xCC, 0x0E, 0xD7,
+	0xEB, 0x7E, 0x39, 0x73, 0x9A, 0x1E,
+
+	0xC0, 0x0B, 0x6A, 0x9B, 0xD1, 0x02, 0xA1, 0x70,
+	0x03, 0x16, 0xB6, 0x71, 0xBB, 0x44, 0xCC, 0x42,
+	0x25, 0xBD, 0x25, 0x48, 0xCC, 0x11, 0xB1, 0x95,
+	0xA8, 0xD8, 0xF6, 0x42, 0x78, 0x21, 0x68, 0x6C,
+	0x07, 0xB9, 0xA3, 0x4C, 0xBA, 0xCF, 0x43, 0x65,
+	0xCB, 0xBB, 0xC4, 0x7A, 0xFC, 0x10, 0x14, 0x17,
+	0xFB, 0xB9, 0x3F, 0x1A, 0x8B, 0xDF, 0x68, 0xF2,
+	0x2E, 0x33, 0x10, 0xD5, 0xD8, 0x6F, 0x59, 0xD8,
+	0x58, 0x8F, 0x97, 0xA6, 0xB5, 0x72, 0xF0, 0x4F,
+	0x39, 0x24, 0xD5, 0x35, 0xE8, 0x4C, 0x63, 0x19,
+	0x05, 0x8A, 0x3C, 0xEB, 0xDE, 0x37, 0x4E, 0x59,
+	0x78, 0x98, 0xA1, 0x45, 0xF3, 0x89, 0x97, 0x4E,
+	0xED, 0xA7, 0xD5, 0xF3, 0x89, 0x34, 0xA7, 0x2B,
+	0xD1, 0x68, 0x72, 0xF9, 0xB0, 0x40, 0x04, 0xB0,
+	0x98, 0x06, 0x7C, 0x46, 0x48, 0xAE, 0x0D, 0x37,
+	0x4A, 0xF5, 0x55, 0x7F, 0x7B, 0x37, 0xFD, 0x4C,
+	0x14, 0x42, 0xED, 0xFD, 0x42, 0xD5, 0x23, 0xAD,
+	0x41, 0xD7, 0x9B, 0x0E, 0x14, 0x8B, 0xAF, 0x3B,
+	0xA2, 0x09, 0x47, 0x03, 0xAF, 0xA7, 0x50, 0x24,
+	0xEE, 0xF6, 0xB3, 0x34, 0x0A, 0xC5, 0xA8, 0x4E,
+	0x4C, 0x27, 0xB2, 0x70, 0xF5, 0x91, 0x3C, 0x34,
+	0xE5, 0x69, 0x88, 0x02, 0x1B, 0x65, 0xB1, 0x5A,
+	0x00, 0xD9, 0xF7, 0x31, 0x1F, 0xCA, 0x07, 0x96,
+	0x1F, 0xBB, 0x4F, 0xCF, 0x43, 0x6E, 0x42,
+
+	0xD4, 0x0B, 0x6A, 0xAE, 0xD1, 0x02, 0xA1, 0x70,
+	0x03, 0x16, 0xB6, 0x71, 0xBB, 0x44, 0xDF, 0x42,
+	0xE1, 0xEE, 0x2E, 0xD2, 0xDC, 0xBA, 0xB1, 0x12,
+	0xE8, 0x4A, 0x8E, 0x5A, 0xF9, 0x23, 0x68, 0x6C,
+	0x76, 0x14, 0x64, 0xF4, 0x68, 0xCD, 0xEB, 0x1F,
+	0x77, 0x40, 0x63, 0x22, 0x8E, 0xF7, 0x7A, 0xD8,
+	0xA5, 0x53, 0xF1, 0xE0, 0xA1, 0x5A, 0x0F, 0xBE,
+	0x6C, 0x7E, 0x0C, 0x31, 0x80, 0xC1, 0xC3, 0x52,
+	0x85, 0x4C, 0xBE, 0xA9, 0x1C, 0xA1, 0xA3, 0xC4,
+	0x11, 0x57, 0x65, 0x35, 0xBE, 0xF9, 0xF7, 0xE5,
+	0x03, 0x2C, 0x16, 0x0A, 0x6B, 0xCD, 0xDF, 0x8C,
+	0xD7, 0x24, 0xA5, 0x2F, 0x7F, 0x23, 0x98, 0xF7,
+	0xE8, 0x83, 0x22, 0xD7, 0xE8, 0x5F, 0xE6, 0xF7,
+	0x94, 0x1D, 0x55, 0x0F, 0x56, 0xE9, 0x7D, 0x7E,
+	0x6B, 0x39, 0x8E, 0x78, 0x42, 0x94, 0xF7, 0xA4,
+	0xEC, 0xE2, 0xA7, 0xEC, 0xDD, 0xD5, 0x0B, 0xB9,
+	0xE7, 0x20, 0x4F, 0xF9, 0x78, 0xCC, 0x9D, 0x22,
+	0xEA, 0x03, 0xC4, 0x3F, 0xA0, 0x33, 0x4C, 0xF0,
+	0xC4, 0x17, 0xAE, 0xC3, 0x4E, 0xF3, 0xC3, 0xAC,
+	0x80, 0x8E, 0xF4, 0xAE, 0x56, 0xBD, 0x4E, 0x3F,
+	0x47, 0xF1, 0xFB, 0x5A, 0x04, 0xB6, 0x65, 0x5C,
+	0x70, 0x98, 0x6E, 0xA0, 0x0F, 0x9A, 0x29, 0xE1,
+	0x0B, 0xAD, 0xCF, 0x55, 0xF9, 0xAD, 0xC1, 0x38,
+	0x96, 0xF3, 0x96, 0x17, 0x15, 0x94, 0x7C, 0xA1,
+
+	0x62, 0x59, 0xE4, 0xB7, 0xEB, 0xA3, 0xC3, 0x95,
+	0x83, 0xB9, 0x72, 0x25, 0x41, 0x36, 0x9A, 0xC9,
+	0xD8, 0xB7, 0xBB, 0x88, 0x88, 0x2E, 0xCF, 0x9E,
+	0x42, 0x21, 0x4F, 0xA3, 0xAA, 0x69, 0x20, 0x32,
+	0x3F, 0xFD, 0x55, 0x2E, 0x9D, 0x59, 0x5F, 0xD1,
+	0x30, 0x07, 0x2F, 0xC0, 0x35, 0x94, 0xC0, 0xCF,
+	0xB5, 0xE9, 0xC6, 0x62, 0xD8, 0xF3, 0x42, 0xEA,
+	0x2B, 0xC7, 0xB3, 0x8C, 0xCF, 0x32, 0x0F, 0xC1,
+	0x7A, 0xAE, 0xF5, 0x4A, 0x2D, 0x6A, 0x70, 0xC1,
+	0x93, 0x0C, 0x98, 0x21, 0x26, 0x9A, 0x1C, 0x25,
+	0x80, 0xD2, 0x3C, 0x6C, 0x88, 0x73, 0x15, 0x4C,
+	0x9F, 0xA0, 0xF2, 0x6F, 0x60, 0xE2, 0x5C, 0x86,
+	0xC4, 0x1A, 0xD8, 0xA2, 0x54, 0x60, 0x4F, 0x6E,
+	0x20, 0x48, 0x70, 0xB8, 0xD1, 0x72, 0x04, 0x34,
+	0xA3, 0xC3, 0xEC, 0xE5, 0xDD, 0x41, 0xA8, 0x4B,
+	0xD9, 0x6C, 0x17, 0xE6, 0x65, 0x1D, 0x64, 0xA2,
+	0x57, 0x3C, 0x2B, 0xE7, 0xBE, 0xF0, 0xCE, 0x8C,
+	0xF0, 0x81, 0x6A, 0x4D, 0x00, 0x56, 0xA3, 0x97,
+	0xA5, 0x23, 0x0A, 0x78, 0x79, 0x6E, 0x9B, 0x69,
+	0xC7, 0x72, 0xDD, 0x3B, 0xCC, 0x3B, 0xD0, 0xFA,
+	0x8C, 0xF3, 0x36, 0x19, 0xD5, 0x39, 0x0E, 0x49,
+	0xC0, 0xB5, 0xAF, 0x09, 0x3D, 0x16, 0xEA, 0xC3,
+	0x21, 0x12, 0xD0, 0xE1, 0xCC, 0x11, 0x2C, 0x40,
+	0x18, 0x2E, 0xDC, 0x57, 0x9F, 0xAF, 0x80, 0x5D,
+	0xDF,
+
+	0x42, 0x59, 0xE4, 0xB7, 0xEB, 0xD5, 0xD3, 0x95,
+	0x83, 0xB9, 0x72, 0x25, 0x41, 0x36, 0x9A, 0xC9,
+	0xD8, 0x17, 0x20, 0x89, 0x0F, 0x2E, 0x27, 0xF9,
+	0x45, 0x03, 0x66, 0x38, 0x7A, 0x56, 0x20, 0xA7,
+	0x1E, 0xA5, 0x44, 0xAB, 0x0F, 0x17, 0x48, 0xBD,
+	0x8A, 0x88, 0x66, 0x8C, 0xEB, 0xB6, 0xED, 0x77,
+	0x2A, 0x7F, 0x1D, 0x70, 0x94, 0x85, 0xC6, 0x3C,
+	0xA8, 0x85, 0x61, 0xF5, 0x1D, 0xE0, 0x6F, 0x35,
+	0xD6, 0x86, 0x44, 0xA0, 0xDD, 0x84, 0x76, 0x35,
+	0xF9, 0x0D, 0x10, 0xAD, 0x43, 0xB2, 0x7E, 0x03,
+	0x2D, 0x5B, 0xD6, 0x29, 0xD2, 0x49, 0x18, 0x25,
+	0xFF, 0xBB, 0xC8, 0x13, 0x34, 0xF3, 0x03, 0x92,
+	0x10, 0xAA, 0xBC, 0x9A, 0x0C, 0x0C, 0x5D, 0xBF,
+	0x70, 0xBA, 0x0B, 0x7A, 0x25, 0x06, 0x7A, 0xE9,
+	0xB2, 0xE0, 0xDC, 0x0B, 0x98, 0xF8, 0x4D, 0x4A,
+	0x6E, 0x24, 0x9D, 0x1D, 0xDC, 0xFE, 0x71, 0xD7,
+	0x18, 0xA5, 0xE1, 0xC2, 0x8D, 0xDB, 0x1C, 0x46,
+	0xB4, 0x90, 0xC3, 0x90, 0xFC, 0xD4, 0x15, 0xE8,
+	0x3D, 0x3E, 0xE4, 0x3A, 0xED, 0x17, 0x58, 0xE4,
+	0xB9, 0x79, 0x59, 0xC6, 0xAF, 0x58, 0x85, 0x9B,
+	0x6E, 0x69, 0xFD, 0x42, 0x67, 0xF8, 0x32, 0xED,
+	0x64, 0xDC, 0xE2, 0x9C, 0xE2, 0xED, 0xE1, 0x2F,
+	0x1D, 0xC7, 0x7C, 0xC5, 0xD8, 0xE1, 0x52, 0x11,
+	0x64, 0xAC, 0x8F, 0x04, 0x95, 0x17, 0xB5, 0x05,
+	0x7C, 0x48,
+
+	0xA2, 0x4F, 0xE4, 0xB7, 0xEB, 0x55, 0x85, 0x85,
+	0x83, 0xB9, 0x72, 0x25, 0x41, 0x36, 0x9A, 0xC9,
+	0xD0, 0x17, 0xA4, 0x91, 0x2C, 0x2B, 0x99, 0x43,
+	0x07, 0x02, 0x04, 0x1B, 0xEC, 0xCB, 0x0C, 0xC7,
+	0x0F, 0x3D, 0x03, 0x0C, 0x3D, 0xE1, 0x12, 0x57,
+	0x05, 0x78, 0x73, 0xC3, 0xEB, 0xC6, 0x2E, 0xC7,
+	0xC7, 0x4D, 0x48, 0x2F, 0xFB, 0xBC, 0xA7, 0xFF,
+	0xA3, 0x10, 0x35, 0xF0, 0xE6, 0xC4, 0xDF, 0x15,
+	0x57, 0xB4, 0x15, 0x39, 0xAA, 0x82, 0xCE, 0x37,
+	0xC8, 0x7D, 0xA7, 0xFC, 0x32, 0x2C, 0xCF, 0xCD,
+	0x6E, 0x2F, 0x86, 0xDA, 0x61, 0x85, 0xF1, 0x5D,
+	0x17, 0xE1, 0x70, 0x83, 0x90, 0x35, 0x04, 0x94,
+	0xCA, 0x89, 0x2A, 0xC4, 0xD0, 0x8A, 0x2B, 0xBF,
+	0x6E, 0xEB, 0x1D, 0x47, 0xAE, 0x88, 0xC3, 0xD7,
+	0x86, 0x83, 0xC8, 0xA5, 0xCC, 0xBB, 0x9D, 0x09,
+	0xBD, 0x12, 0xE5, 0x69, 0xAE, 0xF5, 0x0F, 0x69,
+	0x48, 0xA1, 0xF1, 0xEB, 0xD9, 0x84, 0x9B, 0x44,
+	0x2E, 0x36, 0x9D, 0x85, 0xFC, 0x9E, 0x66, 0x85,
+	0x52, 0x72, 0xCF, 0x58, 0x75, 0x2C, 0x5B, 0x7A,
+	0xBA, 0x63, 0x17, 0x55, 0x4C, 0xB9, 0xE1, 0x25,
+	0xB8, 0xAC, 0x1A, 0xD6, 0x93, 0xFB, 0x24, 0x22,
+	0x06, 0x24, 0x06, 0x3D, 0xE9, 0x53, 0xBC, 0x12,
+	0x78, 0x4C, 0xDC, 0xA8, 0x54, 0x1E, 0x6A, 0xE0,
+	0x91, 0x11, 0xC4, 0x32, 0x65, 0x6A, 0x46, 0x1D,
+	0xE0, 0x64, 0x67,
+
+	0xA2, 0x4F, 0xE6, 0xB7, 0xCA, 0x55, 0x85, 0xB2,
+	0x83, 0xB9, 0x72, 0x25, 0x41, 0x36, 0x9A, 0xC9,
+	0x00, 0x17, 0xA4, 0x99, 0xD8, 0x59, 0x5A, 0x47,
+	0x1C, 0x0A, 0x04, 0x2B, 0x8C, 0x48, 0x04, 0xDE,
+	0x46, 0x4E, 0x20, 0x7D, 0x06, 0xF6, 0xFC, 0x66,
+	0xD9, 0x66, 0x17, 0x90, 0xBF, 0x66, 0xCB, 0x8E,
+	0xE2, 0xF0, 0x9D, 0x9E, 0x76, 0x77, 0x4F, 0x96,
+	0xE5, 0xE5, 0x9A, 0x93, 0x5E, 0xA4, 0xB2, 0x1B,
+	0x8A, 0x07, 0xD0, 0x8C, 0x4B, 0xD6, 0x4C, 0x81,
+	0x29, 0x1F, 0x53, 0x8B, 0x38, 0x80, 0x87, 0x2A,
+	0xD7, 0xFD, 0x3C, 0x3D, 0x43, 0x00, 0xC2, 0xFD,
+	0x2A, 0xE9, 0x0A, 0x10, 0x22, 0x6B, 0x5A, 0x47,
+	0x21, 0x17, 0x5A, 0x3E, 0x78, 0xAB, 0x94, 0x62,
+	0xF5, 0xF6, 0xB8, 0xA6, 0x7D, 0x87, 0xB4, 0x18,
+	0x9C, 0x91, 0x67, 0xD3, 0xAF, 0x4C, 0x52, 0x16,
+	0x16, 0xD2, 0x72, 0x5D, 0x06, 0xC7, 0x9A, 0xF4,
+	0x4C, 0x77, 0xCE, 0xFA, 0xD0, 0xB2, 0x4A, 0xA5,
+	0xFA, 0xAE, 0xCC, 0x21, 0x85, 0xEC, 0xE0, 0x2F,
+	0x7D, 0x0D, 0x5C, 0xB8, 0x62, 0x35, 0xF1, 0xD9,
+	0x83, 0x17, 0xFE, 0x2A, 0x52, 0xEC, 0xC6, 0xC5,
+	0x13, 0x40, 0x6A, 0xFC, 0xB4, 0x62, 0xF7, 0x5B,
+	0x35, 0x13, 0xA1, 0x36, 0xAB, 0xC1, 0x71, 0x53,
+	0xEB, 0x81, 0x2C, 0x29, 0x10, 0x73, 0x0C, 0x63,
+	0xFF, 0x2E, 0x26, 0xE6, 0xFF, 0x4B, 0x4E, 0x61,
+	0x6A, 0xD0, 0x14, 0xA9,
+
+	0xA2, 0x4F, 0xE6, 0xB7, 0xCA, 0x55, 0x85, 0xB8,
+	0x14, 0xAE, 0x72, 0xA5, 0x41, 0x36, 0x9A, 0xC9,
+	0x0B, 0x0F, 0xB4, 0xCD, 0x48, 0xE5, 0x5A, 0x47,
+	0x7E, 0xF0, 0x6C, 0x4A, 0x4A, 0xCA, 0x44, 0xDD,
+	0xD4, 0x00, 0xAE, 0x86, 0x45, 0x08, 0xD5, 0x6A,
+	0xA1, 0x90, 0x85, 0x95, 0x81, 0x5F, 0x25, 0x52,
+	0x8F, 0xEC, 0xE4, 0x16, 0x80, 0x33, 0x70, 0x14,
+	0xC1, 0x8C, 0xA1, 0x4D, 0x0F, 0x54, 0x2A, 0xA2,
+	0xA1, 0xB6, 0x28, 0x91, 0xBF, 0x5A, 0xF9, 0xBE,
+	0x26, 0xF3, 0xCA, 0xE8, 0xDD, 0x01, 0x42, 0x50,
+	0x9E, 0x1D, 0x01, 0x1C, 0xE7, 0xD5, 0x32, 0x8B,
+	0xC9, 0x19, 0x77, 0x63, 0xBC, 0x1A, 0x72, 0x0A,
+	0xDF, 0xB3, 0x3F, 0x96, 0xD3, 0xBE, 0x58, 0x42,
+	0x1D, 0xE6, 0xEE, 0x13, 0x0A, 0x65, 0x62, 0x86,
+	0x51, 0xB6, 0x78, 0xD2, 0xC3, 0x2E, 0x54, 0x4C,
+	0x77, 0x1F, 0x10, 0xB7, 0x50, 0xA8, 0xB4, 0x50,
+	0x40, 0xC3, 0xD8, 0x6F, 0x6A, 0x31, 0x83, 0xF9,
+	0x0F, 0x79, 0xA1, 0x78, 0xFE, 0x9B, 0x02, 0x4E,
+	0x78, 0x5F, 0xD2, 0x66, 0x58, 0x52, 0x63, 0xAC,
+	0x37, 0xF8, 0x9A, 0x75, 0x80, 0x1B, 0x25, 0xB0,
+	0x3C, 0x80, 0x51, 0x19, 0x32, 0xB5, 0x81, 0x68,
+	0x73, 0x3D, 0x78, 0xEF, 0xEF, 0x59, 0x8D, 0x64,
+	0xAB, 0x90, 0xAC, 0x6E, 0x31, 0xB8, 0x90, 0x54,
+	0xCC, 0x05, 0x81, 0x44, 0xDC, 0xFE, 0xA9, 0x30,
+	0x99, 0x58, 0x22, 0x96, 0x33,
+
+	0xA2, 0x4F, 0xE6, 0xB7, 0xEA, 0x55, 0x85, 0xB8,
+	0x14, 0xD6, 0x12, 0xA5, 0x41, 0x36, 0x9A, 0xC9,
+	0x48, 0x33, 0x34, 0xCE, 0x48, 0xE5, 0x98, 0x4E,
+	0x7B, 0xF0, 0x20, 0xCD, 0x5F, 0xCA, 0x5E, 0x41,
+	0xB1, 0x6F, 0x15, 0xDE, 0x07, 0xE9, 0xFA, 0x72,
+	0x77, 0xAB, 0x73, 0x90, 0x64, 0x1E, 0x56, 0x71,
+	0xBA, 0x3D, 0x57, 0xF5, 0x1F, 0x20, 0x05, 0xDD,
+	0x5C, 0x8D, 0xB9, 0xFD, 0x2E, 0xA3, 0xE7, 0x5D,
+	0x52, 0xBF, 0xEB, 0x78, 0xBC, 0x55, 0x69, 0xBC,
+	0x4D, 0x68, 0x5A, 0xD5, 0x81, 0x72, 0xB4, 0x7F,
+	0x26, 0x2E, 0x4E, 0xC9, 0xF8, 0xC9, 0xAA, 0x86,
+	0xD2, 0x8A, 0xC2, 0x4D, 0x46, 0x5B, 0xD1, 0xAF,
+	0xB7, 0x4E, 0xD8, 0x08, 0x0A, 0x36, 0x5B, 0x71,
+	0xB9, 0xC1, 0xA2, 0x8C, 0x73, 0x64, 0xE5, 0xF6,
+	0xC7, 0x88, 0x94, 0x79, 0xC9, 0x38, 0x19, 0x68,
+	0x01, 0x42, 0xCE, 0xDF, 0x57, 0xC0, 0x79, 0x4F,
+	0x9D, 0x26, 0xBB, 0xA2, 0x82, 0xF9, 0x6D, 0xF1,
+	0x03, 0x04, 0x5D, 0xFC, 0xB2, 0x26, 0x45, 0x5F,
+	0xDC, 0x91, 0x4B, 0xCE, 0x59, 0xE3, 0xD3, 0xF3,
+	0x19, 0x33, 0x07, 0x85, 0xF0, 0x8B, 0x9C, 0x59,
+	0x71, 0x13, 0x52, 0x2E, 0x42, 0xB0, 0x87, 0x73,
+	0x0C, 0x17, 0x61, 0x8E, 0x22, 0xB2, 0x4E, 0xB1,
+	0xD8, 0x5B, 0x66, 0x1B, 0xD4, 0xDF, 0x74, 0xC9,
+	0x5E, 0xA8, 0xC5, 0x84, 0x9B, 0xF0, 0x20, 0x9D,
+	0xA5, 0x4A, 0x5C, 0x6E, 0x8A, 0xA0,
+
+	0xA2, 0x4F, 0xE6, 0xB7, 0xCA, 0x57, 0x85, 0xB8,
+	0x14, 0x56, 0xCA, 0xAD, 0x41, 0x36, 0x9A, 0xC9,
+	0x5F, 0xBF, 0x1A, 0xC6, 0x48, 0xE5, 0xD8, 0x4E,
+	0xFC, 0xF4, 0xA2, 0x06, 0x67, 0xC4, 0x40, 0x0D,
+	0xE8, 0x24, 0x79, 0x60, 0xBD, 0xEB, 0xA9, 0x34,
+	0x1D, 0xD5, 0xFE, 0x2B, 0xEE, 0xFE, 0xFF, 0xB3,
+	0x63, 0xC6, 0x84, 0xF5, 0x05, 0x54, 0x10, 0x86,
+	0xC1, 0x7F, 0xD5, 0x95, 0xDB, 0x54, 0x77, 0x97,
+	0x49, 0xD1, 0x3B, 0xE1, 0x99, 0xB3, 0x9E, 0x67,
+	0xE9, 0x9A, 0xD7, 0x80, 0x48, 0xD8, 0x6C, 0x1B,
+	0x72, 0xBC, 0xAD, 0x84, 0xF4, 0xF8, 0x9A, 0x6D,
+	0x49, 0x6B, 0xE6, 0x25, 0x3A, 0x48, 0x5A, 0xC1,
+	0x04, 0xAF, 0xF2, 0xF8, 0xEE, 0xA8, 0xC3, 0xAE,
+	0xC4, 0x9E, 0x44, 0x31, 0xDF, 0xEF, 0xB6, 0x7A,
+	0xB8, 0x85, 0x98, 0x00, 0x6D, 0x76, 0x4E, 0xF8,
+	0x7D, 0xBA, 0xD7, 0x65, 0xC5, 0x87, 0xBE, 0xC2,
+	0xB8, 0xB6, 0x8C, 0x29, 0x39, 0xCD, 0x01, 0x5F,
+	0xE0, 0x0E, 0x5C, 0x8F, 0x28, 0x7F, 0xF8, 0x7C,
+	0x3C, 0x68, 0x6B, 0x7E, 0x72, 0x04, 0x9F, 0xA2,
+	0x2E, 0xC7, 0x03, 0x2C, 0x07, 0x2D, 0x35, 0xF7,
+	0xBA, 0x4E, 0xDE, 0x3B, 0x15, 0x58, 0x87, 0x65,
+	0x04, 0x64, 0x2D, 0xF7, 0x80, 0x29, 0x5E, 0x2D,
+	0x04, 0x76, 0x2C, 0xF2, 0x82, 0x9F, 0xB2, 0x1E,
+	0xF3, 0xD9, 0xFB, 0xE9, 0x16, 0x5F, 0x3A, 0xED,
+	0x45, 0x9B, 0x38, 0x83, 0xB8, 0xE3, 0xBE,
+
+	0xA2, 0x4F, 0xE6, 0xB7, 0xCA, 0x97, 0x85, 0xB8,
+	0x3D, 0x56, 0xCA, 0x94, 0x41, 0x36, 0x9A, 0xC9,
+	0xDF, 0xB9, 0xC7, 0xD8, 0xA8, 0xE7, 0xD8, 0x4E,
+	0xDC, 0x61, 0xC3, 0x0A, 0xF7, 0xA5, 0x2F, 0xAC,
+	0xBD, 0xA0, 0x3F, 0x91, 0xB4, 0x8E, 0x0D, 0xDC,
+	0x63, 0x0E, 0x48, 0x35, 0xEA, 0x74, 0x67, 0x99,
+	0xB7, 0xA7, 0x62, 0x25, 0x28, 0xCB, 0xA2, 0x22,
+	0xA0, 0x3E, 0x8D, 0x37, 0xD4, 0xC6, 0x89, 0x54,
+	0x97, 0x95, 0x8D, 0x79, 0xC0, 0x9E, 0x9D, 0x4F,
+	0x5D, 0xE2, 0xFF, 0x34, 0x30, 0xDF, 0x0C, 0x4F,
+	0x33, 0x86, 0x55, 0x6F, 0xB3, 0xD3, 0xF1, 0x43,
+	0x46, 0xE7, 0x83, 0x24, 0x0B, 0x08, 0x6D, 0xD9,
+	0x6D, 0x37, 0x26, 0xC9, 0x5A, 0xF1, 0xC9, 0x32,
+	0x0E, 0x66, 0xD5, 0x54, 0x71, 0xA8, 0xBC, 0x3F,
+	0x2F, 0x88, 0xF3, 0xB3, 0xBF, 0x9D, 0xBF, 0x2F,
+	0x47, 0x02, 0x49, 0x2B, 0xDE, 0x00, 0xFC, 0x3E,
+	0x78, 0x29, 0xCD, 0xF1, 0x37, 0x7D, 0x6D, 0x6B,
+	0x13, 0x77, 0x74, 0x1C, 0x88, 0xE3, 0xBA, 0x4C,
+	0xDA, 0xFE, 0xED, 0x96, 0x76, 0xCD, 0xAC, 0x47,
+	0x58, 0xCE, 0xAA, 0x9D, 0x12, 0x93, 0x32, 0xA3,
+	0xEF, 0x01, 0xD7, 0xEE, 0x3F, 0xB1, 0xED, 0x1B,
+	0x7C, 0xCA, 0x96, 0xFA, 0x6C, 0xAD, 0xE6, 0xD8,
+	0x24, 0xDC, 0xD0, 0x4B, 0x9D, 0xD2, 0x55, 0xF2,
+	0x1F, 0x6D, 0x49, 0x42, 0x2B, 0x69, 0x00, 0xA7,
+	0xED, 0xB7, 0x55, 0x66, 0xE8, 0xCC, 0xBA, 0xA5,
+
+	0xA2, 0x4F, 0xE6, 0xB7, 0xCA, 0x97, 0x85, 0xB8,
+	0x3D, 0x56, 0xCA, 0x9E, 0x58, 0x3F, 0x9A, 0x49,
+	0x99, 0x71, 0x53, 0x8F, 0xA5, 0xFF, 0x99, 0x4A,
+	0xDC, 0x63, 0xC2, 0x0A, 0xFF, 0x94, 0xAF, 0x8C,
+	0x0E, 0xBA, 0x39, 0x47, 0x91, 0x39, 0xA1, 0x2B,
+	0xBD, 0xD9, 0x7D, 0x33, 0x55, 0xA1, 0x3E, 0xBC,
+	0x28, 0x98, 0xBE, 0x58, 0x84, 0xBB, 0xA9, 0x75,
+	0x35, 0x79, 0x41, 0x04, 0x99, 0x81, 0x2D, 0xFB,
+	0xB7, 0xE8, 0x89, 0x14, 0x07, 0xC2, 0xF4, 0x2C,
+	0xB6, 0x51, 0xB8, 0x22, 0x8A, 0x87, 0x14, 0x9E,
+	0xE1, 0x09, 0xC0, 0xF8, 0xFD, 0x11, 0x6E, 0x08,
+	0x84, 0x53, 0x74, 0xE0, 0x65, 0x5E, 0x22, 0x9B,
+	0x6A, 0x57, 0x8F, 0xEF, 0xE2, 0xBB, 0xE7, 0xCA,
+	0x5B, 0xBC, 0x25, 0xA3, 0x3C, 0x9A, 0xA8, 0x85,
+	0xA3, 0x58, 0xF2, 0x58, 0x92, 0x7B, 0x2F, 0x35,
+	0xE5, 0xEA, 0xD2, 0xD5, 0xA8, 0xB4, 0xAD, 0xE3,
+	0x5A, 0x78, 0x26, 0xAF, 0xEC, 0x22, 0x84, 0xBE,
+	0x5D, 0xCB, 0xF9, 0x47, 0x21, 0xFE, 0xEE, 0x31,
+	0xAC, 0x4F, 0x19, 0xCD, 0xCE, 0x56, 0x47, 0xDC,
+	0x63, 0xA0, 0x9A, 0x0E, 0x84, 0x0E, 0xB3, 0x2C,
+	0xD8, 0xAF, 0x74, 0x26, 0x5D, 0xD6, 0x42, 0x2E,
+	0x0F, 0xC5, 0x50, 0x14, 0xC5, 0x19, 0xF5, 0xB6,
+	0x8E, 0x15, 0x05, 0x51, 0x20, 0xBC, 0x46, 0x5F,
+	0x6B, 0xA7, 0x06, 0xB2, 0x82, 0x46, 0x68, 0x58,
+	0x74, 0x60, 0x74, 0x3E, 0xBE, 0x8E, 0xF1, 0x12,
+	0x68,
+
+	0xA2, 0x4F, 0xE6, 0xB7, 0xCA, 0x97, 0x85, 0xB8,
+	0x3E, 0x56, 0xCA, 0x1E, 0x58, 0x45, 0xEA, 0x49,
+	0x5E, 0xF1, 0x72, 0xD2, 0xF1, 0xC4, 0xB9, 0x4A,
+	0xDC, 0x63, 0x2A, 0x0F, 0x38, 0xD4, 0xCD, 0x23,
+	0xB6, 0xC9, 0xC0, 0x70, 0x8B, 0x2E, 0xC2, 0x65,
+	0xEE, 0x95, 0x1B, 0x99, 0x9F, 0x9E, 0x5D, 0x2D,
+	0x3D, 0x19, 0x3E, 0x90, 0x23, 0x1C, 0xCC, 0xE6,
+	0x1F, 0x1E, 0xEA, 0x46, 0x9F, 0x11, 0x66, 0xD3,
+	0xA4, 0xED, 0xFE, 0x70, 0x3C, 0x8D, 0x42, 0x7E,
+	0xE3, 0xF4, 0x28, 0x4B, 0xD2, 0xE1, 0x22, 0xBD,
+	0x15, 0x60, 0x89, 0x29, 0x3A, 0xC9, 0x22, 0x8A,
+	0x02, 0x1C, 0xB4, 0x61, 0xAD, 0x9B, 0x36, 0x95,
+	0x14, 0x12, 0x65, 0x21, 0x1E, 0x50, 0x50, 0xC3,
+	0x85, 0x69, 0xA8, 0xCC, 0x52, 0xB1, 0xE2, 0xF3,
+	0xDF, 0x97, 0xF8, 0x03, 0x55, 0xBF, 0xFC, 0xA9,
+	0x74, 0x74, 0xBC, 0x55, 0xF6, 0xE5, 0x64, 0x3C,
+	0x35, 0xE9, 0x9A, 0x61, 0xA3, 0xD1, 0xC3, 0x1E,
+	0x3B, 0x5E, 0x72, 0x47, 0xD7, 0xA6, 0xA6, 0xA6,
+	0x6A, 0x9E, 0xF7, 0xD7, 0xC4, 0x10, 0xE8, 0x81,
+	0xD2, 0xDB, 0x35, 0x7E, 0xF1, 0xB9, 0x9C, 0x15,
+	0x00, 0x2D, 0x8B, 0xE1, 0x83, 0xCB, 0xF4, 0xE2,
+	0x88, 0x50, 0x18, 0x05, 0x6A, 0x13, 0x2A, 0x89,
+	0x66, 0x2F, 0xB1, 0x89, 0x5D, 0x88, 0x1B, 0xA4,
+	0xD6, 0x98, 0x05, 0xF9, 0x78, 0xAC, 0x36, 0x4B,
+	0x77, 0xE8, 0xFD, 0x00, 0x5A, 0x0E, 0x5F, 0x39,
+	0x3A, 0x7F,
+
+	0xA2, 0x4F, 0xE6, 0xB7, 0xCA, 0x97, 0x85, 0xB8,
+	0xBE, 0x5D, 0xCA, 0x1E, 0x58, 0xC5, 0xB0, 0x11,
+	0xAE, 0xE8, 0x7A, 0xC3, 0xE1, 0xFA, 0x97, 0x6A,
+	0xD6, 0x63, 0xBE, 0x4F, 0x9B, 0xC1, 0x85, 0x18,
+	0x1A, 0xD2, 0x1A, 0xD4, 0x7B, 0x21, 0x19, 0xBE,
+	0xA0, 0xCE, 0xF4, 0x94, 0x53, 0xB4, 0x0D, 0xC8,
+	0x7B, 0xEC, 0xD3, 0x08, 0xBF, 0x74, 0xB0, 0xCD,
+	0x29, 0xF2, 0x43, 0x3E, 0x49, 0xF8, 0xCC, 0x14,
+	0xC3, 0x39, 0x39, 0x63, 0x7A, 0x22, 0xA1, 0x61,
+	0xC2, 0x02, 0xCC, 0x43, 0x37, 0x01, 0xC6, 0x08,
+	0x73, 0xC6, 0x85, 0x37, 0x43, 0x65, 0x6C, 0xA2,
+	0xC5, 0x23, 0x60, 0xAA, 0x9F, 0x24, 0xBC, 0xE7,
+	0x06, 0x15, 0xB9, 0x6A, 0x03, 0x4A, 0x6D, 0xA0,
+	0xB0, 0x28, 0x15, 0xD2, 0xBC, 0xB4, 0x54, 0x42,
+	0x13, 0xCB, 0xA8, 0x4B, 0x8C, 0xD2, 0xFF, 0x45,
+	0xA2, 0x65, 0xD1, 0xF7, 0x2E, 0xBB, 0xE8, 0x7C,
+	0xF8, 0x23, 0x68, 0x9D, 0x89, 0x6F, 0xD8, 0xC7,
+	0xD1, 0x41, 0xBE, 0xEC, 0x56, 0x84, 0x44, 0x76,
+	0xD2, 0xCF, 0xA1, 0x45, 0x7D, 0x20, 0x51, 0xA7,
+	0xD5, 0x87, 0x91, 0x52, 0x7A, 0x5E, 0x4A, 0x00,
+	0x1B, 0x6D, 0x85, 0x56, 0x8C, 0xE4, 0x61, 0xB1,
+	0x49, 0x5F, 0x69, 0xDF, 0x08, 0x4D, 0xBE, 0x09,
+	0xFF, 0xC5, 0xCD, 0xD5, 0x13, 0x2F, 0x3F, 0x0F,
+	0x22, 0xF9, 0xB9, 0x2E, 0xB5, 0x6E, 0x42, 0x11,
+	0x87, 0x8C, 0x06, 0x21, 0xD0, 0xC5, 0x7C, 0x5B,
+	0x78, 0xAF, 0xC9,
+
+	0xA2, 0x4F, 0xE6, 0xB7, 0xCA, 0x97, 0x85, 0xB8,
+	0xBE, 0x1D, 0xDB, 0x1E, 0x61, 0xC5, 0xB0, 0x2A,
+	0x33, 0xCD, 0x57, 0xD3, 0xE1, 0xF9, 0x7B, 0x75,
+	0xF3, 0x60, 0xBE, 0x1F, 0x75, 0x74, 0x46, 0x69,
+	0x4E, 0xC5, 0x26, 0x70, 0xD3, 0xC8, 0x6A, 0x16,
+	0xE1, 0xDD, 0x5E, 0x4F, 0xC9, 0xBE, 0x60, 0xA5,
+	0xC3, 0x5A, 0x8F, 0x15, 0x7F, 0xC6, 0xC0, 0x98,
+	0x43, 0xBE, 0x28, 0xEA, 0x0C, 0x53, 0x1F, 0xED,
+	0x76, 0x75, 0x9C, 0x10, 0xC2, 0x21, 0x86, 0x9F,
+	0x9F, 0xD6, 0x8A, 0xF7, 0xCF, 0xA7, 0x6E, 0x40,
+	0x50, 0x22, 0x0D, 0x4E, 0x30, 0x16, 0xED, 0xB1,
+	0x60, 0x14, 0x83, 0x09, 0x7C, 0xA2, 0xD9, 0x53,
+	0x41, 0x8A, 0x1D, 0x73, 0xC7, 0xCA, 0x18, 0xDF,
+	0xB2, 0xDA, 0xCA, 0x8C, 0x4A, 0xA9, 0x86, 0x90,
+	0x38, 0xDC, 0x39, 0xF9, 0x44, 0x29, 0xB7, 0x6C,
+	0xA8, 0x19, 0x15, 0x5F, 0x2D, 0xF7, 0x61, 0xEB,
+	0x8E, 0xCB, 0x86, 0xD0, 0x02, 0xDA, 0x0C, 0x1F,
+	0x34, 0xC5, 0x35, 0x7C, 0xDA, 0xA9, 0xD7, 0xCD,
+	0xE5, 0xA1, 0x66, 0x96, 0x3B, 0x1D, 0x2C, 0xB1,
+	0x6F, 0xB9, 0x56, 0x71, 0x12, 0x4F, 0x14, 0x36,
+	0xF7, 0x54, 0x71, 0xE4, 0x02, 0x4E, 0x3B, 0xA4,
+	0xA4, 0x4D, 0x0E, 0xA1, 0xF4, 0x0F, 0x10, 0xDE,
+	0x42, 0xC7, 0x39, 0x1C, 0xE1, 0xB5, 0xF9, 0x77,
+	0xC4, 0xFA, 0xAC, 0x06, 0x15, 0x57, 0x72, 0x47,
+	0xD3, 0x87, 0x74, 0xBD, 0x84, 0x12, 0x3E, 0x77,
+	0xE3, 0x64, 0x63, 0x4C,
+
+	0x39, 0x4D, 0xE6, 0x37, 0xCA, 0x97, 0x85, 0xB8,
+	0xBE, 0x1D, 0xDB, 0x1E, 0x61, 0xC5, 0xB0, 0x2A,
+	0x32, 0xB6, 0xEC, 0xD2, 0x6A, 0x6C, 0x97, 0xE5,
+	0xFF, 0xE8, 0xF7, 0xB9, 0xB9, 0x7E, 0x47, 0x69,
+	0x1D, 0x94, 0x2C, 0xBE, 0x22, 0xE2, 0x75, 0x6D,
+	0xE4, 0xB9, 0x82, 0x30, 0x8F, 0xB2, 0x4D, 0xAD,
+	0x20, 0x86, 0xA5, 0xD3, 0xAB, 0xFF, 0x74, 0x2A,
+	0xBB, 0x97, 0x79, 0x76, 0xAD, 0x3A, 0x0C, 0x64,
+	0x9F, 0x1D, 0xE5, 0xDC, 0x7A, 0xAE, 0xA9, 0xA7,
+	0x38, 0xDC, 0x07, 0xB2, 0xC0, 0x22, 0x37, 0xB4,
+	0x21, 0x59, 0xFD, 0x86, 0xB0, 0x49, 0x96, 0xC6,
+	0x1F, 0xB2, 0x38, 0xB3, 0xC1, 0xCB, 0x7B, 0xCD,
+	0x38, 0x6B, 0xCD, 0xEB, 0xF3, 0x6F, 0x38, 0x9F,
+	0xDC, 0x95, 0x09, 0x50, 0x62, 0xF8, 0x73, 0x78,
+	0xB3, 0xCE, 0x28, 0x63, 0x8A, 0x30, 0xFF, 0x5E,
+	0xAC, 0xFC, 0x5C, 0x8B, 0x2F, 0x8B, 0x9D, 0x09,
+	0x4D, 0x7B, 0x9F, 0x08, 0x68, 0x77, 0x54, 0xB1,
+	0x30, 0x38, 0x3F, 0x20, 0xAE, 0x19, 0xD6, 0x6A,
+	0xE5, 0xB9, 0x85, 0x22, 0x9B, 0x15, 0xD3, 0x0F,
+	0x55, 0x77, 0x67, 0xDC, 0xD8, 0x06, 0x6C, 0x1B,
+	0x23, 0x3C, 0xF7, 0xB5, 0x97, 0x4F, 0xCB, 0x9A,
+	0x7D, 0x1B, 0xB9, 0x9C, 0x22, 0xDC, 0x58, 0xC6,
+	0x57, 0x6F, 0x10, 0xE4, 0x06, 0xBC, 0x38, 0x73,
+	0xED, 0x5F, 0x59, 0xFB, 0x41, 0x0B, 0x15, 0x35,
+	0x37, 0x13, 0x15, 0x9B, 0x8B, 0xD0, 0x59, 0x10,
+	0xBD, 0x03, 0x78, 0x96, 0x3C,
+
+	0x39, 0x31, 0xE2, 0x37, 0xCA, 0x97, 0x85, 0xB8,
+	0xBE, 0x1D, 0xDB, 0x1E, 0x40, 0xC5, 0xB0, 0x2A,
+	0xB5, 0xBE, 0x44, 0x19, 0x71, 0x7C, 0xB9, 0x5B,
+	0x9F, 0xD3, 0xB7, 0xBF, 0xB9, 0x7E, 0x07, 0x62,
+	0x58, 0x4A, 0xFE, 0x2A, 0x98, 0x30, 0x44, 0x69,
+	0x08, 0x01, 0xD9, 0x4B, 0x14, 0xD2, 0x12, 0x33,
+	0x91, 0x20, 0xD1, 0x47, 0xF1, 0x1B, 0x9D, 0xD0,
+	0x75, 0xF9, 0xA1, 0x33, 0xC6, 0x13, 0x3A, 0xEC,
+	0x76, 0xBF, 0x77, 0x0A, 0x5F, 0x94, 0x46, 0x56,
+	0xD4, 0x12, 0xDB, 0x4D, 0xF3, 0x41, 0xCD, 0xF2,
+	0xB9, 0x60, 0x62, 0x2B, 0xA3, 0xCE, 0x2E, 0xDE,
+	0xB1, 0xB2, 0x06, 0x84, 0x99, 0x40, 0xDF, 0x87,
+	0x43, 0xA1, 0xCF, 0x72, 0x05, 0x57, 0xDE, 0xEB,
+	0x7B, 0x08, 0xB1, 0x96, 0xF7, 0xC4, 0x78, 0x61,
+	0x98, 0xD9, 0xDB, 0xA1, 0x62, 0xB1, 0xBD, 0xD5,
+	0x4B, 0xB0, 0x42, 0x8B, 0xE9, 0x36, 0x96, 0x96,
+	0x2B, 0x91, 0x07, 0xDF, 0x68, 0x59, 0x6E, 0x4F,
+	0x39, 0xB6, 0x24, 0x02, 0x0C, 0x4D, 0xD2, 0x8C,
+	0x95, 0xB3, 0x19, 0x4A, 0xE9, 0xA5, 0x04, 0x2B,
+	0x64, 0xD9, 0x50, 0xD9, 0x98, 0x37, 0xC0, 0x79,
+	0x07, 0xFA, 0x68, 0xDC, 0xE9, 0x87, 0x3F, 0x4C,
+	0x4A, 0x21, 0x4A, 0xDC, 0x9A, 0x6D, 0x3A, 0x14,
+	0x68, 0xBA, 0x26, 0xFB, 0xF6, 0x95, 0x81, 0xE2,
+	0x41, 0x83, 0x13, 0xBB, 0xDD, 0x77, 0x5C, 0x79,
+	0x51, 0x77, 0x4F, 0xDC, 0x0B, 0x33, 0x4A, 0x89,
+	0xE1, 0xBA, 0xB2, 0xA6, 0x5D, 0x5A,
+
+	0x39, 0xB1, 0x3E, 0x33, 0xCA, 0x97, 0x85, 0xB8,
+	0xBE, 0x1D, 0xDB, 0x1E, 0x20, 0xFD, 0xB0, 0x2A,
+	0x7F, 0xBE, 0x48, 0x9F, 0xF5, 0xF3, 0xF9, 0x70,
+	0x88, 0xBE, 0x9E, 0xF7, 0xBA, 0x7E, 0xC7, 0x22,
+	0x48, 0x96, 0x68, 0x95, 0xD8, 0xF8, 0x42, 0xA9,
+	0x0D, 0xD2, 0xAD, 0x36, 0xCA, 0x65, 0x8D, 0xC2,
+	0xF6, 0x5B, 0x47, 0x63, 0x0F, 0x56, 0x05, 0x07,
+	0xA1, 0xCC, 0xF8, 0x18, 0x9F, 0x58, 0x34, 0x31,
+	0xC2, 0x6A, 0x1B, 0xA5, 0x07, 0x92, 0xBE, 0x69,
+	0x15, 0xCC, 0xD9, 0xF3, 0xAA, 0x8D, 0x15, 0x7C,
+	0xFA, 0xCB, 0xFB, 0xB2, 0x55, 0x17, 0x25, 0x33,
+	0x05, 0x93, 0xFF, 0xFE, 0x0C, 0x54, 0xF0, 0x30,
+	0x31, 0xB7, 0x06, 0x57, 0xCA, 0xFF, 0x62, 0x5C,
+	0x04, 0xCD, 0xDD, 0x4D, 0x42, 0x07, 0x0E, 0x52,
+	0xF2, 0x05, 0xD3, 0xE2, 0x6C, 0x9B, 0x7B, 0x7A,
+	0x9C, 0xE6, 0x5D, 0xC1, 0x50, 0xBC, 0xC8, 0xBA,
+	0xE7, 0xBB, 0x09, 0x6F, 0x93, 0x4F, 0x19, 0x3D,
+	0x9D, 0xF8, 0x40, 0x6F, 0x34, 0xAD, 0xBE, 0x67,
+	0xEC, 0x86, 0x93, 0x90, 0x89, 0x3D, 0x3B, 0x3E,
+	0x2F, 0x1C, 0x12, 0x3C, 0x35, 0x39, 0x84, 0x99,
+	0x3A, 0x8E, 0xEA, 0xD4, 0xA3, 0xFE, 0xFB, 0x62,
+	0xD7, 0x65, 0xFA, 0x2C, 0x9A, 0x35, 0x30, 0xE1,
+	0xCC, 0xA9, 0x25, 0x97, 0x01, 0x5F, 0x58, 0x83,
+	0x47, 0xB0, 0x53, 0xD3, 0x52, 0xE2, 0x98, 0x34,
+	0x8A, 0xB9, 0x5A, 0x06, 0x5C, 0xF1, 0xA3, 0xE8,
+	0x86, 0x4C, 0x38, 0x6B, 0x3A, 0x3C, 0x9E,
+
+	0x0D, 0xB1, 0x3E, 0x0E, 0xCA, 0x97, 0x85, 0xB8,
+	0xBE, 0x1D, 0xDB, 0x1E, 0x20, 0xBD, 0xA0, 0x2A,
+	0x9E, 0x69, 0xC1, 0xB2, 0x63, 0xB5, 0x53, 0x70,
+	0x48, 0xBE, 0xB6, 0xE9, 0xDF, 0x7F, 0xC7, 0x62,
+	0x35, 0xBD, 0xB6, 0x01, 0x53, 0xD8, 0xE3, 0xA7,
+	0x3A, 0xC5, 0x8B, 0x5C, 0x08, 0x21, 0xB1, 0x0A,
+	0x9F, 0x1E, 0xF2, 0xF0, 0x35, 0x3C, 0xE3, 0x58,
+	0x26, 0xCD, 0xD3, 0xF0, 0x1E, 0x5E, 0x8C, 0x44,
+	0x65, 0x7F, 0xD9, 0xB4, 0x32, 0x8F, 0xB6, 0xB6,
+	0xE6, 0xB0, 0x92, 0x8D, 0x25, 0xE9, 0xE6, 0xD4,
+	0x98, 0x2A, 0x24, 0x88, 0xC6, 0x52, 0xA5, 0xA5,
+	0x4A, 0xF3, 0x66, 0xE9, 0xE8, 0x4F, 0x47, 0xE5,
+	0xD8, 0x0E, 0x5D, 0x06, 0x7D, 0xC1, 0xB8, 0x32,
+	0x78, 0xC6, 0x48, 0x61, 0x59, 0x09, 0xF2, 0xBC,
+	0xB7, 0x4D, 0x67, 0xB6, 0xB3, 0xDA, 0x9D, 0xA3,
+	0x61, 0x30, 0x8F, 0xE1, 0xD3, 0xA1, 0x29, 0xEA,
+	0xA2, 0x36, 0xBF, 0x99, 0xE0, 0xCD, 0x25, 0x74,
+	0xB0, 0x75, 0x4B, 0x30, 0x72, 0x5D, 0xE5, 0x12,
+	0xD1, 0xB6, 0xB1, 0x6A, 0x52, 0x2A, 0x62, 0x2F,
+	0x19, 0x7F, 0x58, 0x13, 0xF3, 0x26, 0x52, 0xE4,
+	0x8E, 0x30, 0x37, 0x23, 0x48, 0x2F, 0xA9, 0x3F,
+	0x9C, 0x84, 0xB8, 0x42, 0xBB, 0x0E, 0x9A, 0x54,
+	0x6B, 0x2E, 0x53, 0x7D, 0xBA, 0xB9, 0x6B, 0xB8,
+	0xE3, 0xAF, 0xEB, 0xC8, 0x14, 0x34, 0xF4, 0xBB,
+	0x07, 0xA4, 0x1B, 0x92, 0xAD, 0x19, 0x39, 0x82,
+	0xE8, 0x00, 0xED, 0x84, 0x94, 0x17, 0x9E, 0x15,
+
+	0xBF, 0x44, 0xAF, 0xC9, 0x6F, 0xC1, 0x81, 0xF7,
+	0x10, 0x4B, 0xE2, 0xAE, 0x3E, 0x23, 0x94, 0xCA,
+	0x7B, 0xF6, 0xE4, 0x40, 0x77, 0x7F, 0x60, 0xA3,
+	0x55, 0xD5, 0x5D, 0xC8, 0xA0, 0x5D, 0x21, 0x40,
+	0x21, 0x00, 0x4B, 0x6F, 0x5C, 0x4B, 0x9A, 0x78,
+	0x4D, 0x0C, 0x2E, 0xF6, 0x46, 0xA4, 0x81, 0xD1,
+	0x98, 0x57, 0x52, 0x20, 0x59, 0x27, 0x63, 0x96,
+	0x76, 0xBF, 0x3E, 0x41, 0x04, 0x32, 0x75, 0xAB,
+	0xC8, 0xCA, 0x67, 0x04, 0x85, 0xB3, 0xE2, 0x2D,
+	0x3F, 0x05, 0x2D, 0xB7, 0x05, 0x58, 0x12, 0x14,
+	0x6E, 0x3E, 0xB4, 0x02, 0xD2, 0xF3, 0xF6, 0xF2,
+	0x5A, 0x6F, 0xDC, 0x70, 0x08, 0x8A, 0x06, 0x0C,
+	0x81, 0x5E, 0x06, 0x7D, 0x47, 0x63, 0x60, 0xAE,
+	0x61, 0x05, 0xB2, 0x29, 0x07, 0x27, 0x17, 0x29,
+	0x26, 0x9D, 0xFA, 0x2F, 0x47, 0x13, 0x70, 0xF1,
+	0xBD, 0x49, 0xEB, 0x54, 0x3C, 0xDB, 0xB3, 0x89,
+	0xEF, 0xA5, 0x1F, 0x62, 0x8C, 0x6E, 0x68, 0x84,
+	0x7E, 0x41, 0xAF, 0x3A, 0xBA, 0x92, 0xA6, 0x38,
+	0xE6, 0xC7, 0xB3, 0x6F, 0x22, 0xD8, 0xCC, 0x8A,
+	0x1F, 0xB5, 0xC3, 0xCD, 0x49, 0xC9, 0x7F, 0x57,
+	0xAC, 0xFE, 0x5D, 0x45, 0x0C, 0x87, 0x78, 0xEF,
+	0x97, 0xD0, 0x88, 0xD0, 0x61, 0xAF, 0x8D, 0x9A,
+	0x83, 0x4B, 0x31, 0xB4, 0xE3, 0x81, 0x27, 0x90,
+	0x46, 0xEB, 0x88, 0x5A, 0xD9, 0xAD, 0xC1, 0x38,
+	0x7E, 0xB6, 0xC2, 0x4D, 0x2C, 0x77, 0xFF, 0x1A,
+	0xD2, 0xBC, 0x46, 0xB2, 0x5F, 0x7E, 0x4B, 0x21,
+	0x73,
+
+	0xBF, 0x44, 0xAF, 0xC9, 0x6F, 0xBF, 0xAB, 0xF7,
+	0x10, 0x4B, 0xE2, 0xAE, 0x3E, 0x23, 0x94, 0xCA,
+	0x7B, 0xC6, 0x2C, 0x40, 0xF0, 0xFD, 0x87, 0x4C,
+	0x15, 0xF5, 0xA7, 0xF4, 0xB5, 0x67, 0x61, 0x40,
+	0x35, 0x55, 0xFA, 0xA9, 0x74, 0x77, 0x6C, 0x9D,
+	0x97, 0x95, 0xAE, 0x08, 0x9D, 0x53, 0x99, 0x26,
+	0x2B, 0xF5, 0x22, 0x91, 0x35, 0x28, 0x75, 0x13,
+	0x4D, 0x6B, 0x7B, 0xA7, 0x77, 0x33, 0x44, 0x7F,
+	0x72, 0xB2, 0xF1, 0xB7, 0xC1, 0x58, 0xB4, 0xCB,
+	0x72, 0xC1, 0x8A, 0xFF, 0xCC, 0x36, 0x78, 0x15,
+	0x39, 0x87, 0x10, 0xF4, 0x15, 0x74, 0x28, 0x58,
+	0x1D, 0xF2, 0x20, 0x2C, 0x6B, 0x26, 0x2B, 0x70,
+	0x0B, 0x98, 0xA8, 0x9F, 0xCD, 0xAE, 0x63, 0xA3,
+	0x0C, 0x7C, 0x58, 0x1C, 0x09, 0x7F, 0xD2, 0xDC,
+	0x87, 0x41, 0xE5, 0x45, 0x3C, 0xEE, 0x90, 0xBB,
+	0x3B, 0xAC, 0x95, 0x33, 0x40, 0x1E, 0x3C, 0x5F,
+	0xB1, 0x05, 0x7D, 0xC0, 0xDE, 0x90, 0xE7, 0x62,
+	0x40, 0x1D, 0xC1, 0xE9, 0xCC, 0x9A, 0xE9, 0xC4,
+	0xFE, 0x16, 0xC6, 0xE3, 0x4B, 0x00, 0xAD, 0xD5,
+	0xC0, 0xFB, 0xE1, 0x3E, 0xB0, 0x10, 0x69, 0x8A,
+	0xCB, 0x05, 0x62, 0xCD, 0x6B, 0x5C, 0xB0, 0xA0,
+	0xD5, 0x47, 0x15, 0xB6, 0x98, 0xAF, 0x32, 0x45,
+	0x22, 0x67, 0x16, 0x16, 0x82, 0x8A, 0x28, 0x93,
+	0xC8, 0xF7, 0x5E, 0xEF, 0x00, 0x48, 0xEB, 0x07,
+	0x5A, 0x7B, 0x4C, 0x37, 0xAD, 0x26, 0x9C, 0xE8,
+	0xC0, 0x35, 0x84, 0x83, 0x17, 0x6C, 0x59, 0x7F,
+	0xD2, 0x6C,
+
+	0xFF, 0x74, 0xAF, 0xC9, 0x6F, 0x3F, 0x75, 0xFF,
+	0x10, 0x4B, 0xE2, 0xAE, 0x3E, 0x23, 0x94, 0xCA,
+	0x79, 0xC6, 0x6C, 0xB8, 0x5B, 0xD8, 0x13, 0xB5,
+	0x65, 0xAC, 0x63, 0x9D, 0xF5, 0x7B, 0x49, 0x00,
+	0x01, 0xC0, 0xB4, 0xD4, 0xFC, 0x5E, 0xE6, 0x25,
+	0x6C, 0x63, 0x00, 0x9D, 0x4D, 0x12, 0xD3, 0x13,
+	0xBD, 0x79, 0x1F, 0x0C, 0x61, 0xD1, 0x95, 0xDB,
+	0xC3, 0x36, 0xB2, 0x66, 0xCD, 0x32, 0xA0, 0x94,
+	0x7C, 0x07, 0xC4, 0x64, 0x1D, 0x91, 0x04, 0x8E,
+	0x51, 0x17, 0xB1, 0x20, 0xAE, 0x2E, 0x27, 0xC8,
+	0xFB, 0x60, 0x98, 0x08, 0x45, 0x72, 0xDB, 0x2F,
+	0x36, 0xF5, 0x7C, 0x2F, 0xBF, 0x8B, 0x6F, 0xE7,
+	0xDA, 0x73, 0xFF, 0x61, 0x53, 0x8B, 0x15, 0xB9,
+	0x5C, 0x65, 0x92, 0xFC, 0x19, 0xA8, 0x67, 0xCD,
+	0x24, 0x0F, 0xA4, 0x26, 0x26, 0xDB, 0xB3, 0xA1,
+	0x79, 0x6A, 0xD0, 0xB9, 0x90, 0xCC, 0x25, 0x7A,
+	0x59, 0xA1, 0xBB, 0xA8, 0x5D, 0x76, 0x76, 0xA2,
+	0x06, 0xC8, 0xB8, 0x89, 0x0C, 0x7E, 0x22, 0x8D,
+	0x4B, 0x90, 0x9C, 0x99, 0xAF, 0x0A, 0xAE, 0xBD,
+	0x36, 0x13, 0x82, 0x39, 0x5E, 0xD7, 0xCF, 0xAE,
+	0xE7, 0xBD, 0xA8, 0x0E, 0xB4, 0xC4, 0x4D, 0x2D,
+	0xA4, 0x7A, 0x66, 0xF6, 0xCC, 0x71, 0x20, 0x31,
+	0x46, 0x6E, 0xA3, 0x8D, 0x14, 0xD9, 0xAA, 0x62,
+	0x05, 0xCA, 0x27, 0x9E, 0xA4, 0x46, 0x78, 0x28,
+	0x00, 0xE5, 0xC6, 0xF2, 0xD3, 0xA7, 0x1B, 0xE9,
+	0xDD, 0x44, 0xEF, 0x7E, 0x86, 0x58, 0x73, 0x82,
+	0x86, 0x23, 0xF7,
+
+	0xFF, 0x34, 0xAF, 0xC9, 0x7D, 0x3F, 0x75, 0xC0,
+	0x10, 0x4B, 0xE2, 0xAE, 0x3E, 0x23, 0x94, 0xCA,
+	0xA1, 0xC3, 0x6C, 0x50, 0xB9, 0x2D, 0x38, 0x56,
+	0xD8, 0x4B, 0x6F, 0xA5, 0x95, 0x3B, 0xF1, 0x1C,
+	0xEB, 0xB8, 0x79, 0xF8, 0x63, 0x0C, 0x9A, 0xAF,
+	0x7C, 0xC4, 0xBC, 0xCC, 0xF4, 0x1A, 0xB9, 0xB3,
+	0x21, 0x8D, 0x7D, 0xCE, 0x1C, 0x7E, 0xF8, 0xB6,
+	0x25, 0x47, 0xE0, 0x72, 0x74, 0x2E, 0xEC, 0x63,
+	0x6E, 0x19, 0x2B, 0x10, 0x70, 0x15, 0x94, 0xF6,
+	0x68, 0x04, 0xB1, 0x57, 0xBD, 0x22, 0xD4, 0x5E,
+	0x37, 0xEE, 0xAA, 0x50, 0x55, 0xEB, 0xAC, 0x86,
+	0xED, 0xEA, 0xD5, 0xE2, 0xFF, 0x15, 0x4E, 0x21,
+	0x4C, 0x45, 0x68, 0xE9, 0x76, 0x3F, 0x1B, 0xF4,
+	0x2C, 0xBD, 0x88, 0x8B, 0xF7, 0x8C, 0xA0, 0x09,
+	0x17, 0x7C, 0xBE, 0x33, 0x8C, 0xD6, 0x5E, 0x65,
+	0x09, 0xFE, 0x41, 0x62, 0xF9, 0x60, 0x5E, 0x28,
+	0x0E, 0x5A, 0xC1, 0xBE, 0xBD, 0x8B, 0x1C, 0x04,
+	0x05, 0xDA, 0x67, 0x94, 0xC4, 0x48, 0xDF, 0x6E,
+	0x61, 0x08, 0xCE, 0xB3, 0x1C, 0xFA, 0x7B, 0x55,
+	0xDD, 0x21, 0xBC, 0x85, 0x7B, 0xAE, 0x6B, 0xF6,
+	0xF9, 0xE9, 0x3A, 0x28, 0x59, 0xC3, 0x84, 0xE2,
+	0x12, 0x4F, 0x8B, 0xA8, 0x79, 0x39, 0xA1, 0xB4,
+	0xA0, 0x15, 0xE8, 0xAC, 0xDC, 0xDB, 0x14, 0xCF,
+	0x7C, 0x03, 0xF5, 0x61, 0xD7, 0x24, 0x78, 0x3A,
+	0x27, 0x97, 0x21, 0x0A, 0xD0, 0x02, 0xFC, 0x48,
+	0x61, 0x70, 0x91, 0xE4, 0xE2, 0x2A, 0x74, 0x9F,
+	0x04, 0x10, 0xD8, 0x3C,
+
+	0xFF, 0x34, 0xAF, 0xC9, 0x7D, 0x3F, 0x75, 0xC8,
+	0x8F, 0x46, 0xE2, 0x2E, 0x3E, 0x23, 0x94, 0xCA,
+	0xAE, 0x8B, 0x4D, 0x14, 0x75, 0xBF, 0x3B, 0x56,
+	0x9A, 0xBD, 0x9C, 0xD4, 0xDD, 0x6A, 0xA1, 0x1F,
+	0x62, 0x24, 0x26, 0x90, 0xCE, 0xC7, 0x36, 0xDC,
+	0x39, 0xE3, 0x0C, 0x96, 0x9D, 0x37, 0xCA, 0x49,
+	0xE2, 0xD4, 0xCF, 0x1C, 0x84, 0xA3, 0x07, 0xB7,
+	0x3F, 0x75, 0x79, 0xE4, 0x00, 0x01, 0xD1, 0xFF,
+	0xD3, 0xF4, 0xB0, 0xD0, 0xA5, 0xB3, 0x65, 0xCB,
+	0x1C, 0xD2, 0x01, 0xE3, 0x76, 0x76, 0x00, 0xBD,
+	0xC4, 0x05, 0x39, 0x19, 0xA6, 0xC7, 0x24, 0x36,
+	0x42, 0x9D, 0x25, 0x75, 0x89, 0xF0, 0x85, 0xB2,
+	0xDB, 0x36, 0xED, 0xDD, 0x45, 0x10, 0x26, 0x0E,
+	0xBC, 0x6E, 0x4C, 0xD9, 0xC9, 0x7B, 0x7B, 0xD2,
+	0xE0, 0xED, 0x1B, 0xEC, 0x40, 0x37, 0x5A, 0x51,
+	0x5C, 0x5C, 0x03, 0xB6, 0x1B, 0xA6, 0x32, 0x45,
+	0xAF, 0xFE, 0xC8, 0xE7, 0x1E, 0x4D, 0x03, 0x7A,
+	0x67, 0x07, 0xFC, 0x6C, 0x93, 0xC1, 0x2F, 0x74,
+	0x88, 0x6A, 0xAB, 0xC8, 0x1E, 0x82, 0xA8, 0xB5,
+	0x1E, 0x9E, 0x9D, 0x8E, 0x0F, 0x01, 0xF9, 0x51,
+	0x7B, 0x7B, 0x93, 0xA9, 0xA3, 0xD8, 0x76, 0x5D,
+	0x09, 0xB4, 0x55, 0x10, 0x84, 0x56, 0xD9, 0x4B,
+	0x5C, 0x47, 0xED, 0xAB, 0x60, 0x3A, 0x94, 0x39,
+	0x8E, 0x9B, 0x63, 0x62, 0x51, 0x1E, 0x64, 0xEF,
+	0x62, 0xC6, 0x76, 0xDA, 0x67, 0x61, 0x94, 0xE7,
+	0xB0, 0x73, 0x5E, 0x24, 0x8A, 0x3F, 0x70, 0x35,
+	0xBD, 0x4B, 0xE1, 0x9E, 0xD9,
+
+	0xFF, 0x34, 0xAF, 0xC9, 0x7D, 0x3F, 0x75, 0xC8,
+	0x8F, 0x46, 0xE2, 0x2E, 0x3E, 0x23, 0x94, 0xCA,
+	0xAE, 0x8B, 0x4D, 0x14, 0x75, 0xBF, 0x3B, 0x56,
+	0x9A, 0xBD, 0x9C, 0xD4, 0xDD, 0x6A, 0xA1, 0x1F,
+	0x62, 0x24, 0x26, 0x90, 0xCE, 0xC7, 0x36, 0xDC,
+	0x39, 0xE3, 0x0C, 0x96, 0x9D, 0x37, 0xCA, 0x49,
+	0xE2, 0xD4, 0xCF, 0x1C, 0x84, 0xA3, 0x07, 0xB7,
+	0x3F, 0x75, 0x79, 0xE4, 0x00, 0x01, 0xD1, 0xFF,
+	0xD3, 0xF4, 0xB0, 0xD0, 0xA5, 0xB3, 0x65, 0xCB,
+	0x1C, 0xD2, 0x01, 0xE3, 0x76, 0x76, 0x00, 0xBD,
+	0xC4, 0x05, 0x39, 0x19, 0xA6, 0xC7, 0x24, 0x36,
+	0x42, 0x9D, 0x25, 0x75, 0x89, 0xF0, 0x85, 0xB2,
+	0xDB, 0x36, 0xED, 0xDD, 0x45, 0x10, 0x26, 0x0E,
+	0xBC, 0x6E, 0x4C, 0xD9, 0xC9, 0x7B, 0x7B, 0xD2,
+	0xE0, 0xED, 0x1B, 0xEC, 0x40, 0x37, 0x5A, 0x51,
+	0x5C, 0x5C, 0x03, 0xB6, 0x1B, 0xA6, 0x32, 0x45,
+	0xAF, 0xFE, 0xC8, 0xE7, 0x1E, 0x4D, 0x03, 0x7A,
+	0x67, 0x07, 0xFC, 0x6C, 0x93, 0xC1, 0x2F, 0x74,
+	0x88, 0x6A, 0xAB, 0xC8, 0x1E, 0x82, 0xA8, 0xB5,
+	0x1E, 0x9E, 0x9D, 0x8E, 0x0F, 0x01, 0xF9, 0x51,
+	0x7B, 0x7B, 0x93, 0xA9, 0xA3, 0xD8, 0x76, 0x5D,
+	0x09, 0xB4, 0x55, 0x10, 0x84, 0x56, 0xD9, 0x4B,
+	0x5C, 0x47, 0xED, 0xAB, 0x60, 0x3A, 0x94, 0x39,
+	0x8E, 0x9B, 0x63, 0x62, 0x51, 0x1E, 0x64, 0xEF,
+	0x62, 0xC6, 0x76, 0xDA, 0x67, 0x88, 0x82, 0xE9,
+	0x79, 0xA5, 0x48, 0x4C, 0x2D, 0x40, 0x7C, 0xFE,
+	0xCD, 0x47, 0xDC, 0x22, 0x5D, 0x5C,
+
+	0xFF, 0x34, 0xAF, 0xC9, 0x1D, 0x1F, 0x75, 0xC8,
+	0x8F, 0xC6, 0x82, 0x2E, 0x3E, 0x23, 0x94, 0xCA,
+	0xAC, 0xC6, 0x78, 0x14, 0x70, 0xBF, 0x3B, 0x54,
+	0x9A, 0xAB, 0x1C, 0xDC, 0xFD, 0x7A, 0xE1, 0x0F,
+	0x7D, 0xAD, 0x55, 0x32, 0x13, 0xC3, 0x78, 0xF7,
+	0x39, 0xC2, 0xFD, 0x53, 0x3C, 0x89, 0x6B, 0x53,
+	0x77, 0xB6, 0x00, 0x6B, 0xA8, 0x35, 0x03, 0x5F,
+	0x93, 0x41, 0xDE, 0x71, 0x5D, 0x21, 0x1A, 0x43,
+	0x82, 0x3B, 0xCC, 0xBB, 0xD3, 0xA2, 0x45, 0xEF,
+	0x20, 0x70, 0x16, 0x77, 0xE0, 0xA7, 0x48, 0xA3,
+	0x2B, 0x2A, 0x2E, 0x8F, 0x14, 0x09, 0xF7, 0x8C,
+	0x27, 0x77, 0x51, 0xB0, 0x8C, 0xF5, 0xF6, 0x99,
+	0x55, 0xF9, 0x53, 0x88, 0x40, 0x43, 0x29, 0x4D,
+	0xE0, 0x62, 0xA2, 0x93, 0xF8, 0xF7, 0xEF, 0xFE,
+	0xB6, 0x1D, 0x41, 0x97, 0x76, 0xBF, 0xB2, 0x12,
+	0x2C, 0x07, 0xF0, 0xBC, 0x3C, 0x55, 0x12, 0xC3,
+	0x7F, 0xE5, 0x6E, 0xC0, 0x46, 0x10, 0x49, 0xB7,
+	0xD0, 0xC6, 0x66, 0x26, 0x53, 0x10, 0x9B, 0x89,
+	0x81, 0xE5, 0xA8, 0x02, 0xE8, 0xF0, 0x00, 0xD7,
+	0x89, 0x00, 0xEF, 0x43, 0x21, 0x1A, 0xF1, 0x46,
+	0x3D, 0xDB, 0x1C, 0x29, 0xA1, 0xBF, 0x5D, 0xED,
+	0xBA, 0xA8, 0xE4, 0x48, 0xC7, 0x25, 0x9E, 0x2B,
+	0x1E, 0x4F, 0xB2, 0xB1, 0xE1, 0xFE, 0x5B, 0x48,
+	0x93, 0xDF, 0x90, 0x65, 0xB0, 0xB4, 0x55, 0x69,
+	0xCE, 0x2A, 0xF6, 0x8D, 0xB5, 0x22, 0x3F, 0x6B,
+	0x45, 0xEC, 0xCC, 0x7D, 0x28, 0xC6, 0xF9, 0x6C,
+	0x7D, 0x59, 0x57, 0x41, 0xBD, 0x6C, 0x3F,
+
+	0xFF, 0x34, 0xAF, 0xC9, 0x1D, 0x9F, 0x65, 0xC8,
+	0xCF, 0xC6, 0x82, 0x6F, 0x3E, 0x23, 0x94, 0xCA,
+	0xBC, 0xE6, 0xE1, 0x36, 0x74, 0xB7, 0x3B, 0x54,
+	0x92, 0xB1, 0x1C, 0xFC, 0xFD, 0x3A, 0xF9, 0x0F,
+	0xE8, 0x81, 0x12, 0x62, 0x75, 0xAC, 0xE4, 0xF4,
+	0xF3, 0x70, 0x8B, 0x23, 0x67, 0xDF, 0x3F, 0x51,
+	0x0B, 0x7B, 0x4C, 0x0E, 0x80, 0xC3, 0x71, 0x23,
+	0x10, 0xBD, 0xA6, 0xE4, 0x67, 0x64, 0xE8, 0x2B,
+	0x62, 0xD4, 0xE3, 0x56, 0x64, 0x9E, 0x93, 0x5A,
+	0x0F, 0x3A, 0x87, 0xC0, 0x1D, 0xA5, 0xFA, 0x92,
+	0xCF, 0xB5, 0xA5, 0x13, 0x61, 0x0A, 0x22, 0x89,
+	0x1F, 0xFB, 0x96, 0xBE, 0x8B, 0xF6, 0x83, 0xEA,
+	0xED, 0x2B, 0xD8, 0x8E, 0xBF, 0x1E, 0xE8, 0x3E,
+	0x6A, 0x03, 0x9E, 0xEA, 0xD0, 0xEE, 0x49, 0xB9,
+	0x78, 0x18, 0x80, 0x62, 0x75, 0x15, 0x4E, 0x2B,
+	0x8D, 0x28, 0x77, 0x6A, 0xC8, 0x6E, 0x17, 0x7E,
+	0xFF, 0xF0, 0x0E, 0xDA, 0x49, 0x00, 0x78, 0x3B,
+	0x2E, 0x00, 0x3F, 0xB4, 0x80, 0xAB, 0x55, 0x38,
+	0x9A, 0x3B, 0x2B, 0x9D, 0x08, 0x71, 0x0F, 0xA6,
+	0x0F, 0x24, 0x1B, 0x8E, 0xF7, 0xA2, 0xA6, 0xCC,
+	0x0D, 0xC7, 0xA0, 0x89, 0x2D, 0xDA, 0xD4, 0x82,
+	0x80, 0x9E, 0xB2, 0xC2, 0xEA, 0x73, 0x4D, 0x92,
+	0x1D, 0x17, 0x10, 0xF6, 0x5F, 0x88, 0x35, 0x7F,
+	0xD1, 0x44, 0xBB, 0x59, 0xAB, 0xA0, 0x94, 0x68,
+	0xCB, 0x26, 0xF5, 0x26, 0xF1, 0x96, 0xA0, 0x68,
+	0x93, 0x9A, 0x4C, 0xD7, 0x14, 0xB9, 0xB5, 0x60,
+	0x17, 0xC5, 0x7E, 0xF0, 0xA4, 0xF8, 0xD9, 0x0C,
+
+	0xFF, 0x34, 0xAF, 0xC9, 0x1D, 0x9F, 0x65, 0xC8,
+	0xCF, 0xC6, 0xA2, 0x7F, 0x1F, 0x03, 0x94, 0x4A,
+	0x74, 0x32, 0xD0, 0x72, 0x67, 0xA7, 0xB9, 0x80,
+	0x96, 0x93, 0x18, 0xFC, 0xD7, 0x0A, 0xE5, 0x0D,
+	0xEB, 0x1C, 0x70, 0x87, 0x0C, 0x6A, 0x24, 0x48,
+	0x78, 0x5C, 0x0E, 0x20, 0x7F, 0xE3, 0x1A, 0xAF,
+	0x01, 0x1C, 0x0F, 0x15, 0x90, 0x48, 0xC1, 0x88,
+	0xC8, 0x51, 0xE9, 0x8A, 0x29, 0x68, 0x45, 0x4F,
+	0x4D, 0xA4, 0x72, 0x77, 0x6F, 0x42, 0x3C, 0x07,
+	0x70, 0x74, 0x8C, 0x60, 0x1F, 0x7D, 0x80, 0xD5,
+	0x5F, 0x73, 0xAE, 0x00, 0xC3, 0x1B, 0x0F, 0xA3,
+	0x2E, 0x2E, 0x68, 0x0C, 0x41, 0x3F, 0x82, 0x75,
+	0xAF, 0xED, 0xE2, 0xEE, 0x12, 0xFE, 0x3E, 0x2E,
+	0x82, 0xA4, 0x01, 0xFE, 0x1E, 0x79, 0xC8, 0xC0,
+	0x0D, 0x52, 0x35, 0x1B, 0xD9, 0x62, 0xF6, 0x94,
+	0x68, 0xCD, 0x34, 0x06, 0xD0, 0x32, 0x1A, 0x15,
+	0x80, 0x5C, 0x8C, 0x5A, 0xF1, 0x65, 0x16, 0x72,
+	0x40, 0xF9, 0xC6, 0x81, 0x17, 0x33, 0x18, 0xA5,
+	0x31, 0x50, 0xD0, 0x4F, 0x37, 0x68, 0x66, 0x8D,
+	0xEC, 0x86, 0xFC, 0x58, 0xB7, 0x39, 0x16, 0xB9,
+	0x11, 0x53, 0x2B, 0xB7, 0x2B, 0x99, 0x89, 0xA9,
+	0x94, 0x50, 0x04, 0xB0, 0x4E, 0xC8, 0xEC, 0xBD,
+	0x2C, 0x3D, 0x30, 0x7B, 0x1E, 0xDB, 0x23, 0x4F,
+	0x7B, 0xB5, 0xEC, 0xE8, 0x14, 0x0C, 0x30, 0x8B,
+	0xD7, 0xD6, 0xE2, 0x25, 0x10, 0xD6, 0xCD, 0x07,
+	0x95, 0xD5, 0x4E, 0x0F, 0x74, 0x06, 0xDD, 0xFF,
+	0xA5, 0x9E, 0x42, 0x5A, 0x95, 0xC9, 0x4C, 0x1B,
+	0x9A,
+
+	0xFF, 0x34, 0xAF, 0xC9, 0x1D, 0x9F, 0x65, 0xC8,
+	0xCE, 0xC6, 0xA2, 0xFF, 0x1F, 0x01, 0x96, 0x4A,
+	0xF4, 0x32, 0xD1, 0x72, 0x57, 0xA6, 0xB9, 0xC8,
+	0x96, 0x93, 0x50, 0xFC, 0xD7, 0x42, 0xA5, 0x2D,
+	0xF9, 0x10, 0xF0, 0x99, 0x16, 0x68, 0xB2, 0x79,
+	0x78, 0xD4, 0x0F, 0x15, 0xF5, 0xE3, 0x99, 0xA0,
+	0x96, 0x64, 0x52, 0x8B, 0xEB, 0x0B, 0xAA, 0x34,
+	0xCF, 0xD9, 0x26, 0xC4, 0xCF, 0x03, 0x1D, 0x60,
+	0x0C, 0x15, 0x43, 0xF6, 0xF6, 0xC6, 0xBF, 0x20,
+	0xB4, 0x1B, 0x85, 0xCF, 0xC9, 0x82, 0xE6, 0x0B,
+	0xCE, 0x63, 0xD6, 0xA6, 0x1C, 0x63, 0xFF, 0x19,
+	0xF3, 0x47, 0x4D, 0x05, 0x51, 0x03, 0xE9, 0xFD,
+	0x5D, 0x1B, 0x81, 0x26, 0x6D, 0x24, 0x40, 0xA5,
+	0x9C, 0x09, 0x08, 0x85, 0xDE, 0xFD, 0xE3, 0x6D,
+	0x10, 0xF9, 0x6E, 0xE6, 0x73, 0x5F, 0x1F, 0xB7,
+	0xB2, 0x5A, 0xA6, 0xC4, 0x6B, 0xF6, 0xA2, 0xFB,
+	0x97, 0x9B, 0xC8, 0xA2, 0x97, 0x5A, 0x25, 0x76,
+	0x77, 0xD6, 0x32, 0x83, 0x60, 0x9D, 0x21, 0x87,
+	0x74, 0xD9, 0xFE, 0xC4, 0x82, 0x69, 0x72, 0xD6,
+	0x30, 0xFA, 0x20, 0x7A, 0x69, 0xD0, 0x78, 0x8F,
+	0xE4, 0x71, 0xA7, 0x79, 0x82, 0xA9, 0x11, 0x3D,
+	0xEE, 0x4D, 0x81, 0x76, 0x99, 0x82, 0xF1, 0x67,
+	0x55, 0xF3, 0x4F, 0x9D, 0x7C, 0x58, 0x3E, 0xEC,
+	0xE5, 0xBC, 0x7C, 0xBD, 0x91, 0x71, 0xC3, 0x39,
+	0xB3, 0xB4, 0x63, 0xBC, 0x13, 0xB1, 0x85, 0xC5,
+	0xF4, 0x50, 0x46, 0x77, 0x45, 0xCA, 0x25, 0x4C,
+	0x1C, 0x4C, 0x25, 0x37, 0xD6, 0x43, 0x76, 0x1E,
+	0xAC, 0xD5,
+
+	0xFF, 0x34, 0xAF, 0xC9, 0x1D, 0x9F, 0x65, 0xC8,
+	0x6E, 0xE7, 0xA2, 0xFF, 0x1F, 0x81, 0x74, 0x4A,
+	0x45, 0x26, 0xB9, 0x73, 0x50, 0xFA, 0x8F, 0xE8,
+	0x9E, 0x93, 0x54, 0xF5, 0xF1, 0x44, 0x23, 0x3D,
+	0x14, 0xF8, 0x18, 0xA1, 0x16, 0x7F, 0xB0, 0xA5,
+	0x10, 0x82, 0x19, 0xC6, 0x15, 0x39, 0x18, 0x09,
+	0x0C, 0x78, 0x68, 0xC8, 0x99, 0x98, 0x57, 0x91,
+	0x6B, 0x0F, 0xF5, 0x3A, 0xF2, 0x51, 0x30, 0x6E,
+	0x58, 0x7A, 0xB0, 0x58, 0x05, 0x0E, 0xA3, 0x7F,
+	0x88, 0xA5, 0x28, 0x1E, 0x21, 0x84, 0x96, 0x9D,
+	0xC7, 0xCF, 0x1F, 0x93, 0x5B, 0xFF, 0x9A, 0xEC,
+	0x7D, 0x6C, 0xEC, 0x11, 0x3C, 0x4F, 0xCC, 0x22,
+	0x31, 0x98, 0xC4, 0xDA, 0xEE, 0x13, 0x9E, 0xE8,
+	0x86, 0x69, 0x18, 0xD4, 0x36, 0x7B, 0xAA, 0x1B,
+	0xDF, 0x62, 0xAF, 0x3E, 0x2A, 0xA2, 0xEC, 0x21,
+	0x56, 0xB5, 0xB5, 0xDA, 0x47, 0x9A, 0xED, 0x0A,
+	0x4F, 0x89, 0x79, 0x77, 0xE1, 0x2C, 0x75, 0x48,
+	0xA7, 0x98, 0x55, 0x28, 0xB8, 0x83, 0xAC, 0x36,
+	0x74, 0xB4, 0x51, 0x1B, 0xE0, 0xD0, 0x8C, 0x17,
+	0x80, 0xCA, 0x4C, 0x90, 0x5A, 0xC2, 0x7E, 0x12,
+	0xE5, 0x65, 0x8A, 0xAA, 0xC8, 0xFF, 0x3E, 0xED,
+	0x97, 0x8C, 0x25, 0x54, 0xFC, 0x54, 0x2C, 0x16,
+	0x24, 0xA6, 0xE2, 0x9F, 0x33, 0x12, 0x30, 0x92,
+	0x53, 0x8A, 0xED, 0xF3, 0xD1, 0x5C, 0x8C, 0x79,
+	0xD3, 0xD6, 0x2B, 0xDA, 0xEF, 0x07, 0x1D, 0xF4,
+	0xEA, 0xB3, 0x7F, 0xF3, 0xEB, 0x18, 0x04, 0xA1,
+	0x8D, 0x2B, 0xFF, 0x01, 0xDC, 0xE1, 0x3A, 0xFF,
+	0xFC, 0x85, 0x4E,
+
+	0xFF, 0x34, 0xAF, 0xC9, 0x1D, 0x9F, 0x65, 0xC8,
+	0x6E, 0xE7, 0x93, 0xFF, 0x5F, 0x81, 0x74, 0x09,
+	0xD9, 0xA7, 0xA9, 0xDB, 0x60, 0xD8, 0x27, 0xCF,
+	0xB8, 0x9B, 0x54, 0xFD, 0x81, 0x76, 0x2F, 0x1D,
+	0x1A, 0xFD, 0xD5, 0x84, 0x98, 0xCE, 0xAC, 0x40,
+	0x66, 0x39, 0x00, 0x0F, 0xD9, 0x05, 0x73, 0x18,
+	0x8F, 0xAC, 0x8D, 0x62, 0xDC, 0x08, 0x49, 0x51,
+	0xDF, 0xC4, 0x7E, 0x97, 0x3A, 0x4B, 0xD6, 0x09,
+	0x11, 0x70, 0xB5, 0xE5, 0x16, 0xF5, 0x13, 0xD3,
+	0xD7, 0x0F, 0x73, 0x3A, 0x66, 0xEC, 0x99, 0x44,
+	0x5E, 0xDB, 0xFE, 0x3F, 0xD6, 0x61, 0xA4, 0x0C,
+	0x25, 0x8D, 0x3A, 0x3B, 0x9A, 0xEE, 0x43, 0xF3,
+	0xEA, 0x1A, 0xB3, 0x14, 0xD0, 0x86, 0x70, 0x88,
+	0x9D, 0xBA, 0x8C, 0xFD, 0x43, 0x6F, 0xB2, 0x82,
+	0x05, 0xEA, 0x6F, 0x87, 0x4F, 0xEF, 0xA0, 0x1D,
+	0x6A, 0x75, 0xF4, 0xB5, 0x51, 0x0F, 0x87, 0xE9,
+	0x7E, 0x1A, 0x15, 0x85, 0xD4, 0xC7, 0xB5, 0xA3,
+	0xF1, 0x40, 0x68, 0x20, 0x43, 0x65, 0xED, 0xFD,
+	0x4C, 0x4F, 0xAB, 0xF1, 0xE6, 0xA2, 0xA8, 0xB6,
+	0x0B, 0x07, 0x87, 0x70, 0xDE, 0xF8, 0x9F, 0x25,
+	0x70, 0xA7, 0x8D, 0x16, 0xF1, 0xE4, 0x28, 0x06,
+	0xCB, 0x80, 0xAD, 0x13, 0x96, 0xF1, 0xBA, 0xF5,
+	0x38, 0xC6, 0x04, 0x17, 0x06, 0xC4, 0x2D, 0xFE,
+	0x45, 0xD1, 0x5C, 0xA1, 0xCF, 0x57, 0xA4, 0xC6,
+	0xB6, 0xC6, 0xE6, 0x34, 0x1C, 0x08, 0xCA, 0x73,
+	0xBC, 0x61, 0x1A, 0x37, 0x3B, 0xCB, 0xD2, 0x5B,
+	0x84, 0x9E, 0xB0, 0xAC, 0x7A, 0x9C, 0x5F, 0xEB,
+	0xE9, 0xA1, 0x98, 0x22,
+
+	0x5C, 0x16, 0xAF, 0x49, 0x1D, 0x9F, 0x65, 0xC8,
+	0x6E, 0xE7, 0x93, 0xFF, 0x5F, 0x81, 0x54, 0x08,
+	0x1D, 0xD2, 0x96, 0xD9, 0xF8, 0x88, 0xAE, 0xDF,
+	0xAA, 0xBB, 0x46, 0x19, 0x81, 0xCD, 0x2F, 0x1D,
+	0x32, 0xC6, 0x52, 0xE9, 0x5B, 0x8B, 0x22, 0xFC,
+	0x1A, 0xBC, 0x20, 0xB7, 0x21, 0xB9, 0x6F, 0x40,
+	0xC0, 0x7F, 0xBB, 0x01, 0x8B, 0x6A, 0xEF, 0x83,
+	0xA2, 0x38, 0x08, 0x46, 0xE0, 0x9D, 0xEA, 0x44,
+	0x5C, 0xD1, 0xAF, 0x2C, 0x54, 0x85, 0x50, 0xFF,
+	0xB2, 0x52, 0x47, 0x0E, 0xFC, 0x06, 0x60, 0x1C,
+	0xA4, 0xF5, 0xD6, 0x0F, 0x24, 0xF3, 0xD4, 0xB5,
+	0x5E, 0xE3, 0x11, 0x04, 0x15, 0xD9, 0xE6, 0x48,
+	0x26, 0xDF, 0xB5, 0x00, 0x0C, 0x8E, 0x06, 0x36,
+	0x4C, 0x22, 0x95, 0xBF, 0xE2, 0x7C, 0xF7, 0xF3,
+	0xB2, 0xC7, 0x2F, 0x43, 0x03, 0xAF, 0x5A, 0x56,
+	0xE9, 0xAE, 0x5C, 0x43, 0x0A, 0xE4, 0x0A, 0x0D,
+	0x4E, 0x02, 0x19, 0xA3, 0xC0, 0xE3, 0x1F, 0x81,
+	0x24, 0x6D, 0x1E, 0x54, 0x22, 0x49, 0x54, 0xB9,
+	0x62, 0x7D, 0x25, 0xEE, 0x23, 0x33, 0x30, 0xFB,
+	0x0E, 0x69, 0xF9, 0x06, 0x9D, 0xF5, 0x7F, 0x24,
+	0x8A, 0xD0, 0x06, 0x23, 0xEE, 0x20, 0x09, 0x7F,
+	0x6F, 0x0D, 0xDD, 0x72, 0xDD, 0xD4, 0x70, 0xF5,
+	0x1E, 0x28, 0x6A, 0xC2, 0x98, 0xBA, 0xB2, 0xD8,
+	0x16, 0x62, 0xDA, 0x6F, 0x99, 0x9F, 0x5B, 0x2A,
+	0x0F, 0xBA, 0x96, 0x42, 0x51, 0x23, 0x0A, 0x44,
+	0x3C, 0x32, 0x21, 0xB2, 0xAE, 0x73, 0x79, 0x71,
+	0x58, 0xE7, 0xC0, 0xD3, 0x96, 0x95, 0x0D, 0x57,
+	0x4A, 0xCB, 0x66, 0x8A, 0x70,
+
+	0x5C, 0x12, 0xAB, 0x49, 0x1D, 0x9F, 0x65, 0xC8,
+	0x6E, 0xE7, 0x93, 0xFF, 0x5E, 0x81, 0x54, 0x08,
+	0x9D, 0x72, 0x36, 0x99, 0xF9, 0x89, 0xAC, 0x1D,
+	0xCA, 0xB9, 0x06, 0x19, 0x81, 0xCD, 0xAF, 0x1D,
+	0x2C, 0xE2, 0x50, 0xB2, 0x53, 0x1B, 0x32, 0xD6,
+	0x48, 0x78, 0x24, 0xA5, 0x68, 0xEC, 0x79, 0xC4,
+	0x58, 0x99, 0x50, 0x21, 0xC8, 0x45, 0xBD, 0xB0,
+	0x46, 0x6D, 0x91, 0x7D, 0xA9, 0x32, 0x70, 0xA8,
+	0xBA, 0x9F, 0xC6, 0x5E, 0x50, 0x56, 0x7B, 0x18,
+	0x25, 0x0D, 0xE3, 0xDF, 0x5B, 0x9D, 0x13, 0x3D,
+	0xAA, 0x27, 0x08, 0x0D, 0x58, 0xC5, 0xC8, 0x11,
+	0xD5, 0x56, 0xD6, 0x66, 0xEA, 0x46, 0x48, 0x7B,
+	0x2F, 0x6E, 0x3E, 0x1A, 0x43, 0x10, 0x97, 0xE5,
+	0x8D, 0x64, 0x2A, 0xE6, 0x88, 0xBB, 0x4D, 0x22,
+	0x76, 0xDD, 0x57, 0x49, 0xCF, 0xA8, 0x98, 0x9E,
+	0x4A, 0xB8, 0xAE, 0xE2, 0x70, 0xAD, 0x33, 0x10,
+	0xFD, 0x3C, 0xB1, 0x74, 0x86, 0x3E, 0xE0, 0x6D,
+	0x86, 0xD8, 0xC8, 0xDD, 0x91, 0x26, 0x27, 0xB7,
+	0x70, 0x5A, 0xD1, 0xAA, 0x31, 0xDD, 0x6C, 0xAF,
+	0x2D, 0xC4, 0x1A, 0xB2, 0x32, 0x7E, 0x84, 0xDD,
+	0xAE, 0x30, 0x9F, 0xDC, 0xD7, 0x05, 0x29, 0x7D,
+	0x73, 0x77, 0x00, 0x9B, 0xC2, 0xD8, 0x24, 0x7B,
+	0x64, 0x58, 0xD1, 0x3D, 0x9B, 0x77, 0xCB, 0x45,
+	0x25, 0xD7, 0x38, 0x46, 0x57, 0xFF, 0x30, 0x8B,
+	0x98, 0x76, 0xD0, 0x5B, 0x1B, 0x8B, 0x79, 0xCD,
+	0x1F, 0x7A, 0x4C, 0x0E, 0x08, 0x37, 0x67, 0xA2,
+	0x04, 0x67, 0x0E, 0x04, 0xBC, 0x19, 0xEF, 0xB0,
+	0x5E, 0xF6, 0xF0, 0x72, 0x8B, 0x48,
+
+	0x5C, 0x92, 0xCF, 0x69, 0x1D, 0x9F, 0x65, 0xC8,
+	0x6E, 0xE7, 0x93, 0xFF, 0x1E, 0xAA, 0x54, 0x08,
+	0xD3, 0x40, 0xA0, 0x21, 0xFA, 0xB9, 0xEC, 0x7C,
+	0xDA, 0x91, 0x31, 0x59, 0x88, 0xCD, 0xAB, 0x1D,
+	0xBB, 0x69, 0xA1, 0xD5, 0x7F, 0x13, 0x1D, 0xAF,
+	0xB3, 0x27, 0x4B, 0x13, 0x94, 0x0C, 0x73, 0xA2,
+	0x92, 0xCD, 0xBF, 0xDA, 0xBC, 0x3B, 0x0F, 0x69,
+	0x99, 0x69, 0x91, 0x8D, 0xBA, 0x1C, 0x4B, 0x0E,
+	0x9A, 0x2B, 0x27, 0xAC, 0xF1, 0xA3, 0x2E, 0xD0,
+	0xF6, 0x53, 0x76, 0x37, 0xEE, 0x03, 0x77, 0x89,
+	0x00, 0x96, 0xD1, 0x15, 0x7C, 0xB1, 0x99, 0x72,
+	0xA2, 0x5F, 0xC4, 0x6E, 0xC7, 0x9F, 0x2B, 0xD6,
+	0xDE, 0x1D, 0x89, 0x6F, 0xC2, 0xA7, 0xCD, 0xF9,
+	0x09, 0xBC, 0x7F, 0x86, 0xF0, 0x84, 0x91, 0x93,
+	0x95, 0x21, 0xC8, 0x56, 0x28, 0xAE, 0xA9, 0x85,
+	0x6A, 0xC8, 0x23, 0x03, 0xEF, 0xA1, 0x8C, 0x77,
+	0x69, 0xC5, 0x40, 0x07, 0x64, 0x8B, 0xF5, 0xA3,
+	0x48, 0x7F, 0x2F, 0x30, 0xCA, 0x83, 0xF0, 0xE3,
+	0x78, 0xAA, 0x36, 0x56, 0xD2, 0x7E, 0xD4, 0x54,
+	0x08, 0xE9, 0xE6, 0x83, 0x52, 0xE4, 0xB1, 0x4B,
+	0x3D, 0x2B, 0xB3, 0x9A, 0xE8, 0xD3, 0x2A, 0x48,
+	0x05, 0x6D, 0xA8, 0x33, 0x95, 0x47, 0x0D, 0x50,
+	0xD7, 0x94, 0x59, 0xA5, 0x6F, 0x23, 0xBF, 0xC3,
+	0x51, 0x89, 0x51, 0x90, 0xB8, 0x8B, 0x6A, 0xDC,
+	0xD4, 0x1D, 0xE9, 0x9F, 0xFD, 0x6D, 0x1F, 0xA9,
+	0x33, 0xA4, 0x58, 0x70, 0x42, 0xDC, 0x64, 0x1F,
+	0x22, 0xCD, 0x4A, 0x87, 0xD4, 0x32, 0xE1, 0xBC,
+	0x36, 0xB4, 0x66, 0x60, 0x1C, 0x6C, 0xF6,
+
+	0x18, 0x92, 0xCF, 0x2C, 0x1D, 0x9F, 0x65, 0xC8,
+	0x6E, 0xE7, 0x93, 0xFF, 0x1E, 0xEA, 0x67, 0x08,
+	0x90, 0x1C, 0xAC, 0x89, 0xDE, 0x3B, 0xCC, 0xED,
+	0x9A, 0x87, 0xD9, 0x7D, 0x98, 0xCC, 0xAB, 0x1D,
+	0x71, 0x28, 0xC8, 0xE8, 0xFF, 0xF8, 0xA0, 0x6E,
+	0x15, 0x9A, 0x2C, 0x2D, 0x4C, 0xAE, 0x71, 0x41,
+	0x47, 0xA7, 0xEF, 0xB9, 0x84, 0xCC, 0x3F, 0x4E,
+	0xCC, 0xA2, 0x9A, 0x83, 0xE3, 0x3F, 0xA7, 0xBD,
+	0x87, 0x85, 0xED, 0x30, 0x9A, 0x9C, 0x5B, 0x70,
+	0x78, 0x12, 0xF7, 0x7C, 0xCA, 0x4C, 0xD3, 0x42,
+	0x1F, 0x19, 0x91, 0xEA, 0xD9, 0x13, 0xE1, 0xA1,
+	0x62, 0x6C, 0x66, 0xD5, 0xC1, 0xF5, 0x52, 0x1A,
+	0x4B, 0x7A, 0xD6, 0x70, 0x03, 0x71, 0xC5, 0xAA,
+	0x39, 0x31, 0xDF, 0xE2, 0x48, 0x7E, 0xD2, 0x54,
+	0xAC, 0xAB, 0xA1, 0xE4, 0xB6, 0xE6, 0xD8, 0x6D,
+	0x73, 0x07, 0x72, 0xD2, 0x8E, 0x15, 0xF2, 0xB2,
+	0xBF, 0x25, 0x42, 0xD2, 0x83, 0x9F, 0xA9, 0x01,
+	0xAF, 0x19, 0xB8, 0x21, 0x37, 0xD1, 0x83, 0x5B,
+	0x14, 0xCD, 0x51, 0x7B, 0x37, 0x07, 0x02, 0xA8,
+	0x3E, 0x9F, 0xC8, 0x25, 0x97, 0xBD, 0xCA, 0x9C,
+	0x61, 0x70, 0xF9, 0x5E, 0xCE, 0x75, 0x33, 0xB1,
+	0xAD, 0xEB, 0xD2, 0xCF, 0x50, 0x32, 0x6D, 0x44,
+	0x9A, 0x30, 0xB7, 0x49, 0x7F, 0x98, 0x4D, 0x37,
+	0x3A, 0xE7, 0xBF, 0x34, 0x29, 0x63, 0x80, 0x81,
+	0xA5, 0x38, 0xD0, 0xF0, 0x6D, 0xDC, 0xD4, 0x09,
+	0x56, 0x8E, 0x97, 0xE4, 0xEE, 0xF1, 0x5D, 0x00,
+	0x89, 0x34, 0x7C, 0x1D, 0xCE, 0xD0, 0x78, 0xEE,
+	0xC4, 0xA8, 0x35, 0x4D, 0x0D, 0xDF, 0xD7, 0x77,
+
+	0x90, 0xE1, 0xD9, 0xD8, 0xD9, 0x7E, 0x1A, 0x5A,
+	0xDF, 0xF8, 0x87, 0x2E, 0x20, 0xC0, 0xDC, 0xA6,
+	0x17, 0x04, 0x55, 0xDB, 0x54, 0x76, 0x91, 0x7D,
+	0x57, 0x08, 0x38, 0xAB, 0xCA, 0x93, 0x0B, 0xF1,
+	0xA6, 0x45, 0x28, 0x78, 0x14, 0xCE, 0x6B, 0x93,
+	0x89, 0xF2, 0x6D, 0x81, 0xF8, 0x23, 0x45, 0x65,
+	0x60, 0xDF, 0x0C, 0x6D, 0xE9, 0xBA, 0xFB, 0xDA,
+	0x57, 0x13, 0x14, 0x9D, 0x60, 0xA8, 0x8D, 0x8F,
+	0x15, 0x9B, 0x46, 0x38, 0x82, 0x17, 0x89, 0x87,
+	0xB1, 0xCF, 0x80, 0x0A, 0x61, 0xC0, 0xA8, 0xC0,
+	0x9C, 0xCD, 0x60, 0xB0, 0xE8, 0xDA, 0xAD, 0x80,
+	0x45, 0xDB, 0xDA, 0x43, 0x8F, 0x23, 0x42, 0xFC,
+	0x35, 0x85, 0x50, 0x6E, 0x0E, 0xD7, 0x89, 0xB9,
+	0x44, 0x16, 0x22, 0x32, 0xDB, 0x3A, 0x59, 0xC4,
+	0xCA, 0xA2, 0x8D, 0x96, 0xF2, 0x1D, 0x8B, 0xEC,
+	0x08, 0x43, 0x3E, 0xCD, 0x92, 0xF1, 0xA9, 0xD3,
+	0xFF, 0xAD, 0x10, 0x82, 0x7F, 0xB8, 0xCC, 0xFB,
+	0xE5, 0xA5, 0xD8, 0xBD, 0xC5, 0x1D, 0x99, 0x82,
+	0xD4, 0x25, 0x4F, 0x70, 0xCB, 0xC2, 0x51, 0x65,
+	0x66, 0xAD, 0x72, 0x1A, 0xED, 0xF0, 0xD3, 0x8D,
+	0x11, 0x03, 0x7D, 0x28, 0xD7, 0x6F, 0x80, 0x27,
+	0x93, 0x81, 0x4F, 0x2B, 0xFF, 0xB6, 0x93, 0x34,
+	0xF8, 0x0E, 0xA1, 0x4C, 0x13, 0xBC, 0x8F, 0x5E,
+	0x20, 0x6A, 0xE6, 0x09, 0xF8, 0xA6, 0x42, 0x9B,
+	0x89, 0xFD, 0x89, 0x76, 0x6B, 0x22, 0xE8, 0x16,
+	0xFE, 0xEC, 0xA7, 0x70, 0xC0, 0x95, 0x7B, 0x18,
+	0x57, 0x2C, 0xC0, 0xDC, 0x6A, 0x3B, 0xC8, 0x6A,
+	0x68, 0x46, 0x91, 0x1B, 0x96, 0x56, 0xA0, 0xBD,
+	0xB7,
+
+	0x92, 0xE1, 0xD9, 0xD8, 0xD9, 0x78, 0x1C, 0x5A,
+	0xDF, 0xF8, 0x87, 0x2E, 0x20, 0xC0, 0xDC, 0xA6,
+	0x17, 0x04, 0x8D, 0xDB, 0x54, 0xD6, 0xD1, 0x1D,
+	0x56, 0x09, 0x2A, 0xCA, 0x9A, 0x90, 0x0B, 0xF1,
+	0xE6, 0x7C, 0x10, 0xC7, 0x80, 0x63, 0xCE, 0xF7,
+	0x9F, 0x96, 0x21, 0xA9, 0x04, 0x22, 0x13, 0x47,
+	0xBE, 0x5D, 0x67, 0x37, 0x87, 0x73, 0xA2, 0x93,
+	0x54, 0x84, 0x5D, 0x3D, 0xCC, 0x5B, 0xDE, 0x0F,
+	0xB8, 0xE6, 0x27, 0x67, 0x04, 0x9E, 0x2B, 0xAD,
+	0x0F, 0x45, 0x8B, 0x1A, 0x00, 0x8F, 0xD1, 0x26,
+	0x71, 0xA2, 0xB8, 0x30, 0xC4, 0x81, 0xD2, 0xB0,
+	0x89, 0x98, 0x6B, 0xA7, 0x69, 0x3B, 0xFD, 0x2C,
+	0x29, 0x63, 0xC7, 0x58, 0xE7, 0x7F, 0xE8, 0x25,
+	0xAF, 0xF8, 0xAE, 0xC3, 0xAF, 0x3A, 0x3D, 0xC5,
+	0xBD, 0x04, 0x5D, 0x84, 0xB5, 0x2C, 0xC8, 0x8F,
+	0x6A, 0x97, 0x44, 0xD7, 0x35, 0x55, 0xF5, 0xF0,
+	0x6D, 0x2C, 0x30, 0x11, 0x17, 0xFE, 0x6E, 0xFA,
+	0x78, 0xB8, 0x55, 0xD3, 0x40, 0x64, 0xC7, 0x60,
+	0x4B, 0x16, 0x94, 0x7B, 0xE6, 0xAF, 0x27, 0xDE,
+	0x7E, 0xAC, 0x1E, 0x1A, 0xDB, 0xB9, 0x23, 0xF9,
+	0xA6, 0x53, 0xEB, 0xC3, 0xDC, 0x11, 0xF1, 0xEB,
+	0xDB, 0xB0, 0x4F, 0xB0, 0x6D, 0x45, 0x6D, 0x38,
+	0xA3, 0x44, 0x23, 0xF5, 0x6C, 0x5E, 0xAC, 0x62,
+	0x82, 0xBD, 0xE2, 0xA9, 0xE8, 0xCE, 0xA3, 0xDA,
+	0xE1, 0xAA, 0xAD, 0x5F, 0x0E, 0x8A, 0x26, 0x64,
+	0xFB, 0x5A, 0xA4, 0x6E, 0x4E, 0x27, 0x5A, 0xD4,
+	0x94, 0xDF, 0xAA, 0x89, 0x23, 0x96, 0x7F, 0x49,
+	0x46, 0x77, 0x8E, 0x28, 0x56, 0xE3, 0x4F, 0xC4,
+	0x18, 0xED,
+
+	0xF2, 0xCB, 0xD9, 0xD8, 0xD9, 0xF8, 0xFA, 0x3C,
+	0xDF, 0xF8, 0x87, 0x2E, 0x20, 0xC0, 0xDC, 0xA6,
+	0x1A, 0x04, 0x9D, 0x43, 0x72, 0x94, 0x3D, 0x49,
+	0xE7, 0x03, 0x39, 0x9B, 0xDF, 0x88, 0x3D, 0x99,
+	0x53, 0x74, 0x01, 0xE8, 0x35, 0x04, 0xF4, 0x98,
+	0x7B, 0x37, 0x21, 0xBF, 0x9A, 0x5A, 0x1A, 0x57,
+	0x18, 0x88, 0x85, 0x2E, 0x0E, 0x9A, 0x95, 0x55,
+	0xC2, 0x79, 0x0A, 0xB9, 0x18, 0xB4, 0x09, 0x08,
+	0x9E, 0x6B, 0x95, 0x4B, 0xF0, 0x8A, 0xF6, 0xFB,
+	0xA6, 0x71, 0x9A, 0x99, 0x1E, 0xDB, 0x09, 0x64,
+	0xB7, 0x89, 0x99, 0x23, 0xE3, 0x6E, 0x10, 0x14,
+	0x79, 0x27, 0xAB, 0x1D, 0x8F, 0x66, 0xDB, 0xE2,
+	0xC8, 0xE7, 0xFC, 0xB9, 0xF7, 0xA4, 0xE1, 0x27,
+	0x08, 0xCF, 0x84, 0x97, 0x18, 0xF9, 0x59, 0xEF,
+	0x8D, 0x27, 0xA1, 0x26, 0x35, 0x11, 0x0E, 0x7A,
+	0xDA, 0xF6, 0x87, 0x80, 0x08, 0x46, 0x44, 0x90,
+	0x56, 0x63, 0xD5, 0xC9, 0x5F, 0xE1, 0x00, 0x20,
+	0x89, 0x1D, 0x32, 0xEB, 0x5D, 0x14, 0xE6, 0x96,
+	0xA8, 0xA2, 0xF7, 0x8F, 0x7F, 0xE3, 0x70, 0x12,
+	0x12, 0x9F, 0x7D, 0x55, 0xC7, 0x4C, 0x69, 0x8C,
+	0x20, 0x69, 0x5D, 0xDC, 0x6A, 0xD5, 0x27, 0xF2,
+	0xED, 0x3D, 0x3E, 0x1F, 0xC2, 0x85, 0x45, 0xA4,
+	0x0D, 0x3A, 0xA9, 0xA1, 0xEB, 0x6B, 0x5E, 0xD3,
+	0xD3, 0x30, 0xB4, 0xE0, 0xA8, 0x37, 0xC6, 0xE2,
+	0x36, 0x63, 0x83, 0xA8, 0xE2, 0x96, 0x32, 0xA4,
+	0x67, 0xEF, 0x28, 0x80, 0x7D, 0xBE, 0x94, 0x54,
+	0x50, 0xE7, 0xE9, 0x87, 0xB3, 0x49, 0x62, 0x52,
+	0xD1, 0xB4, 0x71, 0x5A, 0x1E, 0x60, 0x77, 0x02,
+	0x2E, 0x72, 0x1A,
+
+	0xF2, 0x8B, 0xEB, 0xD8, 0x99, 0xF8, 0xFA, 0x7B,
+	0xDF, 0xF8, 0x87, 0x2E, 0x20, 0xC0, 0xDC, 0xA6,
+	0xFE, 0x04, 0x9D, 0x53, 0x93, 0xEA, 0x39, 0xE5,
+	0x61, 0x82, 0x39, 0x3F, 0xCF, 0x8E, 0x9C, 0xBC,
+	0xC7, 0xBD, 0x72, 0xAF, 0x95, 0x0D, 0xAF, 0x4D,
+	0x7C, 0x58, 0x4D, 0xEA, 0xF0, 0x67, 0x21, 0x63,
+	0x6A, 0xBC, 0xD8, 0xC7, 0x68, 0x33, 0x61, 0x40,
+	0xA1, 0xB7, 0xB0, 0xA7, 0x58, 0x28, 0xA1, 0xEF,
+	0x6A, 0x8F, 0xC6, 0xAE, 0xD5, 0x83, 0x1C, 0x8F,
+	0x8F, 0xF7, 0xF6, 0xE7, 0x9C, 0x23, 0xFF, 0xB7,
+	0x31, 0xD2, 0x25, 0xD5, 0x63, 0xD3, 0xDC, 0xA5,
+	0x0E, 0xF9, 0x3C, 0x6C, 0x4D, 0xA7, 0x2B, 0xE0,
+	0x12, 0x06, 0xF7, 0xFB, 0xC9, 0xDA, 0x68, 0xF9,
+	0xC4, 0x86, 0x08, 0xE0, 0xF8, 0xE0, 0xC0, 0xEF,
+	0x6B, 0xA4, 0x79, 0xF6, 0xFE, 0x69, 0x71, 0xAA,
+	0xF9, 0xDC, 0x7F, 0x8E, 0x56, 0x8A, 0x3F, 0x43,
+	0x87, 0x4D, 0x97, 0xB0, 0x10, 0x9E, 0x99, 0xD6,
+	0xDD, 0x49, 0x84, 0x37, 0x90, 0xC5, 0x53, 0x97,
+	0x69, 0xEE, 0x40, 0xD4, 0x82, 0xA7, 0xDB, 0x92,
+	0x38, 0x2B, 0x58, 0xB2, 0xEF, 0x86, 0x7A, 0x23,
+	0x93, 0xBB, 0x4E, 0xD2, 0x1B, 0x25, 0x9B, 0xA7,
+	0xE4, 0xAD, 0x4D, 0x13, 0x8B, 0x7B, 0xD0, 0xF5,
+	0x44, 0x94, 0x67, 0xF0, 0xE1, 0xDB, 0x9B, 0x2E,
+	0x1D, 0xED, 0x4D, 0xBE, 0xB1, 0x00, 0xEA, 0x9F,
+	0x11, 0x4E, 0x49, 0x63, 0xF1, 0x97, 0x96, 0xB8,
+	0x17, 0x52, 0xE8, 0xBF, 0xBD, 0x07, 0xB6, 0x5F,
+	0x1C, 0x4A, 0x85, 0xE8, 0xE3, 0x2A, 0x88, 0xF7,
+	0xED, 0x64, 0x3E, 0xD1, 0xD0, 0xB1, 0xDC, 0x20,
+	0xFE, 0xF5, 0x34, 0x35,
+
+	0xF2, 0x8B, 0xEB, 0xD8, 0x99, 0xF8, 0xFA, 0x73,
+	0xF8, 0xFF, 0x87, 0xAE, 0x20, 0xC0, 0xDC, 0xA6,
+	0xEC, 0x5C, 0x9D, 0xDF, 0xB3, 0xFC, 0x3D, 0xE5,
+	0xC3, 0xFB, 0x5E, 0x3D, 0xCD, 0x9F, 0xF5, 0xAE,
+	0x79, 0xC1, 0x62, 0xB9, 0xA8, 0x31, 0x16, 0x04,
+	0x92, 0x94, 0xAD, 0x5B, 0xF5, 0x2D, 0x2F, 0x3D,
+	0x67, 0x1C, 0x0D, 0xE1, 0x81, 0x53, 0x5F, 0x39,
+	0xD2, 0x08, 0x4F, 0xEF, 0xB5, 0x4D, 0x30, 0xA1,
+	0x17, 0x08, 0x15, 0xF6, 0xF1, 0x8F, 0x06, 0xBD,
+	0x72, 0xCE, 0xD1, 0x45, 0x30, 0xBE, 0x4F, 0x08,
+	0x66, 0x16, 0xCA, 0xA3, 0x5B, 0x4C, 0x84, 0x75,
+	0x7D, 0x30, 0xF1, 0x92, 0xCA, 0x72, 0x4C, 0x1E,
+	0x48, 0xCC, 0xCD, 0x31, 0xBF, 0xE9, 0x67, 0x82,
+	0x22, 0x7B, 0x30, 0x96, 0x06, 0x85, 0x3B, 0x0B,
+	0xFD, 0x4B, 0x92, 0x09, 0x5D, 0x7C, 0x02, 0xCE,
+	0x98, 0x87, 0xC2, 0x71, 0x53, 0x4F, 0x62, 0xDC,
+	0x0D, 0x6C, 0x41, 0xA4, 0x17, 0xEE, 0x12, 0x86,
+	0x59, 0xCC, 0x22, 0x3F, 0x52, 0xE7, 0x58, 0xF7,
+	0x2B, 0xDD, 0x93, 0xF4, 0x06, 0x88, 0x69, 0x24,
+	0x42, 0xE5, 0x74, 0x71, 0x76, 0xA3, 0x3F, 0xA0,
+	0x69, 0x55, 0xF6, 0xF9, 0x29, 0x5F, 0x7C, 0xD7,
+	0xB5, 0xF9, 0xA0, 0xB0, 0x6A, 0x34, 0x41, 0xB2,
+	0x60, 0x8C, 0xFB, 0xC1, 0x24, 0x56, 0x88, 0x5B,
+	0x0B, 0xBA, 0xC0, 0x68, 0xD1, 0x0C, 0xC5, 0xDC,
+	0x5B, 0x42, 0x7B, 0xA6, 0x70, 0xF5, 0xB3, 0xDC,
+	0x8D, 0x3C, 0x2E, 0x3F, 0x02, 0x7C, 0x18, 0x54,
+	0x6C, 0xB9, 0x45, 0xAB, 0x40, 0x76, 0xEC, 0xA1,
+	0x99, 0xE9, 0xD5, 0x1A, 0x2D, 0xA2, 0x60, 0x0C,
+	0x9F, 0x24, 0xD1, 0x52, 0xDB,
+
+	0xF2, 0x8B, 0xEB, 0xD8, 0x9D, 0xF8, 0xFA, 0x73,
+	0xF8, 0xF7, 0x87, 0xAE, 0x20, 0xC0, 0xDC, 0xA6,
+	0x2C, 0x58, 0x1D, 0xDF, 0xB3, 0xBC, 0x1D, 0xE5,
+	0xC3, 0xFB, 0x5F, 0xBC, 0xCE, 0x9E, 0xF5, 0xAA,
+	0xC1, 0x41, 0xEB, 0xFF, 0xA9, 0x91, 0x76, 0xA0,
+	0xB4, 0x84, 0xF5, 0x59, 0xB1, 0x25, 0x1F, 0x07,
+	0x19, 0x1C, 0x89, 0x73, 0x12, 0x63, 0xF5, 0xF5,
+	0x04, 0xB0, 0x11, 0xF4, 0x5D, 0x88, 0x91, 0x31,
+	0x38, 0xCD, 0x93, 0x7F, 0x48, 0x36, 0xB2, 0x39,
+	0x9D, 0x53, 0x0A, 0xC9, 0xC5, 0x35, 0x67, 0xFF,
+	0x02, 0x7E, 0xFE, 0x56, 0x2F, 0x56, 0xEC, 0x96,
+	0x47, 0x1A, 0xE8, 0x49, 0xCF, 0x67, 0xC3, 0xD8,
+	0x6F, 0x8C, 0x70, 0xB9, 0x7C, 0xAD, 0x56, 0x9A,
+	0xA1, 0x2A, 0x69, 0xAA, 0x33, 0xDF, 0xCB, 0x16,
+	0x74, 0xBA, 0x61, 0xA4, 0x49, 0x4B, 0x81, 0x4C,
+	0x41, 0x95, 0x7F, 0x77, 0xF3, 0xF2, 0x40, 0x17,
+	0x29, 0x0B, 0x08, 0x1A, 0xA1, 0x23, 0x1F, 0x3F,
+	0x7F, 0xB9, 0x76, 0x9C, 0x9B, 0x08, 0x1F, 0xFA,
+	0xD4, 0x12, 0xEA, 0x58, 0xE4, 0xF7, 0xCF, 0x3D,
+	0x63, 0x2E, 0xEF, 0x4D, 0xBE, 0x8F, 0xB9, 0x85,
+	0x38, 0x71, 0xFD, 0xDA, 0x71, 0x59, 0x39, 0xFB,
+	0xA0, 0x2A, 0x90, 0xDE, 0x8C, 0x1A, 0x2A, 0xEB,
+	0xA5, 0x80, 0x84, 0xB8, 0x9A, 0x8D, 0x59, 0x38,
+	0x24, 0x04, 0x92, 0xFA, 0xB0, 0x77, 0x42, 0xB9,
+	0x04, 0xBE, 0x8D, 0x0D, 0x12, 0x38, 0x44, 0x34,
+	0xC1, 0x78, 0x04, 0xA7, 0xD8, 0x17, 0x12, 0xA9,
+	0x51, 0xA6, 0xB2, 0xEC, 0x06, 0x72, 0xDC, 0x6B,
+	0xA3, 0x14, 0xB5, 0xE9, 0x33, 0x04, 0xD4, 0x61,
+	0x82, 0x0F, 0xFD, 0x45, 0x23, 0x4C,
+
+	0xF2, 0x8B, 0xEB, 0xD8, 0x9D, 0xF4, 0xFA, 0x73,
+	0xF8, 0x77, 0xEF, 0x86, 0x20, 0xC0, 0xDC, 0xA6,
+	0x6A, 0xD4, 0x2C, 0x57, 0xB3, 0xBC, 0x19, 0x46,
+	0x44, 0xF9, 0x0C, 0xB9, 0x5A, 0x86, 0xB5, 0xDA,
+	0xCA, 0x21, 0x38, 0xDE, 0x92, 0xF1, 0xB5, 0x02,
+	0x3A, 0x4A, 0xE8, 0xD6, 0xCB, 0xE3, 0x6D, 0xF6,
+	0xBE, 0xE3, 0xDC, 0x37, 0x2D, 0x4D, 0x82, 0x3C,
+	0x5C, 0x7A, 0x79, 0x1B, 0xE4, 0x72, 0xE3, 0xF4,
+	0xE8, 0x50, 0x78, 0xC9, 0x3F, 0x2C, 0x05, 0x0F,
+	0x2A, 0x0A, 0x47, 0xF0, 0xF8, 0x19, 0xB6, 0xE9,
+	0x15, 0x10, 0x71, 0x6D, 0xE4, 0x13, 0xC1, 0xD9,
+	0xDC, 0x2C, 0xC0, 0x60, 0x71, 0xB3, 0x35, 0x06,
+	0xE3, 0x70, 0xB8, 0x48, 0x95, 0xB5, 0x7F, 0x97,
+	0xD9, 0x3C, 0x94, 0x7F, 0xE5, 0xFD, 0xD1, 0xB1,
+	0x02, 0x8E, 0xF5, 0xA5, 0xCC, 0x1D, 0x2D, 0xF8,
+	0xB3, 0x0B, 0xAB, 0x6B, 0x72, 0x72, 0x73, 0xF2,
+	0x74, 0x67, 0x53, 0x29, 0x0E, 0x2A, 0x6C, 0x0E,
+	0x38, 0x0C, 0x3B, 0x59, 0x7E, 0x0E, 0x93, 0x3E,
+	0xAE, 0x9A, 0x19, 0xB1, 0x55, 0x03, 0x2E, 0x3E,
+	0x95, 0x8E, 0x7C, 0x62, 0x3D, 0x16, 0x6D, 0x43,
+	0x83, 0x95, 0x8D, 0x0B, 0x43, 0xC0, 0x7F, 0xCF,
+	0x68, 0x05, 0x48, 0x4B, 0xCD, 0xF1, 0xAF, 0x5B,
+	0x9E, 0x78, 0xA5, 0x80, 0xE8, 0xE4, 0xC1, 0xEE,
+	0xC0, 0xE1, 0x5F, 0x91, 0x1D, 0x04, 0xFE, 0x35,
+	0x11, 0x4E, 0xC1, 0xA8, 0x51, 0xFB, 0x9C, 0x50,
+	0x61, 0x56, 0x5E, 0xD5, 0x48, 0x88, 0xFA, 0x4A,
+	0xB8, 0x70, 0xA7, 0x98, 0x35, 0x7D, 0x01, 0x48,
+	0xBD, 0x73, 0x58, 0x4D, 0xF2, 0xE2, 0xBE, 0xAC,
+	0xF4, 0x42, 0x89, 0xC0, 0xD3, 0xD9, 0xF4,
+
+	0xF2, 0x8B, 0xEB, 0xD8, 0x9D, 0x74, 0xEA, 0x73,
+	0xF8, 0x77, 0xEF, 0xCF, 0x20, 0xC0, 0xDC, 0xA6,
+	0xFA, 0xC0, 0x75, 0x75, 0xB7, 0xBC, 0x19, 0x46,
+	0xEC, 0x68, 0x08, 0xD5, 0xDF, 0x92, 0xA4, 0xE0,
+	0x34, 0xD3, 0x4E, 0xF3, 0xFD, 0x2B, 0xFC, 0x1B,
+	0x9F, 0x64, 0x49, 0x4B, 0xEB, 0xAE, 0x88, 0xB6,
+	0xCB, 0x9C, 0x18, 0x0D, 0x0B, 0xF9, 0x7C, 0xA1,
+	0x27, 0x19, 0x71, 0xE3, 0x83, 0x3B, 0xBB, 0x19,
+	0x65, 0xD9, 0xF4, 0xED, 0xEE, 0x4B, 0xEC, 0x48,
+	0xE3, 0xF1, 0x5D, 0x01, 0x06, 0xE4, 0xDA, 0xA2,
+	0xAD, 0x8D, 0x61, 0x00, 0xBC, 0xCE, 0xC4, 0x71,
+	0x78, 0xF7, 0x1D, 0x32, 0x8E, 0x4F, 0x1C, 0x41,
+	0xAD, 0x3E, 0xAA, 0x9F, 0x9E, 0x0F, 0x62, 0x0C,
+	0x0E, 0xCA, 0xCF, 0xF1, 0x6A, 0x50, 0x0B, 0xE4,
+	0x48, 0xEB, 0xD1, 0x71, 0x39, 0x48, 0xC2, 0xF1,
+	0x6A, 0xE3, 0xD3, 0xE9, 0x63, 0xC8, 0x68, 0x1D,
+	0x3C, 0xE7, 0xE4, 0xCF, 0x4F, 0xC7, 0xEA, 0xC6,
+	0xE9, 0xB7, 0x2F, 0x4D, 0x3F, 0xB2, 0x56, 0x50,
+	0xF6, 0x69, 0x34, 0x75, 0xA9, 0xD9, 0xD3, 0xC4,
+	0x30, 0x42, 0xD5, 0x64, 0xB6, 0x5D, 0x5B, 0xFD,
+	0x18, 0x82, 0x68, 0x46, 0x53, 0xD8, 0x35, 0x3E,
+	0xF0, 0xBD, 0x3A, 0x25, 0xC6, 0x5C, 0x80, 0x4D,
+	0x8B, 0x46, 0x42, 0xB3, 0x6F, 0x90, 0x6F, 0x17,
+	0x88, 0x3E, 0x85, 0x5D, 0x10, 0x34, 0x9A, 0x07,
+	0xD7, 0x53, 0x25, 0xA1, 0x3F, 0xB6, 0x46, 0xF4,
+	0xC1, 0x50, 0xE0, 0xBB, 0x19, 0x12, 0xC6, 0x51,
+	0xC5, 0xBE, 0xD8, 0x39, 0x19, 0x85, 0x4D, 0xD3,
+	0xBE, 0xF6, 0x0E, 0x8C, 0x10, 0x7D, 0xB8, 0xA9,
+	0x99, 0x65, 0x00, 0xB3, 0x70, 0x4A, 0xFF, 0x80,
+
+	0xF2, 0x8B, 0xEB, 0xD8, 0x9D, 0x74, 0xEA, 0x73,
+	0xF8, 0x77, 0xCF, 0xCB, 0x09, 0xE0, 0xDC, 0x26,
+	0x7A, 0x4A, 0x50, 0x66, 0xA0, 0x2C, 0x3D, 0x57,
+	0xE8, 0xEE, 0x08, 0xD5, 0x3D, 0xCB, 0xBB, 0xCA,
+	0x7D, 0xA5, 0x85, 0x03, 0xA1, 0xFE, 0x3D, 0x1C,
+	0x32, 0x65, 0x30, 0x85, 0xE9, 0x8B, 0x34, 0x02,
+	0xD4, 0x43, 0xF1, 0xBA, 0xE1, 0x95, 0x12, 0xEC,
+	0x56, 0x13, 0x3E, 0xD1, 0x7E, 0xAD, 0x8F, 0xB5,
+	0x1A, 0xF9, 0x2B, 0x90, 0x87, 0x36, 0x3B, 0x77,
+	0x8A, 0xB6, 0x3E, 0x17, 0x67, 0x8D, 0xC5, 0xF7,
+	0x32, 0x15, 0x80, 0x87, 0xB6, 0x58, 0x1F, 0x0F,
+	0x3C, 0x0F, 0x58, 0x38, 0xEF, 0xE4, 0x26, 0x5C,
+	0x64, 0x73, 0x7B, 0x64, 0x47, 0x86, 0xB7, 0x6F,
+	0xAA, 0xC0, 0x63, 0x73, 0x38, 0x42, 0x7E, 0x33,
+	0x70, 0x46, 0xF3, 0xC3, 0xAC, 0x63, 0x57, 0x84,
+	0xD2, 0xFE, 0x72, 0x2E, 0xC8, 0x0D, 0xD1, 0xB1,
+	0x1C, 0x1B, 0xB8, 0xC4, 0x6A, 0x7E, 0x79, 0x49,
+	0x14, 0x8B, 0xD1, 0x98, 0xEE, 0xC3, 0x79, 0x0B,
+	0xC7, 0x0F, 0x35, 0xAD, 0xF9, 0x66, 0x66, 0x03,
+	0xE6, 0xAC, 0xA4, 0x47, 0xD1, 0x1B, 0x13, 0x7D,
+	0x97, 0xB1, 0x8B, 0xA4, 0xF7, 0x4B, 0x69, 0xD4,
+	0x1B, 0x50, 0x35, 0x3A, 0x0C, 0x4B, 0x36, 0x08,
+	0xE1, 0xAE, 0x11, 0xEF, 0x1D, 0x35, 0x2E, 0xD7,
+	0x24, 0xD8, 0xED, 0x16, 0x80, 0x87, 0xD6, 0x72,
+	0x34, 0x0B, 0x7B, 0x2B, 0xE6, 0x67, 0x90, 0xDD,
+	0x80, 0x41, 0xFE, 0xB1, 0x4B, 0x83, 0xFE, 0x4F,
+	0xE2, 0x0B, 0x21, 0xD6, 0xD7, 0xE4, 0xA5, 0x44,
+	0xD1, 0xD6, 0xF8, 0x52, 0xBC, 0x61, 0xB9, 0x72,
+	0xEB, 0xFB, 0x57, 0x37, 0x74, 0xDA, 0x25, 0xFD,
+	0x2C,
+
+	0xF2, 0x8B, 0xEB, 0xD8, 0x9D, 0x74, 0xEA, 0x73,
+	0xFC, 0x77, 0xCF, 0x4B, 0x09, 0xEA, 0xD6, 0x26,
+	0x7A, 0x48, 0x41, 0x63, 0xF0, 0x29, 0x1D, 0x57,
+	0xE8, 0xEE, 0x08, 0xD5, 0x3D, 0xF3, 0x7A, 0x6B,
+	0x8F, 0xA7, 0x8B, 0x03, 0xA7, 0x45, 0x80, 0x1F,
+	0x02, 0x24, 0x4A, 0x6B, 0x4C, 0x79, 0xA0, 0x95,
+	0x97, 0x7E, 0x13, 0xBF, 0xA3, 0x71, 0xCE, 0xEF,
+	0xCC, 0xC7, 0xE4, 0xC1, 0x62, 0xCA, 0x7C, 0xA2,
+	0xBB, 0xD4, 0x81, 0x59, 0x53, 0x95, 0xEC, 0x9E,
+	0x29, 0x1A, 0x56, 0x6E, 0x6E, 0xA3, 0x12, 0xA1,
+	0xC8, 0xEB, 0xBD, 0xEB, 0x56, 0x5A, 0x2E, 0x51,
+	0xFA, 0x94, 0x5E, 0x1A, 0x9E, 0x76, 0xDE, 0x92,
+	0x92, 0xF7, 0xEE, 0x50, 0x9D, 0xB5, 0xA1, 0xC7,
+	0xA6, 0x13, 0x7D, 0x02, 0x15, 0xE2, 0xEA, 0x4A,
+	0x45, 0x16, 0xD9, 0x19, 0x0C, 0x8D, 0x86, 0x51,
+	0x34, 0xFA, 0xA7, 0x35, 0xE8, 0x45, 0x57, 0x3F,
+	0xB5, 0x9E, 0x6B, 0x32, 0xE1, 0xF7, 0x68, 0xB2,
+	0x70, 0x91, 0x4C, 0x02, 0x41, 0xD1, 0x7B, 0xC8,
+	0xC7, 0x79, 0x8A, 0x46, 0x75, 0xFE, 0x30, 0x63,
+	0x53, 0x5B, 0x5C, 0x32, 0x2F, 0x5F, 0xB5, 0x87,
+	0x1E, 0x05, 0x4B, 0x9B, 0x99, 0x7E, 0x4E, 0x63,
+	0x06, 0x63, 0x01, 0xA0, 0x6F, 0x13, 0x85, 0xC5,
+	0xD6, 0x3D, 0x1D, 0xFE, 0xCE, 0x87, 0xB8, 0xA6,
+	0xAE, 0xD4, 0xCA, 0x1A, 0x61, 0x0A, 0xBE, 0x85,
+	0x6F, 0x63, 0x12, 0x87, 0x26, 0x3A, 0xC9, 0x6F,
+	0xEB, 0xAE, 0xC6, 0xE0, 0x26, 0x0A, 0x94, 0x7D,
+	0x46, 0xBE, 0xE8, 0x49, 0xCD, 0xCD, 0x72, 0x96,
+	0xF5, 0x44, 0x99, 0xCC, 0x91, 0xA1, 0x85, 0x93,
+	0xD3, 0xE6, 0x24, 0x63, 0xDA, 0xB3, 0xDA, 0x5E,
+	0xDF, 0x91,
+
+	0xF2, 0x8B, 0xEB, 0xD8, 0x9D, 0x74, 0xEA, 0x73,
+	0xBC, 0x75, 0xCF, 0x4B, 0x09, 0x6A, 0x3C, 0x6C,
+	0xAF, 0x56, 0x0F, 0x72, 0xF0, 0xB0, 0x2D, 0x5F,
+	0xE5, 0xEE, 0x08, 0xDD, 0x95, 0xF5, 0x40, 0x35,
+	0xE2, 0x8D, 0x4E, 0x82, 0xCD, 0x84, 0x03, 0x4F,
+	0x64, 0xAE, 0x79, 0xF4, 0xC4, 0xF7, 0x4A, 0x26,
+	0x56, 0x18, 0x53, 0xDF, 0x2E, 0xAA, 0x99, 0x84,
+	0xF1, 0x39, 0x7D, 0x06, 0x0C, 0xDA, 0xB9, 0xAA,
+	0x14, 0x7E, 0xB8, 0xC9, 0x16, 0xFD, 0x1C, 0xCD,
+	0x9B, 0xDA, 0xF6, 0xA8, 0x76, 0x38, 0xEA, 0x18,
+	0xDC, 0x3E, 0x22, 0xD0, 0x35, 0x91, 0xE4, 0xB0,
+	0x71, 0xE7, 0xA7, 0x37, 0x98, 0x8F, 0xEA, 0xFB,
+	0x2E, 0x19, 0x93, 0x12, 0xCE, 0xA2, 0xE9, 0x3B,
+	0x72, 0x50, 0x18, 0x0E, 0x64, 0xA5, 0x23, 0x4F,
+	0xFE, 0xD0, 0x15, 0xAB, 0xF8, 0xCB, 0xF9, 0x9D,
+	0xBB, 0xCA, 0xEA, 0xA8, 0x2A, 0xF7, 0x2D, 0x72,
+	0x43, 0x69, 0xD9, 0x53, 0xBC, 0x3B, 0xB7, 0x45,
+	0x02, 0x60, 0x9E, 0x5D, 0x90, 0x6F, 0xD8, 0x44,
+	0x68, 0x31, 0x1D, 0x97, 0x88, 0xBE, 0x7A, 0xD6,
+	0x88, 0xDF, 0x6A, 0x76, 0x03, 0xF1, 0x4B, 0xFA,
+	0x66, 0x56, 0x76, 0x1E, 0x0E, 0x5C, 0x89, 0x40,
+	0xB0, 0x4C, 0x80, 0xDE, 0x01, 0x8F, 0xDC, 0x06,
+	0xC6, 0xB9, 0x54, 0x69, 0xB2, 0x58, 0xAC, 0x39,
+	0x52, 0x79, 0xAC, 0x3F, 0xAF, 0x9E, 0x89, 0x86,
+	0xCB, 0x76, 0x22, 0x15, 0xC4, 0xA2, 0xB0, 0x40,
+	0x76, 0xF2, 0x5B, 0x07, 0x59, 0x1E, 0x77, 0x4E,
+	0x70, 0xC4, 0xE1, 0xE2, 0xBC, 0xC3, 0xE1, 0xFB,
+	0xB5, 0xA1, 0x1A, 0x5E, 0xA9, 0x73, 0xE1, 0x5D,
+	0x7E, 0xB9, 0x98, 0xDA, 0x52, 0x2A, 0xFC, 0xB9,
+	0x14, 0xE8, 0x9F,
+
+	0xF2, 0x8B, 0xEB, 0xD8, 0x9D, 0x74, 0xEA, 0x73,
+	0xBC, 0x75, 0xED, 0x4B, 0x42, 0x6A, 0x3C, 0x27,
+	0x8B, 0xB1, 0x1B, 0x67, 0xE0, 0x84, 0xC5, 0x78,
+	0x81, 0xE6, 0x08, 0x85, 0xAC, 0x4C, 0x24, 0x09,
+	0xD4, 0x88, 0x9F, 0xF4, 0xEB, 0xF9, 0xB7, 0x90,
+	0x2F, 0x38, 0xFE, 0xB1, 0xC0, 0xB0, 0x74, 0xF5,
+	0x28, 0x40, 0xCB, 0xF0, 0x43, 0xF2, 0x43, 0xD6,
+	0x70, 0xC8, 0x0B, 0xFF, 0xBD, 0x2C, 0xFB, 0x16,
+	0x6C, 0x43, 0x40, 0x2B, 0x24, 0x45, 0xC3, 0x3F,
+	0x29, 0x4B, 0x4C, 0x46, 0x71, 0xF9, 0xA7, 0x27,
+	0xA3, 0xBB, 0xC7, 0x69, 0x35, 0x3F, 0xAD, 0x03,
+	0xE5, 0xBD, 0x07, 0x0A, 0xBA, 0x1A, 0xEE, 0x0A,
+	0xEA, 0x37, 0xE6, 0x76, 0xCE, 0x02, 0x8A, 0x0E,
+	0x30, 0xC0, 0x27, 0x23, 0xBA, 0x25, 0x3E, 0x70,
+	0x81, 0xC1, 0x38, 0x72, 0x0C, 0xA9, 0xAB, 0x1B,
+	0xD7, 0x7D, 0xA6, 0x9C, 0x2F, 0x97, 0x39, 0x2B,
+	0x28, 0xE2, 0xFD, 0x2A, 0xDB, 0xF5, 0x19, 0x8E,
+	0x87, 0x7C, 0xBC, 0xF3, 0xDF, 0x28, 0x49, 0x3F,
+	0x27, 0xA8, 0xCF, 0xAE, 0x74, 0x08, 0x25, 0x70,
+	0x21, 0x24, 0xEF, 0x43, 0x85, 0xE1, 0x70, 0xEC,
+	0x52, 0xD7, 0x8C, 0x0D, 0x81, 0x75, 0x34, 0xE3,
+	0x79, 0xCC, 0x01, 0xCC, 0xCA, 0x83, 0x49, 0xFB,
+	0x9A, 0x89, 0x9F, 0x4E, 0x79, 0xCE, 0xFD, 0x32,
+	0x97, 0xD8, 0x63, 0x0E, 0xAD, 0x8E, 0xE2, 0x0C,
+	0x73, 0xF8, 0x54, 0x90, 0x4F, 0x75, 0xC7, 0xB9,
+	0x54, 0x69, 0x92, 0x07, 0xE8, 0x16, 0x81, 0x12,
+	0x4A, 0xD1, 0xCB, 0x75, 0x95, 0x26, 0x20, 0xA3,
+	0x4C, 0xC0, 0xD7, 0xF4, 0xEB, 0x67, 0x75, 0xDF,
+	0x35, 0x55, 0x73, 0xB5, 0x92, 0x35, 0x02, 0xA5,
+	0xDA, 0xF4, 0x43, 0x59,
+
+	0x59, 0xA3, 0xEB, 0x58, 0x9D, 0x74, 0xEA, 0x73,
+	0xBC, 0x75, 0xED, 0x4B, 0x42, 0x6A, 0x1C, 0x2B,
+	0xDD, 0x49, 0xB6, 0x65, 0x60, 0x94, 0xD0, 0x75,
+	0x97, 0x76, 0xA9, 0xAD, 0xF0, 0xC8, 0x24, 0x09,
+	0x5F, 0xD8, 0x6A, 0x72, 0xE9, 0xA4, 0x48, 0xAC,
+	0xB0, 0xBB, 0x25, 0x57, 0xB7, 0x95, 0x00, 0xA2,
+	0x0E, 0x13, 0xA3, 0x82, 0xDC, 0x58, 0xC7, 0x9D,
+	0x0B, 0xC7, 0xD1, 0x86, 0xF0, 0x14, 0x12, 0xFD,
+	0xCC, 0x1C, 0xCB, 0xDA, 0x83, 0x23, 0xA7, 0x86,
+	0x13, 0x22, 0xDF, 0x62, 0xAD, 0x4B, 0xC4, 0x0E,
+	0x66, 0x36, 0x7E, 0xAA, 0xC2, 0xD6, 0x54, 0xB8,
+	0xF1, 0x2A, 0x14, 0x54, 0x71, 0x80, 0xC1, 0x71,
+	0xD8, 0xA8, 0x7A, 0xAD, 0x70, 0x86, 0xCE, 0xE5,
+	0x29, 0xBB, 0xB5, 0x5E, 0x51, 0xD2, 0xA2, 0xE7,
+	0xA4, 0x23, 0x68, 0xCD, 0x89, 0xAA, 0x5E, 0xF2,
+	0x84, 0x22, 0xEF, 0x62, 0x80, 0xBB, 0xCA, 0xAC,
+	0x86, 0xDE, 0x2C, 0xD2, 0xFB, 0xBA, 0x8E, 0xB8,
+	0x7B, 0x41, 0xB4, 0x1D, 0xE9, 0x1C, 0xB4, 0xAE,
+	0xB0, 0x92, 0x48, 0x5B, 0xD8, 0xAA, 0x76, 0xDF,
+	0x7F, 0x0D, 0x38, 0xEF, 0x8C, 0x71, 0xB2, 0xD6,
+	0xCE, 0x44, 0xA7, 0xDE, 0x5C, 0x4E, 0x15, 0x4F,
+	0xDA, 0xF5, 0x46, 0x09, 0x21, 0x5F, 0x1B, 0x18,
+	0x00, 0x17, 0x4D, 0xAA, 0xB5, 0xE4, 0xAB, 0x8D,
+	0xB3, 0xEB, 0x7A, 0x2A, 0x4C, 0x8F, 0xF4, 0x1B,
+	0xB4, 0xD7, 0xD1, 0x0C, 0xE7, 0x28, 0x79, 0x9A,
+	0x80, 0xAB, 0x30, 0xFE, 0xAD, 0x66, 0xA9, 0x31,
+	0x60, 0xF3, 0x93, 0xBD, 0x50, 0x77, 0x9E, 0x89,
+	0xA0, 0xFA, 0xB8, 0x4E, 0x71, 0xFA, 0x95, 0xE9,
+	0x47, 0x63, 0xF5, 0x3E, 0xE2, 0x81, 0x88, 0x4D,
+	0xBD, 0x40, 0xB0, 0x34, 0x50,
+
+	0x59, 0xAF, 0xE7, 0x58, 0x9D, 0x74, 0xEA, 0x73,
+	0xBC, 0x75, 0xED, 0x4B, 0x43, 0x6A, 0x1C, 0x2B,
+	0xDD, 0x88, 0x17, 0xA4, 0x62, 0x04, 0xD4, 0xF7,
+	0x76, 0x70, 0x69, 0x8D, 0xF0, 0xC8, 0x84, 0x08,
+	0x6A, 0xE3, 0x50, 0x29, 0xB7, 0xBC, 0x7E, 0xDC,
+	0x9D, 0xFF, 0xC3, 0x49, 0x6E, 0x89, 0x60, 0x56,
+	0xDC, 0xF9, 0xFF, 0x0A, 0xAF, 0x91, 0x07, 0xB6,
+	0xAF, 0xE8, 0x9D, 0xBB, 0x90, 0x7F, 0x9A, 0xB1,
+	0xD6, 0x00, 0x32, 0x5C, 0x16, 0xF7, 0x84, 0x86,
+	0x9F, 0xBE, 0xE5, 0x43, 0x21, 0x6F, 0xF9, 0x88,
+	0xB2, 0xFA, 0x98, 0xC0, 0x37, 0x73, 0x4E, 0x3C,
+	0x5F, 0xEE, 0x2F, 0x95, 0x8B, 0xAE, 0x81, 0xFF,
+	0xCE, 0xE3, 0x7F, 0x97, 0xF2, 0x89, 0x0C, 0xDB,
+	0x06, 0x09, 0x03, 0x0E, 0x47, 0xDB, 0xC1, 0x79,
+	0x19, 0x43, 0xE9, 0xCC, 0x98, 0x12, 0xE6, 0x72,
+	0x0F, 0xDA, 0x75, 0x7C, 0x2E, 0xEE, 0xC4, 0xC8,
+	0x1F, 0x6E, 0x0A, 0xFE, 0x35, 0x99, 0xE4, 0x6E,
+	0x53, 0xAA, 0x2D, 0x01, 0x80, 0xFB, 0xEC, 0x0E,
+	0xCF, 0x53, 0xB1, 0x55, 0x09, 0x8C, 0x5D, 0x91,
+	0x9D, 0x7C, 0x90, 0x98, 0xA6, 0x01, 0x0F, 0xC6,
+	0x74, 0xCF, 0x72, 0xA1, 0x33, 0xF9, 0x98, 0x1B,
+	0xAC, 0x1D, 0x8E, 0x62, 0x66, 0xDE, 0xAD, 0x78,
+	0x5D, 0x21, 0x6B, 0xA2, 0x43, 0x09, 0x00, 0xFB,
+	0x00, 0x14, 0x5A, 0xD6, 0xD4, 0x93, 0xAB, 0x08,
+	0xBC, 0x88, 0x97, 0x25, 0xC6, 0x1C, 0x02, 0xD7,
+	0x75, 0x88, 0xAB, 0xD8, 0x45, 0x1F, 0x28, 0x00,
+	0xBC, 0x33, 0x1C, 0x1B, 0xBA, 0x57, 0xFB, 0x8E,
+	0x2C, 0x31, 0xF9, 0xEB, 0x38, 0x26, 0x58, 0xF7,
+	0xF9, 0xCD, 0x2A, 0xE2, 0xD9, 0x6D, 0x54, 0x31,
+	0x2F, 0x25, 0x58, 0x22, 0x07, 0xCD,
+
+	0x59, 0x2F, 0x8B, 0x18, 0x9D, 0x74, 0xEA, 0x73,
+	0xBC, 0x75, 0xED, 0x4B, 0x63, 0x4B, 0x1C, 0x2B,
+	0x11, 0x0E, 0xDD, 0xA0, 0xC6, 0x05, 0x92, 0x95,
+	0x32, 0x9C, 0x5E, 0x8D, 0xFC, 0xC8, 0xE4, 0x18,
+	0x83, 0x76, 0x82, 0x64, 0x17, 0x20, 0x16, 0x7D,
+	0xF3, 0x48, 0x9A, 0xE7, 0x60, 0xD0, 0x13, 0x04,
+	0xA9, 0xAF, 0x4D, 0x2A, 0xCE, 0xEE, 0xEF, 0x68,
+	0x8B, 0x8A, 0xF1, 0xDE, 0x43, 0x44, 0x44, 0x3F,
+	0xD9, 0x16, 0x9C, 0xA1, 0x74, 0xC3, 0xED, 0x61,
+	0x6F, 0xAF, 0x9F, 0xD2, 0xDC, 0x90, 0xEC, 0x85,
+	0x8D, 0x5B, 0x1C, 0xC9, 0x5D, 0xAF, 0xE9, 0x7F,
+	0xF8, 0xED, 0x5C, 0xFE, 0x05, 0x4E, 0x09, 0x1B,
+	0xBF, 0xCB, 0x96, 0x7A, 0x0C, 0x13, 0x3E, 0xAE,
+	0x1F, 0x43, 0x97, 0x00, 0xCF, 0x82, 0xA1, 0x55,
+	0x2C, 0xDF, 0xA1, 0xF6, 0x34, 0x2A, 0x02, 0x86,
+	0x89, 0x95, 0x16, 0xBC, 0xEF, 0x7C, 0xB3, 0x64,
+	0x4B, 0x5A, 0x31, 0x2E, 0x3D, 0x4B, 0x59, 0xF1,
+	0x4E, 0x17, 0x8F, 0x34, 0x6D, 0x23, 0x5B, 0x53,
+	0xC0, 0xA9, 0xCE, 0x0F, 0x62, 0x0F, 0xD6, 0x71,
+	0xA9, 0xE5, 0x22, 0xE1, 0xAB, 0x81, 0xD2, 0x2D,
+	0xD1, 0x3F, 0x30, 0xA9, 0xC1, 0xE6, 0xD1, 0x2E,
+	0x72, 0x05, 0xD4, 0x90, 0xAD, 0x57, 0xF9, 0x1A,
+	0xFC, 0x94, 0x7F, 0xF2, 0x6A, 0x8C, 0x1C, 0x5B,
+	0xDE, 0xE6, 0xDA, 0x38, 0x8E, 0xCA, 0x53, 0x52,
+	0xFC, 0x63, 0x4E, 0x30, 0xAD, 0x9D, 0xBE, 0x20,
+	0xAF, 0x84, 0x85, 0x67, 0xD5, 0x5E, 0x41, 0x5B,
+	0x3B, 0xFA, 0x5A, 0xAA, 0xAA, 0x7F, 0x5D, 0xE0,
+	0x37, 0xC4, 0x9D, 0x46, 0x04, 0x35, 0xBF, 0x10,
+	0x1D, 0x89, 0x8B, 0xA3, 0x21, 0xC2, 0x99, 0x3F,
+	0x75, 0x5D, 0xB5, 0xB6, 0x66, 0xFD, 0xBD,
+
+	0x1C, 0x2F, 0x8B, 0x55, 0x9D, 0x74, 0xEA, 0x73,
+	0xBC, 0x75, 0xED, 0x4B, 0x63, 0x8B, 0x2D, 0x2B,
+	0xF9, 0x9C, 0x19, 0xEC, 0x44, 0x47, 0xC8, 0xD5,
+	0x62, 0xB0, 0xEF, 0xA9, 0xCA, 0xC9, 0xE4, 0x18,
+	0x93, 0xA5, 0xE9, 0xB0, 0x48, 0xA1, 0x0B, 0xB1,
+	0x67, 0xEB, 0xCA, 0x34, 0x38, 0x1C, 0xD2, 0xB8,
+	0x26, 0x76, 0x4D, 0x7E, 0xA1, 0xB3, 0x65, 0xBF,
+	0x9A, 0xA6, 0x19, 0xD5, 0xDB, 0x7C, 0x0C, 0xB4,
+	0xCC, 0xA2, 0x4C, 0xBA, 0xA2, 0x6A, 0x1B, 0xB5,
+	0xB1, 0x1E, 0xEB, 0x05, 0xBB, 0xF8, 0x2E, 0x72,
+	0x66, 0x70, 0xAD, 0x5F, 0xCD, 0x78, 0xBD, 0xFF,
+	0xD6, 0x34, 0x90, 0xE9, 0x75, 0x0B, 0x23, 0x58,
+	0x59, 0x65, 0xE2, 0x83, 0x8D, 0x0D, 0x29, 0x95,
+	0x15, 0x07, 0x90, 0xE9, 0x26, 0xB9, 0x01, 0xDA,
+	0x83, 0x6D, 0xBF, 0xA4, 0x54, 0xE1, 0x90, 0xC0,
+	0x3B, 0xF7, 0x29, 0x26, 0xAE, 0x1A, 0x2B, 0x7E,
+	0x24, 0x00, 0xC4, 0xBD, 0x11, 0xB1, 0xDA, 0xC1,
+	0xD0, 0xD9, 0xC7, 0xEC, 0x5B, 0x6B, 0xD9, 0xE8,
+	0x04, 0xCB, 0xD8, 0x2F, 0x89, 0x3C, 0xB4, 0x27,
+	0x4A, 0xEB, 0xAC, 0xEA, 0x74, 0x60, 0x35, 0xEB,
+	0xFA, 0x82, 0xB8, 0xF4, 0x54, 0x50, 0x49, 0xD6,
+	0xDD, 0x44, 0x41, 0x10, 0xD3, 0x40, 0xEA, 0x48,
+	0x97, 0xFB, 0xCE, 0xF4, 0xBD, 0x17, 0x1D, 0x33,
+	0x2B, 0xD1, 0xED, 0xEE, 0xA2, 0xCB, 0x1E, 0x88,
+	0x2F, 0x02, 0xA0, 0xC2, 0x2C, 0xE8, 0x0B, 0xA1,
+	0xBB, 0x20, 0xD3, 0xD5, 0xC4, 0x0E, 0x22, 0xB0,
+	0x33, 0x60, 0xA0, 0xAC, 0x57, 0x57, 0x2C, 0xD1,
+	0x84, 0x1F, 0xCA, 0xFB, 0x40, 0x05, 0x92, 0xCA,
+	0xB3, 0xF5, 0xD2, 0xE4, 0x6E, 0x6A, 0xAE, 0xDF,
+	0x26, 0x49, 0xA3, 0xD3, 0xA7, 0x3F, 0x45, 0xFC,
+
+	0x4B, 0x21, 0x79, 0x9F, 0x14, 0x16, 0x1E, 0x50,
+	0xF7, 0xC5, 0x1B, 0xDE, 0x43, 0x8C, 0x32, 0xBF,
+	0x93, 0xA5, 0x90, 0xE3, 0xB0, 0x09, 0x37, 0xC3,
+	0xF4, 0xCF, 0x59, 0x31, 0xC8, 0x3C, 0x5A, 0x94,
+	0xDD, 0xAC, 0x9E, 0xC6, 0xC1, 0xBB, 0x2E, 0x7E,
+	0xA8, 0x1F, 0xA1, 0x27, 0xDF, 0x41, 0x9F, 0xA8,
+	0xAD, 0x76, 0x30, 0xC0, 0x04, 0x4C, 0xDE, 0x00,
+	0x1A, 0xBD, 0xF7, 0x76, 0x4A, 0x7F, 0xC2, 0xB5,
+	0x00, 0x75, 0xAD, 0x7B, 0x34, 0xFE, 0xC2, 0x57,
+	0x68, 0x56, 0x5E, 0x9A, 0xC9, 0x07, 0x5A, 0xE3,
+	0x86, 0xF6, 0x37, 0x76, 0xE1, 0x90, 0xA3, 0x28,
+	0x53, 0x1F, 0x15, 0xC5, 0x45, 0x60, 0x78, 0xAE,
+	0xEF, 0xC4, 0x54, 0x0F, 0xCE, 0x9B, 0x3F, 0xC1,
+	0x59, 0xBB, 0x8C, 0xB3, 0x43, 0xAE, 0xE5, 0xC3,
+	0xC7, 0xF9, 0xBC, 0xB3, 0xDF, 0xCA, 0xAC, 0xA3,
+	0xD2, 0x07, 0xEE, 0x33, 0xD6, 0x6B, 0x49, 0xC2,
+	0xC7, 0x23, 0xF0, 0xD1, 0x51, 0xF2, 0x63, 0x35,
+	0x6D, 0x0A, 0xB1, 0x4A, 0x3E, 0xE5, 0x95, 0xDE,
+	0x7C, 0x01, 0x15, 0xE0, 0x69, 0xDC, 0xA8, 0xB4,
+	0x22, 0xFF, 0x85, 0xAD, 0xFD, 0x94, 0x23, 0x72,
+	0xF3, 0x7C, 0x59, 0x7A, 0x8D, 0x39, 0x5E, 0x23,
+	0xB3, 0x2F, 0x8D, 0xA1, 0xB7, 0xE0, 0x08, 0x9C,
+	0x8B, 0x7B, 0x6C, 0x17, 0x98, 0x21, 0x34, 0xB5,
+	0x86, 0x41, 0x0A, 0x1E, 0x65, 0x13, 0x60, 0x53,
+	0xAF, 0x08, 0x43, 0xEE, 0xA4, 0x6E, 0xBF, 0xFF,
+	0xF1, 0x98, 0xB5, 0x2B, 0xDA, 0xA8, 0x89, 0xB4,
+	0x85, 0xB6, 0x17, 0x58, 0xF8, 0xED, 0x5D, 0x16,
+	0x2D, 0xAD, 0x73, 0x90, 0xE5, 0x78, 0xD4, 0x1E,
+	0xA2, 0xAA, 0x65, 0x77, 0x2B, 0x1E, 0xD1, 0xB9,
+	0xCD, 0x34, 0x6F, 0xDE, 0x0D, 0xBA, 0xDF, 0xC9,
+	0x5B,
+
+	0x4F, 0x21, 0x79, 0x1F, 0x14, 0x18, 0x1C, 0x50,
+	0xF7, 0xC5, 0x1B, 0xDE, 0x43, 0x8C, 0x32, 0xBF,
+	0x93, 0xF5, 0x80, 0xE3, 0xB0, 0x49, 0xD6, 0xA2,
+	0xB4, 0xD5, 0x4D, 0xB4, 0x18, 0x3B, 0xBA, 0x90,
+	0xEC, 0x84, 0xBC, 0x06, 0xF6, 0xFB, 0xBF, 0x96,
+	0xB9, 0x0D, 0x95, 0xB3, 0xA3, 0x94, 0x90, 0xFF,
+	0x27, 0x32, 0x23, 0xDE, 0x41, 0xCE, 0xDB, 0x1D,
+	0x2E, 0x00, 0xDC, 0xEE, 0xC8, 0xD0, 0x42, 0x3A,
+	0x6D, 0x70, 0x54, 0x8C, 0xA0, 0x05, 0x25, 0x5C,
+	0x48, 0x26, 0x39, 0x8C, 0x0E, 0xC8, 0x9A, 0xF2,
+	0x6D, 0x3D, 0xE7, 0x7B, 0xF3, 0x12, 0x89, 0x31,
+	0x8E, 0xFB, 0xC3, 0x57, 0x9A, 0xDE, 0x19, 0x76,
+	0x3C, 0xEA, 0x55, 0xFE, 0xE8, 0xE5, 0x29, 0xF2,
+	0x5D, 0xC1, 0xF4, 0x2E, 0x29, 0x9F, 0xA0, 0x0D,
+	0x11, 0x60, 0xD4, 0x9B, 0x72, 0x84, 0x39, 0xF5,
+	0x52, 0x44, 0x32, 0xC9, 0xDF, 0xC5, 0xF0, 0x6D,
+	0x74, 0x29, 0x09, 0x09, 0x4C, 0x64, 0xB6, 0x76,
+	0x70, 0xA5, 0x5A, 0x91, 0xA6, 0xB0, 0xEF, 0xE2,
+	0x25, 0x90, 0x80, 0x33, 0xC0, 0x95, 0x14, 0x5B,
+	0x90, 0x0B, 0x57, 0xF5, 0x54, 0x99, 0xA6, 0x38,
+	0xB3, 0x2C, 0x57, 0x22, 0xA4, 0x0E, 0xFD, 0xCF,
+	0xD6, 0xF1, 0x4C, 0x85, 0x9D, 0xF2, 0xFD, 0xB1,
+	0x3C, 0x76, 0x24, 0x38, 0xF8, 0xBE, 0x09, 0x49,
+	0xFE, 0x69, 0x3F, 0x95, 0xA7, 0x74, 0xDA, 0x0C,
+	0x0C, 0x28, 0xBA, 0xF0, 0xA5, 0x73, 0xBB, 0xFB,
+	0x86, 0x75, 0xC7, 0xD7, 0x5F, 0x05, 0xD2, 0xEE,
+	0xF2, 0x6A, 0x4B, 0xB8, 0x02, 0x05, 0x63, 0xC5,
+	0xB7, 0x39, 0x52, 0xB3, 0xCB, 0xF7, 0x08, 0x71,
+	0xDF, 0xA5, 0x48, 0x8B, 0x2C, 0x31, 0x0E, 0xC8,
+	0x24, 0xD9, 0x04, 0xBB, 0xEF, 0x8C, 0x11, 0x00,
+	0x83, 0x28,
+
+	0x8F, 0x21, 0x79, 0x1F, 0x14, 0x98, 0xF2, 0x76,
+	0xF7, 0xC5, 0x1B, 0xDE, 0x43, 0x8C, 0x32, 0xBF,
+	0x9F, 0xF5, 0x94, 0xF9, 0x5E, 0x4B, 0x5C, 0x78,
+	0x82, 0xCF, 0xD5, 0xE7, 0x5A, 0x46, 0x8E, 0xF0,
+	0xC9, 0x20, 0xF8, 0x63, 0xF0, 0x1D, 0xA9, 0xD1,
+	0xBD, 0xC1, 0x51, 0x54, 0xEB, 0xE4, 0x5F, 0x9A,
+	0x2A, 0xD3, 0x11, 0xFB, 0xCB, 0xC4, 0x76, 0x6B,
+	0x20, 0x2A, 0x02, 0x2F, 0xD3, 0x35, 0xDD, 0x39,
+	0xB3, 0x61, 0xC8, 0x74, 0x13, 0x28, 0x08, 0xAB,
+	0xF2, 0xFF, 0xD3, 0x23, 0x90, 0x65, 0x0A, 0x03,
+	0x63, 0x8D, 0xA4, 0x21, 0x90, 0x4F, 0x66, 0xA7,
+	0x3B, 0xC8, 0x1C, 0x91, 0xFC, 0xE4, 0x26, 0x79,
+	0xFC, 0x64, 0x61, 0xBC, 0xF5, 0xD8, 0xD7, 0xCE,
+	0x60, 0x3B, 0x76, 0x18, 0x80, 0xE7, 0x4C, 0x69,
+	0x35, 0x6C, 0xB5, 0x82, 0x15, 0x93, 0x80, 0x3C,
+	0xA2, 0xB9, 0xF5, 0xBB, 0xC4, 0x63, 0xDE, 0x56,
+	0x42, 0xF3, 0x68, 0x08, 0x2C, 0x32, 0xC5, 0x2C,
+	0x1E, 0x78, 0xC7, 0xF9, 0xCB, 0x45, 0x2A, 0x08,
+	0x94, 0x2A, 0x39, 0x22, 0xE4, 0xD2, 0x5A, 0x5A,
+	0x45, 0xB0, 0x4D, 0xD5, 0xA1, 0xDD, 0xB3, 0xE7,
+	0x34, 0x39, 0x4C, 0x2B, 0x48, 0xB4, 0x4A, 0xC7,
+	0x43, 0x44, 0x3F, 0xB7, 0x0B, 0x9F, 0x15, 0xAA,
+	0xD1, 0x7A, 0x6D, 0xC0, 0xE1, 0xFE, 0x04, 0x9C,
+	0x74, 0x10, 0x13, 0xBA, 0x95, 0x04, 0x2F, 0x3D,
+	0x8D, 0x5C, 0xDE, 0xAE, 0x81, 0xC0, 0x47, 0xAC,
+	0x4B, 0x88, 0xAC, 0xF6, 0x2C, 0x8E, 0x92, 0xBA,
+	0xAC, 0x43, 0x0A, 0x16, 0x24, 0xB5, 0xD4, 0x22,
+	0x22, 0x96, 0xB3, 0x1C, 0xB0, 0xBF, 0x61, 0x41,
+	0x4D, 0x09, 0x65, 0xC6, 0x95, 0x5C, 0xF1, 0xDD,
+	0xC8, 0xF2, 0x3E, 0xF3, 0x40, 0x0D, 0x0C, 0x22,
+	0x5B, 0x4F, 0x12,
+
+	0x8F, 0x21, 0x79, 0x1F, 0x50, 0x98, 0xF2, 0x39,
+	0xF7, 0xC5, 0x1B, 0xDE, 0x43, 0x8C, 0x32, 0xBF,
+	0x6B, 0xF4, 0x94, 0xF9, 0xB4, 0xA2, 0x58, 0x9C,
+	0x06, 0x89, 0xCC, 0x47, 0x4A, 0xE6, 0xB7, 0xD1,
+	0xFD, 0xEB, 0x9A, 0xA5, 0xCB, 0xA2, 0x1B, 0x11,
+	0xB0, 0x54, 0xE0, 0x85, 0x9C, 0x56, 0x1C, 0xFB,
+	0xF2, 0xC3, 0x3C, 0xF8, 0x23, 0xDD, 0x50, 0xFF,
+	0x63, 0x23, 0x1D, 0xB9, 0xA2, 0x84, 0xCE, 0xA2,
+	0xB4, 0xEC, 0xEA, 0x3F, 0x08, 0xB5, 0x29, 0x70,
+	0x59, 0xA8, 0xB2, 0x56, 0xCA, 0x9E, 0x49, 0x00,
+	0xF8, 0x4F, 0x8E, 0x1B, 0xF4, 0x31, 0xCB, 0x85,
+	0xDB, 0xBB, 0xC7, 0x55, 0x7C, 0x98, 0x31, 0xE2,
+	0x3B, 0x41, 0x06, 0x9B, 0x49, 0x95, 0x11, 0xEF,
+	0x71, 0x86, 0x2D, 0xDA, 0x96, 0xB8, 0x74, 0x7D,
+	0xC5, 0x66, 0x3D, 0x6B, 0xD5, 0x41, 0x09, 0x83,
+	0x77, 0x3D, 0x5F, 0xEF, 0x88, 0x6A, 0x75, 0xA9,
+	0x72, 0x2B, 0x28, 0x98, 0xA1, 0xAE, 0xF6, 0x1C,
+	0xEE, 0x06, 0x4B, 0x93, 0x61, 0xA0, 0x0D, 0xEE,
+	0xB0, 0x2C, 0x76, 0xFE, 0xF9, 0xD4, 0xA0, 0x88,
+	0x97, 0xC4, 0x06, 0x54, 0x22, 0xF0, 0x5D, 0x55,
+	0x1B, 0xF3, 0x25, 0xDE, 0xE3, 0xCD, 0x68, 0xDD,
+	0xE6, 0x2B, 0x68, 0x71, 0xA9, 0xD2, 0x7E, 0xA8,
+	0x36, 0x1F, 0x3D, 0x48, 0xBC, 0x53, 0x95, 0x97,
+	0x4F, 0x1A, 0xF6, 0x31, 0x15, 0xB8, 0xFD, 0x1A,
+	0x0B, 0x06, 0x6B, 0xCF, 0x65, 0xC4, 0x41, 0xC7,
+	0x53, 0x08, 0x63, 0x73, 0xB9, 0x9F, 0xC6, 0x89,
+	0x20, 0x2C, 0x52, 0x4C, 0x7E, 0x36, 0xF2, 0xE1,
+	0xEF, 0x28, 0x37, 0x5E, 0x16, 0x49, 0xD2, 0x26,
+	0xBD, 0x03, 0x7C, 0x49, 0xC5, 0x47, 0x05, 0x38,
+	0x8E, 0x37, 0xE0, 0xF4, 0x2A, 0x3C, 0x93, 0xEE,
+	0x88, 0x08, 0xE1, 0x02,
+
+	0x8F, 0x21, 0x79, 0x1F, 0x50, 0x98, 0xD2, 0x2D,
+	0x58, 0xEC, 0x1B, 0x5E, 0x43, 0x8C, 0x32, 0xBF,
+	0x7F, 0x0C, 0x86, 0xAD, 0xBC, 0x02, 0x5C, 0x9C,
+	0x65, 0x75, 0x3C, 0x85, 0xC8, 0x61, 0x38, 0xC7,
+	0xFD, 0xD0, 0x88, 0xD6, 0xCC, 0x13, 0x26, 0x8E,
+	0xE9, 0x8E, 0x91, 0xD7, 0x3B, 0xA5, 0xE6, 0x97,
+	0xC0, 0x33, 0x8C, 0x33, 0x80, 0xFA, 0xD1, 0x4F,
+	0x9D, 0x58, 0xA0, 0x73, 0xC7, 0xD0, 0x52, 0x40,
+	0x1B, 0x96, 0xD0, 0xC8, 0xFE, 0x56, 0xBC, 0x6B,
+	0x6A, 0x5A, 0xE3, 0x9E, 0x6B, 0xA7, 0xDA, 0x3E,
+	0xC9, 0xFE, 0x77, 0xA0, 0xAF, 0xCD, 0x93, 0xA5,
+	0x6A, 0x22, 0x67, 0xB1, 0xE6, 0xBE, 0x04, 0x7F,
+	0xE0, 0x87, 0x45, 0xB0, 0x15, 0x3D, 0xD1, 0x52,
+	0x70, 0xF3, 0x21, 0xE5, 0x94, 0x5C, 0x91, 0x69,
+	0x85, 0x21, 0xEC, 0xAE, 0xA4, 0x01, 0x83, 0xA2,
+	0xAF, 0x6E, 0x36, 0x74, 0xF2, 0x36, 0xEC, 0x05,
+	0x88, 0x78, 0xE3, 0x49, 0x5D, 0xAA, 0x1F, 0xD4,
+	0x31, 0x97, 0x5F, 0xC8, 0x11, 0xE6, 0x4B, 0x57,
+	0xBE, 0xC0, 0xE8, 0x5A, 0xEA, 0xE1, 0x06, 0x63,
+	0x71, 0x32, 0xAD, 0x73, 0x8F, 0xE2, 0x97, 0xDC,
+	0x2A, 0x8F, 0x68, 0x33, 0xB2, 0x39, 0x1D, 0xD3,
+	0x66, 0x7D, 0xF1, 0x18, 0xE9, 0x10, 0x59, 0x66,
+	0xE8, 0x89, 0xE7, 0x12, 0x92, 0x36, 0x8A, 0xE8,
+	0xCD, 0x07, 0xA5, 0x73, 0x64, 0xB2, 0x64, 0x05,
+	0x52, 0x87, 0x12, 0x81, 0x17, 0xF5, 0x20, 0xE3,
+	0x66, 0xE0, 0x12, 0x03, 0x69, 0x70, 0xBC, 0xDD,
+	0xA5, 0x54, 0x31, 0x9E, 0xF6, 0x8D, 0xBC, 0xE0,
+	0x62, 0x76, 0x8C, 0x3E, 0x57, 0xB9, 0xCC, 0xB0,
+	0x83, 0xC8, 0x99, 0xBB, 0x8A, 0x66, 0x29, 0xC1,
+	0x1D, 0x81, 0xAF, 0xEF, 0x0D, 0x05, 0xDB, 0xB3,
+	0x6A, 0xF8, 0x93, 0x17, 0x0B,
+
+	0x8F, 0x21, 0x79, 0x1F, 0x5C, 0x98, 0xD2, 0x2D,
+	0x58, 0xFC, 0x1B, 0x5E, 0x43, 0x8C, 0x32, 0xBF,
+	0xFF, 0x04, 0x86, 0xAC, 0xBC, 0x02, 0x5C, 0x9C,
+	0x64, 0xF7, 0x3E, 0x85, 0xCE, 0x61, 0xB0, 0xCF,
+	0xBD, 0xF2, 0x8A, 0x6B, 0x5E, 0x57, 0x73, 0xCE,
+	0x85, 0x8E, 0x25, 0xC3, 0x33, 0x15, 0xCB, 0xB7,
+	0x6C, 0x70, 0xCE, 0xB9, 0xCA, 0xF5, 0xFA, 0xCD,
+	0xA0, 0x92, 0x92, 0x1A, 0x8C, 0xD9, 0x7E, 0xC4,
+	0xF0, 0x6D, 0xBF, 0xA4, 0x6C, 0x54, 0x0F, 0x04,
+	0x2D, 0xE8, 0x0A, 0x0A, 0xFA, 0x47, 0x5F, 0xC0,
+	0xB3, 0x6C, 0xF7, 0x49, 0xD6, 0x84, 0xCB, 0xFE,
+	0xF5, 0xAA, 0xE8, 0x59, 0x80, 0x6A, 0x83, 0x08,
+	0xA3, 0x9A, 0x00, 0x75, 0x38, 0x49, 0xBD, 0x0D,
+	0x31, 0xD3, 0x83, 0x89, 0x40, 0x80, 0x50, 0x2F,
+	0xF3, 0x2D, 0x02, 0xCB, 0x9E, 0xBD, 0xB9, 0x5A,
+	0x53, 0x46, 0xD6, 0x35, 0x4F, 0xA1, 0xB6, 0x3F,
+	0xF2, 0x35, 0xEC, 0x0A, 0xF2, 0xA0, 0x09, 0x78,
+	0x4D, 0x53, 0xBD, 0x00, 0x00, 0xD6, 0x38, 0x31,
+	0xF0, 0x92, 0x43, 0xF0, 0x87, 0x0A, 0xA7, 0x20,
+	0x40, 0x4C, 0xD6, 0x65, 0x04, 0xD2, 0x21, 0xF3,
+	0x75, 0xD4, 0xC5, 0x18, 0x78, 0x14, 0x27, 0xBA,
+	0xA7, 0x2F, 0xA1, 0x37, 0xDE, 0x31, 0x59, 0xF2,
+	0xA6, 0xAA, 0xC8, 0xCE, 0x9F, 0x06, 0xFF, 0xC8,
+	0x0A, 0x12, 0xD8, 0xC4, 0xBA, 0x23, 0x78, 0x70,
+	0x7E, 0x60, 0x83, 0x1B, 0xDA, 0xCC, 0x3C, 0x02,
+	0xEF, 0xC0, 0xC3, 0xF2, 0xFB, 0xD2, 0xDC, 0x4D,
+	0x2F, 0xBB, 0xDC, 0xAA, 0x54, 0xEA, 0xAB, 0x4C,
+	0x09, 0x36, 0x7E, 0xB3, 0x5E, 0xBF, 0xE0, 0xC9,
+	0xF9, 0x92, 0x68, 0x9C, 0xF2, 0x9F, 0xA2, 0x8C,
+	0x55, 0x0F, 0x61, 0xB9, 0x68, 0x31, 0xDC, 0xD2,
+	0xF0, 0x7F, 0x04, 0xE0, 0xC6, 0x4A,
+
+	0x8F, 0x21, 0x79, 0x1F, 0x3C, 0xB8, 0xD2, 0x2D,
+	0x58, 0x7C, 0x6B, 0x4E, 0x43, 0x8C, 0x32, 0xBF,
+	0xFB, 0xC8, 0xBE, 0xAC, 0xBA, 0x02, 0x5C, 0x8C,
+	0x6B, 0x74, 0x6A, 0x1F, 0xFF, 0x70, 0xF0, 0xDF,
+	0x57, 0xD4, 0xF2, 0xB6, 0xBE, 0x1D, 0xAA, 0xA9,
+	0x42, 0x23, 0x2E, 0x4E, 0xBB, 0xA2, 0x2E, 0x5C,
+	0x09, 0x88, 0xB6, 0xF0, 0x3E, 0xD8, 0xCD, 0x0D,
+	0xEA, 0x0A, 0xCB, 0xD6, 0x93, 0xD3, 0x9B, 0x9F,
+	0x61, 0xB2, 0x7E, 0x8B, 0xC9, 0xF5, 0x02, 0x22,
+	0xCA, 0x4B, 0xD9, 0xEF, 0x49, 0x73, 0xE6, 0x2C,
+	0x8D, 0x29, 0x8E, 0xF1, 0xEF, 0x32, 0xD2, 0x26,
+	0x14, 0xDD, 0x08, 0x3A, 0x92, 0x28, 0xB7, 0xFE,
+	0x63, 0x9C, 0xB0, 0x5C, 0xDB, 0xE7, 0xE8, 0xCA,
+	0xD3, 0x95, 0x11, 0x7A, 0xE0, 0xC6, 0xA7, 0x66,
+	0xE1, 0x56, 0xDF, 0x2E, 0x5A, 0x1F, 0x33, 0x26,
+	0xB6, 0x46, 0x33, 0x8C, 0x3E, 0xA5, 0x9B, 0xDE,
+	0xD0, 0x95, 0x02, 0xB3, 0xAA, 0x98, 0x85, 0xCE,
+	0x34, 0x2D, 0x44, 0x0D, 0xAC, 0x34, 0xD7, 0x7D,
+	0xCD, 0xDC, 0x7F, 0x11, 0x20, 0xBB, 0xDE, 0x0C,
+	0xAB, 0xF3, 0xC1, 0xDC, 0x0B, 0x0A, 0x6C, 0x70,
+	0x2C, 0xE8, 0xE2, 0x91, 0x7E, 0xB0, 0x8A, 0xC5,
+	0x5D, 0x69, 0xA9, 0x02, 0x48, 0x2A, 0xAA, 0x77,
+	0x7D, 0xD6, 0x7A, 0xE3, 0xE3, 0x6E, 0xA4, 0xAD,
+	0xA4, 0x8E, 0xA0, 0x17, 0xA6, 0x0B, 0x7A, 0x0F,
+	0xF8, 0x13, 0x9B, 0xC1, 0xAD, 0xFD, 0xA2, 0x89,
+	0x48, 0x9D, 0x76, 0x73, 0xC5, 0xF2, 0xB0, 0x2B,
+	0x69, 0xBE, 0x17, 0x4E, 0x82, 0xB1, 0x84, 0xD3,
+	0xC8, 0xD9, 0xC4, 0xE4, 0x36, 0xF1, 0xC6, 0x9E,
+	0x0E, 0x0B, 0x28, 0x34, 0xF0, 0x61, 0x2B, 0xED,
+	0x74, 0xF1, 0xF1, 0x6A, 0xB8, 0xB7, 0xC0, 0x05,
+	0x83, 0x7B, 0x0A, 0x19, 0xD7, 0x79, 0xFE,
+
+	0x8F, 0x21, 0x79, 0x1F, 0x3C, 0xB8, 0xFA, 0x2D,
+	0x08, 0x7C, 0x6B, 0x1F, 0x43, 0x8C, 0x32, 0xBF,
+	0xFB, 0xED, 0xB6, 0x83, 0xBA, 0x0A, 0x5C, 0x8C,
+	0x69, 0x62, 0x6F, 0x9B, 0xFF, 0x5A, 0xBE, 0xD1,
+	0x98, 0x62, 0x81, 0x43, 0xB6, 0x17, 0x07, 0x99,
+	0x81, 0x9A, 0x5D, 0x43, 0xDE, 0x12, 0x99, 0x01,
+	0x8E, 0x0B, 0xEB, 0xD8, 0xD1, 0x1A, 0x71, 0xC5,
+	0x34, 0xCE, 0x54, 0x86, 0x17, 0x4B, 0x1B, 0x6B,
+	0x15, 0x28, 0xF7, 0xFA, 0x99, 0x53, 0x70, 0x65,
+	0x73, 0x6F, 0xCF, 0xDB, 0xB0, 0x76, 0x59, 0xDC,
+	0x43, 0x5B, 0x08, 0xF7, 0xC4, 0x39, 0xBB, 0x8F,
+	0xF0, 0x43, 0x5B, 0x4C, 0xF7, 0x37, 0x07, 0xF1,
+	0x9E, 0xFA, 0x3B, 0x07, 0xF0, 0x02, 0x7B, 0xE3,
+	0xC7, 0x96, 0x4D, 0xD2, 0x9D, 0x93, 0x62, 0x65,
+	0x3C, 0xC0, 0xC0, 0xEA, 0xE3, 0x14, 0xB8, 0x59,
+	0xD7, 0x2C, 0xCD, 0xC6, 0x8C, 0xDE, 0x7E, 0xED,
+	0xDE, 0x84, 0x65, 0x80, 0xFB, 0x8B, 0xB4, 0xCE,
+	0x5A, 0x24, 0xA4, 0x54, 0xE7, 0x43, 0xA5, 0x6B,
+	0xD9, 0x44, 0xF4, 0x5A, 0x2B, 0xCF, 0xA9, 0xA6,
+	0x22, 0x5E, 0xDE, 0xE6, 0x2D, 0x5C, 0x8E, 0x26,
+	0xF9, 0xA6, 0x89, 0xAF, 0x19, 0x6B, 0xF5, 0x1F,
+	0x69, 0x7D, 0xD1, 0x60, 0x43, 0xD1, 0x61, 0x8B,
+	0x3D, 0x35, 0xAC, 0x6F, 0xFF, 0xA9, 0x26, 0x51,
+	0xDB, 0x6E, 0xBD, 0x7C, 0x7C, 0xE6, 0x79, 0x2F,
+	0xF0, 0x9B, 0x93, 0xEB, 0x8D, 0x5E, 0x13, 0xFE,
+	0x3F, 0x76, 0x38, 0x01, 0xDB, 0xEE, 0x62, 0x28,
+	0xB3, 0xD6, 0xF0, 0x24, 0x4B, 0x95, 0x7E, 0xBE,
+	0xDB, 0x01, 0x9D, 0x72, 0x05, 0x5D, 0x4D, 0x42,
+	0x07, 0x1A, 0x4C, 0x55, 0xCA, 0xD9, 0xDB, 0xD3,
+	0xD3, 0x93, 0x27, 0x7B, 0xA6, 0x31, 0xEC, 0x65,
+	0xB7, 0x12, 0x03, 0xD7, 0x8B, 0x28, 0x24, 0xB3,
+
+	0x8F, 0x21, 0x79, 0x1F, 0x3C, 0xB8, 0xFA, 0x2D,
+	0x08, 0x7C, 0xEB, 0x0B, 0x72, 0xAD, 0x32, 0x3F,
+	0x2B, 0x7D, 0xC6, 0x83, 0xA3, 0x1A, 0x5E, 0xC4,
+	0x61, 0xB4, 0x6B, 0x9B, 0xCB, 0x41, 0xA4, 0xC1,
+	0xE9, 0x31, 0x13, 0x5C, 0xC3, 0x9A, 0xB7, 0x53,
+	0x82, 0x79, 0xDE, 0x21, 0x6E, 0xA4, 0xA1, 0xFA,
+	0x02, 0x4C, 0x98, 0x96, 0x89, 0x08, 0xDE, 0xFC,
+	0x52, 0x17, 0xA3, 0x1A, 0x4B, 0x36, 0x0F, 0x66,
+	0x30, 0xF8, 0x9F, 0x72, 0xBB, 0x06, 0xE2, 0x9A,
+	0x57, 0x1E, 0xE4, 0x61, 0x9F, 0x30, 0xC9, 0xF3,
+	0xED, 0x49, 0xA6, 0xFD, 0xC4, 0x4B, 0xF3, 0x18,
+	0x05, 0x13, 0x85, 0xB4, 0x4F, 0x9D, 0xF5, 0x26,
+	0xA3, 0xA8, 0xFB, 0xEC, 0x55, 0x93, 0x6C, 0xBE,
+	0x36, 0x84, 0x2A, 0xBC, 0xE3, 0x0D, 0xDD, 0x30,
+	0x2A, 0x49, 0xE2, 0xEE, 0x59, 0xDF, 0x23, 0xD5,
+	0xA7, 0xE7, 0xFE, 0x20, 0xEE, 0xB0, 0x4A, 0x77,
+	0xA5, 0xD6, 0x0E, 0x4D, 0x31, 0x49, 0x5D, 0xBA,
+	0x96, 0x42, 0x00, 0xC3, 0xE4, 0xA7, 0xC9, 0x77,
+	0x3E, 0x06, 0x2C, 0x4A, 0x4D, 0x34, 0xA0, 0xE0,
+	0x3F, 0x05, 0x78, 0x52, 0xB3, 0xC2, 0x53, 0xFB,
+	0x52, 0xD1, 0xEA, 0x80, 0xFA, 0x4E, 0x76, 0x9E,
+	0x70, 0x33, 0xEB, 0x9F, 0xC7, 0x4E, 0x77, 0x3C,
+	0xA2, 0x37, 0xE4, 0x88, 0x2C, 0x4D, 0xCF, 0x94,
+	0x88, 0x21, 0x13, 0xE5, 0x23, 0x40, 0x03, 0x48,
+	0x27, 0x53, 0x21, 0x8C, 0x3A, 0xD0, 0x2D, 0xC6,
+	0xBB, 0x5E, 0x76, 0x60, 0xE7, 0x47, 0xE8, 0x53,
+	0x29, 0x99, 0x2D, 0x04, 0x1A, 0x58, 0xE6, 0xD4,
+	0xD6, 0xF4, 0xF3, 0xE1, 0x0C, 0x1A, 0x02, 0xFE,
+	0xB6, 0x06, 0xA1, 0xFF, 0xE3, 0xEF, 0x6D, 0x4D,
+	0x4C, 0xBD, 0x55, 0x61, 0x6B, 0x5C, 0x10, 0x8D,
+	0xA6, 0x35, 0xF9, 0x35, 0xF5, 0x06, 0x68, 0x2E,
+	0x14,
+
+	0x8F, 0x21, 0x79, 0x1F, 0x3C, 0xB8, 0xFA, 0x2D,
+	0x00, 0x7C, 0xEB, 0x0B, 0x72, 0xBF, 0x22, 0x3F,
+	0x2A, 0x3D, 0xC6, 0x22, 0x11, 0x12, 0x7E, 0xCD,
+	0x61, 0x34, 0x2B, 0x99, 0xCB, 0xD1, 0x26, 0xE2,
+	0xD8, 0xE1, 0x37, 0x51, 0x82, 0xB8, 0xB6, 0xF7,
+	0x60, 0x34, 0x0F, 0x18, 0x8F, 0xAA, 0x96, 0xEF,
+	0x0F, 0xC1, 0x79, 0x31, 0xC6, 0x55, 0x43, 0x28,
+	0xC0, 0x17, 0x2F, 0xDD, 0x3F, 0x7B, 0xD1, 0x8A,
+	0x93, 0x0B, 0x10, 0x5B, 0xEE, 0x38, 0x86, 0xA1,
+	0xDC, 0x0A, 0x17, 0x3D, 0x0A, 0x6F, 0x8D, 0x0B,
+	0xFA, 0xB4, 0xCF, 0x7B, 0xD6, 0x12, 0x9F, 0x59,
+	0x70, 0x5E, 0xAB, 0x2A, 0x5A, 0x5A, 0xCA, 0x89,
+	0x82, 0xFF, 0xDE, 0x9E, 0x6F, 0x55, 0x11, 0x7B,
+	0x70, 0x55, 0xE0, 0x29, 0xC1, 0x51, 0xC1, 0x6F,
+	0x6D, 0x69, 0xD3, 0x60, 0x70, 0x73, 0x49, 0x92,
+	0x54, 0x6C, 0x38, 0xDE, 0x04, 0xE6, 0x39, 0x3D,
+	0xB8, 0x95, 0x43, 0xF6, 0xFD, 0x1D, 0xF6, 0xCD,
+	0x0B, 0x4E, 0x0A, 0x5F, 0x32, 0xD1, 0xF2, 0x00,
+	0x39, 0x24, 0x1D, 0x6B, 0x59, 0x30, 0xD0, 0x58,
+	0x5C, 0xAD, 0xD1, 0x23, 0x80, 0xCB, 0x4E, 0xDF,
+	0xE8, 0x2E, 0xC2, 0x97, 0x75, 0xE2, 0x57, 0x86,
+	0xBE, 0x03, 0xF8, 0x08, 0x06, 0xAE, 0x3C, 0xE7,
+	0xC6, 0xD7, 0xDF, 0xB3, 0x1F, 0x04, 0x33, 0xE8,
+	0x08, 0x37, 0x13, 0x12, 0x7A, 0xB9, 0x8A, 0x6C,
+	0x7F, 0x6B, 0x87, 0xDD, 0xE5, 0x1F, 0x07, 0x0D,
+	0xB5, 0x5B, 0x4F, 0xA3, 0x69, 0xC4, 0x5C, 0x99,
+	0x38, 0xD1, 0xFD, 0x7A, 0xCB, 0x6B, 0x32, 0x04,
+	0xA4, 0x70, 0xBB, 0x7D, 0x68, 0xAB, 0x3E, 0x92,
+	0x21, 0xCC, 0xF0, 0x28, 0x46, 0x0D, 0xEE, 0x64,
+	0x28, 0xD8, 0x7F, 0x9D, 0x15, 0xE9, 0x4B, 0xAD,
+	0x8E, 0x95, 0x15, 0xA9, 0xEA, 0x64, 0x5B, 0x02,
+	0xD1, 0xA0,
+
+	0x8F, 0x21, 0x79, 0x1F, 0x3C, 0xB8, 0xFA, 0x2D,
+	0x60, 0x58, 0xEB, 0x0B, 0x72, 0x3F, 0x50, 0x0D,
+	0x29, 0xBB, 0xA6, 0x22, 0x03, 0xEE, 0x41, 0xED,
+	0x6B, 0x34, 0x3B, 0x80, 0xE1, 0xC2, 0x7E, 0x8A,
+	0xFB, 0x66, 0xC7, 0xF9, 0x18, 0xBD, 0x5B, 0x91,
+	0xA2, 0x08, 0x4F, 0x87, 0xC2, 0x3E, 0xDB, 0x5C,
+	0xCC, 0x82, 0x41, 0x05, 0x90, 0x94, 0xB6, 0x80,
+	0x9A, 0xBC, 0x82, 0xD0, 0x10, 0x21, 0xB7, 0xD5,
+	0x7E, 0xE1, 0x66, 0x7E, 0xFD, 0x04, 0xA1, 0xAB,
+	0x6F, 0xAF, 0x1E, 0x44, 0x77, 0x04, 0x9D, 0x53,
+	0x59, 0xFB, 0x72, 0xF3, 0xF3, 0xFB, 0x41, 0xC6,
+	0xF4, 0xE2, 0xE1, 0xD1, 0x3D, 0xCD, 0xB4, 0xA2,
+	0x12, 0x62, 0x24, 0xC6, 0x05, 0x6B, 0x2E, 0x88,
+	0x24, 0x9D, 0x6D, 0xFA, 0xA7, 0xC9, 0xD8, 0x62,
+	0xFE, 0xFF, 0x27, 0x26, 0x01, 0x5D, 0x35, 0xF3,
+	0x25, 0xB8, 0x4F, 0x85, 0xB6, 0x66, 0x43, 0x40,
+	0x1D, 0xC1, 0x40, 0xC5, 0xB1, 0x6D, 0x0B, 0xCC,
+	0x83, 0x7B, 0xCB, 0xF1, 0x9C, 0x91, 0x7B, 0x90,
+	0x0F, 0x0A, 0x7F, 0x33, 0xAD, 0xDE, 0xF8, 0xCC,
+	0xE4, 0x11, 0xA4, 0x1F, 0x8B, 0x2A, 0x94, 0x57,
+	0x70, 0x05, 0xC9, 0x5E, 0x45, 0xB5, 0xA1, 0x81,
+	0xAD, 0x7E, 0xD0, 0x33, 0x2B, 0x42, 0xD0, 0xD2,
+	0x47, 0x97, 0xAB, 0x9A, 0xE4, 0x42, 0xFC, 0x93,
+	0xFF, 0x48, 0xC4, 0x5D, 0x70, 0x2F, 0x95, 0x18,
+	0x46, 0x41, 0xB8, 0xA3, 0xD4, 0xB4, 0xF0, 0x92,
+	0x4B, 0xCA, 0xDE, 0x7D, 0x1E, 0x62, 0xBE, 0x13,
+	0x52, 0x45, 0xD9, 0xB8, 0x79, 0x88, 0xB0, 0x41,
+	0x2A, 0xD5, 0x4F, 0x93, 0x3B, 0x1E, 0x9E, 0x93,
+	0x3A, 0x80, 0x7A, 0x09, 0x14, 0x80, 0xBF, 0x50,
+	0x8C, 0x07, 0x7E, 0x8E, 0xE0, 0x22, 0xEF, 0x8F,
+	0x9F, 0xA9, 0xCB, 0x70, 0x79, 0x19, 0xC2, 0x72,
+	0x7C, 0x82, 0x28,
+
+	0x8F, 0x21, 0x79, 0x1F, 0x3C, 0xB8, 0xFA, 0x2D,
+	0x60, 0x58, 0xFE, 0x0B, 0x60, 0x3F, 0x50, 0x5E,
+	0xA0, 0xF3, 0x25, 0xC6, 0x03, 0xAB, 0x4A, 0xC3,
+	0x63, 0x3E, 0x3B, 0x88, 0x11, 0xFE, 0x7B, 0x8C,
+	0x71, 0x88, 0xDA, 0x0A, 0x1B, 0x69, 0xE8, 0x62,
+	0xE7, 0xA9, 0x69, 0xDF, 0x14, 0xF4, 0x7B, 0x13,
+	0x1F, 0xAE, 0xB7, 0x70, 0x37, 0xFF, 0xAC, 0x36,
+	0x5B, 0x28, 0xD2, 0x10, 0x20, 0x28, 0x55, 0xD4,
+	0x68, 0xF1, 0xDB, 0x00, 0xEA, 0x2B, 0x25, 0xF4,
+	0x44, 0x0C, 0x8C, 0xD0, 0xD2, 0x2A, 0xBE, 0x56,
+	0xC6, 0x14, 0xCE, 0xB2, 0x54, 0xA8, 0xC4, 0x4B,
+	0x19, 0x16, 0x75, 0xDD, 0x6A, 0x00, 0x21, 0x4B,
+	0xA5, 0x5A, 0xFE, 0xA9, 0x48, 0xE2, 0x81, 0x66,
+	0x47, 0x37, 0x0B, 0xA3, 0xCC, 0xA5, 0xF0, 0x89,
+	0xEC, 0x66, 0x8C, 0x4D, 0xAE, 0x7F, 0xBE, 0x07,
+	0x77, 0xBA, 0xAE, 0x50, 0x72, 0x8C, 0x45, 0xF8,
+	0xB2, 0x0A, 0x3E, 0xFA, 0xE7, 0x29, 0xBD, 0xC4,
+	0xAF, 0x8E, 0xE1, 0x48, 0x74, 0x90, 0x4A, 0x67,
+	0x27, 0x3D, 0x20, 0xDF, 0xAD, 0x1B, 0x72, 0x0E,
+	0x84, 0xA8, 0x50, 0xFD, 0xF1, 0x4A, 0x28, 0x03,
+	0xAB, 0xAC, 0x7D, 0x10, 0x87, 0x2A, 0x80, 0xDC,
+	0x72, 0x43, 0x85, 0x8E, 0x64, 0xD8, 0x57, 0x13,
+	0x51, 0x9D, 0x62, 0x53, 0xBF, 0x09, 0x71, 0x48,
+	0xDC, 0x95, 0x8C, 0xBD, 0xF3, 0x4C, 0x13, 0x45,
+	0xDA, 0x19, 0x09, 0x8F, 0x38, 0xBC, 0x60, 0x50,
+	0x0A, 0xA3, 0x5B, 0x81, 0x18, 0x02, 0x70, 0x19,
+	0x98, 0x88, 0x6A, 0x99, 0x8D, 0xC7, 0x0A, 0x7C,
+	0x54, 0x85, 0x3A, 0x39, 0x93, 0xE4, 0xA1, 0x36,
+	0x2A, 0x1B, 0x55, 0x74, 0xB6, 0xED, 0xE2, 0x27,
+	0xBC, 0xD4, 0x7F, 0xA1, 0xE0, 0x12, 0x4A, 0xBA,
+	0xFF, 0x91, 0xF9, 0x32, 0x87, 0x2C, 0x01, 0x35,
+	0x60, 0x5C, 0xB1, 0x8D,
+
+	0x3C, 0x13, 0x79, 0x9F, 0x3C, 0xB8, 0xFA, 0x2D,
+	0x60, 0x58, 0xFE, 0x0B, 0x60, 0x3F, 0x50, 0x4E,
+	0xB0, 0xCD, 0x1E, 0xA5, 0x5F, 0x3B, 0x73, 0xC5,
+	0x78, 0x06, 0x28, 0xEA, 0x85, 0xF9, 0x7F, 0x8C,
+	0xFF, 0x5A, 0x83, 0x4A, 0xAD, 0xA9, 0xEA, 0xF9,
+	0x55, 0xA6, 0x2C, 0x3E, 0x43, 0x1B, 0x63, 0x43,
+	0x17, 0x00, 0x35, 0xF8, 0x88, 0xD1, 0x43, 0xFF,
+	0xD8, 0xE5, 0x17, 0xE7, 0x42, 0xD1, 0xEE, 0xBD,
+	0xDB, 0x61, 0x86, 0x3B, 0x04, 0xBB, 0x33, 0xEC,
+	0xF3, 0xBB, 0x92, 0xEA, 0x5F, 0xDC, 0x7B, 0xD2,
+	0x66, 0x6F, 0xD3, 0x80, 0x4B, 0x2E, 0x00, 0x0E,
+	0xFB, 0x45, 0xC8, 0x1E, 0x57, 0x18, 0xF7, 0x12,
+	0x55, 0x8F, 0x64, 0x13, 0x96, 0xC9, 0x75, 0xAC,
+	0x6D, 0xF6, 0x49, 0x09, 0x13, 0x0E, 0xE9, 0xB3,
+	0xB5, 0x47, 0x85, 0x9F, 0xCA, 0xE4, 0x81, 0x22,
+	0x2F, 0xC4, 0x5B, 0xCE, 0x6F, 0xD2, 0xE4, 0x41,
+	0x86, 0xCA, 0xE4, 0x92, 0xB8, 0xA0, 0x31, 0x24,
+	0x16, 0x94, 0x87, 0x7E, 0xBB, 0xAE, 0xC1, 0x61,
+	0x43, 0x76, 0xED, 0x9F, 0x7D, 0x66, 0x92, 0x41,
+	0x62, 0xCC, 0xE0, 0xB1, 0x71, 0x9F, 0xFF, 0x59,
+	0xB1, 0xBF, 0x89, 0x94, 0x27, 0x6A, 0x32, 0xEF,
+	0x13, 0x54, 0xE5, 0xEC, 0xF6, 0x98, 0x8C, 0xC4,
+	0x5C, 0xD2, 0x89, 0x47, 0x7D, 0xC6, 0x34, 0xC2,
+	0x85, 0x80, 0x6E, 0xCA, 0xE6, 0xC2, 0x77, 0xA1,
+	0x8A, 0xD4, 0x9B, 0xB6, 0xDA, 0x43, 0x4D, 0xF5,
+	0xE0, 0xAC, 0x22, 0x30, 0xD3, 0xD2, 0xC6, 0xEA,
+	0x97, 0xBC, 0xB7, 0x3C, 0x1E, 0x2F, 0x5F, 0x5C,
+	0x7F, 0x68, 0x02, 0xC1, 0x26, 0x1C, 0xAF, 0xDD,
+	0x4A, 0xE2, 0xEF, 0x11, 0xCC, 0xE9, 0x1C, 0x25,
+	0x2E, 0x4A, 0x6B, 0xC0, 0x3D, 0x94, 0xD5, 0x9C,
+	0x52, 0xFE, 0x10, 0x53, 0x84, 0xC4, 0xA8, 0xF8,
+	0xA3, 0x0C, 0xE8, 0x55, 0x16,
+
+	0x3C, 0x07, 0x7D, 0x9F, 0x3C, 0xB8, 0xFA, 0x2D,
+	0x60, 0x58, 0xFE, 0x0B, 0x64, 0x3F, 0x50, 0x4E,
+	0xB1, 0x1D, 0xFC, 0xE5, 0x5A, 0x3A, 0x7B, 0x45,
+	0x98, 0x0D, 0x28, 0xC2, 0x85, 0xD9, 0x7F, 0x8E,
+	0xAA, 0x6F, 0x0B, 0x67, 0x70, 0xBD, 0x80, 0x7C,
+	0x59, 0x5E, 0x60, 0x70, 0xD1, 0xFD, 0x3F, 0x57,
+	0xA7, 0x5E, 0x10, 0x64, 0xA1, 0xFB, 0x4C, 0x77,
+	0x16, 0x70, 0x77, 0x21, 0x42, 0xEF, 0x33, 0x37,
+	0xC6, 0xF7, 0x00, 0x02, 0x1F, 0xFA, 0xD4, 0xEF,
+	0x71, 0x02, 0xC2, 0xF8, 0x0D, 0x28, 0xA7, 0xA3,
+	0x33, 0x08, 0xB7, 0xE7, 0xB1, 0x9D, 0x94, 0xF6,
+	0x12, 0x13, 0xDD, 0xDA, 0x8C, 0xBE, 0xA5, 0xDB,
+	0x90, 0xAD, 0x59, 0xF0, 0x2E, 0x2C, 0x2D, 0x81,
+	0xBB, 0xF7, 0x50, 0x84, 0x56, 0xEC, 0x55, 0xC3,
+	0xE1, 0x15, 0x99, 0x72, 0xA8, 0xE3, 0x09, 0xB2,
+	0x00, 0xE6, 0x20, 0x29, 0x6D, 0x32, 0x9C, 0xB9,
+	0x03, 0x5E, 0x67, 0x05, 0x2F, 0x54, 0x15, 0xB9,
+	0xA2, 0x9E, 0xA6, 0xC5, 0x83, 0x3D, 0x73, 0xCF,
+	0x2F, 0x67, 0xC4, 0x34, 0x6E, 0x34, 0x83, 0xF1,
+	0x4F, 0x42, 0xD6, 0x27, 0x5A, 0x87, 0x6F, 0x33,
+	0x6E, 0xCF, 0xAB, 0x74, 0xFE, 0x91, 0xC7, 0xC1,
+	0x6F, 0xDF, 0x57, 0x27, 0xA5, 0xAE, 0x3B, 0xCA,
+	0x14, 0xE0, 0x89, 0x8E, 0xCD, 0xD2, 0xA2, 0xD0,
+	0x01, 0x6C, 0x83, 0xC4, 0xB0, 0x7E, 0x89, 0x06,
+	0x08, 0xE1, 0x7E, 0xF1, 0x43, 0x32, 0x32, 0x2E,
+	0x58, 0x8B, 0x4B, 0x73, 0x9D, 0x40, 0x25, 0xC5,
+	0x68, 0x5E, 0xC9, 0xA5, 0xBE, 0x20, 0x82, 0xDF,
+	0x46, 0xD5, 0x4D, 0xB5, 0xAF, 0x5C, 0x54, 0xFE,
+	0xD1, 0x26, 0xA9, 0x1C, 0xBF, 0x89, 0xBE, 0xBF,
+	0xE3, 0x55, 0xE8, 0xD8, 0x91, 0xD5, 0xA1, 0x32,
+	0xE2, 0x73, 0xD2, 0xB4, 0x86, 0x10, 0xAD, 0x32,
+	0x4D, 0xA3, 0x7B, 0x4D, 0x90, 0xDD,
+
+	0x3C, 0x87, 0x09, 0xFF, 0x3C, 0xB8, 0xFA, 0x2D,
+	0x60, 0x58, 0xFE, 0x0B, 0x04, 0x1B, 0x50, 0x4E,
+	0xBF, 0x1A, 0x9F, 0x5B, 0x90, 0x82, 0x6A, 0x74,
+	0xA6, 0xE1, 0x16, 0x8A, 0x86, 0xD9, 0xFB, 0xCF,
+	0x8B, 0xB7, 0x72, 0xA9, 0x36, 0xC4, 0x0A, 0xC7,
+	0xB6, 0x97, 0x00, 0x23, 0xEF, 0x13, 0x88, 0xBF,
+	0xD6, 0x0B, 0xBA, 0xD6, 0xB8, 0x37, 0xD9, 0xB8,
+	0x59, 0xAE, 0x32, 0xA2, 0x44, 0xC3, 0x6B, 0x10,
+	0x62, 0x84, 0x00, 0xBC, 0x42, 0x1F, 0x40, 0x8B,
+	0x76, 0xE0, 0x58, 0x05, 0x5A, 0x76, 0x27, 0x09,
+	0xAF, 0xF3, 0x6E, 0x80, 0x1C, 0x40, 0xF3, 0x97,
+	0xFA, 0x26, 0xBD, 0x51, 0x2D, 0xC3, 0x2D, 0xE1,
+	0x25, 0xFD, 0x7E, 0x20, 0xB9, 0xCE, 0x4C, 0xFB,
+	0x0E, 0x66, 0xBA, 0xE1, 0x73, 0x66, 0x51, 0x6F,
+	0x2F, 0xE5, 0xE0, 0xC9, 0x92, 0xE0, 0x15, 0xFD,
+	0x98, 0x1E, 0x87, 0xF3, 0x17, 0x41, 0x51, 0x7F,
+	0x10, 0xBD, 0xE4, 0xF2, 0xD1, 0x1B, 0x77, 0xD9,
+	0x85, 0x17, 0xD7, 0x30, 0x24, 0xE6, 0x15, 0x0A,
+	0xDB, 0xAC, 0x45, 0x1B, 0x00, 0x94, 0x69, 0xE4,
+	0x5A, 0xFD, 0x74, 0xB1, 0xEF, 0xC0, 0xA8, 0xB1,
+	0x7B, 0x55, 0xB7, 0xF1, 0x61, 0x07, 0x88, 0x86,
+	0xAE, 0xCD, 0x2B, 0x8B, 0xAF, 0xC1, 0x39, 0x2C,
+	0x13, 0x34, 0xA0, 0x82, 0x6A, 0x4C, 0x1E, 0x15,
+	0x74, 0x5F, 0x15, 0x47, 0xF9, 0x6D, 0x76, 0xED,
+	0x60, 0x69, 0xEE, 0x62, 0x33, 0x59, 0xE2, 0xE5,
+	0xF6, 0x0A, 0xAE, 0x72, 0xA1, 0x1E, 0xA6, 0x5C,
+	0xAF, 0xAF, 0x1C, 0x3E, 0x45, 0xE9, 0x4F, 0xFF,
+	0x58, 0xE7, 0x7F, 0x41, 0xEE, 0xD6, 0xED, 0xAA,
+	0x32, 0x18, 0x7B, 0x93, 0x3F, 0xE3, 0x7B, 0x2D,
+	0x36, 0x62, 0x6B, 0x95, 0xCF, 0x7C, 0x87, 0x3D,
+	0x51, 0x67, 0x86, 0xAD, 0x6E, 0xAD, 0xE3, 0x79,
+	0x12, 0xCF, 0x55, 0x47, 0x0C, 0x32, 0x3F,
+
+	0x3D, 0x87, 0x09, 0xAA, 0x3C, 0xB8, 0xFA, 0x2D,
+	0x60, 0x58, 0xFE, 0x0B, 0x04, 0xDB, 0x5E, 0x4E,
+	0x1D, 0x49, 0x9E, 0xCF, 0x1A, 0xCA, 0x62, 0xB4,
+	0xA6, 0xEF, 0x7E, 0xA3, 0xB0, 0xD2, 0xFB, 0x6F,
+	0xC3, 0xB9, 0xA9, 0x5E, 0x0A, 0x19, 0x83, 0x57,
+	0xA3, 0x3B, 0xF4, 0x75, 0x5D, 0x9C, 0x6A, 0x0D,
+	0x3A, 0x17, 0x10, 0x08, 0x5A, 0x12, 0x4C, 0xB8,
+	0x05, 0x03, 0x2C, 0x24, 0x93, 0x74, 0x7A, 0x91,
+	0x4F, 0x2B, 0x15, 0x59, 0x43, 0xAA, 0xD5, 0x9A,
+	0xB6, 0xF1, 0x32, 0x3A, 0xC4, 0xA3, 0xAA, 0x8B,
+	0x1C, 0x95, 0x79, 0x6B, 0x9E, 0x5F, 0x7A, 0xFE,
+	0xA5, 0x2F, 0xF3, 0x66, 0x31, 0x23, 0x57, 0x7E,
+	0xBA, 0x77, 0xF9, 0xF2, 0xAD, 0x8B, 0xAD, 0xB8,
+	0xB1, 0xE7, 0x59, 0x0B, 0x29, 0x64, 0x63, 0x81,
+	0x66, 0x4A, 0xFB, 0x13, 0x55, 0x98, 0x46, 0x26,
+	0x9C, 0xAD, 0xEA, 0x41, 0x86, 0xCA, 0x68, 0xE8,
+	0x50, 0x02, 0x97, 0xB9, 0x49, 0x31, 0xE1, 0x8D,
+	0x1B, 0x70, 0xA8, 0x47, 0x36, 0x95, 0x76, 0x17,
+	0xE7, 0x3A, 0x21, 0x55, 0x08, 0x97, 0xF5, 0x20,
+	0x3E, 0xEE, 0x54, 0x3E, 0xB1, 0xF3, 0xAA, 0x0B,
+	0x8E, 0x7B, 0x1B, 0x15, 0x7C, 0xCF, 0x00, 0xFD,
+	0x3C, 0x80, 0xD2, 0xCB, 0x7E, 0xFB, 0x21, 0x8D,
+	0x9F, 0xCD, 0x26, 0x57, 0x2F, 0x60, 0x8B, 0xBF,
+	0x96, 0xFA, 0xF2, 0xB0, 0x37, 0x6F, 0xBD, 0xA3,
+	0x3E, 0xD3, 0x2C, 0xCD, 0x02, 0x3C, 0x7A, 0x59,
+	0x9E, 0x5D, 0x46, 0x6F, 0x16, 0xE0, 0x7A, 0xA1,
+	0xF5, 0xDC, 0xCB, 0x15, 0xA2, 0xE7, 0x53, 0x28,
+	0x9F, 0xEC, 0x61, 0x92, 0xBB, 0x76, 0x04, 0x52,
+	0xDE, 0x28, 0xB0, 0x6A, 0x6E, 0xE5, 0x59, 0x79,
+	0xA7, 0xEA, 0x80, 0xE2, 0x7B, 0x60, 0xA2, 0x34,
+	0x9F, 0x09, 0x5F, 0xE0, 0x3F, 0x08, 0x4C, 0x47,
+	0x2B, 0x91, 0xA4, 0x6A, 0x1A, 0xEF, 0xD6, 0xBC,
+
+	0x6E, 0xBE, 0x41, 0x9C, 0x4B, 0x93, 0x34, 0x01,
+	0xC7, 0x1D, 0x20, 0xD0, 0x20, 0x97, 0x08, 0x61,
+	0xC0, 0xA6, 0x3D, 0x3B, 0x5A, 0xE8, 0x84, 0x78,
+	0x42, 0xD4, 0xCC, 0x68, 0x7D, 0xE7, 0xF7, 0xF3,
+	0x40, 0xDD, 0x87, 0xBA, 0x7D, 0x36, 0xD7, 0x13,
+	0xEE, 0x4A, 0x88, 0x48, 0x47, 0x3B, 0xBF, 0x74,
+	0xD5, 0xF9, 0x01, 0xA6, 0x03, 0xEA, 0xBA, 0xC5,
+	0x1E, 0x4D, 0x53, 0xCD, 0x3E, 0xF5, 0x9B, 0xAD,
+	0x89, 0xF7, 0x46, 0x2D, 0x0A, 0xDB, 0x6D, 0x77,
+	0x4F, 0x00, 0x3B, 0x1D, 0x5A, 0x49, 0x86, 0x39,
+	0x39, 0x0A, 0x70, 0x62, 0xCC, 0x3C, 0xA2, 0xDB,
+	0xCD, 0x7E, 0x88, 0x5C, 0xBB, 0x0D, 0x43, 0xD0,
+	0x23, 0x58, 0xAB, 0xB5, 0xCC, 0x69, 0x5F, 0x6B,
+	0xAB, 0x51, 0x5E, 0x09, 0xA1, 0xCA, 0xC8, 0xF9,
+	0xC0, 0x51, 0x5C, 0xE2, 0x40, 0x61, 0x2E, 0xF6,
+	0xFF, 0x3A, 0x71, 0x30, 0x23, 0xCB, 0xCE, 0x23,
+	0x11, 0x51, 0xF5, 0xE5, 0x7F, 0x2C, 0x02, 0x4F,
+	0xAF, 0xAF, 0xF1, 0xD3, 0x8E, 0x29, 0xA7, 0x2D,
+	0x68, 0x8D, 0x98, 0xD0, 0x56, 0x4A, 0x52, 0xBC,
+	0x69, 0x35, 0xB0, 0x7C, 0x81, 0x0E, 0xE6, 0x03,
+	0x50, 0x33, 0xEA, 0x4C, 0x79, 0x86, 0xCD, 0x13,
+	0x64, 0x19, 0x1D, 0x43, 0xF1, 0x47, 0x9E, 0x8E,
+	0x8F, 0x9A, 0xBA, 0x76, 0x38, 0x6C, 0xFE, 0xEA,
+	0x33, 0x3A, 0xD2, 0x91, 0x27, 0x14, 0x01, 0x97,
+	0x78, 0x4F, 0x55, 0x16, 0x66, 0xF3, 0x6D, 0x33,
+	0x80, 0xBF, 0x02, 0xB1, 0x22, 0xF3, 0x0D, 0x07,
+	0xF8, 0x83, 0x67, 0x3B, 0x7E, 0xAC, 0x13, 0xA6,
+	0xE1, 0x5F, 0x77, 0x05, 0xFF, 0x41, 0xE9, 0xCB,
+	0x3E, 0x36, 0xF3, 0xF6, 0x76, 0xBC, 0xD5, 0x8A,
+	0x1E, 0x40, 0xDF, 0x14, 0x1C, 0x91, 0xB0, 0xE4,
+	0x78, 0xB9, 0xF6, 0x4F, 0x86, 0x64, 0x1C, 0xBD,
+	0x9E, 0x51, 0xB7, 0xCB, 0x51, 0xE8, 0x62, 0x98,
+	0x2B,
+
+	0x66, 0xBE, 0x41, 0x1C, 0x4B, 0x85, 0x32, 0x01,
+	0xC7, 0x1D, 0x20, 0xD0, 0x20, 0x97, 0x08, 0x61,
+	0xC0, 0x86, 0xA5, 0x3B, 0x7B, 0xE8, 0x44, 0x18,
+	0x44, 0xD5, 0xCE, 0x23, 0xEF, 0xED, 0xD7, 0x72,
+	0xA3, 0xE1, 0x96, 0xEE, 0xD8, 0x4A, 0xDC, 0x8E,
+	0x16, 0x7D, 0x16, 0x4B, 0xCA, 0x08, 0xDE, 0x0E,
+	0x18, 0x52, 0x46, 0x07, 0x98, 0xCD, 0x1F, 0x82,
+	0x60, 0x90, 0xA8, 0x33, 0x5D, 0x0E, 0xE5, 0x5F,
+	0x33, 0xA8, 0xCD, 0xAF, 0x02, 0xFC, 0xB5, 0xF2,
+	0x97, 0x33, 0xEB, 0xF1, 0xF8, 0x2E, 0x34, 0x82,
+	0x88, 0x74, 0xE6, 0x50, 0xE8, 0xF4, 0x59, 0x7D,
+	0xAE, 0x32, 0x13, 0xA5, 0x9F, 0xFC, 0x9F, 0x4D,
+	0xB5, 0xD8, 0x21, 0xDF, 0x2C, 0xFA, 0xB1, 0xE8,
+	0x32, 0xF3, 0x2E, 0x20, 0xA8, 0xA3, 0xD7, 0xED,
+	0x32, 0x10, 0xD4, 0x5C, 0xE2, 0x04, 0xCE, 0x02,
+	0xAE, 0xE7, 0x50, 0x28, 0x8D, 0xE2, 0x00, 0x10,
+	0x8C, 0x1E, 0xA3, 0x69, 0x84, 0x21, 0x62, 0x83,
+	0x5F, 0x12, 0x25, 0x00, 0xFC, 0x36, 0x9B, 0x14,
+	0xEC, 0xF1, 0xD4, 0xE5, 0xE5, 0x13, 0xFB, 0x04,
+	0x60, 0xD3, 0x17, 0x6A, 0x91, 0xC8, 0x18, 0x91,
+	0x14, 0x55, 0x59, 0xDB, 0x3D, 0x30, 0x36, 0x30,
+	0x79, 0x45, 0x93, 0xD5, 0xDF, 0xF6, 0xB3, 0x37,
+	0xEB, 0x2A, 0x75, 0xA9, 0x00, 0x24, 0x02, 0x96,
+	0x6B, 0x9D, 0x81, 0x10, 0xB9, 0xBF, 0x5F, 0xCA,
+	0xE8, 0x0E, 0x6C, 0x6D, 0x29, 0x75, 0x35, 0xFD,
+	0xE5, 0x3C, 0x7E, 0xF7, 0xC1, 0x9B, 0x83, 0x51,
+	0x7F, 0x53, 0xC5, 0x25, 0x9A, 0x49, 0xC9, 0x62,
+	0xE0, 0x4E, 0x88, 0x1B, 0x79, 0x57, 0x22, 0x2C,
+	0xDB, 0x68, 0x75, 0x11, 0x54, 0xE8, 0x3F, 0x48,
+	0x4A, 0xEC, 0x5B, 0xA3, 0xB3, 0x16, 0xEE, 0x1D,
+	0x19, 0x63, 0x50, 0x60, 0xB0, 0x78, 0x27, 0xDC,
+	0x38, 0x4F, 0x99, 0x5C, 0x21, 0x8C, 0x0D, 0xD2,
+	0xBD, 0x60,
+
+	0x66, 0x9C, 0x41, 0x1C, 0x4B, 0x05, 0x44, 0x77,
+	0xC7, 0x1D, 0x20, 0xD0, 0x20, 0x97, 0x08, 0x61,
+	0xCE, 0x86, 0x85, 0x7B, 0x5C, 0xBD, 0x15, 0x0E,
+	0xE5, 0xE1, 0x07, 0x4A, 0xBE, 0x73, 0xEC, 0x12,
+	0xDD, 0x4E, 0xDE, 0xFF, 0xEB, 0x46, 0xAA, 0xFC,
+	0xD5, 0x36, 0x93, 0x1E, 0x9E, 0x61, 0x12, 0x78,
+	0x42, 0xEE, 0x48, 0x2A, 0x1D, 0xC3, 0x72, 0xD0,
+	0x28, 0xCB, 0x29, 0x6C, 0x63, 0x72, 0xA8, 0xAC,
+	0x1C, 0x75, 0x6F, 0xCC, 0x45, 0x7A, 0xDF, 0x22,
+	0xDD, 0x08, 0xEE, 0x18, 0xE2, 0xD1, 0xE6, 0xBD,
+	0x88, 0x3F, 0xE8, 0xEF, 0x7C, 0xC2, 0xA3, 0x42,
+	0x1A, 0x6C, 0x3F, 0xDE, 0x8B, 0xFD, 0xFF, 0xC4,
+	0x64, 0x21, 0x16, 0xA0, 0x3B, 0xAB, 0x44, 0xC5,
+	0xD9, 0xED, 0x10, 0xAB, 0xFA, 0x87, 0x9B, 0xE6,
+	0x45, 0x2E, 0x73, 0xBE, 0x05, 0xEE, 0x93, 0xE8,
+	0x77, 0xD3, 0x11, 0x0D, 0x3F, 0xFC, 0x39, 0x9B,
+	0x20, 0x3C, 0xC5, 0xA2, 0xC2, 0xA0, 0xB5, 0x6C,
+	0xA9, 0x4D, 0x94, 0x17, 0xFE, 0x4B, 0x28, 0x50,
+	0x9F, 0x72, 0x65, 0x7A, 0x46, 0x21, 0x2E, 0x8B,
+	0xCC, 0x78, 0x6D, 0x1A, 0x7E, 0x7B, 0x8A, 0xDF,
+	0xB8, 0xAE, 0x54, 0x30, 0x9C, 0x1F, 0xBE, 0xA9,
+	0x23, 0x31, 0x08, 0xCF, 0x23, 0x35, 0xED, 0x6A,
+	0x6B, 0x29, 0x24, 0xBB, 0x53, 0x6E, 0xDA, 0x55,
+	0x3B, 0xB8, 0x2A, 0x31, 0xFC, 0xB8, 0xA6, 0xA8,
+	0x0E, 0x58, 0xAD, 0x12, 0x81, 0xE3, 0x35, 0xDC,
+	0xFC, 0x09, 0xEB, 0xD1, 0x55, 0xF7, 0xCD, 0x37,
+	0x63, 0xD6, 0x03, 0x75, 0xF7, 0xE2, 0xD7, 0x79,
+	0x7C, 0xE5, 0xC4, 0x95, 0x08, 0x80, 0x2F, 0xCD,
+	0x45, 0x3A, 0xAF, 0x8E, 0x3B, 0xD7, 0xC6, 0xD2,
+	0x60, 0x34, 0x3A, 0x2C, 0xFB, 0xB7, 0xCF, 0x33,
+	0x2A, 0x7F, 0xE5, 0x8F, 0x0C, 0x47, 0xA4, 0x88,
+	0x9C, 0xC1, 0x6E, 0xA5, 0xC7, 0x95, 0x75, 0xDB,
+	0xCF, 0xF4, 0x59,
+
+	0x66, 0xDC, 0x69, 0x1C, 0x4C, 0x05, 0x44, 0x20,
+	0xC7, 0x1D, 0x20, 0xD0, 0x20, 0x97, 0x08, 0x61,
+	0xF4, 0x85, 0x85, 0xFB, 0xBF, 0xC6, 0x00, 0xCA,
+	0x45, 0xE0, 0x16, 0x60, 0xEE, 0x77, 0xA5, 0x3B,
+	0x66, 0x6F, 0xC2, 0xF5, 0x5C, 0x2C, 0x68, 0x26,
+	0x12, 0x31, 0x85, 0x7A, 0xD1, 0x37, 0x66, 0xC4,
+	0x22, 0x96, 0x43, 0x5D, 0xDD, 0x75, 0x35, 0x81,
+	0x86, 0x28, 0xCE, 0x74, 0x86, 0xB5, 0x35, 0x1E,
+	0x1E, 0xCE, 0x71, 0x82, 0x5A, 0x91, 0x7E, 0x72,
+	0xA2, 0x00, 0xCD, 0x5B, 0xA9, 0x3D, 0x13, 0xD6,
+	0x30, 0x2D, 0xC6, 0x0A, 0x15, 0x44, 0x3F, 0x7C,
+	0x4A, 0xDE, 0x2F, 0x99, 0x24, 0xF8, 0xDC, 0xC2,
+	0xE7, 0xE5, 0x88, 0xAB, 0xC9, 0x82, 0x51, 0xF5,
+	0x54, 0xC7, 0xBB, 0xF3, 0xB0, 0x27, 0x49, 0xC0,
+	0xD8, 0x2B, 0x28, 0x50, 0xA0, 0xBC, 0xCA, 0x16,
+	0x7F, 0xA1, 0x8F, 0x27, 0xB6, 0x28, 0x27, 0x53,
+	0x9D, 0x77, 0xB7, 0xB9, 0x35, 0xFD, 0x8D, 0x83,
+	0x73, 0x55, 0x52, 0x56, 0x23, 0xFA, 0x51, 0x19,
+	0x2A, 0x5E, 0x29, 0xB3, 0x2E, 0xA6, 0xBA, 0x1B,
+	0xBA, 0xCD, 0xF6, 0x51, 0x8D, 0xDC, 0xEC, 0x25,
+	0x25, 0xDB, 0x68, 0x46, 0x16, 0x1B, 0x75, 0x74,
+	0x85, 0xB9, 0x94, 0xB9, 0x4A, 0x2A, 0xA8, 0x6F,
+	0x11, 0x5D, 0x38, 0x96, 0x63, 0x88, 0x01, 0x25,
+	0x7F, 0x4E, 0xB5, 0xF7, 0x37, 0xF9, 0x6F, 0x73,
+	0x3A, 0x75, 0x0A, 0xC0, 0xED, 0x53, 0x56, 0xDA,
+	0x91, 0x14, 0x96, 0x1F, 0xF7, 0xE0, 0x05, 0x73,
+	0xB5, 0x5B, 0x93, 0xD0, 0x3E, 0x38, 0x88, 0x6E,
+	0x9E, 0xF2, 0x09, 0x64, 0xC3, 0xE8, 0x31, 0x7F,
+	0x81, 0x19, 0x50, 0xD3, 0x5D, 0x3A, 0x6A, 0xF4,
+	0xDB, 0x83, 0x00, 0x87, 0x80, 0x09, 0x74, 0x44,
+	0xA8, 0x8C, 0x48, 0x6C, 0x37, 0x1E, 0x80, 0x80,
+	0xAA, 0x8F, 0x9A, 0x50, 0x9A, 0x41, 0xC9, 0x4C,
+	0xC2, 0x6B, 0x07, 0xEC,
+
+	0x66, 0xDC, 0x69, 0x1C, 0x4C, 0x05, 0x64, 0x32,
+	0x70, 0x3F, 0x20, 0x50, 0x20, 0x97, 0x08, 0x61,
+	0xEC, 0xBD, 0xC4, 0xFF, 0xBF, 0xC5, 0x02, 0xCA,
+	0x97, 0x1D, 0x6C, 0x62, 0xAC, 0x75, 0xA5, 0x3F,
+	0x27, 0xF0, 0x8C, 0xFC, 0x85, 0x8F, 0x59, 0x88,
+	0x16, 0xE0, 0xF0, 0x71, 0x50, 0x91, 0x65, 0x42,
+	0xC8, 0x5A, 0x8D, 0x3B, 0x7B, 0xD6, 0xEF, 0x26,
+	0x1E, 0x1D, 0x44, 0x7C, 0xAD, 0x88, 0xAF, 0xCD,
+	0xDC, 0xC6, 0xBD, 0x55, 0xDB, 0xFB, 0x10, 0xE7,
+	0xEC, 0xA3, 0xA2, 0x27, 0x86, 0x90, 0xB5, 0xEC,
+	0xD7, 0x40, 0x70, 0x98, 0xEF, 0x7E, 0x28, 0x80,
+	0x2D, 0x0E, 0x96, 0xE8, 0xED, 0xA7, 0x50, 0x2F,
+	0x97, 0xB6, 0xD0, 0x65, 0x5C, 0x5D, 0x36, 0xCC,
+	0x82, 0x21, 0xD8, 0xEA, 0x5B, 0x3B, 0xD8, 0xAD,
+	0x4F, 0xC7, 0xEF, 0xFC, 0x6E, 0x97, 0xA6, 0x63,
+	0x21, 0x57, 0x75, 0x3E, 0x55, 0x77, 0x9D, 0xD5,
+	0xFE, 0x06, 0x8C, 0x15, 0x2D, 0x4F, 0xA9, 0x5F,
+	0xDF, 0x48, 0xA0, 0xED, 0x29, 0x5A, 0xE6, 0xCF,
+	0x02, 0x00, 0x61, 0xFB, 0x61, 0x7A, 0x5A, 0x94,
+	0xB3, 0xC2, 0x90, 0xE5, 0x61, 0xF9, 0x81, 0xCA,
+	0x8D, 0xF9, 0x0B, 0x34, 0x10, 0xF0, 0x88, 0x5A,
+	0xAE, 0x3D, 0x4C, 0x9D, 0xAD, 0x71, 0xDE, 0x56,
+	0xAB, 0x37, 0xD0, 0x46, 0x65, 0xA4, 0x53, 0x6C,
+	0xB6, 0xD3, 0x86, 0x8E, 0x17, 0xB2, 0x3E, 0xE8,
+	0xDD, 0x66, 0x52, 0x0B, 0xEC, 0x25, 0xEE, 0xB6,
+	0x5E, 0x53, 0xDE, 0x28, 0x6F, 0x3B, 0x6A, 0xEC,
+	0xEB, 0x9A, 0x5F, 0xB0, 0x99, 0x1F, 0x4A, 0x66,
+	0x90, 0x51, 0x6E, 0xD2, 0xC9, 0xF8, 0x07, 0xAF,
+	0xC0, 0x00, 0xDB, 0xEC, 0x3F, 0xC3, 0xFB, 0xF4,
+	0x80, 0x34, 0x45, 0x33, 0x3D, 0xE2, 0xE9, 0x3C,
+	0xBA, 0x0B, 0x39, 0x78, 0xDF, 0x50, 0x34, 0xDE,
+	0xDA, 0xAD, 0x73, 0x1C, 0x91, 0x1D, 0xE5, 0x53,
+	0xD9, 0x2E, 0xAD, 0x9F, 0xE7,
+
+	0x66, 0xDC, 0x69, 0x1C, 0x46, 0x05, 0x64, 0x32,
+	0x70, 0x27, 0x30, 0x50, 0x20, 0x97, 0x08, 0x61,
+	0xAD, 0xB1, 0x44, 0xFF, 0xBF, 0xC5, 0x22, 0xCA,
+	0x96, 0x1C, 0xAC, 0x63, 0xAC, 0xF1, 0xA1, 0x37,
+	0x5E, 0x40, 0xDD, 0x7F, 0x86, 0xBE, 0x6F, 0x98,
+	0x40, 0x6F, 0xF4, 0x7B, 0xB0, 0xCD, 0x15, 0x0E,
+	0x81, 0x2B, 0x19, 0xAF, 0x39, 0xB5, 0xF8, 0x50,
+	0x87, 0x51, 0xFB, 0xEB, 0x4B, 0x1B, 0xA7, 0x28,
+	0xC1, 0xB7, 0x60, 0x42, 0x9D, 0x58, 0xCA, 0x0C,
+	0x7C, 0x1A, 0x1F, 0xDC, 0x68, 0xEF, 0x11, 0x8A,
+	0xBE, 0xC4, 0x0B, 0x92, 0x51, 0x7E, 0xBE, 0x95,
+	0x40, 0xB2, 0x16, 0x04, 0x9B, 0xE5, 0x4C, 0xB0,
+	0xA6, 0x3B, 0x89, 0x0A, 0x01, 0xCB, 0x36, 0x05,
+	0x03, 0xFF, 0x78, 0x9A, 0x1E, 0x1D, 0xBC, 0x54,
+	0x3D, 0xF1, 0xF9, 0x46, 0x38, 0x7B, 0x4E, 0xF9,
+	0xDF, 0x8B, 0xF1, 0x5F, 0xB0, 0x04, 0x0C, 0xA9,
+	0xA8, 0x30, 0xFE, 0x7B, 0x8C, 0xF0, 0x07, 0xA9,
+	0x60, 0x6F, 0x0C, 0xA7, 0x4A, 0x66, 0xCA, 0xAD,
+	0x14, 0x3E, 0xAC, 0xBE, 0x16, 0x04, 0x14, 0x34,
+	0x2A, 0x75, 0x75, 0x8C, 0x82, 0xC8, 0xDF, 0x97,
+	0x0E, 0x49, 0x50, 0x6A, 0x67, 0xD9, 0x30, 0xC5,
+	0x40, 0xB3, 0x42, 0xD4, 0x2F, 0x49, 0xC4, 0xFC,
+	0x97, 0x66, 0x44, 0x22, 0x93, 0x25, 0x25, 0x34,
+	0xC3, 0x94, 0xAD, 0x3D, 0xCF, 0xFB, 0x2E, 0xE9,
+	0xEB, 0x04, 0xF1, 0x3D, 0x2D, 0xD7, 0x4E, 0xAA,
+	0xF1, 0xFF, 0x3A, 0x70, 0xA5, 0x5D, 0x1D, 0xD6,
+	0x77, 0x38, 0xD3, 0x51, 0x12, 0x20, 0x3E, 0x0B,
+	0x13, 0x7D, 0x6C, 0xEB, 0x38, 0x16, 0x14, 0xE4,
+	0xC0, 0xA6, 0x38, 0x45, 0xB5, 0x2D, 0xE2, 0xE8,
+	0xC2, 0x0C, 0x21, 0x3E, 0xE0, 0xFD, 0x7A, 0xC6,
+	0x20, 0xC5, 0x35, 0xF6, 0x05, 0xB8, 0x68, 0xFE,
+	0xD1, 0xFD, 0x15, 0xD5, 0x74, 0xB9, 0xE7, 0x51,
+	0x8D, 0xBA, 0x08, 0xF7, 0x79, 0x57,
+
+	0x66, 0xDC, 0x69, 0x1C, 0x46, 0x27, 0x64, 0x32,
+	0x70, 0xA7, 0x48, 0x70, 0x20, 0x97, 0x08, 0x61,
+	0xBE, 0xBD, 0x78, 0xF7, 0xB1, 0xC5, 0x22, 0xEA,
+	0x11, 0x1B, 0x84, 0xF4, 0x98, 0xF2, 0x81, 0x47,
+	0x60, 0x89, 0x7E, 0x71, 0x57, 0xBD, 0xCC, 0x28,
+	0xD0, 0xA8, 0xBA, 0x9F, 0x5F, 0x33, 0xBC, 0x8A,
+	0xFC, 0x5A, 0x70, 0xE3, 0xEC, 0xC1, 0x02, 0xD2,
+	0x93, 0xF8, 0x79, 0xC0, 0xB1, 0x4A, 0xBB, 0x63,
+	0xDA, 0x65, 0xF3, 0xD0, 0xE6, 0xB2, 0x3A, 0x25,
+	0xD3, 0x60, 0x6F, 0x42, 0xFA, 0x21, 0x88, 0xD8,
+	0xD4, 0xF7, 0x42, 0x2F, 0x78, 0xA7, 0xD1, 0x31,
+	0xFC, 0xC5, 0x19, 0x0F, 0xF6, 0xD5, 0xC4, 0x6C,
+	0x09, 0xA3, 0xC3, 0xF1, 0xC0, 0xE9, 0x20, 0x62,
+	0x16, 0x2B, 0x57, 0x57, 0x8C, 0xAE, 0x87, 0x95,
+	0x7A, 0x16, 0x95, 0xCC, 0x9F, 0xE8, 0xDA, 0x0E,
+	0x48, 0x21, 0x72, 0xAA, 0xF2, 0x92, 0xF1, 0xE7,
+	0x9E, 0xBF, 0x1C, 0x36, 0xD6, 0x06, 0x2F, 0xF1,
+	0xAE, 0xB2, 0xD1, 0x02, 0x40, 0x03, 0xA7, 0x04,
+	0xEF, 0x1A, 0xCF, 0x62, 0x4F, 0x46, 0x83, 0x37,
+	0x84, 0xBD, 0xB2, 0x83, 0xF7, 0x1B, 0xE8, 0xA0,
+	0x12, 0xAA, 0x0F, 0x05, 0xE6, 0xFB, 0xF8, 0x97,
+	0xC9, 0x1C, 0x02, 0xDD, 0xB5, 0xD2, 0xC1, 0x66,
+	0x3B, 0xC0, 0x23, 0x75, 0xCA, 0x9E, 0x10, 0xB7,
+	0x06, 0xDB, 0x76, 0x86, 0x92, 0x28, 0x9E, 0xE5,
+	0x73, 0x23, 0x39, 0xE4, 0x9D, 0x64, 0x88, 0xBB,
+	0x84, 0xB8, 0x6B, 0xFC, 0x98, 0xF5, 0xE8, 0xB9,
+	0xEE, 0x4C, 0xA9, 0x14, 0xBA, 0x94, 0x72, 0x25,
+	0x92, 0x83, 0x65, 0xDF, 0x58, 0x4A, 0xA7, 0x62,
+	0xEF, 0xF6, 0xEE, 0x90, 0x56, 0xD8, 0x9E, 0xC5,
+	0xB5, 0xF9, 0xAB, 0xF1, 0x69, 0xD7, 0x26, 0xB6,
+	0xA4, 0x29, 0xDF, 0x01, 0x20, 0x77, 0xEE, 0xE5,
+	0x72, 0x59, 0x69, 0x95, 0x8E, 0xF5, 0x2C, 0xF2,
+	0x13, 0xA8, 0xDC, 0x97, 0x21, 0xA5, 0x86,
+
+	0x66, 0xDC, 0x69, 0x1C, 0x46, 0x27, 0x54, 0x32,
+	0x70, 0xA7, 0x48, 0x29, 0x20, 0x97, 0x08, 0x61,
+	0xAE, 0xA9, 0xB0, 0xDC, 0xB1, 0xCE, 0x22, 0xEA,
+	0x79, 0x8A, 0x89, 0xD8, 0xBC, 0xB2, 0xB1, 0xCE,
+	0x85, 0xB8, 0x42, 0x0C, 0x1B, 0x23, 0xB3, 0x4B,
+	0x18, 0xBB, 0x5B, 0xED, 0x9C, 0x73, 0x16, 0x66,
+	0x17, 0x0D, 0x51, 0x1A, 0x19, 0x8A, 0x76, 0x2E,
+	0x5C, 0xEB, 0x82, 0x64, 0xB5, 0x74, 0xDA, 0xA2,
+	0x60, 0xB1, 0x70, 0x94, 0x21, 0x97, 0xAF, 0xB3,
+	0x3A, 0xA7, 0xD3, 0xE8, 0xF7, 0x61, 0xA6, 0xF3,
+	0xDA, 0x8B, 0x02, 0x9B, 0x68, 0xEF, 0xB3, 0x9A,
+	0x5D, 0x01, 0x9E, 0x02, 0x9E, 0x6B, 0x5C, 0xBC,
+	0x7B, 0xA7, 0x01, 0xC8, 0xE3, 0x48, 0x64, 0x94,
+	0xBB, 0xD1, 0x0B, 0xDB, 0xF8, 0x5E, 0x4B, 0x5F,
+	0xD6, 0xAE, 0x45, 0xBE, 0x25, 0xC8, 0x18, 0xE1,
+	0x3C, 0x24, 0x09, 0x89, 0xF9, 0x85, 0x27, 0x33,
+	0xF8, 0x7C, 0xE5, 0x33, 0xEC, 0xAE, 0x87, 0xDD,
+	0x99, 0x7B, 0xAA, 0xD7, 0xF4, 0x73, 0x20, 0x95,
+	0x04, 0x8F, 0x68, 0x8B, 0xFD, 0xDA, 0x97, 0x08,
+	0x29, 0x90, 0x1B, 0x08, 0xC1, 0x3C, 0x8D, 0x3D,
+	0xEF, 0xCC, 0xAF, 0xDE, 0xF4, 0xB9, 0x0E, 0xBA,
+	0x5A, 0x36, 0x04, 0x03, 0x17, 0x6D, 0xCD, 0x0C,
+	0xD7, 0xFF, 0x1D, 0x22, 0x2F, 0x2B, 0xDE, 0x3E,
+	0x81, 0xE2, 0x45, 0x1F, 0xDD, 0xBC, 0x6E, 0x49,
+	0x70, 0x83, 0x9D, 0x06, 0xC7, 0x34, 0xDE, 0x89,
+	0xBE, 0x8F, 0x83, 0xE8, 0x18, 0x11, 0x35, 0x70,
+	0x84, 0x77, 0x99, 0x2C, 0x19, 0xED, 0x0C, 0x2F,
+	0xD2, 0xC2, 0xA6, 0xFB, 0xE4, 0x81, 0xE5, 0xC1,
+	0x09, 0x2C, 0xA0, 0x40, 0xDE, 0xD7, 0xDF, 0x86,
+	0xA8, 0xE4, 0x1D, 0x06, 0x07, 0x6B, 0x27, 0x24,
+	0x01, 0x83, 0xA1, 0xA4, 0xE3, 0x7E, 0xC6, 0xB7,
+	0x78, 0x6A, 0xAF, 0x6E, 0xFE, 0x9F, 0xB4, 0x6F,
+	0x07, 0x57, 0x38, 0x7E, 0xD6, 0xC9, 0xC4, 0xAC,
+
+	0x66, 0xDC, 0x69, 0x1C, 0x46, 0x27, 0x54, 0x32,
+	0x70, 0xA7, 0x68, 0x3B, 0x19, 0xAE, 0x08, 0xE1,
+	0xF6, 0xFD, 0x94, 0xD5, 0xAF, 0xCE, 0x31, 0x62,
+	0x39, 0x3C, 0x8F, 0xD8, 0x8B, 0x29, 0x2E, 0x8D,
+	0x27, 0x5E, 0x29, 0x36, 0x91, 0x00, 0x05, 0x96,
+	0x91, 0x45, 0xB4, 0x93, 0xA6, 0x13, 0x54, 0xE3,
+	0xC1, 0x1A, 0xEB, 0x68, 0xE7, 0xC6, 0xAA, 0xEB,
+	0x32, 0x18, 0xE0, 0xED, 0xDD, 0x53, 0x74, 0xC0,
+	0xE8, 0xFC, 0x40, 0xC6, 0x20, 0x6F, 0xCA, 0x7C,
+	0x97, 0x32, 0x2E, 0x95, 0x07, 0x99, 0xA0, 0x69,
+	0x4E, 0x70, 0x49, 0xCC, 0x16, 0xFE, 0xF7, 0x21,
+	0xD5, 0x8A, 0xA2, 0xF7, 0xF1, 0x55, 0xD1, 0xA4,
+	0xB0, 0x99, 0xF3, 0x26, 0xBA, 0x9F, 0xB6, 0xDE,
+	0x59, 0xA6, 0x4A, 0x9C, 0x63, 0x8E, 0x38, 0x44,
+	0x87, 0x49, 0x08, 0xD2, 0xD0, 0x14, 0xA1, 0x98,
+	0x1F, 0xF3, 0x16, 0x7D, 0x68, 0xDF, 0xDA, 0x29,
+	0xF2, 0x95, 0xD8, 0x60, 0x92, 0xBF, 0xE1, 0xE6,
+	0x64, 0x3C, 0x47, 0x39, 0x61, 0xDE, 0x8D, 0xED,
+	0x98, 0x99, 0x0E, 0x26, 0xC0, 0xBA, 0x1A, 0x30,
+	0xF6, 0xDB, 0x64, 0x83, 0x92, 0xA2, 0x2B, 0x72,
+	0x44, 0x68, 0x35, 0x3C, 0x24, 0x23, 0x7D, 0x29,
+	0xD5, 0x8E, 0x46, 0x20, 0x7E, 0x25, 0x6B, 0x67,
+	0x0E, 0x4B, 0x3C, 0xA7, 0x75, 0x39, 0x1B, 0xB4,
+	0x6F, 0x21, 0xD0, 0x38, 0xE5, 0x77, 0x01, 0x9B,
+	0x7E, 0x8E, 0x46, 0xB2, 0x1B, 0x59, 0xE3, 0x09,
+	0xDA, 0x38, 0x10, 0x86, 0x02, 0x4F, 0xE9, 0xDA,
+	0x36, 0x82, 0xA6, 0xDA, 0xB8, 0xA3, 0x8B, 0x83,
+	0xAE, 0xE3, 0x25, 0xE4, 0x89, 0x27, 0x83, 0x8F,
+	0xA0, 0xD5, 0x49, 0xDB, 0x95, 0xE5, 0x6D, 0xD6,
+	0x9E, 0x86, 0x29, 0x45, 0x71, 0x42, 0x14, 0x12,
+	0x6F, 0x17, 0xEC, 0x6B, 0x03, 0x76, 0xA7, 0x40,
+	0xC0, 0x9D, 0xDA, 0xC8, 0xC1, 0x4B, 0x7E, 0x70,
+	0x17, 0x6B, 0x11, 0x11, 0x70, 0xC3, 0xE0, 0x6B,
+	0xEB,
+
+	0x66, 0xDC, 0x69, 0x1C, 0x46, 0x27, 0x54, 0x32,
+	0x7A, 0xA7, 0x68, 0xBB, 0x19, 0xB4, 0x10, 0xE1,
+	0xF6, 0xB9, 0xDD, 0x10, 0xDF, 0xC2, 0x91, 0x6B,
+	0x39, 0xBC, 0x87, 0xD9, 0x8A, 0x61, 0xEC, 0xAC,
+	0xFF, 0x28, 0x31, 0x17, 0xBE, 0x9C, 0x57, 0x64,
+	0x81, 0x8A, 0x6A, 0xDD, 0x4E, 0x9F, 0xFA, 0x48,
+	0x82, 0x2B, 0x67, 0x72, 0x61, 0x5D, 0x5C, 0xDE,
+	0x4D, 0x63, 0x6E, 0x8D, 0xC8, 0x60, 0x66, 0x17,
+	0x4A, 0x51, 0x3A, 0x74, 0xF1, 0x89, 0x3C, 0x7A,
+	0x38, 0xC8, 0x91, 0x9A, 0x04, 0x73, 0xE0, 0x10,
+	0xA8, 0xBD, 0xB0, 0xCF, 0x75, 0xED, 0xCB, 0x96,
+	0x35, 0x62, 0xAE, 0x41, 0x31, 0x99, 0xD9, 0xE0,
+	0x3A, 0xAB, 0xD3, 0x9E, 0x4A, 0x71, 0x81, 0xB8,
+	0xD9, 0xA7, 0x5E, 0xB9, 0xA7, 0x03, 0xE1, 0xF3,
+	0x3E, 0x82, 0x68, 0xE5, 0x32, 0xAE, 0x8B, 0xAC,
+	0x82, 0x9A, 0x99, 0xFB, 0x01, 0xAB, 0x69, 0x9B,
+	0xDF, 0xE5, 0x8B, 0x7C, 0xDF, 0xC7, 0x46, 0x50,
+	0xCE, 0x10, 0x45, 0x6D, 0x8E, 0xDA, 0x05, 0x9D,
+	0x07, 0xCF, 0x90, 0xB7, 0x59, 0xBB, 0x89, 0xF2,
+	0x55, 0x13, 0xAC, 0x05, 0x7A, 0xD7, 0xC3, 0x10,
+	0x15, 0x04, 0x12, 0xBD, 0xF8, 0xDC, 0xB5, 0x34,
+	0xEA, 0xB0, 0xA7, 0xC0, 0x7F, 0x59, 0xDF, 0x99,
+	0x79, 0xAE, 0xA8, 0xBB, 0xED, 0x32, 0x53, 0xEA,
+	0x69, 0x90, 0xCF, 0x2F, 0x94, 0x86, 0xC9, 0x8E,
+	0xFD, 0xA6, 0x90, 0x7C, 0xF7, 0xE4, 0x0C, 0xE8,
+	0xBD, 0x63, 0x99, 0x45, 0x48, 0x12, 0xA4, 0x40,
+	0xAE, 0x3D, 0x33, 0xC4, 0x37, 0xFE, 0x19, 0x6C,
+	0x10, 0x60, 0xCA, 0x92, 0x9F, 0x44, 0x5A, 0x20,
+	0x04, 0xA3, 0xDA, 0xC6, 0xDD, 0x31, 0x80, 0x8B,
+	0x55, 0xBA, 0x98, 0x87, 0xFA, 0xE4, 0x33, 0x9B,
+	0x1A, 0xD6, 0xCD, 0x0E, 0x32, 0xFF, 0x3A, 0xC0,
+	0x20, 0x32, 0x5E, 0x95, 0x91, 0xDB, 0x23, 0x2E,
+	0x30, 0x37, 0x55, 0x09, 0x8F, 0x39, 0x12, 0x2F,
+	0xB7, 0x72,
+
+	0x66, 0xDC, 0x69, 0x1C, 0x46, 0x27, 0x54, 0x32,
+	0xBA, 0xA4, 0x68, 0xBB, 0x19, 0x34, 0x6A, 0x81,
+	0x57, 0xB8, 0x85, 0x08, 0xDD, 0x7F, 0xAC, 0x6B,
+	0x3B, 0xBC, 0x57, 0xD1, 0x25, 0x64, 0xE9, 0xFB,
+	0xC7, 0x4F, 0x81, 0x3C, 0xE5, 0x09, 0xDC, 0xD7,
+	0xAE, 0x69, 0x44, 0x6F, 0x9D, 0x12, 0xD7, 0xDD,
+	0xBD, 0xA4, 0x58, 0x9D, 0x3E, 0x98, 0xF5, 0x03,
+	0x40, 0x69, 0x49, 0x05, 0x48, 0xF9, 0xE5, 0x00,
+	0xAA, 0xB1, 0x3F, 0x0E, 0x5D, 0xF7, 0x64, 0x56,
+	0xA5, 0x65, 0xD8, 0xA2, 0xC3, 0x3B, 0xC1, 0xDD,
+	0x98, 0x43, 0x31, 0x51, 0xF6, 0x2A, 0xFC, 0xC7,
+	0xD5, 0xD5, 0xC9, 0xEA, 0x27, 0x0E, 0xD2, 0x17,
+	0x51, 0x5A, 0xAA, 0x43, 0xD2, 0x62, 0x05, 0x5D,
+	0x1B, 0xA8, 0x13, 0x38, 0x21, 0x72, 0xE8, 0x5F,
+	0xB4, 0x68, 0x25, 0x8D, 0x23, 0xB8, 0x33, 0x4B,
+	0xC6, 0xB8, 0x82, 0xA7, 0xB8, 0x0B, 0xAC, 0x45,
+	0x70, 0xB3, 0x6A, 0xAD, 0xD4, 0x1D, 0xFF, 0x97,
+	0xD3, 0xFE, 0x60, 0xE9, 0x62, 0x52, 0x8B, 0xDB,
+	0xD5, 0xE1, 0x33, 0x6B, 0xEF, 0x7C, 0x43, 0x42,
+	0x65, 0x51, 0xEB, 0xB2, 0xB8, 0xE2, 0x39, 0xBC,
+	0xD1, 0x6D, 0x09, 0xA4, 0x36, 0xE5, 0xD8, 0x3B,
+	0x9A, 0xEE, 0x6B, 0xDB, 0x01, 0x39, 0xA7, 0x76,
+	0x1C, 0xED, 0x67, 0x7E, 0x15, 0x11, 0x0A, 0xD3,
+	0xCD, 0xDF, 0x35, 0x16, 0xB9, 0xE1, 0x7C, 0x57,
+	0x08, 0xF3, 0xF0, 0x5B, 0x05, 0x7B, 0x4A, 0x6A,
+	0x86, 0x25, 0x0B, 0xEA, 0x91, 0x2F, 0xE1, 0x06,
+	0xF1, 0xBC, 0x4D, 0xBA, 0x6B, 0x11, 0x72, 0x09,
+	0x8E, 0xD0, 0xB5, 0xE2, 0xFD, 0xC6, 0x55, 0x73,
+	0xD4, 0x7E, 0x25, 0x60, 0x5E, 0x67, 0x2B, 0x44,
+	0x55, 0x88, 0x28, 0x37, 0x1B, 0x9D, 0x93, 0x0D,
+	0xEC, 0x96, 0x5D, 0xF8, 0x97, 0x94, 0x00, 0xBC,
+	0x00, 0x4A, 0x5B, 0xFF, 0x39, 0x29, 0x87, 0x6B,
+	0x68, 0x51, 0xBD, 0xAA, 0xC1, 0x4A, 0x90, 0xA0,
+	0x24, 0x8A, 0xF7,
+
+	0x66, 0xDC, 0x69, 0x1C, 0x46, 0x27, 0x54, 0x32,
+	0xBA, 0xE4, 0x42, 0xBB, 0x5A, 0x34, 0x6A, 0xDA,
+	0xFF, 0x11, 0x18, 0xCC, 0xCD, 0x77, 0x44, 0x45,
+	0x5B, 0xB6, 0x57, 0xD1, 0xC4, 0xDD, 0x4C, 0xEE,
+	0x18, 0x8F, 0x58, 0x31, 0xBB, 0xA5, 0x92, 0x47,
+	0x4F, 0x4D, 0x06, 0x87, 0x07, 0xC0, 0xE2, 0x08,
+	0xB3, 0xB6, 0x50, 0x85, 0x97, 0x6D, 0x70, 0xA3,
+	0x5A, 0x79, 0x9A, 0x51, 0x81, 0xBE, 0x05, 0xC9,
+	0xF4, 0xF7, 0x12, 0xA6, 0xC0, 0x1E, 0xC0, 0x09,
+	0x7B, 0xC4, 0x9B, 0xA7, 0xDC, 0x65, 0xA2, 0x16,
+	0x38, 0x2E, 0x92, 0xF5, 0xAC, 0x15, 0xE5, 0xB3,
+	0x9D, 0x09, 0xD4, 0x0D, 0xE6, 0x9F, 0xC1, 0x63,
+	0xAF, 0x2E, 0x40, 0xD1, 0xE5, 0x2B, 0x18, 0x18,
+	0x25, 0x61, 0x1B, 0x84, 0x80, 0xF6, 0xC0, 0xF7,
+	0x6B, 0xE4, 0x31, 0xB4, 0xBA, 0x68, 0xEF, 0x16,
+	0x18, 0xF3, 0x66, 0xEF, 0x76, 0x6D, 0x6F, 0xBC,
+	0xF5, 0x69, 0x43, 0xA7, 0x20, 0xF5, 0xD3, 0xEA,
+	0x82, 0x79, 0xF5, 0xF5, 0x1B, 0xEA, 0x09, 0x2A,
+	0x94, 0x03, 0x61, 0x25, 0x37, 0xC5, 0xDB, 0xA1,
+	0xFC, 0xED, 0x5D, 0x4A, 0xF6, 0x57, 0x5D, 0xE5,
+	0xA3, 0x11, 0x59, 0xC6, 0xF0, 0x37, 0x5D, 0xEC,
+	0x7B, 0xEF, 0xC7, 0xFF, 0x27, 0x1A, 0x2A, 0x59,
+	0xA5, 0x71, 0x99, 0xE3, 0x02, 0xB7, 0x6F, 0xAC,
+	0xCB, 0x2F, 0xD3, 0xC2, 0x4A, 0xF7, 0x7C, 0x3B,
+	0x30, 0xBC, 0x93, 0x74, 0xA6, 0x8C, 0x0C, 0x93,
+	0x43, 0xC3, 0xC5, 0xC7, 0x84, 0xF0, 0x64, 0xA9,
+	0x61, 0xF4, 0xBB, 0xB1, 0x41, 0x85, 0x77, 0x20,
+	0x51, 0x8C, 0x62, 0xA8, 0xAB, 0x11, 0xCA, 0xA3,
+	0x44, 0xCA, 0x60, 0xC3, 0x78, 0xBB, 0xA9, 0xA5,
+	0x0A, 0x2A, 0xAF, 0x0B, 0xA7, 0x30, 0xD0, 0x02,
+	0x50, 0xF8, 0xD8, 0x9C, 0x71, 0x2F, 0xCF, 0x9D,
+	0x3B, 0x27, 0x56, 0xC9, 0x44, 0x9A, 0x76, 0xB4,
+	0xAF, 0xE1, 0x9C, 0x85, 0xE8, 0x78, 0x2C, 0x3F,
+	0x6C, 0xF4, 0x51, 0xCF,
+
+	0xDD, 0xE6, 0x69, 0x9C, 0x46, 0x27, 0x54, 0x32,
+	0xBA, 0xE4, 0x42, 0xBB, 0x5A, 0x34, 0x4A, 0xD9,
+	0xB9, 0x4F, 0x83, 0xAF, 0x0E, 0xE3, 0xD1, 0x4D,
+	0x45, 0xBE, 0x6E, 0x54, 0x4C, 0x8F, 0x4B, 0xEE,
+	0x7C, 0xC1, 0xC6, 0x59, 0xB9, 0x60, 0xB2, 0x7F,
+	0x20, 0x6A, 0x71, 0x1B, 0x90, 0x74, 0x78, 0xFE,
+	0x73, 0x3E, 0x53, 0xA6, 0x89, 0xDA, 0xB1, 0x20,
+	0xB1, 0x89, 0x16, 0xC0, 0xFF, 0x71, 0x36, 0x4B,
+	0x0A, 0x62, 0x5C, 0x4C, 0xAE, 0x1C, 0x81, 0x04,
+	0x04, 0x56, 0x81, 0xF1, 0x8A, 0x8C, 0xD9, 0x0B,
+	0x8B, 0x08, 0xFD, 0xA2, 0xB0, 0x72, 0xC2, 0xCE,
+	0x39, 0xF4, 0x01, 0x07, 0xED, 0x2F, 0xE6, 0xF4,
+	0x51, 0x8F, 0x3A, 0x6E, 0x3F, 0xA9, 0x50, 0xEA,
+	0x18, 0x9B, 0x1C, 0xCD, 0x4A, 0xD5, 0xDB, 0x70,
+	0xF1, 0xDF, 0x6F, 0xD8, 0x7E, 0x2E, 0x1E, 0x7D,
+	0x0F, 0x94, 0x86, 0x8C, 0x7C, 0x35, 0x68, 0x44,
+	0x47, 0x9A, 0x59, 0xF5, 0xCF, 0xD3, 0xFB, 0x85,
+	0x95, 0x1E, 0x2E, 0x8C, 0x7C, 0xE1, 0x63, 0xCF,
+	0xD0, 0x94, 0xBE, 0xE6, 0x9D, 0xF4, 0x0E, 0xED,
+	0xC0, 0x50, 0x1C, 0x93, 0xA5, 0x64, 0x3B, 0xE9,
+	0x14, 0x0C, 0x7F, 0xBC, 0xDC, 0x17, 0xC1, 0xBB,
+	0x6E, 0xB2, 0xC0, 0xCA, 0x27, 0x3D, 0xA6, 0x09,
+	0xF5, 0x82, 0x11, 0x4C, 0x03, 0xA6, 0x3C, 0x1B,
+	0x33, 0x1A, 0x0E, 0x92, 0x70, 0xC8, 0xEC, 0x44,
+	0x52, 0x98, 0x40, 0xC2, 0x66, 0xC2, 0x47, 0x85,
+	0x6B, 0x4F, 0xC7, 0xBA, 0x0E, 0xAE, 0x35, 0x8D,
+	0xF6, 0x5A, 0x15, 0x2D, 0x93, 0x21, 0x4D, 0x9B,
+	0x60, 0xC3, 0xDA, 0xA3, 0x8F, 0x29, 0x59, 0x66,
+	0x2C, 0x66, 0x18, 0x89, 0x0D, 0xA9, 0x98, 0x94,
+	0x98, 0xD3, 0x69, 0x29, 0x04, 0x95, 0x3B, 0xB4,
+	0x3B, 0x4E, 0x95, 0x0D, 0x82, 0xDF, 0x80, 0x75,
+	0x5D, 0x06, 0x4D, 0x42, 0x51, 0xED, 0xB8, 0x4D,
+	0xA2, 0x42, 0x5D, 0xB7, 0xF1, 0xC0, 0xEF, 0x85,
+	0xA9, 0xDE, 0x12, 0x3B, 0x61,
+
+	0xDD, 0xFA, 0x79, 0x9C, 0x46, 0x27, 0x54, 0x32,
+	0xBA, 0xE4, 0x42, 0xBB, 0x53, 0x34, 0x4A, 0xD9,
+	0xF8, 0xCF, 0x40, 0xEC, 0x09, 0xC3, 0xFF, 0xCF,
+	0x24, 0xB1, 0x6E, 0x55, 0x4C, 0x8F, 0xFB, 0xEC,
+	0x13, 0xDF, 0x27, 0x3E, 0xED, 0xE1, 0xEE, 0x1C,
+	0x29, 0x62, 0xF3, 0x97, 0xA9, 0xCB, 0xB9, 0xAE,
+	0x5B, 0x03, 0x03, 0x0E, 0x17, 0xF1, 0xDF, 0xDB,
+	0xDD, 0x46, 0x26, 0x32, 0x81, 0xDD, 0x3F, 0x7E,
+	0x9D, 0x74, 0x80, 0x4E, 0x80, 0x2C, 0x92, 0x9D,
+	0x43, 0x6A, 0xAF, 0x68, 0xEB, 0x68, 0xD3, 0xF3,
+	0x33, 0x15, 0x8A, 0x94, 0xE4, 0xA4, 0xAF, 0x7A,
+	0x6E, 0x76, 0x99, 0x85, 0xD7, 0xE2, 0xAB, 0xB5,
+	0x87, 0x16, 0x08, 0x69, 0x56, 0x1B, 0xF2, 0xB9,
+	0x6C, 0x7B, 0xCC, 0x4B, 0xDE, 0x60, 0x73, 0x7D,
+	0xDB, 0x27, 0x47, 0xB8, 0x8F, 0x5B, 0xB1, 0x1F,
+	0xE8, 0x8F, 0xBD, 0x06, 0xEE, 0x5F, 0xEA, 0x4E,
+	0x18, 0xDB, 0x04, 0x37, 0x43, 0x40, 0x9F, 0x3F,
+	0x51, 0x60, 0xB5, 0x92, 0xBD, 0x6A, 0xBA, 0x16,
+	0x7B, 0x07, 0xDB, 0x1E, 0xE6, 0x43, 0x13, 0x3B,
+	0x42, 0x39, 0x55, 0xED, 0x5D, 0xFC, 0x7A, 0x96,
+	0x3B, 0xED, 0x61, 0x42, 0x43, 0xBF, 0x47, 0x4E,
+	0xAF, 0xDB, 0x6A, 0xD6, 0x56, 0x7C, 0x2B, 0x2C,
+	0x17, 0xFF, 0x41, 0x20, 0xDB, 0xFB, 0xD8, 0x07,
+	0xD8, 0x1F, 0x04, 0xE3, 0x0A, 0xB6, 0xA5, 0x6D,
+	0x48, 0x85, 0xA9, 0x1C, 0x50, 0xEB, 0x7F, 0x0D,
+	0x30, 0x6D, 0x8B, 0xC8, 0x04, 0xC1, 0x61, 0x24,
+	0xA6, 0x21, 0x42, 0x7C, 0x54, 0xE3, 0xC8, 0x59,
+	0xA8, 0x9D, 0x4D, 0xDE, 0xFE, 0xB8, 0x16, 0x12,
+	0xB7, 0xCB, 0x20, 0xF0, 0x8C, 0x98, 0x4D, 0xF7,
+	0xE7, 0x7A, 0x23, 0x70, 0xA4, 0x23, 0xD6, 0x01,
+	0x59, 0x21, 0xE5, 0x2A, 0x2B, 0x38, 0xE4, 0x3B,
+	0x74, 0xCE, 0x36, 0x22, 0xD3, 0x34, 0x19, 0xE2,
+	0xAF, 0xE2, 0x90, 0x30, 0xDA, 0xCD, 0xB5, 0xDC,
+	0x7B, 0x66, 0x61, 0xEE, 0xD1, 0xF3,
+
+	0xDD, 0x7A, 0x85, 0x84, 0x46, 0x27, 0x54, 0x32,
+	0xBA, 0xE4, 0x42, 0xBB, 0x53, 0x35, 0x4A, 0xD9,
+	0xF7, 0xC8, 0x58, 0x10, 0x35, 0x48, 0xDD, 0xF5,
+	0x25, 0x99, 0x54, 0x9D, 0x43, 0x8F, 0xDB, 0x3C,
+	0xE5, 0x0A, 0x56, 0xF8, 0x49, 0x6C, 0x34, 0x07,
+	0x48, 0x4E, 0x54, 0x5C, 0xE4, 0x77, 0xA8, 0x0A,
+	0x73, 0x79, 0x21, 0x7B, 0x72, 0x0E, 0xE0, 0x37,
+	0x7B, 0xF9, 0x3E, 0x9E, 0xD7, 0x7C, 0x63, 0x38,
+	0xC9, 0xDA, 0x97, 0xE3, 0x02, 0x78, 0xDC, 0x03,
+	0x3D, 0xEA, 0x9D, 0x46, 0x77, 0x74, 0xFB, 0x33,
+	0xE9, 0x1E, 0x8B, 0xBA, 0xB9, 0xCF, 0x63, 0xF0,
+	0xF5, 0xEA, 0x41, 0x7A, 0x2F, 0x22, 0x64, 0x35,
+	0xE8, 0xA6, 0x61, 0xF6, 0x77, 0x7F, 0xEF, 0x5D,
+	0xAB, 0x54, 0xED, 0xBD, 0xD0, 0x6D, 0xEA, 0x58,
+	0x12, 0xE2, 0xF0, 0x55, 0xC0, 0xF7, 0xB1, 0x80,
+	0x8A, 0xAD, 0x0F, 0x5D, 0x09, 0xD6, 0x12, 0x8A,
+	0x26, 0x49, 0xB3, 0xFE, 0x00, 0xD7, 0x82, 0x2C,
+	0x36, 0x5E, 0x6B, 0x66, 0xB9, 0xA5, 0xF5, 0xA1,
+	0xB6, 0x79, 0xE6, 0xFD, 0x2E, 0xC3, 0x2F, 0x7A,
+	0x00, 0x22, 0x63, 0xFA, 0x19, 0xB0, 0xA4, 0x36,
+	0x16, 0x59, 0x06, 0x64, 0xC5, 0x27, 0x7E, 0xBA,
+	0x62, 0x92, 0x29, 0xBE, 0xFE, 0xFE, 0xA8, 0xB6,
+	0xC8, 0xF6, 0x78, 0xFC, 0x2E, 0xBA, 0x76, 0x7F,
+	0x1A, 0x62, 0x22, 0xC4, 0x90, 0x1E, 0x59, 0x54,
+	0x15, 0xBA, 0x61, 0x38, 0x52, 0x5F, 0x09, 0x7C,
+	0x33, 0x41, 0x83, 0x18, 0x4C, 0xCA, 0x4B, 0x57,
+	0x3C, 0xB7, 0xE2, 0xE0, 0x30, 0xAE, 0x7D, 0xED,
+	0x73, 0xBF, 0x07, 0x6C, 0x20, 0x54, 0xB8, 0x6E,
+	0x5E, 0x9A, 0x0A, 0x13, 0xD4, 0x4A, 0xD2, 0x99,
+	0xF7, 0x47, 0x9F, 0xF6, 0x0E, 0xDD, 0x14, 0x85,
+	0x6F, 0xF8, 0xAF, 0x17, 0xDE, 0xC7, 0x2A, 0x6E,
+	0x56, 0x8F, 0x3C, 0xA5, 0x31, 0xC4, 0x40, 0x53,
+	0x72, 0x3B, 0x96, 0xC0, 0x1D, 0xE6, 0xE1, 0x74,
+	0x35, 0x6E, 0x2A, 0x14, 0x7B, 0x8A, 0xFF,
+}
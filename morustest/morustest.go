@@ -0,0 +1,321 @@
+// morustest.go - MORUS conformance tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+// Package morustest provides conformance tests and benchmarks for MORUS
+// implementations, so that other implementations (eg: a backend for a
+// custom platform) can verify that they match this one.
+//
+// The tests only use the cipher.AEAD interface, and take a constructor that
+// returns a new instance keyed with the provided key.
+package morustest
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"testing"
+)
+
+const (
+	nonceSize = 16
+	tagSize   = 16
+)
+
+// NewFunc returns a new cipher.AEAD instance keyed with key.
+type NewFunc func(key []byte) cipher.AEAD
+
+// Variant is a MORUS parameter set.
+type Variant struct {
+	// Name is the name of the variant.
+	Name string
+
+	// KeySize is the size of a key in bytes.
+	KeySize int
+
+	// KAT is the concatenation of the ciphertexts produced by sealing
+	// each prefix of the `genkat.c` message, with the same length prefix
+	// of the `genkat.c` additional data, in order of increasing length.
+	//
+	// There are no official test vectors, so see the documentation of
+	// each variant for where the values come from.
+	KAT []byte
+}
+
+var (
+	// MORUS1280256 is MORUS-1280-256.  The KAT was generated by combining
+	// `genkat.c` from the NORX source package and
+	// `supercop-20171218/crypto_aead/morus1280256v2/ref64`.
+	MORUS1280256 = &Variant{Name: "MORUS-1280-256", KeySize: 32, KAT: kat1280256}
+
+	// MORUS1280128 is MORUS-1280-128.  The KAT is a self-generated
+	// regression vector, produced by the `github.com/Yawning/morus`
+	// package with the same `genkat.c` parameters, and has not been
+	// checked against the reference code.
+	MORUS1280128 = &Variant{Name: "MORUS-1280-128", KeySize: 16, KAT: kat1280128}
+
+	// MORUS640128 is MORUS-640-128.  The KAT is a self-generated
+	// regression vector, produced by the `github.com/Yawning/morus`
+	// package with the same `genkat.c` parameters, and has not been
+	// checked against any reference code.
+	MORUS640128 = &Variant{Name: "MORUS-640-128", KeySize: 16, KAT: kat640128}
+)
+
+// BenchSizes are the message sizes used by BenchmarkAEAD.
+var BenchSizes = []int{8, 32, 64, 576, 1536, 4096, 1024768}
+
+// TestAEAD runs all of the conformance tests against the instances returned
+// by newFn, as subtests of t.
+func TestAEAD(t *testing.T, v *Variant, newFn NewFunc) {
+	t.Run("Contract", func(t *testing.T) { TestContract(t, v, newFn) })
+	t.Run("KAT", func(t *testing.T) { TestKAT(t, v, newFn) })
+	t.Run("Tamper", func(t *testing.T) { TestTamper(t, v, newFn) })
+	t.Run("Aliasing", func(t *testing.T) { TestAliasing(t, v, newFn) })
+}
+
+// TestContract tests the NonceSize and Overhead values, and that an invalid
+// nonce size results in a panic.
+func TestContract(t testing.TB, v *Variant, newFn NewFunc) {
+	t.Helper()
+
+	aead := newFn(randomBytes(t, v.KeySize))
+	if sz := aead.NonceSize(); sz != nonceSize {
+		t.Fatalf("NonceSize(): got %d, expected %d", sz, nonceSize)
+	}
+	if sz := aead.Overhead(); sz != tagSize {
+		t.Fatalf("Overhead(): got %d, expected %d", sz, tagSize)
+	}
+
+	m := []byte("No one would have believed in the last years of the nineteenth century")
+	c := aead.Seal(nil, make([]byte, nonceSize), m, nil)
+	if len(c) != len(m)+tagSize {
+		t.Fatalf("Seal(): len(c) %d, expected %d", len(c), len(m)+tagSize)
+	}
+
+	for _, sz := range []int{0, nonceSize - 1, nonceSize + 1} {
+		nonce := make([]byte, sz)
+		mustPanic(t, func() { aead.Seal(nil, nonce, m, nil) }, "Seal(nonce): len(nonce) %d", sz)
+		mustPanic(t, func() { _, _ = aead.Open(nil, nonce, c, nil) }, "Open(nonce): len(nonce) %d", sz)
+	}
+}
+
+// TestKAT tests against the variant's known answer tests.
+func TestKAT(t testing.TB, v *Variant, newFn NewFunc) {
+	t.Helper()
+
+	var w, h [256]byte
+	var k [32]byte
+	var n [nonceSize]byte
+
+	for i := range w {
+		w[i] = byte(255 & (i*197 + 123))
+	}
+	for i := range h {
+		h[i] = byte(255 & (i*193 + 123))
+	}
+	for i := range k {
+		k[i] = byte(255 & (i*191 + 123))
+	}
+	for i := range n {
+		n[i] = byte(255 & (i*181 + 123))
+	}
+
+	var katAcc []byte
+	katOff := 0
+
+	aead := newFn(k[:v.KeySize])
+	for i := range w {
+		katAcc = aead.Seal(katAcc, n[:], w[:i], h[:i])
+		c := katAcc[katOff:]
+		if len(c) != i+tagSize {
+			t.Fatalf("Seal(): %d: len(c) %d, expected %d", i, len(c), i+tagSize)
+		}
+		if expected := v.KAT[katOff : katOff+len(c)]; !bytes.Equal(expected, c) {
+			t.Fatalf("Seal(): %d: got %x, expected %x", i, c, expected)
+		}
+
+		m, err := aead.Open(nil, n[:], c, h[:i])
+		if err != nil {
+			t.Fatalf("Open(): %d: %v", i, err)
+		}
+		if !bytes.Equal(w[:i], m) {
+			t.Fatalf("Open(): %d: got %x, expected %x", i, m, w[:i])
+		}
+		katOff += len(c)
+	}
+	if !bytes.Equal(v.KAT, katAcc) {
+		t.Fatalf("Final concatenated cipher texts mismatch")
+	}
+}
+
+// TestTamper tests that modifying any bit of the ciphertext, tag, additional
+// data or nonce, or truncating the ciphertext, causes Open to fail.
+func TestTamper(t testing.TB, v *Variant, newFn NewFunc) {
+	t.Helper()
+
+	aead := newFn(randomBytes(t, v.KeySize))
+	for _, sz := range []int{0, 1, 31, 32, 33, 64, 65} {
+		nonce, m, ad := randomBytes(t, nonceSize), randomBytes(t, sz), randomBytes(t, sz)
+		c := aead.Seal(nil, nonce, m, ad)
+
+		for _, in := range []struct {
+			name string
+			b    []byte
+		}{
+			{"c", c},
+			{"ad", ad},
+			{"nonce", nonce},
+		} {
+			for i := 0; i < len(in.b)*8; i++ {
+				in.b[i/8] ^= 1 << uint(i%8)
+				d, err := aead.Open(nil, nonce, c, ad)
+				in.b[i/8] ^= 1 << uint(i%8)
+
+				if err == nil || d != nil {
+					t.Fatalf("Open(Bad %s): len(m) %d, bit %d: accepted", in.name, sz, i)
+				}
+			}
+		}
+
+		for i := 0; i < len(c); i++ {
+			d, err := aead.Open(nil, nonce, c[:i], ad)
+			if err == nil || d != nil {
+				t.Fatalf("Open(Truncated c): len(m) %d, len(c) %d: accepted", sz, i)
+			}
+		}
+
+		d, err := aead.Open(nil, nonce, c, ad)
+		if err != nil {
+			t.Fatalf("Open(): len(m) %d: %v", sz, err)
+		}
+		if !bytes.Equal(m, d) {
+			t.Fatalf("Open(): len(m) %d: got %x, expected %x", sz, d, m)
+		}
+	}
+}
+
+// TestAliasing tests the cipher.AEAD dst handling: that output is appended
+// to dst, and that exact overlap between the input and output is supported.
+func TestAliasing(t testing.TB, v *Variant, newFn NewFunc) {
+	t.Helper()
+
+	aead := newFn(randomBytes(t, v.KeySize))
+	prefix := []byte("prefix")
+	for _, sz := range []int{0, 1, 31, 32, 33, 64, 65, 1000} {
+		nonce, m, ad := randomBytes(t, nonceSize), randomBytes(t, sz), randomBytes(t, sz)
+		c := aead.Seal(nil, nonce, m, ad)
+
+		// Appending to dst, with and without spare capacity.
+		for _, dst := range [][]byte{
+			append([]byte{}, prefix...),
+			append(make([]byte, 0, len(prefix)+sz+tagSize), prefix...),
+		} {
+			out := aead.Seal(dst, nonce, m, ad)
+			if !bytes.Equal(prefix, out[:len(prefix)]) {
+				t.Fatalf("Seal(dst): len(m) %d: prefix clobbered", sz)
+			}
+			if !bytes.Equal(c, out[len(prefix):]) {
+				t.Fatalf("Seal(dst): len(m) %d: got %x, expected %x", sz, out[len(prefix):], c)
+			}
+
+			out, err := aead.Open(dst[:len(prefix)], nonce, c, ad)
+			if err != nil {
+				t.Fatalf("Open(dst): len(m) %d: %v", sz, err)
+			}
+			if !bytes.Equal(prefix, out[:len(prefix)]) {
+				t.Fatalf("Open(dst): len(m) %d: prefix clobbered", sz)
+			}
+			if !bytes.Equal(m, out[len(prefix):]) {
+				t.Fatalf("Open(dst): len(m) %d: got %x, expected %x", sz, out[len(prefix):], m)
+			}
+		}
+
+		// Exact overlap.
+		buf := make([]byte, sz, sz+tagSize)
+		copy(buf, m)
+		out := aead.Seal(buf[:0], nonce, buf, ad)
+		if !bytes.Equal(c, out) {
+			t.Fatalf("Seal(in-place): len(m) %d: got %x, expected %x", sz, out, c)
+		}
+
+		out, err := aead.Open(out[:0], nonce, out, ad)
+		if err != nil {
+			t.Fatalf("Open(in-place): len(m) %d: %v", sz, err)
+		}
+		if !bytes.Equal(m, out) {
+			t.Fatalf("Open(in-place): len(m) %d: got %x, expected %x", sz, out, m)
+		}
+	}
+}
+
+// BenchmarkAEAD runs Seal and Open benchmarks for each of the BenchSizes, as
+// sub-benchmarks of b.
+func BenchmarkAEAD(b *testing.B, v *Variant, newFn NewFunc) {
+	for _, sz := range BenchSizes {
+		sn := fmt.Sprintf("_%d", sz)
+		b.Run(v.Name+"_Encrypt"+sn, func(b *testing.B) { benchmarkSeal(b, v, newFn, sz) })
+		b.Run(v.Name+"_Decrypt"+sn, func(b *testing.B) { benchmarkOpen(b, v, newFn, sz) })
+	}
+}
+
+func benchmarkSeal(b *testing.B, v *Variant, newFn NewFunc, sz int) {
+	b.StopTimer()
+	b.SetBytes(int64(sz))
+
+	aead := newFn(randomBytes(b, v.KeySize))
+	nonce, m := randomBytes(b, nonceSize), randomBytes(b, sz)
+	c := make([]byte, 0, sz+tagSize)
+
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		c = aead.Seal(c[:0], nonce, m, nil)
+		if len(c) != sz+tagSize {
+			b.Fatalf("Seal failed")
+		}
+	}
+}
+
+func benchmarkOpen(b *testing.B, v *Variant, newFn NewFunc, sz int) {
+	b.StopTimer()
+	b.SetBytes(int64(sz))
+
+	aead := newFn(randomBytes(b, v.KeySize))
+	nonce, m := randomBytes(b, nonceSize), randomBytes(b, sz)
+	c := aead.Seal(nil, nonce, m, nil)
+	d := make([]byte, 0, sz)
+
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		if d, err = aead.Open(d[:0], nonce, c, nil); err != nil {
+			b.Fatalf("Open failed: %v", err)
+		}
+	}
+	b.StopTimer()
+
+	if !bytes.Equal(m, d) {
+		b.Fatalf("Open output mismatch")
+	}
+}
+
+func mustPanic(tb testing.TB, fn func(), format string, args ...interface{}) {
+	tb.Helper()
+	defer func() {
+		if recover() == nil {
+			tb.Fatalf(format+": did not panic", args...)
+		}
+	}()
+	fn()
+}
+
+func randomBytes(tb testing.TB, n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		tb.Fatalf("rand.Read: %v", err)
+	}
+	return b
+}
@@ -0,0 +1,40 @@
+// morustest_test.go - MORUS conformance tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morustest_test
+
+import (
+	"crypto/cipher"
+	"testing"
+
+	"github.com/Yawning/morus"
+	"github.com/Yawning/morus/morustest"
+)
+
+func newWithImpl(name string) morustest.NewFunc {
+	return func(key []byte) cipher.AEAD {
+		aead := morus.New(key)
+		if err := aead.SetImplementation(name); err != nil {
+			panic(err)
+		}
+		return aead
+	}
+}
+
+func TestConformance(t *testing.T) {
+	for _, name := range morus.Implementations() {
+		t.Run("MORUS-1280-256_"+name, func(t *testing.T) {
+			morustest.TestAEAD(t, morustest.MORUS1280256, newWithImpl(name))
+		})
+	}
+	t.Run("MORUS-1280-128", func(t *testing.T) {
+		morustest.TestAEAD(t, morustest.MORUS1280128, func(key []byte) cipher.AEAD { return morus.New128(key) })
+	})
+	t.Run("MORUS-640-128", func(t *testing.T) {
+		morustest.TestAEAD(t, morustest.MORUS640128, func(key []byte) cipher.AEAD { return morus.New640(key) })
+	})
+}
@@ -0,0 +1,46 @@
+// protected.go - Protected key storage
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import "errors"
+
+// ErrProtectedMemoryUnsupported is the error returned when protected key
+// storage is not supported on the host platform.
+var ErrProtectedMemoryUnsupported = errors.New("morus: protected memory not supported")
+
+// protectedKey is a key stored in a dedicated memory mapping.
+type protectedKey struct {
+	mapping []byte // The entire mapping, including the guard pages.
+	key     []byte
+}
+
+// NewProtected returns a new keyed MORUS-1280-256 instance, that stores the
+// key in a dedicated memory mapping that is locked into memory, surrounded
+// by inaccessible guard pages, and excluded from core dumps.  The mapping is
+// zeroed and released by Reset, or by a finalizer if the instance becomes
+// unreachable first, though calling Reset explicitly is preferred.
+//
+// This only protects the key itself.  The cipher state used while
+// processing a message is still stored on the goroutine stack, though it is
+// zeroed after use.
+//
+// ErrProtectedMemoryUnsupported is returned on platforms other than Linux.
+// The instance counts towards RLIMIT_MEMLOCK until it is Reset.
+func NewProtected(key []byte) (*AEAD, error) {
+	if len(key) != KeySize {
+		return nil, &KeySizeError{Expected: KeySize, Actual: len(key)}
+	}
+
+	pk, err := newProtectedKey(key)
+	if err != nil {
+		return nil, err
+	}
+	aead := &AEAD{key: pk.key, protected: pk, tagSize: TagSize}
+	aead.SetFinalizer()
+	return aead, nil
+}
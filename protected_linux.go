@@ -0,0 +1,61 @@
+// protected_linux.go - Protected key storage (Linux)
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+// +build linux
+
+package morus
+
+import (
+	"os"
+	"syscall"
+)
+
+// Not all versions of the syscall package define this.
+const madvDontDump = 0x10
+
+func newProtectedKey(key []byte) (*protectedKey, error) {
+	pageSize := os.Getpagesize()
+
+	// Allocate a single data page with a guard page on each side.
+	mapping, err := syscall.Mmap(-1, 0, 3*pageSize, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return nil, os.NewSyscallError("mmap", err)
+	}
+	data := mapping[pageSize : 2*pageSize]
+
+	if err = syscall.Mprotect(mapping[:pageSize], syscall.PROT_NONE); err != nil {
+		err = os.NewSyscallError("mprotect", err)
+	} else if err = syscall.Mprotect(mapping[2*pageSize:], syscall.PROT_NONE); err != nil {
+		err = os.NewSyscallError("mprotect", err)
+	} else if err = syscall.Madvise(mapping, madvDontDump); err != nil {
+		err = os.NewSyscallError("madvise", err)
+	} else if err = syscall.Mlock(data); err != nil {
+		err = os.NewSyscallError("mlock", err)
+	}
+	if err != nil {
+		_ = syscall.Munmap(mapping)
+		return nil, err
+	}
+
+	// Place the key at the end of the data page, so that overruns fault
+	// on the trailing guard page.
+	pk := &protectedKey{
+		mapping: mapping,
+		key:     data[pageSize-len(key):],
+	}
+	copy(pk.key, key)
+
+	return pk, nil
+}
+
+func (pk *protectedKey) release() {
+	pageSize := os.Getpagesize()
+
+	_ = syscall.Munlock(pk.mapping[pageSize : 2*pageSize])
+	_ = syscall.Munmap(pk.mapping)
+	pk.mapping, pk.key = nil, nil
+}
@@ -0,0 +1,154 @@
+// protected_linux_test.go - Protected key storage tests (Linux)
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+// +build linux
+
+package morus
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtectedMapping(t *testing.T) {
+	require := require.New(t)
+
+	key := make([]byte, KeySize)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	aead, err := NewProtected(key)
+	require.NoError(err, "NewProtected()")
+	defer aead.Reset()
+
+	mapping := aead.protected.mapping
+	pageSize := os.Getpagesize()
+
+	// The guard pages must fault on access, and the data page must not.
+	for _, v := range []struct {
+		name  string
+		off   int
+		fault bool
+	}{
+		{"Leading guard", pageSize - 1, true},
+		{"Data", pageSize, false},
+		{"Key", 2*pageSize - 1, false},
+		{"Trailing guard", 2 * pageSize, true},
+	} {
+		require.Equal(v.fault, faults(mapping, v.off), "%s page: Fault", v.name)
+	}
+
+	// The kernel splits the mapping into one VMA per page, with differing
+	// protections, and reports the advice and lock state of each.
+	base := uintptr(unsafe.Pointer(&mapping[0]))
+	flags, err := vmFlags()
+	require.NoError(err, "vmFlags()")
+	for i, v := range []struct {
+		name     string
+		expected []string
+		absent   []string
+	}{
+		{"Leading guard", []string{"dd"}, []string{"rd", "wr", "lo"}},
+		{"Data", []string{"rd", "wr", "dd", "lo"}, nil},
+		{"Trailing guard", []string{"dd"}, []string{"rd", "wr", "lo"}},
+	} {
+		start := base + uintptr(i*pageSize)
+		f, ok := flags[start]
+		require.True(ok, "%s page: No VMA at %#x", v.name, start)
+		for _, flag := range v.expected {
+			require.Contains(f, flag, "%s page: VmFlags", v.name)
+		}
+		for _, flag := range v.absent {
+			require.NotContains(f, flag, "%s page: VmFlags", v.name)
+		}
+	}
+}
+
+// The syscall package does not define this, and the value differs on some
+// architectures (eg: MIPS).
+const rlimitMemlock = 8
+
+func TestProtectedMemlockLimit(t *testing.T) {
+	require := require.New(t)
+
+	switch runtime.GOARCH {
+	case "386", "amd64", "arm", "arm64":
+	default:
+		t.Skip("RLIMIT_MEMLOCK value unknown for " + runtime.GOARCH)
+	}
+	if os.Geteuid() == 0 {
+		t.Skip("RLIMIT_MEMLOCK is not enforced for root")
+	}
+
+	aead, err := NewProtected(make([]byte, KeySize))
+	require.NoError(err, "NewProtected()")
+	defer aead.Reset()
+
+	var old syscall.Rlimit
+	require.NoError(syscall.Getrlimit(rlimitMemlock, &old), "Getrlimit()")
+	lim := syscall.Rlimit{Cur: 0, Max: old.Max}
+	require.NoError(syscall.Setrlimit(rlimitMemlock, &lim), "Setrlimit()")
+	defer func() { _ = syscall.Setrlimit(rlimitMemlock, &old) }()
+
+	clone, err := aead.Clone()
+	require.Nil(clone, "Clone(): RLIMIT_MEMLOCK exhausted")
+	require.Error(err, "Clone(): RLIMIT_MEMLOCK exhausted")
+
+	_, err = NewProtected(make([]byte, KeySize))
+	require.Error(err, "NewProtected(): RLIMIT_MEMLOCK exhausted")
+}
+
+// faultSink forces the load in faults to happen.
+var faultSink byte
+
+// faults returns true iff reading b[off] faults.
+func faults(b []byte, off int) (faulted bool) {
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	defer func() {
+		faulted = recover() != nil
+	}()
+
+	faultSink = b[off]
+	return false
+}
+
+// vmFlags returns the VmFlags of each of the process's VMAs, by start
+// address.
+func vmFlags() (map[uintptr][]string, error) {
+	f, err := os.Open("/proc/self/smaps")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	flags := make(map[uintptr][]string)
+	var start uintptr
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "VmFlags:") {
+			flags[start] = strings.Fields(line)[1:]
+			continue
+		}
+		// Field names such as "Anonymous" start with hex digits, so
+		// only a full match is a VMA header.
+		var lo, hi uintptr
+		if n, _ := fmt.Sscanf(line, "%x-%x", &lo, &hi); n == 2 {
+			start = lo
+		}
+	}
+	return flags, scanner.Err()
+}
@@ -0,0 +1,16 @@
+// protected_other.go - Protected key storage (Unsupported)
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+// +build !linux
+
+package morus
+
+func newProtectedKey(key []byte) (*protectedKey, error) {
+	return nil, ErrProtectedMemoryUnsupported
+}
+
+func (pk *protectedKey) release() {}
@@ -0,0 +1,79 @@
+// protected_test.go - Protected key storage tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/rand"
+	"errors"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtected(t *testing.T) {
+	require := require.New(t)
+
+	var nonce [NonceSize]byte
+	key := make([]byte, KeySize)
+	_, err := rand.Read(key)
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+
+	_, err = NewProtected(key[:KeySize-1])
+	require.True(errors.Is(err, ErrInvalidKeySize), "NewProtected(Short key)")
+
+	aead, err := NewProtected(key)
+	if runtime.GOOS != "linux" {
+		require.Equal(ErrProtectedMemoryUnsupported, err, "NewProtected()")
+		return
+	}
+	require.NoError(err, "NewProtected()")
+
+	// The key should be at the very end of the data page, immediately
+	// before the trailing guard page.
+	pk := aead.protected
+	pageSize := os.Getpagesize()
+	require.Len(pk.mapping, 3*pageSize, "NewProtected(): len(mapping)")
+	require.Equal(key, pk.mapping[2*pageSize-KeySize:2*pageSize], "NewProtected(): Key placement")
+
+	m := []byte("Keys kept in locked memory are never swapped out")
+	c := aead.Seal(nil, nonce[:], m, nil)
+	require.Equal(New(key).Seal(nil, nonce[:], m, nil), c, "Seal()")
+
+	require.True(aead.finalizer, "NewProtected(): Finalizer")
+	clone, err := aead.Clone()
+	require.NoError(err, "Clone()")
+	require.NotNil(clone.protected, "Clone(): Protected")
+	require.True(clone.finalizer, "Clone(): Finalizer")
+
+	aead.Reset()
+	require.Nil(aead.protected, "Reset(): Protected")
+	require.Nil(pk.mapping, "Reset(): Mapping")
+	require.PanicsWithValue(ErrInvalidState, func() { aead.Seal(nil, nonce[:], m, nil) }, "Seal(): After Reset()")
+
+	d, err := clone.Open(nil, nonce[:], c, nil)
+	require.NoError(err, "Clone(): Open()")
+	require.Equal(m, d, "Clone(): Open()")
+	clone.Reset()
+
+	// Test that the finalizer releases the mapping.
+	func() {
+		aead, err := NewProtected(key)
+		require.NoError(err, "NewProtected()")
+		pk = aead.protected
+	}()
+	for i := 0; i < 100 && pk.mapping != nil; i++ {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+	}
+	require.Nil(pk.mapping, "Finalizer: Mapping")
+}
@@ -0,0 +1,157 @@
+// session.go - Session interface
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import "crypto/subtle"
+
+// sessionDomain is the first byte of each session frame header.  It can't
+// collide with the tag size used to domain separate truncated tags.
+const sessionDomain = 0x80
+
+// Session is a MORUS-1280-256 session, where a single state is initialized
+// once, and continued across a sequence of messages.  Each message produces
+// a tag that authenticates the message, and every message that preceded it
+// in the session, which avoids the cost of initializing a new state for
+// each message.
+//
+// Each message is preceded by a frame header containing the message's
+// sequence number and the lengths of the additional data and plaintext,
+// which is absorbed into the state, and is not included in the lengths used
+// to derive the tag.  This means that messages can't be reordered, dropped
+// from the middle of the session, replayed or spliced together, and that
+// tags are distinct from those produced by AEAD.Seal.  Dropping messages
+// from the end of the session can not be detected, and protocols that care
+// should send an explicit final message.
+//
+// Both ends of a session must process the same sequence of messages in the
+// same order, so a single Session may be used for a half-duplex exchange,
+// but traffic in both directions at once requires a Session per direction,
+// with a different key or nonce.
+//
+// A Session is not safe for concurrent use.
+type Session struct {
+	s    state
+	impl *hwaccelImpl
+	seq  uint64
+}
+
+// Seal encrypts and authenticates the next message of the session,
+// appending the ciphertext and tag to dst, and returns the updated slice.
+//
+// The plaintext and dst must overlap exactly or not at all. To reuse
+// plaintext's storage for the encrypted output, use plaintext[:0] as dst.
+func (ss *Session) Seal(dst, plaintext, additionalData []byte) []byte {
+	ss.checkReset()
+
+	mLen := len(plaintext)
+	ret, out := sliceForAppend(dst, mLen+TagSize)
+
+	ss.beginFrame(len(additionalData), mLen)
+	ss.impl.absorbData(&ss.s, additionalData)
+	ss.impl.encryptData(&ss.s, out[:mLen], plaintext)
+	ss.frameTag(out[mLen:], len(additionalData), mLen)
+	ss.seq++
+
+	return ret
+}
+
+// Open decrypts and authenticates the next message of the session,
+// appending the plaintext to dst, and returns the updated slice.  On
+// failure, ErrOpen is returned, and the session is left as if Open was
+// never called, so that forged messages can be discarded.
+//
+// The ciphertext and dst must overlap exactly or not at all. To reuse
+// ciphertext's storage for the decrypted output, use ciphertext[:0] as dst.
+func (ss *Session) Open(dst, ciphertext, additionalData []byte) ([]byte, error) {
+	var srcTag, expectedTag [TagSize]byte
+
+	ss.checkReset()
+
+	cLen := len(ciphertext)
+	if cLen < TagSize {
+		return nil, ErrOpen
+	}
+	mLen := cLen - TagSize
+
+	// Copy the tag first, in case it is overwritten by in-place decryption.
+	copy(srcTag[:], ciphertext[mLen:])
+
+	ret, out := sliceForAppend(dst, mLen)
+
+	saved := ss.s
+	ss.beginFrame(len(additionalData), mLen)
+	ss.impl.absorbData(&ss.s, additionalData)
+	ss.impl.decryptData(&ss.s, out, ciphertext[:mLen])
+	ss.frameTag(expectedTag[:], len(additionalData), mLen)
+
+	ok := subtle.ConstantTimeCompare(srcTag[:], expectedTag[:]) == 1
+	if ok {
+		ss.seq++
+	} else {
+		// Burn decrypted plaintext on auth failure, and roll back.
+		if mLen > 0 {
+			burnBytes(out)
+		}
+		ss.s = saved
+		ret = nil
+	}
+	burnUint64s(saved.s[:])
+
+	if !ok {
+		return nil, ErrOpen
+	}
+	return ret, nil
+}
+
+// Reset securely purges stored sensitive data from the Session instance,
+// which may not be used afterwards.
+func (ss *Session) Reset() {
+	burnUint64s(ss.s.s[:])
+	ss.impl = nil
+}
+
+func (ss *Session) checkReset() {
+	if ss.impl == nil {
+		panic(ErrInvalidState)
+	}
+}
+
+// beginFrame absorbs the header for the next message.
+func (ss *Session) beginFrame(adLen, mLen int) {
+	var hdr [blockSize]byte
+
+	hdr[0] = sessionDomain
+	byteOrder.PutUint64(hdr[8:16], ss.seq)
+	byteOrder.PutUint64(hdr[16:24], uint64(adLen))
+	byteOrder.PutUint64(hdr[24:32], uint64(mLen))
+	ss.impl.absorbBlocksFn(&ss.s, hdr[:])
+}
+
+// frameTag derives the tag for the current message, by finalizing a copy
+// of the state, so that the session can continue.
+func (ss *Session) frameTag(tag []byte, adLen, mLen int) {
+	s := ss.s
+	ss.impl.finalizeFn(&s, uint64(mLen), uint64(adLen), tag)
+	burnUint64s(s.s[:])
+}
+
+// NewSession returns a new Session keyed with a MORUS-1280-256 key and
+// nonce.  The nonce must be NonceSize bytes long and unique for all time,
+// for a given key.
+func NewSession(key, nonce []byte) *Session {
+	if len(key) != KeySize {
+		panic(ErrInvalidKeySize)
+	}
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+
+	ss := &Session{impl: hardwareAccelImpl}
+	ss.impl.initFn(&ss.s, key, nonce)
+	return ss
+}
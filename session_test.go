@@ -0,0 +1,150 @@
+// session_test.go - Session interface tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newSessionWithImpl(impl *hwaccelImpl, key, nonce []byte) *Session {
+	ss := NewSession(key, nonce)
+	ss.impl = impl
+	ss.impl.initFn(&ss.s, key, nonce)
+	return ss
+}
+
+func TestSession(t *testing.T) {
+	require := require.New(t)
+
+	var key [KeySize]byte
+	var nonce [NonceSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+
+	sizes := [][2]int{{0, 0}, {64, 0}, {0, 64}, {1, 31}, {32, 32}, {33, 65}, {7, 1027}, {0, 8}}
+	ms, as := make([][]byte, len(sizes)), make([][]byte, len(sizes))
+	for i, sz := range sizes {
+		ms[i], as[i] = make([]byte, sz[1]), make([]byte, sz[0])
+		_, _ = rand.Read(ms[i])
+		_, _ = rand.Read(as[i])
+	}
+
+	// Every implementation must produce the same frames, and be able to
+	// open the frames produced by every other implementation.
+	var expected [][]byte
+	for _, sealImpl := range supportedImpls() {
+		sealer := newSessionWithImpl(sealImpl, key[:], nonce[:])
+		var cs [][]byte
+		for i := range ms {
+			cs = append(cs, sealer.Seal(nil, ms[i], as[i]))
+		}
+		if expected == nil {
+			expected = cs
+		}
+		require.Equal(expected, cs, "Seal(%s)", sealImpl.name)
+
+		for _, openImpl := range supportedImpls() {
+			opener := newSessionWithImpl(openImpl, key[:], nonce[:])
+			for i, c := range cs {
+				m, err := opener.Open(nil, c, as[i])
+				require.NoError(err, "Open(%s, %s): %d", sealImpl.name, openImpl.name, i)
+				require.Equal(string(ms[i]), string(m), "Open(%s, %s): %d", sealImpl.name, openImpl.name, i)
+			}
+		}
+	}
+
+	// Frames are distinct from regular MORUS, even for the first message.
+	aead := New(key[:])
+	require.NotEqual(aead.Seal(nil, nonce[:], ms[0], as[0]), expected[0], "Seal(): Not AEAD.Seal()")
+
+	// Identical messages produce different frames.
+	sealer := NewSession(key[:], nonce[:])
+	require.NotEqual(sealer.Seal(nil, ms[1], nil), sealer.Seal(nil, ms[1], nil), "Seal(): Repeated message")
+
+	// In-place.
+	sealer = NewSession(key[:], nonce[:])
+	opener := NewSession(key[:], nonce[:])
+	for i, m := range ms {
+		buf := make([]byte, len(m), len(m)+TagSize)
+		copy(buf, m)
+		c := sealer.Seal(buf[:0], buf, as[i])
+		require.Equal(expected[i], c, "Seal(in-place): %d", i)
+
+		d, err := opener.Open(c[:0], c, as[i])
+		require.NoError(err, "Open(in-place): %d", i)
+		require.Equal(string(m), string(d), "Open(in-place): %d", i)
+	}
+
+	// Failures must leave the session unchanged.
+	opener = NewSession(key[:], nonce[:])
+	badC := append([]byte{}, expected[0]...)
+	badC[len(badC)-1] ^= 0x23
+	spliced := append(append([]byte{}, expected[3]...), expected[4]...)
+	for _, v := range []struct {
+		name string
+		c    []byte
+		ad   []byte
+	}{
+		{"Reordered", expected[1], as[1]},
+		{"Wrong AD", expected[0], as[1]},
+		{"Truncated", expected[0][:TagSize-1], as[0]},
+		{"Spliced", spliced, as[3]},
+		{"Bad c", badC, as[0]},
+	} {
+		m, err := opener.Open(nil, v.c, v.ad)
+		require.Equal(ErrOpen, err, "Open(%s)", v.name)
+		require.Nil(m, "Open(%s)", v.name)
+	}
+	m, err := opener.Open(nil, expected[0], as[0])
+	require.NoError(err, "Open(): After failures")
+	require.Equal(string(ms[0]), string(m), "Open(): After failures")
+
+	m, err = opener.Open(nil, expected[0], as[0])
+	require.Equal(ErrOpen, err, "Open(Replayed)")
+	require.Nil(m, "Open(Replayed)")
+
+	m, err = opener.Open(nil, expected[1], as[1])
+	require.NoError(err, "Open(): After replay")
+	require.Equal(string(ms[1]), string(m), "Open(): After replay")
+
+	opener.Reset()
+	require.PanicsWithValue(ErrInvalidState, func() { opener.Seal(nil, ms[0], nil) }, "Seal(): After Reset")
+	require.PanicsWithValue(ErrInvalidState, func() { _, _ = opener.Open(nil, expected[0], nil) }, "Open(): After Reset")
+}
+
+func BenchmarkSession(b *testing.B) {
+	for _, sz := range []int{8, 32, 64, 576} {
+		sn := fmt.Sprintf("_%d", sz)
+		b.Run("Session"+sn, func(b *testing.B) {
+			key, nonce, m := make([]byte, KeySize), make([]byte, NonceSize), make([]byte, sz)
+			ss := NewSession(key, nonce)
+			c := make([]byte, 0, sz+TagSize)
+			b.SetBytes(int64(sz))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				c = ss.Seal(c[:0], m, nil)
+			}
+		})
+		b.Run("AEAD"+sn, func(b *testing.B) {
+			key, nonce, m := make([]byte, KeySize), make([]byte, NonceSize), make([]byte, sz)
+			aead := New(key)
+			c := make([]byte, 0, sz+TagSize)
+			b.SetBytes(int64(sz))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				c = aead.Seal(c[:0], nonce, m, nil)
+			}
+		})
+	}
+}
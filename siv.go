@@ -0,0 +1,173 @@
+// siv.go - Nonce misuse resistant MORUS-SIV
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+)
+
+// SIVKeySize is the size of a MORUS-SIV key in bytes.
+const SIVKeySize = 2 * KeySize
+
+var _ cipher.AEAD = (*SIV)(nil)
+
+// SIV is a MORUS-SIV instance, implementing crypto/cipher.AEAD.
+//
+// MORUS-SIV is not part of the MORUS specification.  It is a SIV style
+// construction built out of MORUS-1280-256, where the first half of the
+// key is used to calculate a synthetic IV over the nonce, additional data
+// and plaintext, and the second half of the key is used to encrypt the
+// plaintext with MORUS-1280-256, using the synthetic IV as the nonce.  The
+// synthetic IV is used as the authentication tag.
+//
+// Reusing a nonce with MORUS-SIV only reveals if the same additional data
+// and plaintext was sealed more than once, at the cost of requiring two
+// passes over the plaintext.
+type SIV struct {
+	key []byte
+}
+
+// NonceSize returns the size of the nonce that must be passed to Seal and
+// Open.
+func (ae *SIV) NonceSize() int {
+	return NonceSize
+}
+
+// Overhead returns the maximum difference between the lengths of a plaintext
+// and its ciphertext.
+func (ae *SIV) Overhead() int {
+	return TagSize
+}
+
+// Seal encrypts and authenticates plaintext, authenticates the
+// additional data and appends the result to dst, returning the updated
+// slice. The nonce must be NonceSize() bytes long, and should be unique
+// for a given key.
+//
+// The plaintext and dst must overlap exactly or not at all. To reuse
+// plaintext's storage for the encrypted output, use plaintext[:0] as dst.
+func (ae *SIV) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	var siv [TagSize]byte
+
+	ae.checkKey()
+
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+	impl := hardwareAccelImpl
+	mLen := len(plaintext)
+
+	ret, out := sliceForAppend(dst, mLen+TagSize)
+
+	// The synthetic IV must be derived before the plaintext can be
+	// overwritten.
+	sivDerive(impl, siv[:], ae.key[:KeySize], nonce, plaintext, additionalData)
+	sivCrypt(impl, out, plaintext, siv[:], ae.key[KeySize:], false)
+	copy(out[mLen:], siv[:])
+
+	return ret
+}
+
+// Open decrypts and authenticates ciphertext, authenticates the
+// additional data and, if successful, appends the resulting plaintext
+// to dst, returning the updated slice. The nonce must be NonceSize()
+// bytes long and both it and the additional data must match the
+// value passed to Seal.
+//
+// The ciphertext and dst must overlap exactly or not at all. To reuse
+// ciphertext's storage for the decrypted output, use ciphertext[:0] as dst.
+//
+// Even if the function fails, the contents of dst, up to its capacity,
+// may be overwritten.
+func (ae *SIV) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	var srcTag, tag [TagSize]byte
+
+	ae.checkKey()
+
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+	impl := hardwareAccelImpl
+	cLen := len(ciphertext)
+
+	if cLen < TagSize {
+		return nil, ErrOpen
+	}
+
+	mLen := cLen - TagSize
+	ret, out := sliceForAppend(dst, mLen)
+
+	copy(srcTag[:], ciphertext[mLen:])
+	sivCrypt(impl, out, ciphertext[:mLen], srcTag[:], ae.key[KeySize:], true)
+	sivDerive(impl, tag[:], ae.key[:KeySize], nonce, out, additionalData)
+
+	ok := subtle.ConstantTimeCompare(srcTag[:], tag[:]) == 1
+	if !ok {
+		// Burn decrypted plaintext on auth failure.
+		if mLen > 0 {
+			burnBytes(out)
+		}
+		return nil, ErrOpen
+	}
+
+	return ret, nil
+}
+
+// Reset securely purges stored sensitive data from the SIV instance.  Any
+// further use of the instance, other than calling Reset again, will panic
+// with ErrInvalidState.
+func (ae *SIV) Reset() {
+	if ae.key == nil {
+		return
+	}
+
+	burnBytes(ae.key)
+	ae.key = nil
+}
+
+func (ae *SIV) checkKey() {
+	if ae.key == nil {
+		panic(ErrInvalidState)
+	}
+}
+
+// NewSIV returns a new keyed MORUS-SIV instance.
+func NewSIV(key []byte) *SIV {
+	if len(key) != SIVKeySize {
+		panic(ErrInvalidKeySize)
+	}
+	return &SIV{key: append([]byte{}, key...)}
+}
+
+func sivDerive(impl *hwaccelImpl, siv, key, nonce, m, a []byte) {
+	var s state
+
+	// This is MORUS-1280-256 with the plaintext absorbed immediately after
+	// the additional data.  As both lengths are included in the
+	// finalization, the encoding is unambiguous.
+	impl.initFn(&s, key, nonce)
+	impl.absorbData(&s, a)
+	impl.absorbData(&s, m)
+	impl.finalizeFn(&s, uint64(len(m)), uint64(len(a)), siv)
+
+	burnUint64s(s.s[:])
+}
+
+func sivCrypt(impl *hwaccelImpl, out, in, siv, key []byte, decrypt bool) {
+	var s state
+
+	impl.initFn(&s, key, siv)
+	if decrypt {
+		impl.decryptData(&s, out, in)
+	} else {
+		impl.encryptData(&s, out, in)
+	}
+
+	burnUint64s(s.s[:])
+}
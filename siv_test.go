@@ -0,0 +1,107 @@
+// siv_test.go - MORUS-SIV tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSIV(t *testing.T) {
+	require := require.New(t)
+	defer initHardwareAcceleration()
+
+	var key [SIVKeySize]byte
+	var nonce [NonceSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+
+	aead := NewSIV(key[:])
+	require.Equal(NonceSize, aead.NonceSize(), "NonceSize()")
+	require.Equal(TagSize, aead.Overhead(), "Overhead()")
+
+	for _, sz := range []int{0, 1, 31, 32, 33, 1024, 4099} {
+		m, a := make([]byte, sz), make([]byte, sz/2)
+		_, _ = rand.Read(m)
+		_, _ = rand.Read(a)
+
+		hardwareAccelImpl = portableImpls[0]
+		expected := aead.Seal(nil, nonce[:], m, a)
+
+		for _, impl := range supportedImpls() {
+			hardwareAccelImpl = impl
+			t.Run(fmt.Sprintf("SIV_%s_%d", impl.name, sz), func(t *testing.T) {
+				doTestSIV(t, aead, nonce[:], m, a, expected)
+			})
+		}
+	}
+
+	_, err = aead.Open(nil, nonce[:], make([]byte, TagSize-1), nil)
+	require.Equal(ErrOpen, err, "Open(Short c)")
+}
+
+func doTestSIV(t *testing.T, aead *SIV, nonce, m, a, expected []byte) {
+	require := require.New(t)
+	sz := len(m)
+
+	c := aead.Seal(nil, nonce, m, a)
+	require.Len(c, sz+TagSize, "Seal(): len(c)")
+	require.Equal(expected, c, "Seal()")
+
+	// Test in-place decryption.
+	d, err := aead.Open(nil, nonce, c, a)
+	require.NoError(err, "Open()")
+	require.Len(d, sz, "Open(): len(d)")
+	if sz != 0 {
+		require.Equal(m, d, "Open()")
+	}
+	d = append([]byte{}, c...)
+	d, err = aead.Open(d[:0], nonce, d, a)
+	require.NoError(err, "Open(In place)")
+	if sz != 0 {
+		require.Equal(m, d, "Open(In place)")
+	}
+
+	// Test malformed ciphertext.
+	badC := append([]byte{}, c...)
+	badC[sz] ^= 0x23
+	d, err = aead.Open(nil, nonce, badC, a)
+	require.Equal(ErrOpen, err, "Open(Bad c)")
+	require.Nil(d, "Open(Bad c)")
+
+	// Test malformed AD.
+	if sz > 1 {
+		badA := append([]byte{}, a...)
+		badA[0] ^= 0x23
+		_, err = aead.Open(nil, nonce, c, badA)
+		require.Equal(ErrOpen, err, "Open(Bad a)")
+	}
+
+	// Test that nonce reuse only reveals message equality.
+	if sz != 0 {
+		m2 := append([]byte{}, m...)
+		m2[sz-1] ^= 0x23
+		c2 := aead.Seal(nil, nonce, m2, a)
+		if sz >= TagSize {
+			// Shorter ciphertexts can collide by chance.
+			require.NotEqual(c[:sz], c2[:sz], "Seal(Reused nonce)")
+		}
+		require.NotEqual(c[sz:], c2[sz:], "Seal(Reused nonce): tag")
+	}
+}
+
+func TestSIVLifecycle(t *testing.T) {
+	aead := NewSIV(bytes.Repeat([]byte{0x23}, SIVKeySize))
+	doTestResetLifecycle(t, aead, aead.key)
+}
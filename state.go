@@ -0,0 +1,127 @@
+// state.go - Low level state interface
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+// State is a raw MORUS-1280-256 state, for building custom constructions on
+// top of the MORUS state update function.  It uses the same implementation
+// (eg: AVX2) as the AEAD, selected when Init is called.
+//
+// The following invariants apply:
+//
+//   - Init must be called before any other method, and may be called again
+//     to start over.  Using a State that was never initialized, or that has
+//     been finalized or reset, will panic with ErrInvalidState.
+//
+//   - Absorb, Encrypt and Decrypt each process their input as a sequence of
+//     BlockSize byte blocks, and a trailing partial block is padded with
+//     zeros.  The output of multiple calls is only identical to that of a
+//     single call over the concatenated input if every call but the last is
+//     a multiple of BlockSize bytes.
+//
+//   - Finalize does not track what has been processed, and the lengths
+//     passed to it are the only thing that binds the padding.  Absorbing the
+//     additional data, encrypting the plaintext, and finalizing with their
+//     lengths is exactly MORUS-1280-256 as implemented by AEAD.Seal.
+//
+//   - Nothing prevents the same key stream from being used to encrypt two
+//     different plaintexts, either by reusing a key and nonce, or by
+//     encrypting with two clones of the same State.  Constructions built on
+//     State are responsible for avoiding this.
+//
+// A State is not safe for concurrent use.
+type State struct {
+	s    state
+	impl *hwaccelImpl
+}
+
+// BlockSize is the size of a MORUS-1280 block in bytes.
+const BlockSize = blockSize
+
+// Init initializes the State with a MORUS-1280-256 key and nonce.
+func (st *State) Init(key, nonce []byte) {
+	if len(key) != KeySize {
+		panic(ErrInvalidKeySize)
+	}
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+
+	st.impl = hardwareAccelImpl
+	st.impl.initFn(&st.s, key, nonce)
+}
+
+// Implementation returns the name of the implementation used by the State.
+func (st *State) Implementation() string {
+	st.checkInit()
+	return st.impl.name
+}
+
+// Absorb updates the State with data that is authenticated but not
+// encrypted.
+func (st *State) Absorb(data []byte) {
+	st.checkInit()
+	st.impl.absorbData(&st.s, data)
+}
+
+// Encrypt encrypts plaintext, appends the result to dst, and returns the
+// updated slice.
+//
+// The plaintext and dst must overlap exactly or not at all. To reuse
+// plaintext's storage for the encrypted output, use plaintext[:0] as dst.
+func (st *State) Encrypt(dst, plaintext []byte) []byte {
+	st.checkInit()
+	ret, out := sliceForAppend(dst, len(plaintext))
+	st.impl.encryptData(&st.s, out, plaintext)
+	return ret
+}
+
+// Decrypt decrypts ciphertext, appends the result to dst, and returns the
+// updated slice.  The plaintext is unauthenticated until a tag derived by
+// Finalize has been checked, and must be treated accordingly.
+//
+// The ciphertext and dst must overlap exactly or not at all. To reuse
+// ciphertext's storage for the decrypted output, use ciphertext[:0] as dst.
+func (st *State) Decrypt(dst, ciphertext []byte) []byte {
+	st.checkInit()
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	st.impl.decryptData(&st.s, out, ciphertext)
+	return ret
+}
+
+// Finalize derives a TagSize byte tag from the State, and the lengths in
+// bytes of the absorbed additional data and of the message, and returns
+// it.  The State is reset afterwards, and Clone should be used first if the
+// State is to be continued.
+func (st *State) Finalize(adLen, msgLen uint64) []byte {
+	st.checkInit()
+
+	tag := make([]byte, TagSize)
+	st.impl.finalizeFn(&st.s, msgLen, adLen, tag)
+	st.Reset()
+
+	return tag
+}
+
+// Clone returns an independent copy of the State.
+func (st *State) Clone() *State {
+	st.checkInit()
+	return &State{s: st.s, impl: st.impl}
+}
+
+// Reset securely purges the State, which may not be used afterwards, until
+// Init is called again.
+func (st *State) Reset() {
+	burnUint64s(st.s.s[:])
+	st.impl = nil
+}
+
+func (st *State) checkInit() {
+	if st.impl == nil {
+		panic(ErrInvalidState)
+	}
+}
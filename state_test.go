@@ -0,0 +1,102 @@
+// state_test.go - Low level state interface tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestState(t *testing.T) {
+	defer initHardwareAcceleration()
+
+	for _, impl := range supportedImpls() {
+		hardwareAccelImpl = impl
+		t.Run("State_"+impl.name, doTestState)
+	}
+}
+
+// blockChunks splits b into BlockSize chunks, and a trailing partial chunk.
+func blockChunks(b []byte) [][]byte {
+	var chunks [][]byte
+	for len(b) > BlockSize {
+		chunks = append(chunks, b[:BlockSize])
+		b = b[BlockSize:]
+	}
+	return append(chunks, b)
+}
+
+func doTestState(t *testing.T) {
+	require := require.New(t)
+
+	var key [KeySize]byte
+	var nonce [NonceSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+
+	aead := New(key[:])
+	for _, sz := range [][2]int{{0, 0}, {1, 0}, {0, 31}, {32, 32}, {33, 65}, {100, 1027}} {
+		ad, m := make([]byte, sz[0]), make([]byte, sz[1])
+		_, _ = rand.Read(ad)
+		_, _ = rand.Read(m)
+		expected := aead.Seal(nil, nonce[:], m, ad)
+
+		var st State
+		st.Init(key[:], nonce[:])
+		require.Equal(hardwareAccelImpl.name, st.Implementation(), "Implementation()")
+		st.Absorb(ad)
+		c := st.Encrypt(nil, m)
+		c = append(c, st.Finalize(uint64(len(ad)), uint64(len(m)))...)
+		require.Equal(expected, c, "Encrypt(): %v", sz)
+
+		// Splitting the input at block boundaries is equivalent.
+		st.Init(key[:], nonce[:])
+		for _, chunk := range blockChunks(ad) {
+			st.Absorb(chunk)
+		}
+		d := make([]byte, 0, len(m))
+		for _, chunk := range blockChunks(expected[:len(m)]) {
+			d = st.Decrypt(d, chunk)
+		}
+		require.Equal(string(m), string(d), "Decrypt(): %v", sz)
+		require.Equal(expected[len(m):], st.Finalize(uint64(len(ad)), uint64(len(m))), "Finalize(): %v", sz)
+	}
+
+	// Clones are independent.
+	var st State
+	st.Init(key[:], nonce[:])
+	st.Absorb([]byte("Annie are you OK?"))
+	clone := st.Clone()
+	a := st.Encrypt(nil, make([]byte, BlockSize))
+	b := clone.Encrypt(nil, make([]byte, BlockSize))
+	require.Equal(a, b, "Clone(): Encrypt()")
+	require.Equal(st.Finalize(17, BlockSize), clone.Finalize(17, BlockSize), "Clone(): Finalize()")
+
+	// Finalize and Reset invalidate the State.
+	for _, fn := range []func(){
+		func() { st.Absorb(nil) },
+		func() { st.Encrypt(nil, nil) },
+		func() { st.Decrypt(nil, nil) },
+		func() { st.Finalize(0, 0) },
+		func() { st.Clone() },
+	} {
+		require.PanicsWithValue(ErrInvalidState, fn, "After Finalize()")
+	}
+	st.Init(key[:], nonce[:])
+	st.Reset()
+	require.PanicsWithValue(ErrInvalidState, func() { st.Absorb(nil) }, "After Reset()")
+
+	var zero State
+	require.PanicsWithValue(ErrInvalidState, func() { zero.Absorb(nil) }, "Uninitialized")
+	require.PanicsWithValue(ErrInvalidKeySize, func() { zero.Init(key[:1], nonce[:]) }, "Init(Short key)")
+	require.PanicsWithValue(ErrInvalidNonceSize, func() { zero.Init(key[:], nonce[:1]) }, "Init(Short nonce)")
+}
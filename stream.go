@@ -0,0 +1,311 @@
+// stream.go - Incremental interface
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/subtle"
+	"errors"
+)
+
+// ErrInvalidState is the error thrown via a panic when an operation is
+// called out of order (eg: additional data is provided after encryption has
+// started, or the instance is used after Finish), or when any instance is
+// used after Reset.
+var ErrInvalidState = errors.New("morus: invalid state for operation")
+
+const (
+	phaseAD = iota
+	phaseData
+	phaseDone
+)
+
+type stream struct {
+	s    state
+	impl *hwaccelImpl
+
+	buf    [blockSize]byte // Pending partial block (AD or plaintext).
+	ks     [blockSize]byte // Key stream for the pending plaintext block.
+	bufLen int
+
+	adLen   uint64
+	msgLen  uint64
+	phase   int
+	tagSize int
+}
+
+func (st *stream) init(impl *hwaccelImpl, key, nonce []byte, tagSize int) {
+	if len(key) != KeySize {
+		panic(ErrInvalidKeySize)
+	}
+	if len(nonce) != NonceSize {
+		panic(ErrInvalidNonceSize)
+	}
+
+	st.impl = impl
+	st.tagSize = tagSize
+	st.impl.initFn(&st.s, key, nonce)
+
+	// Truncated tags are domain separated, exactly as with truncatedCrypt.
+	if tagSize != TagSize {
+		st.buf[0] = byte(tagSize)
+		st.impl.absorbBlocksFn(&st.s, st.buf[:])
+		st.buf[0] = 0
+	}
+}
+
+func (st *stream) writeAD(ad []byte) {
+	if st.phase != phaseAD {
+		panic(ErrInvalidState)
+	}
+	st.adLen += uint64(len(ad))
+
+	if st.bufLen > 0 {
+		n := copy(st.buf[st.bufLen:], ad)
+		st.bufLen += n
+		ad = ad[n:]
+		if st.bufLen < blockSize {
+			return
+		}
+		st.impl.absorbBlocksFn(&st.s, st.buf[:])
+		st.bufLen = 0
+	}
+
+	if n := len(ad) &^ (blockSize - 1); n > 0 {
+		st.impl.absorbBlocksFn(&st.s, ad[:n])
+		ad = ad[n:]
+	}
+
+	st.bufLen = copy(st.buf[:], ad)
+}
+
+// flush pads and absorbs the pending partial block, if any.
+func (st *stream) flush() {
+	if st.bufLen > 0 {
+		burnBytes(st.buf[st.bufLen:])
+		st.impl.absorbBlocksFn(&st.s, st.buf[:])
+		st.bufLen = 0
+	}
+}
+
+func (st *stream) crypt(out, in []byte, decrypt bool) {
+	switch st.phase {
+	case phaseAD:
+		st.flush()
+		st.phase = phaseData
+	case phaseData:
+	default:
+		panic(ErrInvalidState)
+	}
+	st.msgLen += uint64(len(in))
+
+	// Finish off the pending partial block, with the previously derived
+	// key stream.
+	if st.bufLen > 0 {
+		n := st.xorPartial(out, in, decrypt)
+		out, in = out[n:], in[n:]
+		if st.bufLen < blockSize {
+			return
+		}
+		st.impl.absorbBlocksFn(&st.s, st.buf[:])
+		st.bufLen = 0
+	}
+
+	if n := len(in) &^ (blockSize - 1); n > 0 {
+		if decrypt {
+			st.impl.decryptBlocksFn(&st.s, out[:n], in[:n])
+		} else {
+			st.impl.encryptBlocksFn(&st.s, out[:n], in[:n])
+		}
+		out, in = out[n:], in[n:]
+	}
+
+	// Derive the key stream for the trailing partial block, and process
+	// as much of it as possible.  The state update is deferred till the
+	// block is completed, or the stream is finished.
+	if len(in) > 0 {
+		burnBytes(st.ks[:])
+		st.s.decryptBlockCommon(st.ks[:], st.ks[:])
+		st.xorPartial(out, in, decrypt)
+	}
+}
+
+func (st *stream) xorPartial(out, in []byte, decrypt bool) int {
+	n := blockSize - st.bufLen
+	if n > len(in) {
+		n = len(in)
+	}
+
+	buf, ks := st.buf[st.bufLen:st.bufLen+n], st.ks[st.bufLen:st.bufLen+n]
+	for i, v := range in[:n] {
+		// Careful: in and out may alias.
+		out[i] = v ^ ks[i]
+		if decrypt {
+			buf[i] = out[i]
+		} else {
+			buf[i] = v
+		}
+	}
+	st.bufLen += n
+
+	return n
+}
+
+func (st *stream) finish(tag []byte) {
+	var tmp [TagSize]byte
+
+	if st.phase == phaseDone {
+		panic(ErrInvalidState)
+	}
+	st.flush()
+	st.phase = phaseDone
+
+	st.impl.finalizeFn(&st.s, st.msgLen, st.adLen, tmp[:])
+	copy(tag, tmp[:st.tagSize])
+	burnBytes(tmp[:])
+	st.reset()
+}
+
+func (st *stream) reset() {
+	burnUint64s(st.s.s[:])
+	burnBytes(st.buf[:])
+	burnBytes(st.ks[:])
+	st.phase = phaseDone
+}
+
+// Sealer is an incremental MORUS-1280-256 encryption instance.
+//
+// All of the additional data must be provided via WriteAD before any
+// plaintext is passed to Encrypt, and the result is identical to that of
+// AEAD.Seal over the concatenated additional data and plaintext.
+type Sealer struct {
+	st stream
+}
+
+// WriteAD authenticates additional data.  It may be called any number of
+// times, prior to the first call to Encrypt.
+func (se *Sealer) WriteAD(additionalData []byte) {
+	se.st.writeAD(additionalData)
+}
+
+// Encrypt encrypts plaintext of arbitrary length, appends the result to
+// dst, and returns the updated slice.
+//
+// The plaintext and dst must overlap exactly or not at all. To reuse
+// plaintext's storage for the encrypted output, use plaintext[:0] as dst.
+func (se *Sealer) Encrypt(dst, plaintext []byte) []byte {
+	ret, out := sliceForAppend(dst, len(plaintext))
+	se.st.crypt(out, plaintext, false)
+	return ret
+}
+
+// Finish completes the encryption, and returns the authentication tag.
+// The Sealer instance may not be used after this is called.
+func (se *Sealer) Finish() []byte {
+	tag := make([]byte, se.st.tagSize)
+	se.st.finish(tag)
+	return tag
+}
+
+// Reset securely purges stored sensitive data from the Sealer instance,
+// which may not be used afterwards.
+func (se *Sealer) Reset() {
+	se.st.reset()
+}
+
+// NewSealer returns a new Sealer keyed with a MORUS-1280-256 key and nonce.
+// The nonce must be NonceSize bytes long and unique for all time, for a
+// given key.
+//
+// The Sealer uses the package default implementation.  AEAD.NewSealer
+// should be used instead for MORUS-1280-128 keys, truncated tags, or to
+// use the implementation selected for an AEAD instance.
+func NewSealer(key, nonce []byte) *Sealer {
+	se := new(Sealer)
+	se.st.init(hardwareAccelImpl, key, nonce, TagSize)
+	return se
+}
+
+// Opener is an incremental MORUS-1280-256 decryption instance.
+//
+// All of the additional data must be provided via WriteAD before any
+// ciphertext is passed to Decrypt.  Plaintext returned by Decrypt is
+// unauthenticated until Finish succeeds, and must be treated accordingly.
+type Opener struct {
+	st stream
+}
+
+// WriteAD authenticates additional data.  It may be called any number of
+// times, prior to the first call to Decrypt.
+func (op *Opener) WriteAD(additionalData []byte) {
+	op.st.writeAD(additionalData)
+}
+
+// Decrypt decrypts ciphertext of arbitrary length (excluding the tag),
+// appends the result to dst, and returns the updated slice.
+//
+// The ciphertext and dst must overlap exactly or not at all. To reuse
+// ciphertext's storage for the decrypted output, use ciphertext[:0] as dst.
+func (op *Opener) Decrypt(dst, ciphertext []byte) []byte {
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	op.st.crypt(out, ciphertext, true)
+	return ret
+}
+
+// Finish completes the decryption, and verifies the authentication tag,
+// returning ErrOpen on failure.  The Opener instance may not be used after
+// this is called.
+func (op *Opener) Finish(tag []byte) error {
+	var derivedTag [TagSize]byte
+	tagSize := op.st.tagSize
+	op.st.finish(derivedTag[:tagSize])
+
+	ok := subtle.ConstantTimeCompare(tag, derivedTag[:tagSize]) == 1
+	burnBytes(derivedTag[:])
+	if !ok {
+		return ErrOpen
+	}
+	return nil
+}
+
+// Reset securely purges stored sensitive data from the Opener instance,
+// which may not be used afterwards.
+func (op *Opener) Reset() {
+	op.st.reset()
+}
+
+// NewOpener returns a new Opener keyed with a MORUS-1280-256 key and nonce.
+//
+// The Opener uses the package default implementation.  AEAD.NewOpener
+// should be used instead for MORUS-1280-128 keys, truncated tags, or to
+// use the implementation selected for an AEAD instance.
+func NewOpener(key, nonce []byte) *Opener {
+	op := new(Opener)
+	op.st.init(hardwareAccelImpl, key, nonce, TagSize)
+	return op
+}
+
+// NewSealer returns a new Sealer using the AEAD instance's key, tag size and
+// implementation.  The nonce must be NonceSize bytes long and unique for all
+// time, for a given key.  The result is identical to that of Seal.
+func (ae *AEAD) NewSealer(nonce []byte) *Sealer {
+	ae.checkKey()
+
+	se := new(Sealer)
+	se.st.init(ae.getImpl(), ae.key, nonce, ae.tagSize)
+	return se
+}
+
+// NewOpener returns a new Opener using the AEAD instance's key, tag size and
+// implementation.  Finish must be passed a tag of Overhead() bytes.
+func (ae *AEAD) NewOpener(nonce []byte) *Opener {
+	ae.checkKey()
+
+	op := new(Opener)
+	op.st.init(ae.getImpl(), ae.key, nonce, ae.tagSize)
+	return op
+}
@@ -0,0 +1,161 @@
+// stream_test.go - Incremental interface tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/rand"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStream(t *testing.T) {
+	defer initHardwareAcceleration()
+
+	for _, impl := range supportedImpls() {
+		hardwareAccelImpl = impl
+		t.Run("Stream_"+impl.name, doTestStream)
+	}
+}
+
+// randomChunks splits b into randomly sized chunks.
+func randomChunks(b []byte) [][]byte {
+	var chunks [][]byte
+	for len(b) > 0 {
+		n := mrand.Intn(3*blockSize) + 1
+		if n > len(b) {
+			n = len(b)
+		}
+		chunks = append(chunks, b[:n])
+		b = b[n:]
+	}
+	return chunks
+}
+
+func doTestStream(t *testing.T) {
+	require := require.New(t)
+
+	var key [KeySize]byte
+	var nonce [NonceSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+
+	// The expected output always comes from the Reference implementation.
+	aead := New(key[:])
+	aead.impl = implReference
+	for _, sz := range []int{0, 1, 31, 32, 33, 95, 96, 1024, 4099} {
+		m, a := make([]byte, sz), make([]byte, (sz*7)/3)
+		_, _ = rand.Read(m)
+		_, _ = rand.Read(a)
+		expected := aead.Seal(nil, nonce[:], m, a)
+
+		se := NewSealer(key[:], nonce[:])
+		for _, chunk := range randomChunks(a) {
+			se.WriteAD(chunk)
+		}
+		var c []byte
+		for _, chunk := range randomChunks(m) {
+			c = se.Encrypt(c, chunk)
+		}
+		tag := se.Finish()
+		require.Len(c, sz, "Encrypt(): len(c) %d", sz)
+		if sz != 0 {
+			require.Equal(expected[:sz], c, "Encrypt(): %d", sz)
+		}
+		require.Equal(expected[sz:], tag, "Finish(): %d", sz)
+		require.Panics(func() { se.Encrypt(nil, m) }, "Encrypt() after Finish(): %d", sz)
+
+		op := NewOpener(key[:], nonce[:])
+		for _, chunk := range randomChunks(a) {
+			op.WriteAD(chunk)
+		}
+		var d []byte
+		for _, chunk := range randomChunks(c) {
+			// Exercise in-place decryption.
+			chunk = append([]byte{}, chunk...)
+			d = append(d, op.Decrypt(chunk[:0], chunk)...)
+		}
+		require.NoError(op.Finish(tag), "Finish(): %d", sz)
+		require.Len(d, sz, "Decrypt(): len(d) %d", sz)
+		if sz != 0 {
+			require.Equal(m, d, "Decrypt(): %d", sz)
+		}
+
+		// Test malformed tag.
+		badTag := append([]byte{}, tag...)
+		badTag[sz%TagSize] ^= 0x23
+		op = NewOpener(key[:], nonce[:])
+		op.WriteAD(a)
+		_ = op.Decrypt(nil, c)
+		require.Equal(ErrOpen, op.Finish(badTag), "Finish(Bad tag): %d", sz)
+
+		// Test out of order calls.
+		se = NewSealer(key[:], nonce[:])
+		_ = se.Encrypt(nil, m)
+		require.Panics(func() { se.WriteAD(a) }, "WriteAD() after Encrypt(): %d", sz)
+		se.Reset()
+	}
+}
+
+func TestStreamAEAD(t *testing.T) {
+	require := require.New(t)
+
+	var key [KeySize]byte
+	var nonce [NonceSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+
+	m, a := make([]byte, 1027), make([]byte, 45)
+	_, _ = rand.Read(m)
+	_, _ = rand.Read(a)
+
+	for _, v := range []struct {
+		name string
+		aead *AEAD
+	}{
+		{"MORUS-1280-256", New(key[:])},
+		{"MORUS-1280-128", New128(key[:KeySize128])},
+		{"Truncated", NewWithTagSize(key[:], MinTagSize+3)},
+	} {
+		for _, implName := range Implementations() {
+			err = v.aead.SetImplementation(implName)
+			require.NoError(err, "SetImplementation(%s)", implName)
+			expected := v.aead.Seal(nil, nonce[:], m, a)
+			mLen := len(m)
+
+			se := v.aead.NewSealer(nonce[:])
+			require.Equal(v.aead.getImpl(), se.st.impl, "NewSealer(%s, %s): impl", v.name, implName)
+			for _, chunk := range randomChunks(a) {
+				se.WriteAD(chunk)
+			}
+			var c []byte
+			for _, chunk := range randomChunks(m) {
+				c = se.Encrypt(c, chunk)
+			}
+			tag := se.Finish()
+			require.Equal(expected, append(c, tag...), "NewSealer(%s, %s)", v.name, implName)
+
+			op := v.aead.NewOpener(nonce[:])
+			require.Equal(v.aead.getImpl(), op.st.impl, "NewOpener(%s, %s): impl", v.name, implName)
+			op.WriteAD(a)
+			d := op.Decrypt(nil, expected[:mLen])
+			require.NoError(op.Finish(expected[mLen:]), "NewOpener(%s, %s)", v.name, implName)
+			require.Equal(m, d, "NewOpener(%s, %s)", v.name, implName)
+
+			op = v.aead.NewOpener(nonce[:])
+			op.WriteAD(a)
+			_ = op.Decrypt(nil, expected[:mLen])
+			require.Equal(ErrOpen, op.Finish(append(expected[mLen:], 0)), "NewOpener(%s, %s): Long tag", v.name, implName)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+// streamio.go - Segmented online encryption (STREAM)
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+const (
+	// SegmentSize is the maximum size of the plaintext of each segment
+	// of a Writer/Reader stream in bytes.
+	SegmentSize = 64 * 1024
+
+	// NoncePrefixSize is the size of a Writer/Reader nonce prefix in bytes.
+	NoncePrefixSize = NonceSize - segmentHeaderSize
+
+	// Each segment is prefixed by its big endian counter and a flag
+	// indicating if it is the final segment.  The header is not encrypted,
+	// but is authenticated as the suffix of the segment's nonce.
+	segmentHeaderSize = 4 + 1
+
+	flagFinalSegment = 1
+)
+
+var (
+	// ErrTruncated is the error returned when a stream ends without a
+	// final segment.
+	ErrTruncated = errors.New("morus: stream truncated")
+
+	// ErrSegmentDuplicated is the error returned when a stream contains
+	// a segment that was already read.
+	ErrSegmentDuplicated = errors.New("morus: stream segment duplicated")
+
+	// ErrSegmentReordered is the error returned when a stream contains
+	// a segment out of order.
+	ErrSegmentReordered = errors.New("morus: stream segment reordered")
+
+	// ErrTrailingData is the error returned when a stream contains data
+	// after a full sized final segment.  Trailing data after a short final
+	// segment is indistinguishable from a corrupted segment, and results
+	// in ErrOpen.
+	ErrTrailingData = errors.New("morus: stream has trailing data")
+
+	errStreamClosed = errors.New("morus: stream closed")
+	errStreamTooBig = errors.New("morus: stream segment counter overflow")
+)
+
+func initSegmentNonce(nonce *[NonceSize]byte, noncePrefix []byte) {
+	if len(noncePrefix) != NoncePrefixSize {
+		panic(ErrInvalidNonceSize)
+	}
+	copy(nonce[:], noncePrefix)
+}
+
+// Writer is an io.WriteCloser that encrypts and authenticates data in
+// segments, such that truncation, reordering and duplication of segments
+// are detected when read back via Reader.  Calling Reset once the stream is
+// closed purges the key from memory.
+type Writer struct {
+	w     io.Writer
+	aead  *AEAD
+	nonce [NonceSize]byte
+	buf   []byte
+	ctr   uint32
+	err   error
+}
+
+// Write encrypts p, and writes the resulting segments to the underlying
+// io.Writer.  The final segment is only written on Close.
+func (wr *Writer) Write(p []byte) (int, error) {
+	wr.checkReset()
+
+	var n int
+	for len(p) > 0 {
+		if wr.err != nil {
+			return n, wr.err
+		}
+
+		// Only flush a full segment once it is known that there is more
+		// data, as the final segment needs to be flagged as such.
+		if len(wr.buf) == SegmentSize {
+			wr.err = wr.flush(false)
+			continue
+		}
+
+		sz := copy(wr.buf[len(wr.buf):SegmentSize], p)
+		wr.buf = wr.buf[:len(wr.buf)+sz]
+		n += sz
+		p = p[sz:]
+	}
+	return n, wr.err
+}
+
+// Close writes the final segment to the underlying io.Writer.  It does not
+// close the underlying io.Writer.  Calling Close again after it succeeds
+// does nothing.
+func (wr *Writer) Close() error {
+	wr.checkReset()
+
+	if wr.err == errStreamClosed {
+		return nil
+	}
+	if wr.err != nil {
+		return wr.err
+	}
+	wr.err = wr.flush(true)
+	if wr.err == nil {
+		wr.err = errStreamClosed
+		return nil
+	}
+	return wr.err
+}
+
+// Reset securely purges stored sensitive data, including any data that has
+// not been written to the underlying io.Writer yet, from the Writer.  Any
+// further use of the Writer, other than calling Reset again, will panic with
+// ErrInvalidState.
+func (wr *Writer) Reset() {
+	if wr.aead == nil {
+		return
+	}
+
+	wr.aead.Reset()
+	wr.aead = nil
+	burnBytes(wr.buf[:cap(wr.buf)])
+	wr.buf = nil
+	burnBytes(wr.nonce[:])
+}
+
+func (wr *Writer) checkReset() {
+	if wr.aead == nil {
+		panic(ErrInvalidState)
+	}
+}
+
+func (wr *Writer) flush(final bool) error {
+	if !final && wr.ctr == math.MaxUint32 {
+		return errStreamTooBig
+	}
+
+	hdr := wr.nonce[NoncePrefixSize:]
+	binary.BigEndian.PutUint32(hdr[0:4], wr.ctr)
+	if final {
+		hdr[4] = flagFinalSegment
+	}
+
+	out := make([]byte, 0, segmentHeaderSize+len(wr.buf)+TagSize)
+	out = append(out, hdr...)
+	out = wr.aead.Seal(out, wr.nonce[:], wr.buf, nil)
+	burnBytes(wr.buf[:cap(wr.buf)])
+	wr.buf = wr.buf[:0]
+
+	if _, err := wr.w.Write(out); err != nil {
+		return err
+	}
+	wr.ctr++
+	return nil
+}
+
+// NewWriter returns a new Writer that writes the encrypted stream to w,
+// keyed with a MORUS-1280-256 key and a NoncePrefixSize byte nonce prefix,
+// that must be unique for all time, for a given key.
+func NewWriter(w io.Writer, key, noncePrefix []byte) *Writer {
+	wr := &Writer{
+		w:    w,
+		aead: New(key),
+		buf:  make([]byte, 0, SegmentSize),
+	}
+	initSegmentNonce(&wr.nonce, noncePrefix)
+	return wr
+}
+
+// Reader is an io.Reader that decrypts and authenticates a stream written
+// by Writer.  Only plaintext from authenticated segments is returned.
+// Calling Reset once the stream is read purges the key from memory.
+type Reader struct {
+	r     io.Reader
+	aead  *AEAD
+	nonce [NonceSize]byte
+	buf   []byte
+	off   int
+	ctr   uint32
+	final bool
+	err   error
+}
+
+// Read reads up to len(p) bytes of authenticated plaintext into p.  Errors
+// that indicate a malformed stream are persistent.
+func (rd *Reader) Read(p []byte) (int, error) {
+	rd.checkReset()
+
+	for rd.off == len(rd.buf) {
+		if rd.err != nil {
+			return 0, rd.err
+		}
+		rd.err = rd.readSegment()
+	}
+
+	n := copy(p, rd.buf[rd.off:])
+	rd.off += n
+	return n, nil
+}
+
+// Reset securely purges stored sensitive data, including any buffered
+// plaintext, from the Reader.  Any further use of the Reader, other than
+// calling Reset again, will panic with ErrInvalidState.
+func (rd *Reader) Reset() {
+	if rd.aead == nil {
+		return
+	}
+
+	rd.aead.Reset()
+	rd.aead = nil
+	burnBytes(rd.buf[:cap(rd.buf)])
+	rd.buf, rd.off = nil, 0
+	burnBytes(rd.nonce[:])
+}
+
+func (rd *Reader) checkReset() {
+	if rd.aead == nil {
+		panic(ErrInvalidState)
+	}
+}
+
+func (rd *Reader) readSegment() error {
+	if rd.final {
+		var tmp [1]byte
+		if _, err := io.ReadFull(rd.r, tmp[:]); err != nil {
+			return err
+		}
+		return ErrTrailingData
+	}
+
+	hdr := rd.nonce[NoncePrefixSize:]
+	if _, err := io.ReadFull(rd.r, hdr); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return ErrTruncated
+		}
+		return err
+	}
+	ctr, flag := binary.BigEndian.Uint32(hdr[0:4]), hdr[4]
+	if flag&^flagFinalSegment != 0 {
+		return ErrOpen
+	}
+
+	// Only the final segment is allowed to be short.
+	b := rd.buf[:SegmentSize+TagSize]
+	n, err := io.ReadFull(rd.r, b)
+	switch err {
+	case nil:
+	case io.EOF, io.ErrUnexpectedEOF:
+		if flag != flagFinalSegment {
+			return ErrTruncated
+		}
+	default:
+		return err
+	}
+
+	rd.buf, err = rd.aead.Open(b[:0], rd.nonce[:], b[:n], nil)
+	rd.off = 0
+	if err != nil {
+		return err
+	}
+	switch {
+	case ctr < rd.ctr:
+		rd.buf = rd.buf[:0]
+		return ErrSegmentDuplicated
+	case ctr > rd.ctr:
+		rd.buf = rd.buf[:0]
+		return ErrSegmentReordered
+	}
+
+	rd.final = flag == flagFinalSegment
+	if !rd.final {
+		rd.ctr++
+	}
+	return nil
+}
+
+// NewReader returns a new Reader that reads the encrypted stream from r,
+// keyed with a MORUS-1280-256 key and a NoncePrefixSize byte nonce prefix.
+func NewReader(r io.Reader, key, noncePrefix []byte) *Reader {
+	rd := &Reader{
+		r:    r,
+		aead: New(key),
+		buf:  make([]byte, 0, SegmentSize+TagSize),
+	}
+	initSegmentNonce(&rd.nonce, noncePrefix)
+	return rd
+}
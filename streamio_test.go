@@ -0,0 +1,143 @@
+// streamio_test.go - Segmented online encryption tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const encSegmentSize = segmentHeaderSize + SegmentSize + TagSize
+
+func TestStreamIO(t *testing.T) {
+	require := require.New(t)
+
+	var key [KeySize]byte
+	var noncePrefix [NoncePrefixSize]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(noncePrefix[:])
+	require.NoError(err, "rand.Read(noncePrefix)")
+
+	seal := func(m []byte) []byte {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, key[:], noncePrefix[:])
+		for _, chunk := range randomChunks(m) {
+			n, err := w.Write(chunk)
+			require.NoError(err, "Write()")
+			require.Len(chunk, n, "Write(): n")
+		}
+		require.NoError(w.Close(), "Close()")
+		require.NoError(w.Close(), "Close(): Repeated")
+		return buf.Bytes()
+	}
+	open := func(c []byte) ([]byte, error) {
+		return ioutil.ReadAll(NewReader(bytes.NewReader(c), key[:], noncePrefix[:]))
+	}
+
+	for _, sz := range []int{0, 1, SegmentSize - 1, SegmentSize, SegmentSize + 1, 3 * SegmentSize} {
+		m := make([]byte, sz)
+		_, _ = rand.Read(m)
+
+		c := seal(m)
+		nSegs := 1
+		if sz > SegmentSize {
+			nSegs = (sz + SegmentSize - 1) / SegmentSize
+		}
+		require.Len(c, sz+nSegs*(segmentHeaderSize+TagSize), "Writer: len(c) %d", sz)
+
+		d, err := open(c)
+		require.NoError(err, "Reader: %d", sz)
+		require.Equal(m, append([]byte{}, d...), "Reader: %d", sz)
+
+		// Test malformed ciphertext.
+		badC := append([]byte{}, c...)
+		badC[len(badC)/2] ^= 0x23
+		_, err = open(badC)
+		require.Equal(ErrOpen, err, "Reader(Bad c): %d", sz)
+
+		// Test trailing data.  If the final segment is short, the trailing
+		// data is treated as part of the segment.
+		_, err = open(append(append([]byte{}, c...), 0x23))
+		if sz > 0 && sz%SegmentSize == 0 {
+			require.Equal(ErrTrailingData, err, "Reader(Trailing data): %d", sz)
+		} else {
+			require.Equal(ErrOpen, err, "Reader(Trailing data): %d", sz)
+		}
+
+		// Test truncation.
+		if nSegs > 1 {
+			_, err = open(c[:(nSegs-1)*encSegmentSize])
+			require.Equal(ErrTruncated, err, "Reader(Truncated): %d", sz)
+		}
+		_, err = open(c[:segmentHeaderSize-1])
+		require.Equal(ErrTruncated, err, "Reader(Truncated header): %d", sz)
+	}
+
+	// Test reordering and duplication.
+	m := make([]byte, 2*SegmentSize+1)
+	_, _ = rand.Read(m)
+	c := seal(m)
+	seg0, seg1, seg2 := c[:encSegmentSize], c[encSegmentSize:2*encSegmentSize], c[2*encSegmentSize:]
+
+	_, err = open(bytes.Join([][]byte{seg1, seg0, seg2}, nil))
+	require.Equal(ErrSegmentReordered, err, "Reader(Reordered)")
+	_, err = open(bytes.Join([][]byte{seg0, seg0, seg1, seg2}, nil))
+	require.Equal(ErrSegmentDuplicated, err, "Reader(Duplicated)")
+	_, err = open(bytes.Join([][]byte{seg0, seg2}, nil))
+	require.Equal(ErrSegmentReordered, err, "Reader(Dropped)")
+
+	// Test that only authenticated plaintext is released.
+	badC := append([]byte{}, c...)
+	badC[len(badC)-1] ^= 0x23
+	d, err := open(badC)
+	require.Equal(ErrOpen, err, "Reader(Bad final segment)")
+	require.Equal(m[:2*SegmentSize], d, "Reader(Bad final segment): plaintext")
+}
+
+func TestStreamIOReset(t *testing.T) {
+	require := require.New(t)
+
+	key := bytes.Repeat([]byte{0x23}, KeySize)
+	noncePrefix := make([]byte, NoncePrefixSize)
+	m := bytes.Repeat([]byte{0x42}, SegmentSize+1)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, key, noncePrefix)
+	_, err := w.Write(m)
+	require.NoError(err, "Write()")
+	require.NoError(w.Close(), "Close()")
+
+	aead := w.aead
+	w.Reset()
+	require.Nil(aead.key, "Writer.Reset(): key")
+	require.Nil(w.buf, "Writer.Reset(): buf")
+	require.Equal([NonceSize]byte{}, w.nonce, "Writer.Reset(): nonce")
+	require.NotPanics(w.Reset, "Writer.Reset(): Repeated")
+	require.PanicsWithValue(ErrInvalidState, func() { _, _ = w.Write(m) }, "Writer.Write(): After Reset")
+	require.PanicsWithValue(ErrInvalidState, func() { _ = w.Close() }, "Writer.Close(): After Reset")
+
+	r := NewReader(bytes.NewReader(buf.Bytes()), key, noncePrefix)
+	d := make([]byte, 1)
+	_, err = r.Read(d)
+	require.NoError(err, "Read()")
+	require.Equal(m[:1], d, "Read()")
+
+	aead = r.aead
+	rbuf := r.buf[:cap(r.buf)]
+	r.Reset()
+	require.Nil(aead.key, "Reader.Reset(): key")
+	require.Equal(make([]byte, len(rbuf)), rbuf, "Reader.Reset(): buffered plaintext")
+	require.Equal([NonceSize]byte{}, r.nonce, "Reader.Reset(): nonce")
+	require.NotPanics(r.Reset, "Reader.Reset(): Repeated")
+	require.PanicsWithValue(ErrInvalidState, func() { _, _ = r.Read(d) }, "Reader.Read(): After Reset")
+}
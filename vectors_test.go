@@ -0,0 +1,155 @@
+// vectors_test.go - JSON test vector tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The test vectors follow the schema used by Project Wycheproof for AEAD
+// test vectors (`aead_test_schema.json`), and are generated by
+// `testdata/gen_vectors.py`.  The script computes them with a Python model
+// of MORUS that shares no code with this package, and refuses to run unless
+// the model reproduces the SUPERCOP derived MORUS-1280-256 KAT.  There is no
+// such anchor for MORUS-640-128.
+
+type hexBytes []byte
+
+func (b *hexBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
+type aeadTestVectors struct {
+	Algorithm     string            `json:"algorithm"`
+	NumberOfTests int               `json:"numberOfTests"`
+	TestGroups    []aeadTestGroup   `json:"testGroups"`
+	Notes         map[string]string `json:"notes"`
+}
+
+type aeadTestGroup struct {
+	IvSize  int            `json:"ivSize"`
+	KeySize int            `json:"keySize"`
+	TagSize int            `json:"tagSize"`
+	Type    string         `json:"type"`
+	Tests   []aeadTestCase `json:"tests"`
+}
+
+type aeadTestCase struct {
+	TcID    int      `json:"tcId"`
+	Comment string   `json:"comment"`
+	Key     hexBytes `json:"key"`
+	IV      hexBytes `json:"iv"`
+	AAD     hexBytes `json:"aad"`
+	Msg     hexBytes `json:"msg"`
+	CT      hexBytes `json:"ct"`
+	Tag     hexBytes `json:"tag"`
+	Result  string   `json:"result"`
+	Flags   []string `json:"flags"`
+}
+
+func loadAEADTestVectors(t *testing.T, fn string) *aeadTestVectors {
+	require := require.New(t)
+
+	b, err := ioutil.ReadFile(filepath.Join("testdata", fn))
+	require.NoError(err, "ReadFile(%s)", fn)
+
+	var vectors aeadTestVectors
+	err = json.Unmarshal(b, &vectors)
+	require.NoError(err, "Unmarshal(%s)", fn)
+
+	n := 0
+	for _, group := range vectors.TestGroups {
+		require.Equal("AeadTest", group.Type, "%s: Type", fn)
+		require.Equal(NonceSize*8, group.IvSize, "%s: ivSize", fn)
+		require.Equal(TagSize*8, group.TagSize, "%s: tagSize", fn)
+		for _, tc := range group.Tests {
+			for _, flag := range tc.Flags {
+				require.Contains(vectors.Notes, flag, "%s: tcId %d: Undocumented flag", fn, tc.TcID)
+			}
+		}
+		n += len(group.Tests)
+	}
+	require.Equal(vectors.NumberOfTests, n, "%s: numberOfTests", fn)
+
+	return &vectors
+}
+
+func TestVectors1280(t *testing.T) {
+	vectors := loadAEADTestVectors(t, "morus1280_test.json")
+
+	for _, name := range Implementations() {
+		t.Run("MORUS-1280_"+name, func(t *testing.T) {
+			doTestVectors(t, vectors, func(key []byte) cipher.AEAD {
+				var aead *AEAD
+				if len(key) == KeySize128 {
+					aead = New128(key)
+				} else {
+					aead = New(key)
+				}
+				require.NoError(t, aead.SetImplementation(name), "SetImplementation(%s)", name)
+				return aead
+			})
+		})
+	}
+}
+
+func TestVectors640(t *testing.T) {
+	vectors := loadAEADTestVectors(t, "morus640_test.json")
+
+	doTestVectors(t, vectors, func(key []byte) cipher.AEAD {
+		return New640(key)
+	})
+}
+
+func doTestVectors(t *testing.T, vectors *aeadTestVectors, newFn func([]byte) cipher.AEAD) {
+	require := require.New(t)
+
+	for _, group := range vectors.TestGroups {
+		for _, tc := range group.Tests {
+			require.Len(tc.Key, group.KeySize/8, "tcId %d: len(key)", tc.TcID)
+
+			aead := newFn(tc.Key)
+			ct := append(append([]byte{}, tc.CT...), tc.Tag...)
+
+			switch tc.Result {
+			case "valid":
+				c := aead.Seal(nil, tc.IV, tc.Msg, tc.AAD)
+				require.Equal(ct, c, "tcId %d: Seal(): %s", tc.TcID, tc.Comment)
+
+				m, err := aead.Open(nil, tc.IV, ct, tc.AAD)
+				require.NoError(err, "tcId %d: Open(): %s", tc.TcID, tc.Comment)
+				require.Len(m, len(tc.Msg), "tcId %d: Open(): len(m) %s", tc.TcID, tc.Comment)
+				if len(m) != 0 {
+					require.Equal([]byte(tc.Msg), m, "tcId %d: Open(): %s", tc.TcID, tc.Comment)
+				}
+			case "invalid":
+				m, err := aead.Open(nil, tc.IV, ct, tc.AAD)
+				require.Equal(ErrOpen, err, "tcId %d: Open(): %s", tc.TcID, tc.Comment)
+				require.Nil(m, "tcId %d: Open(): %s", tc.TcID, tc.Comment)
+			default:
+				t.Fatalf("tcId %d: unknown result: %s", tc.TcID, tc.Result)
+			}
+		}
+	}
+}
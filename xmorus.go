@@ -0,0 +1,151 @@
+// xmorus.go - Extended nonce XMORUS
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import "crypto/cipher"
+
+// NonceSizeX is the size of a XMORUS nonce in bytes.
+const NonceSizeX = 32
+
+var _ cipher.AEAD = (*XAEAD)(nil)
+
+// XAEAD is a XMORUS instance, implementing crypto/cipher.AEAD.
+//
+// XMORUS is not part of the MORUS specification.  It is an extended nonce
+// variant of MORUS-1280-256, analogous to XChaCha20-Poly1305, where the
+// first NonceSize bytes of the nonce are used to derive a per-message
+// subkey, and the remaining NonceSize bytes are used as the nonce for
+// MORUS-1280-256 under the subkey.  The nonce is large enough that it is
+// safe to generate it randomly.
+type XAEAD struct {
+	key []byte
+}
+
+// NonceSize returns the size of the nonce that must be passed to Seal and
+// Open.
+func (ae *XAEAD) NonceSize() int {
+	return NonceSizeX
+}
+
+// Overhead returns the maximum difference between the lengths of a plaintext
+// and its ciphertext.
+func (ae *XAEAD) Overhead() int {
+	return TagSize
+}
+
+// Seal encrypts and authenticates plaintext, authenticates the
+// additional data and appends the result to dst, returning the updated
+// slice. The nonce must be NonceSize() bytes long and unique for all
+// time, for a given key.
+//
+// The plaintext and dst must overlap exactly or not at all. To reuse
+// plaintext's storage for the encrypted output, use plaintext[:0] as dst.
+func (ae *XAEAD) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	var subKey [KeySize]byte
+
+	ae.checkKey()
+
+	if len(nonce) != NonceSizeX {
+		panic(ErrInvalidNonceSize)
+	}
+	impl := hardwareAccelImpl
+
+	hMORUS(impl, subKey[:], ae.key, nonce[:NonceSize])
+	dst = impl.seal(dst, plaintext, additionalData, nonce[NonceSize:], subKey[:], TagSize)
+	burnBytes(subKey[:])
+
+	return dst
+}
+
+// Open decrypts and authenticates ciphertext, authenticates the
+// additional data and, if successful, appends the resulting plaintext
+// to dst, returning the updated slice. The nonce must be NonceSize()
+// bytes long and both it and the additional data must match the
+// value passed to Seal.
+//
+// The ciphertext and dst must overlap exactly or not at all. To reuse
+// ciphertext's storage for the decrypted output, use ciphertext[:0] as dst.
+//
+// Even if the function fails, the contents of dst, up to its capacity,
+// may be overwritten.
+func (ae *XAEAD) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	var subKey [KeySize]byte
+	var err error
+	var ok bool
+
+	ae.checkKey()
+
+	if len(nonce) != NonceSizeX {
+		panic(ErrInvalidNonceSize)
+	}
+	impl := hardwareAccelImpl
+
+	hMORUS(impl, subKey[:], ae.key, nonce[:NonceSize])
+	dst, ok = impl.open(dst, ciphertext, additionalData, nonce[NonceSize:], subKey[:], TagSize)
+	burnBytes(subKey[:])
+	if !ok {
+		err = ErrOpen
+	}
+	return dst, err
+}
+
+// Reset securely purges stored sensitive data from the XAEAD instance.  Any
+// further use of the instance, other than calling Reset again, will panic
+// with ErrInvalidState.
+func (ae *XAEAD) Reset() {
+	if ae.key == nil {
+		return
+	}
+
+	burnBytes(ae.key)
+	ae.key = nil
+}
+
+func (ae *XAEAD) checkKey() {
+	if ae.key == nil {
+		panic(ErrInvalidState)
+	}
+}
+
+// NewX returns a new keyed XMORUS instance.
+func NewX(key []byte) *XAEAD {
+	if len(key) != KeySize {
+		panic(ErrInvalidKeySize)
+	}
+	return &XAEAD{key: append([]byte{}, key...)}
+}
+
+// hMORUS derives a KeySize byte subkey from a key and a NonceSize byte
+// nonce.
+//
+// The state is initialized and finalized as with MORUS-1280-256, with no
+// additional data or message, except that the length block used during
+// finalization has the least significant bit set.  As MORUS always encodes
+// lengths in bits, this is not a value that can occur otherwise.  The
+// entire combined output row is used as the subkey, rather than only the
+// first half of it as with the tag.
+func hMORUS(impl *hwaccelImpl, subKey, key, nonce []byte) {
+	var s state
+	var tmp [blockSize]byte
+
+	impl.initFn(&s, key, nonce)
+
+	tmp[0] = 0x01
+	s.s[16] ^= s.s[0]
+	s.s[17] ^= s.s[1]
+	s.s[18] ^= s.s[2]
+	s.s[19] ^= s.s[3]
+	for i := 0; i < 10; i++ {
+		s.update(tmp[:])
+	}
+
+	burnBytes(tmp[:])
+	s.decryptBlockCommon(subKey, tmp[:])
+
+	burnUint64s(s.s[:])
+}
@@ -0,0 +1,105 @@
+// xmorus_test.go - XMORUS tests
+//
+// To the extent possible under law, Yawning Angel has waived all copyright
+// and related or neighboring rights to the software, using the Creative
+// Commons "CC0" public domain dedication. See LICENSE or
+// <http://creativecommons.org/publicdomain/zero/1.0/> for full details.
+
+package morus
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXMORUS(t *testing.T) {
+	require := require.New(t)
+	defer initHardwareAcceleration()
+
+	var key [KeySize]byte
+	var nonce [NonceSizeX]byte
+	_, err := rand.Read(key[:])
+	require.NoError(err, "rand.Read(key)")
+	_, err = rand.Read(nonce[:])
+	require.NoError(err, "rand.Read(nonce)")
+
+	aead := NewX(key[:])
+	require.Equal(NonceSizeX, aead.NonceSize(), "NonceSize()")
+	require.Equal(TagSize, aead.Overhead(), "Overhead()")
+
+	var subKey [KeySize]byte
+	hMORUS(implReference, subKey[:], key[:], nonce[:NonceSize])
+
+	for _, sz := range []int{0, 1, 32, 33, 1024} {
+		m, a := make([]byte, sz), make([]byte, sz/3)
+		_, _ = rand.Read(m)
+		_, _ = rand.Read(a)
+
+		hardwareAccelImpl = portableImpls[0]
+		expected := New(subKey[:]).Seal(nil, nonce[NonceSize:], m, a)
+
+		for _, impl := range supportedImpls() {
+			hardwareAccelImpl = impl
+			c := aead.Seal(nil, nonce[:], m, a)
+			require.Equal(expected, c, "Seal(%s): %d", impl.name, sz)
+
+			d, err := aead.Open(nil, nonce[:], c, a)
+			require.NoError(err, "Open(%s): %d", impl.name, sz)
+			require.Len(d, sz, "Open(%s): len(d) %d", impl.name, sz)
+			if sz != 0 {
+				require.Equal(m, d, "Open(%s): %d", impl.name, sz)
+			}
+
+			// Test malformed nonce, in both halves.
+			for _, i := range []int{0, NonceSize} {
+				badN := append([]byte{}, nonce[:]...)
+				badN[i] ^= 0x23
+				d, err = aead.Open(nil, badN, c, a)
+				require.Equal(ErrOpen, err, "Open(%s, Bad n[%d]): %d", impl.name, i, sz)
+				require.Nil(d, "Open(%s, Bad n[%d]): %d", impl.name, i, sz)
+			}
+		}
+	}
+
+	require.Panics(func() { aead.Seal(nil, nonce[:NonceSize], nil, nil) }, "Seal(Short nonce)")
+}
+
+func TestHMORUS(t *testing.T) {
+	require := require.New(t)
+
+	// The expected subkeys were computed with an independent model of the
+	// MORUS-1280 state update, that reproduces the MORUS-1280-256 KAT.
+	for _, v := range []struct {
+		key    string
+		nonce  string
+		subKey string
+	}{
+		{
+			"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			"000102030405060708090a0b0c0d0e0f",
+			"7abb169c80cd8ee7cd9dfe1b7303b415a614f565bd0e793a25655448e28936ba",
+		},
+		{
+			"808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f",
+			"f0f1f2f3f4f5f6f7f8f9fafbfcfdfeff",
+			"c41ba3e8ce6441aad1587632a29655a361a3c151f3656affa596080ef27c9758",
+		},
+	} {
+		key, _ := hex.DecodeString(v.key)
+		nonce, _ := hex.DecodeString(v.nonce)
+		for _, impl := range supportedImpls() {
+			var subKey [KeySize]byte
+			hMORUS(impl, subKey[:], key, nonce)
+			require.Equal(v.subKey, hex.EncodeToString(subKey[:]), "hMORUS(%s): %s", impl.name, v.nonce)
+		}
+	}
+}
+
+func TestXMORUSLifecycle(t *testing.T) {
+	aead := NewX(bytes.Repeat([]byte{0x23}, KeySize))
+	doTestResetLifecycle(t, aead, aead.key)
+}